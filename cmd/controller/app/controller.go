@@ -41,6 +41,7 @@ import (
 	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
 	"github.com/cert-manager/cert-manager/internal/apis/config/shared"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	"github.com/cert-manager/cert-manager/internal/dnsglob"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	"github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/clusterissuers"
@@ -52,6 +53,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/server/tls"
 	"github.com/cert-manager/cert-manager/pkg/server/tls/authority"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/pkg/util/profiling"
 )
 
@@ -66,7 +68,10 @@ const (
 	defaultReadHeaderTimeout = 32 * time.Second
 )
 
-func Run(rootCtx context.Context, opts *config.ControllerConfiguration) error {
+// Run starts the cert-manager controller. If reload is not nil, the
+// configuration is re-loaded using it whenever the process receives SIGHUP and
+// the settings which can be changed at runtime are applied.
+func Run(rootCtx context.Context, opts *config.ControllerConfiguration, reload ConfigLoader) error {
 	rootCtx, cancelContext := context.WithCancel(rootCtx)
 	defer cancelContext()
 
@@ -85,9 +90,21 @@ func Run(rootCtx context.Context, opts *config.ControllerConfiguration) error {
 		return err
 	}
 
+	if reload != nil {
+		reloader := newConfigReloader(log, opts, ctxFactory)
+		g.Go(func() error {
+			reloader.watch(rootCtx, reload)
+			return nil
+		})
+	}
+
 	enabledControllers := options.EnabledControllers(opts)
 	log.Info(fmt.Sprintf("enabled controllers: %s", sets.List(enabledControllers)))
 
+	if opts.RenewalsPaused {
+		log.V(logf.WarnLevel).Info("warning: renewals are paused, certificates will only be renewed when they are about to expire")
+	}
+
 	// start the CertificateSource if provided
 	certificateSource := buildCertificateSource(log, opts.MetricsTLSConfig, ctx.RESTConfig)
 	if certificateSource != nil {
@@ -313,6 +330,12 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 
 	ACMEHTTP01SolverRunAsNonRoot := opts.ACMEHTTP01Config.SolverRunAsNonRoot
 	acmeAccountRegistry := accounts.NewDefaultRegistry()
+	controllerMetrics := metrics.New(log, clock.RealClock{})
+
+	issuerRules, err := defaultIssuerRules(opts.IngressShimConfig.DefaultIssuerRules)
+	if err != nil {
+		return nil, err
+	}
 
 	ctxFactory, err := controller.NewContextFactory(ctx, controller.ContextOptions{
 		Kubeconfig:         opts.KubeConfig,
@@ -320,10 +343,13 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 		KubernetesAPIBurst: opts.KubernetesAPIBurst,
 		APIServerHost:      opts.APIServerHost,
 
+		InitialSyncItemsPerSecond: opts.InitialSyncItemsPerSecond,
+
 		Namespace: opts.Namespace,
 
-		Clock:   clock.RealClock{},
-		Metrics: metrics.New(log, clock.RealClock{}),
+		Clock:           clock.RealClock{},
+		Metrics:         controllerMetrics,
+		IssuanceLimiter: controller.NewIssuanceLimiter(opts.MaxConcurrentIssuances, controllerMetrics),
 
 		ACMEOptions: controller.ACMEOptions{
 			HTTP01SolverResourceRequestCPU:    http01SolverResourceRequestCPU,
@@ -339,6 +365,19 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 			DNS01CheckRetryPeriod:   opts.ACMEDNS01Config.CheckRetryPeriod,
 			DNS01CheckAuthoritative: !opts.ACMEDNS01Config.RecursiveNameserversOnly,
 
+			DNS01PreValidationURL:     opts.ACMEDNS01Config.PreValidationURL,
+			DNS01PreValidationCAFile:  opts.ACMEDNS01Config.PreValidationCAFile,
+			DNS01PreValidationTimeout: opts.ACMEDNS01Config.PreValidationTimeout,
+
+			DNS01CleanUpParallelism: opts.ACMEDNS01Config.CleanUpParallelism,
+
+			ChallengeProcessingTimeout: opts.ChallengeProcessingTimeout,
+
+			NamespaceOrdersPerHour: opts.NamespaceACMEOrdersPerHour,
+			NamespaceOrderBurst:    opts.NamespaceACMEOrderBurst,
+
+			AuthorizationRefreshWindow: opts.ACMEAuthorizationRefreshWindow,
+
 			AccountRegistry: acmeAccountRegistry,
 		},
 
@@ -357,11 +396,40 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 			DefaultIssuerKind:                 opts.IngressShimConfig.DefaultIssuerKind,
 			DefaultIssuerGroup:                opts.IngressShimConfig.DefaultIssuerGroup,
 			DefaultAutoCertificateAnnotations: opts.IngressShimConfig.DefaultAutoCertificateAnnotations,
+			DefaultIssuerRules:                issuerRules,
+			AllowedIngressClasses:             opts.IngressShimConfig.AllowedIngressClasses,
+			DeniedIngressClasses:              opts.IngressShimConfig.DeniedIngressClasses,
+			AllowedGatewayClasses:             opts.IngressShimConfig.AllowedGatewayClasses,
+			DeniedGatewayClasses:              opts.IngressShimConfig.DeniedGatewayClasses,
 		},
 
 		CertificateOptions: controller.CertificateOptions{
-			EnableOwnerRef:           opts.EnableCertificateOwnerRef,
-			CopiedAnnotationPrefixes: opts.CopiedAnnotationPrefixes,
+			EnableOwnerRef:                      opts.EnableCertificateOwnerRef,
+			CopiedAnnotationPrefixes:            opts.CopiedAnnotationPrefixes,
+			SecretTypeMismatchPolicy:            controller.SecretTypeMismatchPolicy(opts.SecretTypeMismatchPolicy),
+			IssuedCertificateVerificationPolicy: controller.IssuedCertificateVerificationPolicy(opts.IssuedCertificateVerificationPolicy),
+			SecretOwnershipConflictPolicy:       controller.SecretOwnershipConflictPolicy(opts.SecretOwnershipConflictPolicy),
+			SecretDeletionPolicy:                controller.SecretDeletionPolicy(opts.SecretDeletionPolicy),
+			SecretWritesPerSecond:               opts.SecretWritesPerSecond,
+			MaxConcurrentSecretWrites:           opts.MaxConcurrentSecretWrites,
+			SecretRecreateConflictRetries:       opts.SecretRecreateConflictRetries,
+			RenewalsPaused:                      opts.RenewalsPaused,
+			OCSPStapleRefreshInterval:           opts.OCSPStapleRefreshInterval,
+			DeprecatedCryptoPolicy: pki.DeprecatedCryptoPolicy{
+				MinimumRSAKeySize:        opts.DeprecatedCryptoMinimumRSAKeySize,
+				MinimumECDSAKeySize:      opts.DeprecatedCryptoMinimumECDSAKeySize,
+				MinimumSignatureHashSize: opts.DeprecatedCryptoMinimumSignatureHashSize,
+			},
+		},
+
+		ApproverOptions: controller.ApproverOptions{
+			CertificateRequestApprovalRules: certificateRequestApprovalRules(opts.CertificateRequestApprovalRules),
+		},
+
+		EventRecorderOptions: controller.EventRecorderOptions{
+			EventRecorderQPS:                 opts.EventRecorderQPS,
+			EventRecorderBurst:               opts.EventRecorderBurst,
+			EventRecorderDeduplicationWindow: opts.EventRecorderDeduplicationWindow,
 		},
 
 		ConfigOptions: controller.ConfigOptions{
@@ -375,6 +443,40 @@ func buildControllerContextFactory(ctx context.Context, opts *config.ControllerC
 	return ctxFactory, nil
 }
 
+// defaultIssuerRules converts the configured default issuer rules to the
+// rules used by the certificate-shim controllers, parsing their patterns.
+func defaultIssuerRules(rules []config.DefaultIssuerRule) ([]controller.DefaultIssuerRule, error) {
+	var out []controller.DefaultIssuerRule
+	for _, rule := range rules {
+		pattern, err := dnsglob.Parse(rule.DNSNamePattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid default issuer rule pattern %q: %w", rule.DNSNamePattern, err)
+		}
+		out = append(out, controller.DefaultIssuerRule{
+			DNSNamePattern: pattern,
+			IssuerName:     rule.IssuerName,
+			IssuerKind:     rule.IssuerKind,
+			IssuerGroup:    rule.IssuerGroup,
+		})
+	}
+	return out, nil
+}
+
+// certificateRequestApprovalRules converts the configured approval rules to
+// the rules used by the certificaterequests-approver controller.
+func certificateRequestApprovalRules(rules []config.CertificateRequestApprovalRule) []controller.CertificateRequestApprovalRule {
+	var out []controller.CertificateRequestApprovalRule
+	for _, rule := range rules {
+		out = append(out, controller.CertificateRequestApprovalRule{
+			IssuerName:  rule.IssuerName,
+			IssuerKind:  rule.IssuerKind,
+			IssuerGroup: rule.IssuerGroup,
+			Namespaces:  rule.Namespaces,
+		})
+	}
+	return out
+}
+
 func startLeaderElection(ctx context.Context, opts *config.ControllerConfiguration, leaderElectionClient kubernetes.Interface, recorder record.EventRecorder, callbacks leaderelection.LeaderCallbacks, healthzAdaptor *leaderelection.HealthzAdaptor) error {
 	// Identity used to distinguish between multiple controller manager instances
 	id, err := os.Hostname()
@@ -149,6 +149,14 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
 		"Kind of the Issuer to use when the tls is requested but issuer kind is not specified on the ingress resource.")
 	fs.StringVar(&c.IngressShimConfig.DefaultIssuerGroup, "default-issuer-group", c.IngressShimConfig.DefaultIssuerGroup, ""+
 		"Group of the Issuer to use when the tls is requested but issuer group is not specified on the ingress resource.")
+	fs.StringSliceVar(&c.IngressShimConfig.AllowedIngressClasses, "allowed-ingress-classes", c.IngressShimConfig.AllowedIngressClasses, ""+
+		"The IngressClasses for which the ingress-shim creates Certificates. If set, Ingresses of any other class, or without a class, are ignored.")
+	fs.StringSliceVar(&c.IngressShimConfig.DeniedIngressClasses, "denied-ingress-classes", c.IngressShimConfig.DeniedIngressClasses, ""+
+		"The IngressClasses for which the ingress-shim never creates Certificates. Takes precedence over --allowed-ingress-classes.")
+	fs.StringSliceVar(&c.IngressShimConfig.AllowedGatewayClasses, "allowed-gateway-classes", c.IngressShimConfig.AllowedGatewayClasses, ""+
+		"The GatewayClasses for which the gateway-shim creates Certificates. If set, Gateways of any other class are ignored.")
+	fs.StringSliceVar(&c.IngressShimConfig.DeniedGatewayClasses, "denied-gateway-classes", c.IngressShimConfig.DeniedGatewayClasses, ""+
+		"The GatewayClasses for which the gateway-shim never creates Certificates. Takes precedence over --allowed-gateway-classes.")
 
 	fs.StringSliceVar(&c.ACMEDNS01Config.RecursiveNameservers, "dns01-recursive-nameservers",
 		c.ACMEDNS01Config.RecursiveNameservers, "A list of comma separated dns server endpoints used for DNS01 and DNS-over-HTTPS (DoH) check requests. "+
@@ -165,10 +173,49 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
 	fs.DurationVar(&c.ACMEDNS01Config.CheckRetryPeriod, "dns01-check-retry-period", c.ACMEDNS01Config.CheckRetryPeriod, ""+
 		"The duration the controller should wait between a propagation check. Despite the name, this flag is used to configure the wait period for both DNS01 and HTTP01 challenge propagation checks. For DNS01 challenges the propagation check verifies that a TXT record with the challenge token has been created. For HTTP01 challenges the propagation check verifies that the challenge token is served at the challenge URL."+
 		"This should be a valid duration string, for example 180s or 1h")
+	fs.StringVar(&c.ACMEDNS01Config.PreValidationURL, "dns01-pre-validation-url", c.ACMEDNS01Config.PreValidationURL, ""+
+		"The URL of an optional HTTP endpoint called to confirm that a DNS01 challenge record exists, for example using the DNS provider's authoritative API, "+
+		"before the ACME server is asked to validate the challenge. The challenge is POSTed as JSON and the endpoint must respond with a 2xx status code once the record exists. "+
+		"Pre-validation is disabled if empty.")
+	fs.StringVar(&c.ACMEDNS01Config.PreValidationCAFile, "dns01-pre-validation-ca-file", c.ACMEDNS01Config.PreValidationCAFile, ""+
+		"Path to a file containing PEM encoded CA certificates used to verify the DNS01 pre-validation endpoint. The system trust store is used if empty.")
+	fs.DurationVar(&c.ACMEDNS01Config.PreValidationTimeout, "dns01-pre-validation-timeout", c.ACMEDNS01Config.PreValidationTimeout, ""+
+		"The maximum amount of time to wait for a response from the DNS01 pre-validation endpoint. "+
+		"This should be a valid duration string, for example 10s")
+	fs.IntVar(&c.ACMEDNS01Config.CleanUpParallelism, "dns01-cleanup-parallelism", c.ACMEDNS01Config.CleanUpParallelism, ""+
+		"The maximum number of DNS01 challenge records cleaned up at once. Records are cleaned up in the background, so that "+
+		"cleaning up the records of a certificate with many identifiers does not hold up other challenges. "+
+		"If 0, records are cleaned up by the challenge controller's workers.")
 
 	fs.BoolVar(&c.EnableCertificateOwnerRef, "enable-certificate-owner-ref", c.EnableCertificateOwnerRef, ""+
 		"Whether to set the certificate resource as an owner of secret where the tls certificate is stored. "+
 		"When this flag is enabled, the secret will be automatically removed when the certificate resource is deleted.")
+	fs.StringVar((*string)(&c.SecretTypeMismatchPolicy), "secret-type-mismatch-policy", string(c.SecretTypeMismatchPolicy), ""+
+		"How to handle an existing Certificate Secret which is not of type kubernetes.io/tls. "+
+		"'Preserve' keeps the existing Secret type, 'Fail' fails the issuance with a condition naming the type mismatch, "+
+		"and 'Recreate' deletes the Secret and recreates it with the type kubernetes.io/tls.")
+	fs.StringVar((*string)(&c.IssuedCertificateVerificationPolicy), "issued-certificate-verification-policy", string(c.IssuedCertificateVerificationPolicy), ""+
+		"How to handle a certificate returned by an issuer whose common name, subject alternative names or key usages do not match the CertificateRequest. "+
+		"'None' does not verify the certificate, 'Warn' records a warning event but stores the certificate, "+
+		"and 'Strict' rejects the certificate and marks the CertificateRequest as failed.")
+	fs.StringVar((*string)(&c.SecretOwnershipConflictPolicy), "secret-ownership-conflict-policy", string(c.SecretOwnershipConflictPolicy), ""+
+		"How to handle Certificates in the same namespace which share a secretName. "+
+		"'Owner' only issues the Certificate which owns the Secret, "+
+		"and 'Refuse' does not issue any of the Certificates until the conflict is resolved.")
+	fs.StringVar((*string)(&c.SecretDeletionPolicy), "secret-deletion-policy", string(c.SecretDeletionPolicy), ""+
+		"What happens to the Secret of a Certificate which does not set spec.secretDeletionPolicy when the Certificate is deleted. "+
+		"'Retain' leaves the Secret in place, unless it is owned by the Certificate, "+
+		"and 'Delete' deletes the Secret using a finalizer on the Certificate, regardless of --enable-certificate-owner-ref.")
+	fs.IntVar(&c.DeprecatedCryptoMinimumRSAKeySize, "deprecated-crypto-minimum-rsa-key-size", c.DeprecatedCryptoMinimumRSAKeySize, ""+
+		"The minimum size in bits of an RSA key which is not considered deprecated. Issued certificates with a smaller RSA key are reported "+
+		"with a warning Event and the certmanager_deprecated_crypto_total metric, but are not blocked. Set to 0 to not report RSA keys.")
+	fs.IntVar(&c.DeprecatedCryptoMinimumECDSAKeySize, "deprecated-crypto-minimum-ecdsa-key-size", c.DeprecatedCryptoMinimumECDSAKeySize, ""+
+		"The minimum size in bits of an ECDSA key which is not considered deprecated, one of 256, 384 or 521. "+
+		"Issued certificates for a key on a smaller curve are reported, but are not blocked. Set to 0 to not report ECDSA keys.")
+	fs.IntVar(&c.DeprecatedCryptoMinimumSignatureHashSize, "deprecated-crypto-minimum-signature-hash-size", c.DeprecatedCryptoMinimumSignatureHashSize, ""+
+		"The minimum size in bits of the hash used by the signature algorithm of an issued certificate which is not considered deprecated, "+
+		"one of 160, 224, 256, 384 or 512. For example, 256 reports certificates signed using SHA-1 or MD5. "+
+		"Issued certificates with a weaker signature are reported, but are not blocked. Set to 0 to not report signature algorithms.")
 	fs.BoolVar(&c.EnableGatewayAPI, "enable-gateway-api", c.EnableGatewayAPI, ""+
 		"Whether gateway API integration is enabled within cert-manager. The ExperimentalGatewayAPISupport "+
 		"feature gate must also be enabled (default as of 1.15).")
@@ -181,8 +228,53 @@ func AddConfigFlags(fs *pflag.FlagSet, c *config.ControllerConfiguration) {
 
 	fs.IntVar(&c.NumberOfConcurrentWorkers, "concurrent-workers", c.NumberOfConcurrentWorkers, ""+
 		"The number of concurrent workers for each controller.")
+	fs.Float32Var(&c.InitialSyncItemsPerSecond, "initial-sync-items-per-second", c.InitialSyncItemsPerSecond, ""+
+		"The rate, in items per second, at which each controller processes the backlog of items queued when its informers first sync, "+
+		"for example after a restart or a restore of many Certificates. Set to 0 to not throttle the initial sync.")
+	fs.IntVar(&c.MaxConcurrentIssuances, "max-concurrent-issuances", c.MaxConcurrentIssuances, ""+
+		"The maximum number of issuances which can be in flight at once across the certificaterequests and orders controllers. "+
+		"An issuance is in flight until its CertificateRequest or Order reaches a final state or is deleted, and issuances beyond the limit are retried once an issuance in flight has completed. "+
+		"Set to 0 to not limit the number of concurrent issuances.")
 	fs.IntVar(&c.MaxConcurrentChallenges, "max-concurrent-challenges", c.MaxConcurrentChallenges, ""+
 		"The maximum number of challenges that can be scheduled as 'processing' at once.")
+	fs.DurationVar(&c.ChallengeProcessingTimeout, "challenge-processing-timeout", c.ChallengeProcessingTimeout, ""+
+		"The maximum amount of time an accepted ACME challenge's authorization may remain 'processing' on the ACME server. "+
+		"Once exceeded, the authorization is deactivated and a fresh authorization is requested with a new order. "+
+		"This should be a valid duration string, for example 10m or 1h")
+	fs.IntVar(&c.NamespaceACMEOrdersPerHour, "namespace-acme-orders-per-hour", c.NamespaceACMEOrdersPerHour, ""+
+		"The maximum number of ACME orders per hour created for the Orders of a single namespace, so that a single namespace "+
+		"cannot use up the rate limits of a shared ACME account. Set to 0 to not limit the rate of ACME orders.")
+	fs.IntVar(&c.NamespaceACMEOrderBurst, "namespace-acme-order-burst", c.NamespaceACMEOrderBurst, ""+
+		"The maximum number of ACME orders created for the Orders of a single namespace in a burst, before the rate set by "+
+		"--namespace-acme-orders-per-hour applies. If 0, the burst is equal to --namespace-acme-orders-per-hour.")
+	fs.DurationVar(&c.ACMEAuthorizationRefreshWindow, "acme-authorization-refresh-window", c.ACMEAuthorizationRefreshWindow, ""+
+		"The window before the expiry of a valid ACME authorization in which the authorization is refreshed when it is reused "+
+		"by a new Order. The authorization is deactivated and validated again with a new ACME order, so that it does not expire "+
+		"mid-renewal. Must not be longer than 168h. Set to 0 to not refresh authorizations.")
+	fs.Float32Var(&c.SecretWritesPerSecond, "secret-writes-per-second", c.SecretWritesPerSecond, ""+
+		"The maximum number of Certificate Secret writes per second made by the certificates controller. "+
+		"Set to 0 to disable rate limiting of Secret writes.")
+	fs.IntVar(&c.MaxConcurrentSecretWrites, "max-concurrent-secret-writes", c.MaxConcurrentSecretWrites, ""+
+		"The maximum number of Certificate Secret writes made by the certificates controller which can be in flight at once. "+
+		"Set to 0 to not limit the number of concurrent Secret writes.")
+	fs.IntVar(&c.SecretRecreateConflictRetries, "secret-recreate-conflict-retries", c.SecretRecreateConflictRetries, ""+
+		"The number of times the certificates controller retries deleting a Certificate Secret of the wrong type which was replaced concurrently, "+
+		"when the 'Recreate' secret type mismatch policy is used. "+
+		"Set to 0 to not retry conflicting Secret deletions.")
+	fs.BoolVar(&c.RenewalsPaused, "renewals-paused", c.RenewalsPaused, ""+
+		"If true, the certificates controller does not trigger the renewal of Certificates, for example during a maintenance window. "+
+		"Certificates which are about to expire are still renewed. This can be changed at runtime by reloading the configuration.")
+	fs.DurationVar(&c.OCSPStapleRefreshInterval, "ocsp-staple-refresh-interval", c.OCSPStapleRefreshInterval, ""+
+		"The interval after which the OCSP response stored in the Secret of a Certificate which opts in to OCSP stapling is refreshed. "+
+		"This should be a valid duration string, for example 12h or 24h")
+	fs.Float32Var(&c.EventRecorderQPS, "event-recorder-qps", c.EventRecorderQPS, ""+
+		"The rate, in Events per second, at which the controllers may record Events about a single object once the burst has been used up. "+
+		"Events exceeding the rate are dropped rather than sent to the Kubernetes apiserver.")
+	fs.IntVar(&c.EventRecorderBurst, "event-recorder-burst", c.EventRecorderBurst, ""+
+		"The maximum number of Events the controllers may record about a single object in a burst.")
+	fs.DurationVar(&c.EventRecorderDeduplicationWindow, "event-recorder-deduplication-window", c.EventRecorderDeduplicationWindow, ""+
+		"The window within which similar Events recorded about the same object are aggregated into a single Event. "+
+		"This should be a valid duration string, for example 5m or 1h")
 
 	fs.StringVar(&c.MetricsListenAddress, "metrics-listen-address", c.MetricsListenAddress, ""+
 		"The host and port that the metrics endpoint should listen on.")
@@ -0,0 +1,169 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"reflect"
+	"syscall"
+
+	"github.com/go-logr/logr"
+	logsapi "k8s.io/component-base/logs/api/v1"
+
+	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
+	"github.com/cert-manager/cert-manager/internal/apis/config/controller/validation"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// ConfigLoader loads a new copy of the controller configuration from the same
+// flags and config file that the controller was started with.
+type ConfigLoader func() (*config.ControllerConfiguration, error)
+
+// runtimeConfigurable is implemented by the parts of the controller whose
+// settings can be changed while the controller is running.
+type runtimeConfigurable interface {
+	SetKubernetesAPIRateLimits(qps float32, burst int) error
+	SetMaxConcurrentChallenges(n int)
+	SetRenewalsPaused(paused bool)
+}
+
+// configReloader applies changes to the subset of the controller
+// configuration which can be changed without restarting the controller:
+// the logging verbosity, the Kubernetes API rate limits, the maximum number
+// of concurrent challenges and whether renewals are paused. Any other change
+// requires a restart and is ignored with a warning.
+type configReloader struct {
+	log          logr.Logger
+	current      *config.ControllerConfiguration
+	target       runtimeConfigurable
+	setVerbosity func(logsapi.VerbosityLevel) error
+}
+
+func newConfigReloader(log logr.Logger, cfg *config.ControllerConfiguration, target runtimeConfigurable) *configReloader {
+	return &configReloader{
+		log:          log.WithName("config-reloader"),
+		current:      cfg.DeepCopy(),
+		target:       target,
+		setVerbosity: logf.SetVerbosity,
+	}
+}
+
+// watch re-loads the configuration using load each time the process receives
+// SIGHUP, until the context is cancelled.
+func (r *configReloader) watch(ctx context.Context, load ConfigLoader) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGHUP)
+	defer signal.Stop(sigs)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sigs:
+			r.log.Info("received SIGHUP, reloading configuration")
+
+			cfg, err := load()
+			if err != nil {
+				r.log.Error(err, "failed to reload configuration, keeping the current configuration")
+				continue
+			}
+
+			if err := r.apply(cfg); err != nil {
+				r.log.Error(err, "failed to apply reloaded configuration")
+			}
+		}
+	}
+}
+
+// apply validates the new configuration and applies the settings which can
+// be changed at runtime.
+func (r *configReloader) apply(cfg *config.ControllerConfiguration) error {
+	if errs := validation.ValidateControllerConfiguration(cfg, nil); len(errs) > 0 {
+		return fmt.Errorf("error validating configuration: %w", errs.ToAggregate())
+	}
+
+	var errs []error
+
+	if cfg.Logging.Verbosity != r.current.Logging.Verbosity {
+		if err := r.setVerbosity(cfg.Logging.Verbosity); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set logging verbosity: %w", err))
+		} else {
+			r.log.Info("updated logging verbosity", "verbosity", cfg.Logging.Verbosity)
+			r.current.Logging.Verbosity = cfg.Logging.Verbosity
+		}
+	}
+
+	if cfg.KubernetesAPIQPS != r.current.KubernetesAPIQPS || cfg.KubernetesAPIBurst != r.current.KubernetesAPIBurst {
+		if err := r.target.SetKubernetesAPIRateLimits(cfg.KubernetesAPIQPS, cfg.KubernetesAPIBurst); err != nil {
+			errs = append(errs, fmt.Errorf("failed to set Kubernetes API rate limits: %w", err))
+		} else {
+			r.log.Info("updated Kubernetes API rate limits", "qps", cfg.KubernetesAPIQPS, "burst", cfg.KubernetesAPIBurst)
+			r.current.KubernetesAPIQPS = cfg.KubernetesAPIQPS
+			r.current.KubernetesAPIBurst = cfg.KubernetesAPIBurst
+		}
+	}
+
+	if cfg.MaxConcurrentChallenges != r.current.MaxConcurrentChallenges {
+		r.target.SetMaxConcurrentChallenges(cfg.MaxConcurrentChallenges)
+		r.log.Info("updated maximum number of concurrent challenges", "max_concurrent", cfg.MaxConcurrentChallenges)
+		r.current.MaxConcurrentChallenges = cfg.MaxConcurrentChallenges
+	}
+
+	if cfg.RenewalsPaused != r.current.RenewalsPaused {
+		r.target.SetRenewalsPaused(cfg.RenewalsPaused)
+		if cfg.RenewalsPaused {
+			r.log.V(logf.WarnLevel).Info("warning: renewals are now paused, certificates will only be renewed when they are about to expire")
+		} else {
+			r.log.Info("renewals have been resumed")
+		}
+		r.current.RenewalsPaused = cfg.RenewalsPaused
+	}
+
+	if ignored := restartRequiredChanges(r.current, cfg); len(ignored) > 0 {
+		r.log.Info("warning: ignoring changes to configuration fields which require a restart of the controller", "fields", ignored)
+	}
+
+	return errors.Join(errs...)
+}
+
+// restartRequiredChanges returns the names of the top level configuration
+// fields which differ between the two configurations, excluding the fields
+// which can be changed at runtime.
+func restartRequiredChanges(current, updated *config.ControllerConfiguration) []string {
+	updated = updated.DeepCopy()
+	updated.Logging.Verbosity = current.Logging.Verbosity
+	updated.KubernetesAPIQPS = current.KubernetesAPIQPS
+	updated.KubernetesAPIBurst = current.KubernetesAPIBurst
+	updated.MaxConcurrentChallenges = current.MaxConcurrentChallenges
+	updated.RenewalsPaused = current.RenewalsPaused
+
+	currentValue := reflect.ValueOf(current).Elem()
+	updatedValue := reflect.ValueOf(updated).Elem()
+
+	var changed []string
+	for i := 0; i < currentValue.NumField(); i++ {
+		if !reflect.DeepEqual(currentValue.Field(i).Interface(), updatedValue.Field(i).Interface()) {
+			changed = append(changed, currentValue.Type().Field(i).Name)
+		}
+	}
+
+	return changed
+}
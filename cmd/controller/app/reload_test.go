@@ -0,0 +1,194 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/go-logr/logr"
+	logsapi "k8s.io/component-base/logs/api/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/cert-manager/cert-manager/controller-binary/app/options"
+	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
+)
+
+type fakeRuntimeConfigurable struct {
+	qps                     float32
+	burst                   int
+	maxConcurrentChallenges int
+	renewalsPaused          bool
+}
+
+func (f *fakeRuntimeConfigurable) SetKubernetesAPIRateLimits(qps float32, burst int) error {
+	f.qps = qps
+	f.burst = burst
+	return nil
+}
+
+func (f *fakeRuntimeConfigurable) SetMaxConcurrentChallenges(n int) {
+	f.maxConcurrentChallenges = n
+}
+
+func (f *fakeRuntimeConfigurable) SetRenewalsPaused(paused bool) {
+	f.renewalsPaused = paused
+}
+
+func TestConfigReloaderApply(t *testing.T) {
+	current, err := options.NewControllerConfiguration()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	target := &fakeRuntimeConfigurable{}
+	var verbosity logsapi.VerbosityLevel
+	reloader := newConfigReloader(logr.Discard(), current, target)
+	reloader.setVerbosity = func(v logsapi.VerbosityLevel) error {
+		verbosity = v
+		return nil
+	}
+
+	updated := current.DeepCopy()
+	updated.Logging.Verbosity = 5
+	updated.KubernetesAPIQPS = 50
+	updated.KubernetesAPIBurst = 100
+	updated.MaxConcurrentChallenges = 10
+	updated.RenewalsPaused = true
+	updated.Namespace = "other"
+
+	if err := reloader.apply(updated); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if verbosity != 5 {
+		t.Errorf("expected verbosity 5, got %d", verbosity)
+	}
+	if exp := (fakeRuntimeConfigurable{qps: 50, burst: 100, maxConcurrentChallenges: 10, renewalsPaused: true}); *target != exp {
+		t.Errorf("expected %+v to be applied, got %+v", exp, *target)
+	}
+
+	// Structural changes are not applied
+	if reloader.current.Namespace != current.Namespace {
+		t.Errorf("expected namespace change to be ignored, got %q", reloader.current.Namespace)
+	}
+	if changed := restartRequiredChanges(reloader.current, updated); !reflect.DeepEqual(changed, []string{"Namespace"}) {
+		t.Errorf("expected only Namespace to require a restart, got %v", changed)
+	}
+
+	// An invalid configuration is rejected as a whole
+	invalid := updated.DeepCopy()
+	invalid.MaxConcurrentChallenges = 20
+	invalid.KubernetesAPIBurst = -1
+	if err := reloader.apply(invalid); err == nil {
+		t.Error("expected an error for an invalid configuration")
+	}
+	if target.maxConcurrentChallenges != 10 {
+		t.Errorf("expected invalid configuration not to be applied, got maxConcurrentChallenges %d", target.maxConcurrentChallenges)
+	}
+}
+
+// newReloadCommand returns the ConfigLoader which the controller command
+// built from args passes to its run function. As in main.go, the Go flags are
+// added to the command, including the klog flags and a flag which is not
+// known to the controller.
+func newReloadCommand(t *testing.T, args []string) ConfigLoader {
+	if err := logsapi.ResetForTest(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	goFlags := flag.NewFlagSet("test", flag.ContinueOnError)
+	klog.InitFlags(goFlags)
+	goFlags.String("test-go-flag", "", "")
+
+	var loader ConfigLoader
+	cmd := newServerCommand(context.TODO(), func(_ context.Context, _ *config.ControllerConfiguration, reload ConfigLoader) error {
+		loader = reload
+		return nil
+	}, args)
+	cmd.Flags().AddGoFlagSet(goFlags)
+	cmd.SetErr(io.Discard)
+	cmd.SetOut(io.Discard)
+
+	if err := cmd.ExecuteContext(context.TODO()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if loader == nil {
+		t.Fatal("expected a config loader when a config file is provided")
+	}
+
+	return loader
+}
+
+func TestReloadConfigFromFile(t *testing.T) {
+	configFilePath := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig := func(yaml string) {
+		if err := os.WriteFile(configFilePath, []byte(yaml), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	args := []string{
+		"--config=" + configFilePath,
+		"--kube-api-burst=80",
+		"-v=2",
+		"--logtostderr=true",
+		"--test-go-flag=value",
+	}
+
+	writeConfig(`
+apiVersion: controller.config.cert-manager.io/v1alpha1
+kind: ControllerConfiguration
+maxConcurrentChallenges: 10
+kubernetesAPIBurst: 40
+`)
+	reload := newReloadCommand(t, args)
+
+	cfg, err := reload()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.MaxConcurrentChallenges != 10 || cfg.KubernetesAPIBurst != 80 {
+		t.Errorf("expected maxConcurrentChallenges=10 and kubernetesAPIBurst=80, got %d and %d", cfg.MaxConcurrentChallenges, cfg.KubernetesAPIBurst)
+	}
+	if cfg.Logging.Verbosity != 2 {
+		t.Errorf("expected verbosity 2, got %d", cfg.Logging.Verbosity)
+	}
+
+	writeConfig(`
+apiVersion: controller.config.cert-manager.io/v1alpha1
+kind: ControllerConfiguration
+maxConcurrentChallenges: 20
+`)
+	cfg, err = reload()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.MaxConcurrentChallenges != 20 || cfg.KubernetesAPIBurst != 80 {
+		t.Errorf("expected maxConcurrentChallenges=20 and kubernetesAPIBurst=80, got %d and %d", cfg.MaxConcurrentChallenges, cfg.KubernetesAPIBurst)
+	}
+
+	writeConfig(`not: [valid`)
+	if _, err := reload(); err == nil {
+		t.Error("expected an error for an invalid config file")
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"path/filepath"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/cert-manager/cert-manager/controller-binary/app/options"
 	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
@@ -42,6 +43,7 @@ import (
 	_ "github.com/cert-manager/cert-manager/pkg/controller/issuers"
 	_ "github.com/cert-manager/cert-manager/pkg/issuer/acme"
 	_ "github.com/cert-manager/cert-manager/pkg/issuer/ca"
+	_ "github.com/cert-manager/cert-manager/pkg/issuer/googlecas"
 	_ "github.com/cert-manager/cert-manager/pkg/issuer/selfsigned"
 	_ "github.com/cert-manager/cert-manager/pkg/issuer/vault"
 	_ "github.com/cert-manager/cert-manager/pkg/issuer/venafi"
@@ -59,7 +61,7 @@ func NewServerCommand(ctx context.Context) *cobra.Command {
 
 func newServerCommand(
 	setupCtx context.Context,
-	run func(context.Context, *config.ControllerConfiguration) error,
+	run func(context.Context, *config.ControllerConfiguration, ConfigLoader) error,
 	allArgs []string,
 ) *cobra.Command {
 	log := logf.FromContext(setupCtx, componentController)
@@ -115,7 +117,15 @@ to renew certificates at an appropriate time before expiry.`,
 		},
 		// nolint:contextcheck // False positive
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return run(cmd.Context(), controllerConfig)
+			// The config file can only be re-read if one was provided.
+			var reload ConfigLoader
+			if len(controllerFlags.Config) > 0 {
+				reload = func() (*config.ControllerConfiguration, error) {
+					return reloadConfigFromFile(cmd, allArgs, controllerFlags.Config)
+				}
+			}
+
+			return run(cmd.Context(), controllerConfig, reload)
 		},
 	}
 
@@ -149,24 +159,10 @@ func loadConfigFromFile(
 	}
 
 	if len(configFilePath) > 0 {
-		// compute absolute path based on current working dir
-		controllerConfigFile, err := filepath.Abs(configFilePath)
-		if err != nil {
-			return fmt.Errorf("failed to load config file %s, error %v", configFilePath, err)
-		}
-
-		loader, err := configfile.NewConfigurationFSLoader(nil, controllerConfigFile)
-		if err != nil {
-			return fmt.Errorf("failed to load config file %s, error %v", configFilePath, err)
-		}
-
-		controllerConfigFromFile := controllerconfigfile.New()
-		if err := loader.Load(controllerConfigFromFile); err != nil {
-			return fmt.Errorf("failed to load config file %s, error %v", configFilePath, err)
+		if err := readConfigFile(configFilePath, cfg); err != nil {
+			return err
 		}
 
-		controllerConfigFromFile.Config.DeepCopyInto(cfg)
-
 		_, args, err := cmd.Root().Find(allArgs)
 		if err != nil {
 			return fmt.Errorf("failed to re-parse flags: %w", err)
@@ -183,3 +179,62 @@ func loadConfigFromFile(
 
 	return nil
 }
+
+// reloadConfigFromFile builds a new configuration from the defaults, the
+// config file and the provided flags, in the same order of precedence as
+// loadConfigFromFile. The configuration in use by the running controller is
+// not modified.
+// Flags of the command which are not part of the controller configuration,
+// such as the Go flags added in main.go, are re-parsed using the command's own
+// definitions so that they are accepted on every reload.
+func reloadConfigFromFile(cmd *cobra.Command, allArgs []string, configFilePath string) (*config.ControllerConfiguration, error) {
+	cfg, err := options.NewControllerConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	fs := pflag.NewFlagSet(componentController, pflag.ContinueOnError)
+	options.NewControllerFlags().AddFlags(fs)
+	options.AddConfigFlags(fs, cfg)
+	// AddFlagSet skips the flags which are already defined above, which are
+	// bound to the new configuration rather than to the running one.
+	fs.AddFlagSet(cmd.Flags())
+
+	if err := readConfigFile(configFilePath, cfg); err != nil {
+		return nil, err
+	}
+
+	_, args, err := cmd.Root().Find(allArgs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-parse flags: %w", err)
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, fmt.Errorf("failed to re-parse flags: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// readConfigFile decodes the config file at the provided path into cfg.
+func readConfigFile(configFilePath string, cfg *config.ControllerConfiguration) error {
+	// compute absolute path based on current working dir
+	controllerConfigFile, err := filepath.Abs(configFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to load config file %s, error %v", configFilePath, err)
+	}
+
+	loader, err := configfile.NewConfigurationFSLoader(nil, controllerConfigFile)
+	if err != nil {
+		return fmt.Errorf("failed to load config file %s, error %v", configFilePath, err)
+	}
+
+	controllerConfigFromFile := controllerconfigfile.New()
+	if err := loader.Load(controllerConfigFromFile); err != nil {
+		return fmt.Errorf("failed to load config file %s, error %v", configFilePath, err)
+	}
+
+	controllerConfigFromFile.Config.DeepCopyInto(cfg)
+
+	return nil
+}
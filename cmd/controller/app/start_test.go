@@ -53,7 +53,7 @@ func testCmdCommand(t *testing.T, tempDir string, yaml string, args func(string)
 	if err := logsapi.ResetForTest(nil); err != nil {
 		t.Error(err)
 	}
-	cmd := newServerCommand(context.TODO(), func(ctx context.Context, cc *config.ControllerConfiguration) error {
+	cmd := newServerCommand(context.TODO(), func(ctx context.Context, cc *config.ControllerConfiguration, _ ConfigLoader) error {
 		finalConfig = cc
 		return nil
 	}, args(tempFilePath))
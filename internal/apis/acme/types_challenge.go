@@ -128,4 +128,24 @@ type ChallengeStatus struct {
 	// State contains the current 'state' of the challenge.
 	// If not set, the state of the challenge is unknown.
 	State State
+
+	// Solver identifies the solver configuration that was selected for this
+	// challenge from the issuer's list of solvers.
+	// This field is set by the 'challenges' controller when the challenge is
+	// first presented.
+	Solver *ChallengeSolverStatus
+}
+
+// ChallengeSolverStatus identifies the solver configuration that was selected
+// for a challenge.
+type ChallengeSolverStatus struct {
+	// Type is the type of the selected solver, given as the path of the
+	// configured solver within the solver configuration, for example
+	// `http01.ingress`, `http01.gatewayHTTPRoute` or `dns01.route53`.
+	Type string
+
+	// Selector is the selector of the selected solver.
+	// If not set, the selected solver has no selector and is used for any
+	// challenge that a more specific solver does not match.
+	Selector *CertificateDNSNameSelector
 }
@@ -19,6 +19,7 @@ package acme
 import (
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwapi "sigs.k8s.io/gateway-api/apis/v1"
 
 	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
@@ -102,6 +103,12 @@ type ACMEIssuer struct {
 	// it, it will create an error on the Order.
 	// Defaults to false.
 	EnableDurationFeature bool
+
+	// HTTPHeaders are additional HTTP headers which are set on all requests
+	// made to the ACME server, for example to authenticate with an egress
+	// gateway. Headers which are managed by the ACME client, such as
+	// Content-Type, User-Agent and Authorization, may not be set.
+	HTTPHeaders map[string]string
 }
 
 // ACMEExternalAccountBinding is a reference to a CA external account of the ACME
@@ -184,6 +191,24 @@ type CertificateDNSNameSelector struct {
 	// If neither has more matches, the solver defined earlier in the list
 	// will be selected.
 	DNSZones []string
+
+	// List of DNS zones whose CNAME strategy overrides the `cnameStrategy`
+	// of this solver's dns01 configuration, for challenged domains within
+	// that zone. This allows a single solver to handle both delegated and
+	// non-delegated zones. If multiple zones match a domain, the most
+	// specific zone's strategy applies.
+	DNSZoneCNAMEStrategies []DNSZoneCNAMEStrategy
+}
+
+// DNSZoneCNAMEStrategy configures the CNAME strategy used for challenged
+// domains within a DNS zone.
+type DNSZoneCNAMEStrategy struct {
+	// The DNS zone that this strategy applies to, for example example.com.
+	DNSZone string
+
+	// CNAMEStrategy configures how the DNS01 provider should handle CNAME
+	// records when found for domains within the DNS zone.
+	CNAMEStrategy CNAMEStrategy
 }
 
 // ACMEChallengeSolverHTTP01 contains configuration detailing how to solve
@@ -204,6 +229,28 @@ type ACMEChallengeSolverHTTP01 struct {
 	// This solver is experimental, and fields / behaviour may change in the future.
 	// +optional
 	GatewayHTTPRoute *ACMEChallengeSolverHTTP01GatewayHTTPRoute
+
+	// SelfCheck configures the self check cert-manager performs to verify that
+	// the challenge token is reachable before asking the ACME server to
+	// validate the challenge.
+	// +optional
+	SelfCheck *ACMEChallengeSolverHTTP01SelfCheck
+}
+
+// ACMEChallengeSolverHTTP01SelfCheck configures the HTTP01 self check.
+// The self check is made up of several requests to the challenge URL which must
+// all succeed, and must complete within 15 minutes.
+type ACMEChallengeSolverHTTP01SelfCheck struct {
+	// Timeout is the time to wait for a response to each self check request.
+	// Increase this for validation targets which are slow to respond.
+	// Must be greater than 0s and not greater than 15m. Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration
+
+	// Interval is the time to wait between self check requests.
+	// Must be greater than 0s and not greater than 15m. Defaults to 2s.
+	// +optional
+	Interval *metav1.Duration
 }
 
 type ACMEChallengeSolverHTTP01Ingress struct {
@@ -338,6 +385,13 @@ type ACMEChallengeSolverDNS01 struct {
 	// records when found in DNS zones.
 	CNAMEStrategy CNAMEStrategy
 
+	// CleanupDelay is the amount of time to wait after a challenge has been
+	// successfully validated before the DNS01 challenge record is removed.
+	// Challenge records of challenges which failed are removed immediately.
+	// The challenge continues to count towards the maximum number of
+	// concurrent challenges until its record has been removed.
+	CleanupDelay *metav1.Duration
+
 	// Use the Akamai DNS zone management API to manage DNS01 challenge records.
 	Akamai *ACMEIssuerDNS01ProviderAkamai
 
@@ -476,6 +530,26 @@ type ACMEIssuerDNS01ProviderCloudDNS struct {
 	ServiceAccount *cmmeta.SecretKeySelector
 	Project        string
 	HostedZoneName string
+
+	// FederatedCredentials authenticates with Google Cloud DNS using
+	// Workload Identity Federation. The audience of the federated credentials
+	// must be set.
+	FederatedCredentials *ACMEIssuerDNS01FederatedCredentials
+}
+
+// ACMEIssuerDNS01FederatedCredentials configures a DNS01 provider to obtain
+// short-lived credentials from its cloud provider by exchanging a bound
+// ServiceAccount token using OIDC federation. The credentials are refreshed
+// automatically before they expire.
+type ACMEIssuerDNS01FederatedCredentials struct {
+	// A reference to a service account that will be used to request bound
+	// tokens to exchange. To use this field, you must configure an RBAC rule
+	// to let cert-manager request a token.
+	ServiceAccountRef ServiceAccountRef
+
+	// Audience is the audience of the token exchange. It is required by and
+	// only supported by the CloudDNS provider.
+	Audience string
 }
 
 // ACMEIssuerDNS01ProviderCloudflare is a structure containing the DNS
@@ -536,6 +610,10 @@ type ACMEIssuerDNS01ProviderRoute53 struct {
 
 	// Always set the region when using AccessKeyID and SecretAccessKey
 	Region string
+
+	// FederatedCredentials authenticates with Route53 using
+	// AssumeRoleWithWebIdentity for the role given by Role.
+	FederatedCredentials *ACMEIssuerDNS01FederatedCredentials
 }
 
 // Route53Auth is configuration used to authenticate with a Route53.
@@ -561,9 +639,10 @@ type ServiceAccountRef struct {
 	Name string
 
 	// TokenAudiences is an optional list of audiences to include in the
-	// token passed to AWS. The default token consisting of the issuer's namespace
-	// and name is always included.
-	// If unset the audience defaults to `sts.amazonaws.com`.
+	// token passed to the cloud provider. The default token consisting of the
+	// issuer's namespace and name is always included.
+	// If unset the audience defaults to `sts.amazonaws.com`, unless the
+	// referencing field documents another default.
 	TokenAudiences []string
 }
 
@@ -588,6 +667,10 @@ type ACMEIssuerDNS01ProviderAzureDNS struct {
 	Environment AzureDNSEnvironment
 
 	ManagedIdentity *AzureManagedIdentity
+
+	// FederatedCredentials authenticates with Azure DNS using workload
+	// identity federation for the application given by ClientID and TenantID.
+	FederatedCredentials *ACMEIssuerDNS01FederatedCredentials
 }
 
 type AzureManagedIdentity struct {
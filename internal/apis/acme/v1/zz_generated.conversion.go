@@ -174,6 +174,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEChallengeSolverHTTP01SelfCheck)(nil), (*acme.ACMEChallengeSolverHTTP01SelfCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEChallengeSolverHTTP01SelfCheck_To_acme_ACMEChallengeSolverHTTP01SelfCheck(a.(*v1.ACMEChallengeSolverHTTP01SelfCheck), b.(*acme.ACMEChallengeSolverHTTP01SelfCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEChallengeSolverHTTP01SelfCheck)(nil), (*v1.ACMEChallengeSolverHTTP01SelfCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEChallengeSolverHTTP01SelfCheck_To_v1_ACMEChallengeSolverHTTP01SelfCheck(a.(*acme.ACMEChallengeSolverHTTP01SelfCheck), b.(*v1.ACMEChallengeSolverHTTP01SelfCheck), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEExternalAccountBinding)(nil), (*acme.ACMEExternalAccountBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEExternalAccountBinding_To_acme_ACMEExternalAccountBinding(a.(*v1.ACMEExternalAccountBinding), b.(*acme.ACMEExternalAccountBinding), scope)
 	}); err != nil {
@@ -184,6 +194,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01FederatedCredentials)(nil), (*acme.ACMEIssuerDNS01FederatedCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01FederatedCredentials_To_acme_ACMEIssuerDNS01FederatedCredentials(a.(*v1.ACMEIssuerDNS01FederatedCredentials), b.(*acme.ACMEIssuerDNS01FederatedCredentials), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01FederatedCredentials)(nil), (*v1.ACMEIssuerDNS01FederatedCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01FederatedCredentials_To_v1_ACMEIssuerDNS01FederatedCredentials(a.(*acme.ACMEIssuerDNS01FederatedCredentials), b.(*v1.ACMEIssuerDNS01FederatedCredentials), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderAcmeDNS)(nil), (*acme.ACMEIssuerDNS01ProviderAcmeDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEIssuerDNS01ProviderAcmeDNS_To_acme_ACMEIssuerDNS01ProviderAcmeDNS(a.(*v1.ACMEIssuerDNS01ProviderAcmeDNS), b.(*acme.ACMEIssuerDNS01ProviderAcmeDNS), scope)
 	}); err != nil {
@@ -324,6 +344,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ChallengeSolverStatus)(nil), (*acme.ChallengeSolverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChallengeSolverStatus_To_acme_ChallengeSolverStatus(a.(*v1.ChallengeSolverStatus), b.(*acme.ChallengeSolverStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ChallengeSolverStatus)(nil), (*v1.ChallengeSolverStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ChallengeSolverStatus_To_v1_ChallengeSolverStatus(a.(*acme.ChallengeSolverStatus), b.(*v1.ChallengeSolverStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ChallengeSpec)(nil), (*acme.ChallengeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ChallengeSpec_To_acme_ChallengeSpec(a.(*v1.ChallengeSpec), b.(*acme.ChallengeSpec), scope)
 	}); err != nil {
@@ -344,6 +374,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.DNSZoneCNAMEStrategy)(nil), (*acme.DNSZoneCNAMEStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_DNSZoneCNAMEStrategy_To_acme_DNSZoneCNAMEStrategy(a.(*v1.DNSZoneCNAMEStrategy), b.(*acme.DNSZoneCNAMEStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.DNSZoneCNAMEStrategy)(nil), (*v1.DNSZoneCNAMEStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_DNSZoneCNAMEStrategy_To_v1_DNSZoneCNAMEStrategy(a.(*acme.DNSZoneCNAMEStrategy), b.(*v1.DNSZoneCNAMEStrategy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.Order)(nil), (*acme.Order)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Order_To_acme_Order(a.(*v1.Order), b.(*acme.Order), scope)
 	}); err != nil {
@@ -521,6 +561,7 @@ func Convert_acme_ACMEChallengeSolver_To_v1_ACMEChallengeSolver(in *acme.ACMECha
 
 func autoConvert_v1_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDNS01(in *v1.ACMEChallengeSolverDNS01, out *acme.ACMEChallengeSolverDNS01, s conversion.Scope) error {
 	out.CNAMEStrategy = acme.CNAMEStrategy(in.CNAMEStrategy)
+	out.CleanupDelay = (*pkgapismetav1.Duration)(unsafe.Pointer(in.CleanupDelay))
 	if in.Akamai != nil {
 		in, out := &in.Akamai, &out.Akamai
 		*out = new(acme.ACMEIssuerDNS01ProviderAkamai)
@@ -604,6 +645,7 @@ func Convert_v1_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDNS01(in *v1
 
 func autoConvert_acme_ACMEChallengeSolverDNS01_To_v1_ACMEChallengeSolverDNS01(in *acme.ACMEChallengeSolverDNS01, out *v1.ACMEChallengeSolverDNS01, s conversion.Scope) error {
 	out.CNAMEStrategy = v1.CNAMEStrategy(in.CNAMEStrategy)
+	out.CleanupDelay = (*pkgapismetav1.Duration)(unsafe.Pointer(in.CleanupDelay))
 	if in.Akamai != nil {
 		in, out := &in.Akamai, &out.Akamai
 		*out = new(v1.ACMEIssuerDNS01ProviderAkamai)
@@ -688,6 +730,7 @@ func Convert_acme_ACMEChallengeSolverDNS01_To_v1_ACMEChallengeSolverDNS01(in *ac
 func autoConvert_v1_ACMEChallengeSolverHTTP01_To_acme_ACMEChallengeSolverHTTP01(in *v1.ACMEChallengeSolverHTTP01, out *acme.ACMEChallengeSolverHTTP01, s conversion.Scope) error {
 	out.Ingress = (*acme.ACMEChallengeSolverHTTP01Ingress)(unsafe.Pointer(in.Ingress))
 	out.GatewayHTTPRoute = (*acme.ACMEChallengeSolverHTTP01GatewayHTTPRoute)(unsafe.Pointer(in.GatewayHTTPRoute))
+	out.SelfCheck = (*acme.ACMEChallengeSolverHTTP01SelfCheck)(unsafe.Pointer(in.SelfCheck))
 	return nil
 }
 
@@ -699,6 +742,7 @@ func Convert_v1_ACMEChallengeSolverHTTP01_To_acme_ACMEChallengeSolverHTTP01(in *
 func autoConvert_acme_ACMEChallengeSolverHTTP01_To_v1_ACMEChallengeSolverHTTP01(in *acme.ACMEChallengeSolverHTTP01, out *v1.ACMEChallengeSolverHTTP01, s conversion.Scope) error {
 	out.Ingress = (*v1.ACMEChallengeSolverHTTP01Ingress)(unsafe.Pointer(in.Ingress))
 	out.GatewayHTTPRoute = (*v1.ACMEChallengeSolverHTTP01GatewayHTTPRoute)(unsafe.Pointer(in.GatewayHTTPRoute))
+	out.SelfCheck = (*v1.ACMEChallengeSolverHTTP01SelfCheck)(unsafe.Pointer(in.SelfCheck))
 	return nil
 }
 
@@ -929,6 +973,28 @@ func Convert_acme_ACMEChallengeSolverHTTP01IngressTemplate_To_v1_ACMEChallengeSo
 	return autoConvert_acme_ACMEChallengeSolverHTTP01IngressTemplate_To_v1_ACMEChallengeSolverHTTP01IngressTemplate(in, out, s)
 }
 
+func autoConvert_v1_ACMEChallengeSolverHTTP01SelfCheck_To_acme_ACMEChallengeSolverHTTP01SelfCheck(in *v1.ACMEChallengeSolverHTTP01SelfCheck, out *acme.ACMEChallengeSolverHTTP01SelfCheck, s conversion.Scope) error {
+	out.Timeout = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Interval = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Interval))
+	return nil
+}
+
+// Convert_v1_ACMEChallengeSolverHTTP01SelfCheck_To_acme_ACMEChallengeSolverHTTP01SelfCheck is an autogenerated conversion function.
+func Convert_v1_ACMEChallengeSolverHTTP01SelfCheck_To_acme_ACMEChallengeSolverHTTP01SelfCheck(in *v1.ACMEChallengeSolverHTTP01SelfCheck, out *acme.ACMEChallengeSolverHTTP01SelfCheck, s conversion.Scope) error {
+	return autoConvert_v1_ACMEChallengeSolverHTTP01SelfCheck_To_acme_ACMEChallengeSolverHTTP01SelfCheck(in, out, s)
+}
+
+func autoConvert_acme_ACMEChallengeSolverHTTP01SelfCheck_To_v1_ACMEChallengeSolverHTTP01SelfCheck(in *acme.ACMEChallengeSolverHTTP01SelfCheck, out *v1.ACMEChallengeSolverHTTP01SelfCheck, s conversion.Scope) error {
+	out.Timeout = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Interval = (*pkgapismetav1.Duration)(unsafe.Pointer(in.Interval))
+	return nil
+}
+
+// Convert_acme_ACMEChallengeSolverHTTP01SelfCheck_To_v1_ACMEChallengeSolverHTTP01SelfCheck is an autogenerated conversion function.
+func Convert_acme_ACMEChallengeSolverHTTP01SelfCheck_To_v1_ACMEChallengeSolverHTTP01SelfCheck(in *acme.ACMEChallengeSolverHTTP01SelfCheck, out *v1.ACMEChallengeSolverHTTP01SelfCheck, s conversion.Scope) error {
+	return autoConvert_acme_ACMEChallengeSolverHTTP01SelfCheck_To_v1_ACMEChallengeSolverHTTP01SelfCheck(in, out, s)
+}
+
 func autoConvert_v1_ACMEExternalAccountBinding_To_acme_ACMEExternalAccountBinding(in *v1.ACMEExternalAccountBinding, out *acme.ACMEExternalAccountBinding, s conversion.Scope) error {
 	out.KeyID = in.KeyID
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Key, &out.Key, s); err != nil {
@@ -988,6 +1054,7 @@ func autoConvert_v1_ACMEIssuer_To_acme_ACMEIssuer(in *v1.ACMEIssuer, out *acme.A
 	}
 	out.DisableAccountKeyGeneration = in.DisableAccountKeyGeneration
 	out.EnableDurationFeature = in.EnableDurationFeature
+	out.HTTPHeaders = *(*map[string]string)(unsafe.Pointer(&in.HTTPHeaders))
 	return nil
 }
 
@@ -1022,9 +1089,36 @@ func autoConvert_acme_ACMEIssuer_To_v1_ACMEIssuer(in *acme.ACMEIssuer, out *v1.A
 	}
 	out.DisableAccountKeyGeneration = in.DisableAccountKeyGeneration
 	out.EnableDurationFeature = in.EnableDurationFeature
+	out.HTTPHeaders = *(*map[string]string)(unsafe.Pointer(&in.HTTPHeaders))
+	return nil
+}
+
+func autoConvert_v1_ACMEIssuerDNS01FederatedCredentials_To_acme_ACMEIssuerDNS01FederatedCredentials(in *v1.ACMEIssuerDNS01FederatedCredentials, out *acme.ACMEIssuerDNS01FederatedCredentials, s conversion.Scope) error {
+	if err := Convert_v1_ServiceAccountRef_To_acme_ServiceAccountRef(&in.ServiceAccountRef, &out.ServiceAccountRef, s); err != nil {
+		return err
+	}
+	out.Audience = in.Audience
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01FederatedCredentials_To_acme_ACMEIssuerDNS01FederatedCredentials is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01FederatedCredentials_To_acme_ACMEIssuerDNS01FederatedCredentials(in *v1.ACMEIssuerDNS01FederatedCredentials, out *acme.ACMEIssuerDNS01FederatedCredentials, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01FederatedCredentials_To_acme_ACMEIssuerDNS01FederatedCredentials(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01FederatedCredentials_To_v1_ACMEIssuerDNS01FederatedCredentials(in *acme.ACMEIssuerDNS01FederatedCredentials, out *v1.ACMEIssuerDNS01FederatedCredentials, s conversion.Scope) error {
+	if err := Convert_acme_ServiceAccountRef_To_v1_ServiceAccountRef(&in.ServiceAccountRef, &out.ServiceAccountRef, s); err != nil {
+		return err
+	}
+	out.Audience = in.Audience
 	return nil
 }
 
+// Convert_acme_ACMEIssuerDNS01FederatedCredentials_To_v1_ACMEIssuerDNS01FederatedCredentials is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01FederatedCredentials_To_v1_ACMEIssuerDNS01FederatedCredentials(in *acme.ACMEIssuerDNS01FederatedCredentials, out *v1.ACMEIssuerDNS01FederatedCredentials, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01FederatedCredentials_To_v1_ACMEIssuerDNS01FederatedCredentials(in, out, s)
+}
+
 func autoConvert_v1_ACMEIssuerDNS01ProviderAcmeDNS_To_acme_ACMEIssuerDNS01ProviderAcmeDNS(in *v1.ACMEIssuerDNS01ProviderAcmeDNS, out *acme.ACMEIssuerDNS01ProviderAcmeDNS, s conversion.Scope) error {
 	out.Host = in.Host
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.AccountSecret, &out.AccountSecret, s); err != nil {
@@ -1106,6 +1200,7 @@ func autoConvert_v1_ACMEIssuerDNS01ProviderAzureDNS_To_acme_ACMEIssuerDNS01Provi
 	out.HostedZoneName = in.HostedZoneName
 	out.Environment = acme.AzureDNSEnvironment(in.Environment)
 	out.ManagedIdentity = (*acme.AzureManagedIdentity)(unsafe.Pointer(in.ManagedIdentity))
+	out.FederatedCredentials = (*acme.ACMEIssuerDNS01FederatedCredentials)(unsafe.Pointer(in.FederatedCredentials))
 	return nil
 }
 
@@ -1131,6 +1226,7 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderAzureDNS_To_v1_ACMEIssuerDNS01Provi
 	out.HostedZoneName = in.HostedZoneName
 	out.Environment = v1.AzureDNSEnvironment(in.Environment)
 	out.ManagedIdentity = (*v1.AzureManagedIdentity)(unsafe.Pointer(in.ManagedIdentity))
+	out.FederatedCredentials = (*v1.ACMEIssuerDNS01FederatedCredentials)(unsafe.Pointer(in.FederatedCredentials))
 	return nil
 }
 
@@ -1151,6 +1247,7 @@ func autoConvert_v1_ACMEIssuerDNS01ProviderCloudDNS_To_acme_ACMEIssuerDNS01Provi
 	}
 	out.Project = in.Project
 	out.HostedZoneName = in.HostedZoneName
+	out.FederatedCredentials = (*acme.ACMEIssuerDNS01FederatedCredentials)(unsafe.Pointer(in.FederatedCredentials))
 	return nil
 }
 
@@ -1171,6 +1268,7 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderCloudDNS_To_v1_ACMEIssuerDNS01Provi
 	}
 	out.Project = in.Project
 	out.HostedZoneName = in.HostedZoneName
+	out.FederatedCredentials = (*v1.ACMEIssuerDNS01FederatedCredentials)(unsafe.Pointer(in.FederatedCredentials))
 	return nil
 }
 
@@ -1307,6 +1405,7 @@ func autoConvert_v1_ACMEIssuerDNS01ProviderRoute53_To_acme_ACMEIssuerDNS01Provid
 	out.Role = in.Role
 	out.HostedZoneID = in.HostedZoneID
 	out.Region = in.Region
+	out.FederatedCredentials = (*acme.ACMEIssuerDNS01FederatedCredentials)(unsafe.Pointer(in.FederatedCredentials))
 	return nil
 }
 
@@ -1333,6 +1432,7 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderRoute53_To_v1_ACMEIssuerDNS01Provid
 	out.Role = in.Role
 	out.HostedZoneID = in.HostedZoneID
 	out.Region = in.Region
+	out.FederatedCredentials = (*v1.ACMEIssuerDNS01FederatedCredentials)(unsafe.Pointer(in.FederatedCredentials))
 	return nil
 }
 
@@ -1417,6 +1517,7 @@ func autoConvert_v1_CertificateDNSNameSelector_To_acme_CertificateDNSNameSelecto
 	out.MatchLabels = *(*map[string]string)(unsafe.Pointer(&in.MatchLabels))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.DNSZones = *(*[]string)(unsafe.Pointer(&in.DNSZones))
+	out.DNSZoneCNAMEStrategies = *(*[]acme.DNSZoneCNAMEStrategy)(unsafe.Pointer(&in.DNSZoneCNAMEStrategies))
 	return nil
 }
 
@@ -1429,6 +1530,7 @@ func autoConvert_acme_CertificateDNSNameSelector_To_v1_CertificateDNSNameSelecto
 	out.MatchLabels = *(*map[string]string)(unsafe.Pointer(&in.MatchLabels))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.DNSZones = *(*[]string)(unsafe.Pointer(&in.DNSZones))
+	out.DNSZoneCNAMEStrategies = *(*[]v1.DNSZoneCNAMEStrategy)(unsafe.Pointer(&in.DNSZoneCNAMEStrategies))
 	return nil
 }
 
@@ -1511,6 +1613,28 @@ func Convert_acme_ChallengeList_To_v1_ChallengeList(in *acme.ChallengeList, out
 	return autoConvert_acme_ChallengeList_To_v1_ChallengeList(in, out, s)
 }
 
+func autoConvert_v1_ChallengeSolverStatus_To_acme_ChallengeSolverStatus(in *v1.ChallengeSolverStatus, out *acme.ChallengeSolverStatus, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Selector = (*acme.CertificateDNSNameSelector)(unsafe.Pointer(in.Selector))
+	return nil
+}
+
+// Convert_v1_ChallengeSolverStatus_To_acme_ChallengeSolverStatus is an autogenerated conversion function.
+func Convert_v1_ChallengeSolverStatus_To_acme_ChallengeSolverStatus(in *v1.ChallengeSolverStatus, out *acme.ChallengeSolverStatus, s conversion.Scope) error {
+	return autoConvert_v1_ChallengeSolverStatus_To_acme_ChallengeSolverStatus(in, out, s)
+}
+
+func autoConvert_acme_ChallengeSolverStatus_To_v1_ChallengeSolverStatus(in *acme.ChallengeSolverStatus, out *v1.ChallengeSolverStatus, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Selector = (*v1.CertificateDNSNameSelector)(unsafe.Pointer(in.Selector))
+	return nil
+}
+
+// Convert_acme_ChallengeSolverStatus_To_v1_ChallengeSolverStatus is an autogenerated conversion function.
+func Convert_acme_ChallengeSolverStatus_To_v1_ChallengeSolverStatus(in *acme.ChallengeSolverStatus, out *v1.ChallengeSolverStatus, s conversion.Scope) error {
+	return autoConvert_acme_ChallengeSolverStatus_To_v1_ChallengeSolverStatus(in, out, s)
+}
+
 func autoConvert_v1_ChallengeSpec_To_acme_ChallengeSpec(in *v1.ChallengeSpec, out *acme.ChallengeSpec, s conversion.Scope) error {
 	out.URL = in.URL
 	out.AuthorizationURL = in.AuthorizationURL
@@ -1560,6 +1684,7 @@ func autoConvert_v1_ChallengeStatus_To_acme_ChallengeStatus(in *v1.ChallengeStat
 	out.Presented = in.Presented
 	out.Reason = in.Reason
 	out.State = acme.State(in.State)
+	out.Solver = (*acme.ChallengeSolverStatus)(unsafe.Pointer(in.Solver))
 	return nil
 }
 
@@ -1573,6 +1698,7 @@ func autoConvert_acme_ChallengeStatus_To_v1_ChallengeStatus(in *acme.ChallengeSt
 	out.Presented = in.Presented
 	out.Reason = in.Reason
 	out.State = v1.State(in.State)
+	out.Solver = (*v1.ChallengeSolverStatus)(unsafe.Pointer(in.Solver))
 	return nil
 }
 
@@ -1581,6 +1707,28 @@ func Convert_acme_ChallengeStatus_To_v1_ChallengeStatus(in *acme.ChallengeStatus
 	return autoConvert_acme_ChallengeStatus_To_v1_ChallengeStatus(in, out, s)
 }
 
+func autoConvert_v1_DNSZoneCNAMEStrategy_To_acme_DNSZoneCNAMEStrategy(in *v1.DNSZoneCNAMEStrategy, out *acme.DNSZoneCNAMEStrategy, s conversion.Scope) error {
+	out.DNSZone = in.DNSZone
+	out.CNAMEStrategy = acme.CNAMEStrategy(in.CNAMEStrategy)
+	return nil
+}
+
+// Convert_v1_DNSZoneCNAMEStrategy_To_acme_DNSZoneCNAMEStrategy is an autogenerated conversion function.
+func Convert_v1_DNSZoneCNAMEStrategy_To_acme_DNSZoneCNAMEStrategy(in *v1.DNSZoneCNAMEStrategy, out *acme.DNSZoneCNAMEStrategy, s conversion.Scope) error {
+	return autoConvert_v1_DNSZoneCNAMEStrategy_To_acme_DNSZoneCNAMEStrategy(in, out, s)
+}
+
+func autoConvert_acme_DNSZoneCNAMEStrategy_To_v1_DNSZoneCNAMEStrategy(in *acme.DNSZoneCNAMEStrategy, out *v1.DNSZoneCNAMEStrategy, s conversion.Scope) error {
+	out.DNSZone = in.DNSZone
+	out.CNAMEStrategy = v1.CNAMEStrategy(in.CNAMEStrategy)
+	return nil
+}
+
+// Convert_acme_DNSZoneCNAMEStrategy_To_v1_DNSZoneCNAMEStrategy is an autogenerated conversion function.
+func Convert_acme_DNSZoneCNAMEStrategy_To_v1_DNSZoneCNAMEStrategy(in *acme.DNSZoneCNAMEStrategy, out *v1.DNSZoneCNAMEStrategy, s conversion.Scope) error {
+	return autoConvert_acme_DNSZoneCNAMEStrategy_To_v1_DNSZoneCNAMEStrategy(in, out, s)
+}
+
 func autoConvert_v1_Order_To_acme_Order(in *v1.Order, out *acme.Order, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_OrderSpec_To_acme_OrderSpec(&in.Spec, &out.Spec, s); err != nil {
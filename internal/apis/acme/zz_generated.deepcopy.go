@@ -106,6 +106,11 @@ func (in *ACMEChallengeSolver) DeepCopy() *ACMEChallengeSolver {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ACMEChallengeSolverDNS01) DeepCopyInto(out *ACMEChallengeSolverDNS01) {
 	*out = *in
+	if in.CleanupDelay != nil {
+		in, out := &in.CleanupDelay, &out.CleanupDelay
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.Akamai != nil {
 		in, out := &in.Akamai, &out.Akamai
 		*out = new(ACMEIssuerDNS01ProviderAkamai)
@@ -177,6 +182,11 @@ func (in *ACMEChallengeSolverHTTP01) DeepCopyInto(out *ACMEChallengeSolverHTTP01
 		*out = new(ACMEChallengeSolverHTTP01GatewayHTTPRoute)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SelfCheck != nil {
+		in, out := &in.SelfCheck, &out.SelfCheck
+		*out = new(ACMEChallengeSolverHTTP01SelfCheck)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -462,6 +472,32 @@ func (in *ACMEChallengeSolverHTTP01IngressTemplate) DeepCopy() *ACMEChallengeSol
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEChallengeSolverHTTP01SelfCheck) DeepCopyInto(out *ACMEChallengeSolverHTTP01SelfCheck) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEChallengeSolverHTTP01SelfCheck.
+func (in *ACMEChallengeSolverHTTP01SelfCheck) DeepCopy() *ACMEChallengeSolverHTTP01SelfCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEChallengeSolverHTTP01SelfCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ACMEExternalAccountBinding) DeepCopyInto(out *ACMEExternalAccountBinding) {
 	*out = *in
@@ -500,6 +536,13 @@ func (in *ACMEIssuer) DeepCopyInto(out *ACMEIssuer) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.HTTPHeaders != nil {
+		in, out := &in.HTTPHeaders, &out.HTTPHeaders
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -513,6 +556,23 @@ func (in *ACMEIssuer) DeepCopy() *ACMEIssuer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ACMEIssuerDNS01FederatedCredentials) DeepCopyInto(out *ACMEIssuerDNS01FederatedCredentials) {
+	*out = *in
+	in.ServiceAccountRef.DeepCopyInto(&out.ServiceAccountRef)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ACMEIssuerDNS01FederatedCredentials.
+func (in *ACMEIssuerDNS01FederatedCredentials) DeepCopy() *ACMEIssuerDNS01FederatedCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(ACMEIssuerDNS01FederatedCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ACMEIssuerDNS01ProviderAcmeDNS) DeepCopyInto(out *ACMEIssuerDNS01ProviderAcmeDNS) {
 	*out = *in
@@ -562,6 +622,11 @@ func (in *ACMEIssuerDNS01ProviderAzureDNS) DeepCopyInto(out *ACMEIssuerDNS01Prov
 		*out = new(AzureManagedIdentity)
 		**out = **in
 	}
+	if in.FederatedCredentials != nil {
+		in, out := &in.FederatedCredentials, &out.FederatedCredentials
+		*out = new(ACMEIssuerDNS01FederatedCredentials)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -583,6 +648,11 @@ func (in *ACMEIssuerDNS01ProviderCloudDNS) DeepCopyInto(out *ACMEIssuerDNS01Prov
 		*out = new(meta.SecretKeySelector)
 		**out = **in
 	}
+	if in.FederatedCredentials != nil {
+		in, out := &in.FederatedCredentials, &out.FederatedCredentials
+		*out = new(ACMEIssuerDNS01FederatedCredentials)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -670,6 +740,11 @@ func (in *ACMEIssuerDNS01ProviderRoute53) DeepCopyInto(out *ACMEIssuerDNS01Provi
 		**out = **in
 	}
 	out.SecretAccessKey = in.SecretAccessKey
+	if in.FederatedCredentials != nil {
+		in, out := &in.FederatedCredentials, &out.FederatedCredentials
+		*out = new(ACMEIssuerDNS01FederatedCredentials)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -756,6 +831,11 @@ func (in *CertificateDNSNameSelector) DeepCopyInto(out *CertificateDNSNameSelect
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DNSZoneCNAMEStrategies != nil {
+		in, out := &in.DNSZoneCNAMEStrategies, &out.DNSZoneCNAMEStrategies
+		*out = make([]DNSZoneCNAMEStrategy, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -775,7 +855,7 @@ func (in *Challenge) DeepCopyInto(out *Challenge) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -830,6 +910,27 @@ func (in *ChallengeList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChallengeSolverStatus) DeepCopyInto(out *ChallengeSolverStatus) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(CertificateDNSNameSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChallengeSolverStatus.
+func (in *ChallengeSolverStatus) DeepCopy() *ChallengeSolverStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ChallengeSolverStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChallengeSpec) DeepCopyInto(out *ChallengeSpec) {
 	*out = *in
@@ -851,6 +952,11 @@ func (in *ChallengeSpec) DeepCopy() *ChallengeSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChallengeStatus) DeepCopyInto(out *ChallengeStatus) {
 	*out = *in
+	if in.Solver != nil {
+		in, out := &in.Solver, &out.Solver
+		*out = new(ChallengeSolverStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -864,6 +970,22 @@ func (in *ChallengeStatus) DeepCopy() *ChallengeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSZoneCNAMEStrategy) DeepCopyInto(out *DNSZoneCNAMEStrategy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSZoneCNAMEStrategy.
+func (in *DNSZoneCNAMEStrategy) DeepCopy() *DNSZoneCNAMEStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSZoneCNAMEStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Order) DeepCopyInto(out *Order) {
 	*out = *in
@@ -66,6 +66,26 @@ const (
 	// Annotation key for the 'group' of the Issuer resource.
 	IssuerGroupAnnotationKey = "cert-manager.io/issuer-group"
 
+	// Annotation key for the revision of the Certificate resource which the
+	// certificate stored in the Secret resource was issued for. It is only set
+	// on the Secrets of Certificates which set the RotationSignalAnnotation.
+	// The key is shared with CertificateRequestRevisionAnnotationKey on
+	// purpose, as the Secret records the revision of the CertificateRequest
+	// it was issued from.
+	SecretCertificateRevisionAnnotationKey = CertificateRequestRevisionAnnotationKey
+
+	// Annotation key set to "true" on the Secret of a Certificate whose
+	// expired certificate has been replaced with a temporary self-signed
+	// certificate, following the Certificate's expiredCertificatePolicy.
+	FallbackCertificateAnnotationKey = "cert-manager.io/fallback-certificate"
+
+	// Annotation key set on an Issuer or ClusterIssuer resource to give it a
+	// stable alias. An issuerRef whose name does not match any Issuer (or
+	// ClusterIssuer) will be resolved to the single Issuer (or ClusterIssuer)
+	// of that kind carrying this annotation with a value equal to the name.
+	// Aliases of Issuers are scoped to the Issuer's namespace.
+	IssuerAliasAnnotationKey = "cert-manager.io/issuer-alias"
+
 	// Annotation key for the name of the certificate that a resource is related to.
 	CertificateNameKey = "cert-manager.io/certificate-name"
 
@@ -103,6 +123,11 @@ const (
 
 	// Annotation to declare the CertificateRequest "revision", belonging to a Certificate Resource
 	CertificateRequestRevisionAnnotationKey = "cert-manager.io/certificate-revision"
+
+	// Annotation added to the canary CertificateRequests of a Certificate
+	// which sets spec.canaryIssuerRef, recording the generation of the
+	// Certificate the canary was requested for.
+	CertificateRequestCanaryGenerationAnnotationKey = "cert-manager.io/canary-generation"
 )
 
 const (
@@ -112,6 +137,37 @@ const (
 	// stored in the target Secret resource whilst the real Issuer is processing
 	// the certificate request.
 	IssueTemporaryCertificateAnnotation = "cert-manager.io/issue-temporary-certificate"
+
+	// AdoptExistingSecretAnnotation is an annotation that can be added to
+	// Certificate resources.
+	// If it is set to "true" and the target Secret already contains a
+	// certificate which matches the Certificate's spec and has not expired,
+	// the Secret is adopted without re-issuing: the Certificate is marked as
+	// Ready and is renewed based on the existing certificate's expiry.
+	AdoptExistingSecretAnnotation = "cert-manager.io/adopt-existing-secret"
+
+	// OCSPStapleAnnotation is an annotation that can be added to Certificate
+	// resources.
+	// If it is set to "true", the OCSP response for the issued certificate is
+	// fetched from the OCSP responder named in the certificate and stored in
+	// the target Secret resource, where it is refreshed before it goes stale.
+	OCSPStapleAnnotation = "cert-manager.io/ocsp-staple"
+
+	// RotationSignalAnnotation is an annotation that can be added to
+	// Certificate resources.
+	// If it is set to "true", the target Secret resource is annotated with the
+	// SecretCertificateRevisionAnnotationKey, which changes each time a new
+	// certificate is stored, so that consumers watching the Secret can detect
+	// the rotation and reload the certificate.
+	RotationSignalAnnotation = "cert-manager.io/rotation-signal"
+
+	// SharedSecretOwnershipAnnotation is an annotation that can be added to
+	// Certificate resources whose target Secret is also written by another
+	// controller.
+	// If it is set to "true", cert-manager only checks the values of the keys,
+	// labels and annotations it writes to the Secret, rather than which fields
+	// it manages, and never deletes the Secret to recreate it.
+	SharedSecretOwnershipAnnotation = "cert-manager.io/shared-secret-ownership"
 )
 
 // Common/known resource kinds.
@@ -69,6 +69,10 @@ const (
 
 	// Ed25519 private key algorithm.
 	Ed25519KeyAlgorithm PrivateKeyAlgorithm = "Ed25519"
+
+	// RSAPSS private key algorithm. The private key is an RSA key, and
+	// signatures are made using RSASSA-PSS rather than PKCS #1 v1.5.
+	RSAPSSKeyAlgorithm PrivateKeyAlgorithm = "RSAPSS"
 )
 
 type PrivateKeyEncoding string
@@ -171,6 +175,26 @@ type CertificateSpec struct {
 	// +optional
 	RenewBeforePercentage *int32
 
+	// IssueAfter is the time at which the certificate is first needed, for
+	// example the go-live time of a scheduled launch.
+	// If set, cert-manager defers the initial issuance of the certificate until
+	// `issueAhead` before this time. For ACME issuers this delays the creation
+	// of the order. Once a certificate has been issued, renewals are not
+	// affected.
+	// +optional
+	IssueAfter *metav1.Time
+
+	// IssueAhead is how long before `issueAfter` cert-manager should issue the
+	// certificate, giving time for the certificate to be distributed before it
+	// is needed. For example, if `issueAfter` is 2024-06-01T12:00:00Z and
+	// `issueAhead=48h`, the certificate is issued at 2024-05-30T12:00:00Z.
+	//
+	// If unset, the certificate is issued at `issueAfter`.
+	// Value must be in units accepted by Go time.ParseDuration https://golang.org/pkg/time/#ParseDuration.
+	// Can only be set if the `issueAfter` field is set.
+	// +optional
+	IssueAhead *metav1.Duration
+
 	// Requested DNS subject alternative names.
 	DNSNames []string
 
@@ -215,6 +239,19 @@ type CertificateSpec struct {
 	// The `name` field of the reference must always be specified.
 	IssuerRef cmmeta.ObjectReference
 
+	// Reference to a secondary issuer, such as one using a staging ACME
+	// endpoint, which is used to validate issuance before the issuer
+	// referenced by `issuerRef` is asked to issue the certificate.
+	//
+	// When set, a canary certificate is requested from this issuer whenever
+	// the Certificate's spec changes. The canary certificate is never stored
+	// in the Certificate's Secret. Its outcome is reported by the
+	// `CanaryIssued` condition, and the Certificate is only issued by
+	// `issuerRef` once the canary issuance has succeeded.
+	//
+	// The `name` field of the reference must always be specified.
+	CanaryIssuerRef *cmmeta.ObjectReference
+
 	// Requested basic constraints isCA value.
 	// The isCA value is used to set the `isCA` field on the created CertificateRequest
 	// resources. Note that the issuer may choose to ignore the requested isCA value, just
@@ -261,6 +298,16 @@ type CertificateSpec struct {
 	// the controller and webhook components.
 	AdditionalOutputFormats []CertificateAdditionalOutputFormat
 
+	// Defines additional Secrets to which the private key and signed
+	// certificate chain are written on issuance, each in its own format. A
+	// Secret in another namespace must already exist and list this Certificate
+	// in its `cert-manager.io/allowed-certificates` annotation.
+	//
+	// This is an Alpha Feature and is only enabled with the
+	// `--feature-gates=AdditionalCertificateSecrets=true` option set on both
+	// the controller and webhook components.
+	AdditionalSecrets []CertificateAdditionalSecret
+
 	// x.509 certificate NameConstraint extension which MUST NOT be used in a non-CA certificate.
 	// More Info: https://datatracker.ietf.org/doc/html/rfc5280#section-4.2.1.10
 	//
@@ -269,8 +316,146 @@ type CertificateSpec struct {
 	// the controller and webhook components.
 	// +optional
 	NameConstraints *NameConstraints
+
+	// MSTemplate is the Microsoft certificate template to request the
+	// certificate with, for issuers backed by Active Directory Certificate
+	// Services (AD CS).
+	// The template is encoded in the CSR as either the Certificate Template
+	// Name extension, when a template name is given, or the Certificate
+	// Template Information extension, when a template OID is given.
+	// +optional
+	MSTemplate *CertificateMSTemplate
+
+	// CACertificatePolicy defines how the `ca.crt` key of the Certificate's
+	// Secret is populated.
+	// `Issuer` stores the CA certificate provided by the issuer, and omits the
+	// key if the issuer does not provide one. `ChainRoot` also stores the CA
+	// certificate provided by the issuer, but if the issuer does not provide
+	// one, the root of the returned certificate chain is stored instead. If
+	// the chain does not contain a self-signed root, the highest certificate
+	// in the chain is used. `Omit` never stores the `ca.crt` key.
+	// If unset, defaults to `Issuer`.
+	// +optional
+	CACertificatePolicy CACertificatePolicy
+
+	// CertificatePolicies are the policies to include in the Certificate
+	// Policies extension of the issued certificate, each identified by its
+	// object identifier and optionally qualified with a Certification
+	// Practice Statement (CPS) URI and a user notice. The extension is
+	// encoded in the CSR, and is copied to the issued certificate by the CA
+	// and SelfSigned issuers.
+	// +optional
+	CertificatePolicies []CertificatePolicy
+
+	// LegacyExtensions are the legacy X.509 extensions to encode in the CSR,
+	// for relying parties which still require them. Only a fixed set of
+	// well-known extensions is supported. The extensions are copied to the
+	// issued certificate by the CA and SelfSigned issuers.
+	// +optional
+	LegacyExtensions *CertificateLegacyExtensions
+
+	// ChainTruncation truncates the certificate chain stored in the `tls.crt`
+	// key of the Certificate's Secret at the given anchor, for clients which
+	// require an exact chain. The chain returned by the issuer is stored from
+	// the leaf certificate up to and including the anchor, which may be any
+	// CA certificate in the chain, including a self-signed root. If the
+	// anchor is not part of the returned chain, the Secret is not updated.
+	// If unset, the chain is stored as returned by the issuer.
+	// +optional
+	ChainTruncation *CertificateChainTruncation
+
+	// PolicyConstraints are the constraints on certification paths encoded
+	// as the Policy Constraints extension of the issued CA certificate. May
+	// only be set if `isCA` is true. The extension is encoded in the CSR, and
+	// is copied to the issued certificate by the CA and SelfSigned issuers.
+	// +optional
+	PolicyConstraints *CertificatePolicyConstraints
+
+	// InhibitAnyPolicy is the number of additional certificates which may
+	// appear in a certification path before the special anyPolicy policy is
+	// no longer permitted, encoded as the Inhibit anyPolicy extension of the
+	// issued CA certificate. Must not be negative, and may only be set if
+	// `isCA` is true. The extension is encoded in the CSR, and is copied to
+	// the issued certificate by the CA and SelfSigned issuers.
+	// +optional
+	InhibitAnyPolicy *int32
+
+	// ExpiredCertificatePolicy defines what happens to the Certificate's
+	// Secret once the certificate stored in it has expired and issuing its
+	// renewal has failed. `Keep` keeps serving the expired certificate.
+	// `Fallback` replaces it with a short-lived self-signed certificate, and
+	// marks the Secret with the `cert-manager.io/fallback-certificate`
+	// annotation. The Certificate is not Ready and its renewal is retried
+	// until a certificate is issued successfully.
+	// If unset, the `defaultExpiredCertificatePolicy` of the issuer is used,
+	// which defaults to `Keep`.
+	// +optional
+	ExpiredCertificatePolicy ExpiredCertificatePolicy
+
+	// SecretDeletionPolicy defines what happens to the Certificate's Secret
+	// when the Certificate is deleted. `Retain` leaves the Secret in place,
+	// unless it is garbage collected because the Certificate owns it.
+	// `Delete` deletes the Secret before the Certificate is removed, using a
+	// finalizer, regardless of whether the Certificate owns the Secret.
+	// If unset, the `secretDeletionPolicy` of the controller is used, which
+	// defaults to `Retain`.
+	// +optional
+	SecretDeletionPolicy SecretDeletionPolicy
+
+	// MaxChainDepth is the maximum number of CA certificates which may follow
+	// the leaf certificate in the chain returned by the issuer. If the
+	// returned chain is deeper, the issuance fails and the certificate is not
+	// stored in the Secret. Must not be negative.
+	// If unset, the depth of the returned chain is not limited.
+	// +optional
+	MaxChainDepth *int32
 }
 
+// CACertificatePolicy denotes how the `ca.crt` key of a Certificate's Secret
+// is populated.
+type CACertificatePolicy string
+
+const (
+	// CACertificatePolicyIssuer stores the CA certificate provided by the
+	// issuer, if any.
+	CACertificatePolicyIssuer CACertificatePolicy = "Issuer"
+
+	// CACertificatePolicyChainRoot stores the CA certificate provided by the
+	// issuer or, if the issuer does not provide one, the root of the returned
+	// certificate chain.
+	CACertificatePolicyChainRoot CACertificatePolicy = "ChainRoot"
+
+	// CACertificatePolicyOmit never stores a CA certificate.
+	CACertificatePolicyOmit CACertificatePolicy = "Omit"
+)
+
+// ExpiredCertificatePolicy denotes what happens to the Secret of a
+// Certificate whose certificate has expired and failed to be renewed.
+type ExpiredCertificatePolicy string
+
+const (
+	// ExpiredCertificatePolicyKeep keeps serving the expired certificate.
+	ExpiredCertificatePolicyKeep ExpiredCertificatePolicy = "Keep"
+
+	// ExpiredCertificatePolicyFallback replaces the expired certificate with
+	// a temporary self-signed certificate.
+	ExpiredCertificatePolicyFallback ExpiredCertificatePolicy = "Fallback"
+)
+
+// SecretDeletionPolicy denotes what happens to the Secret of a Certificate
+// when the Certificate is deleted.
+type SecretDeletionPolicy string
+
+const (
+	// SecretDeletionPolicyRetain leaves the Secret in place when the
+	// Certificate is deleted.
+	SecretDeletionPolicyRetain SecretDeletionPolicy = "Retain"
+
+	// SecretDeletionPolicyDelete deletes the Secret when the Certificate is
+	// deleted.
+	SecretDeletionPolicyDelete SecretDeletionPolicy = "Delete"
+)
+
 type OtherName struct {
 	// OID is the object identifier for the otherName SAN.
 	// The object identifier must be expressed as a dotted string, for
@@ -310,22 +495,36 @@ type CertificatePrivateKey struct {
 	// Algorithm is the private key algorithm of the corresponding private key
 	// for this certificate.
 	//
-	// If provided, allowed values are either `RSA`, `ECDSA` or `Ed25519`.
+	// If provided, allowed values are either `RSA`, `RSAPSS`, `ECDSA` or `Ed25519`.
+	// `RSAPSS` uses an RSA private key, and requests that the CSR and the
+	// issued certificate are signed using RSASSA-PSS where the issuer supports it.
 	// If `algorithm` is specified and `size` is not provided,
-	// key size of 2048 will be used for `RSA` key algorithm and
+	// key size of 2048 will be used for `RSA` and `RSAPSS` key algorithms and
 	// key size of 256 will be used for `ECDSA` key algorithm.
 	// key size is ignored when using the `Ed25519` key algorithm.
 	Algorithm PrivateKeyAlgorithm
 
 	// Size is the key bit size of the corresponding private key for this certificate.
 	//
-	// If `algorithm` is set to `RSA`, valid values are `2048`, `4096` or `8192`,
+	// If `algorithm` is set to `RSA` or `RSAPSS`, valid values are `2048`, `4096` or `8192`,
 	// and will default to `2048` if not specified.
 	// If `algorithm` is set to `ECDSA`, valid values are `256`, `384` or `521`,
 	// and will default to `256` if not specified.
 	// If `algorithm` is set to `Ed25519`, Size is ignored.
 	// No other values are allowed.
 	Size int
+
+	// SeedSecretRef is a reference to a key in a Secret, in the Certificate's
+	// namespace, containing a seed from which the private key is
+	// deterministically derived. Recreating the Certificate with the same seed
+	// results in the same private key.
+	// This is intended for reproducible test and development environments
+	// only: anyone able to read the seed can recreate the private key.
+	// Only the `ECDSA` and `Ed25519` key algorithms are supported, and the
+	// Certificate's namespace must have the
+	// `cert-manager.io/allow-deterministic-private-keys: "true"` label.
+	// Requires the DeterministicPrivateKeys feature gate to be enabled.
+	SeedSecretRef *cmmeta.SecretKeySelector
 }
 
 // Denotes how private keys should be generated or sourced when a Certificate
@@ -346,12 +545,15 @@ var (
 
 // CertificateOutputFormatType specifies which additional output formats should
 // be written to the Certificate's target Secret.
-// Allowed values are `DER` or `CombinedPEM`.
+// Allowed values are `DER`, `CombinedPEM` or `PKCS7`.
 // When Type is set to `DER` an additional entry `key.der` will be written to
 // the Secret, containing the binary format of the private key.
 // When Type is set to `CombinedPEM` an additional entry `tls-combined.pem`
 // will be written to the Secret, containing the PEM formatted private key and
 // signed certificate chain (tls.key + tls.crt concatenated).
+// When Type is set to `PKCS7` an additional entry `tls.p7b` will be written
+// to the Secret, containing the signed certificate chain as a DER encoded
+// PKCS#7 structure.
 type CertificateOutputFormatType string
 
 const (
@@ -366,6 +568,11 @@ const (
 	// character, followed by the chain of signed certificate PEM documents
 	// (`<private key> + \n + <signed certificate chain>`).
 	CertificateOutputFormatCombinedPEM CertificateOutputFormatType = "CombinedPEM"
+
+	// CertificateOutputFormatPKCS7 writes the Certificate's signed certificate
+	// chain as a DER encoded "certs-only" PKCS#7 structure to the `tls.p7b`
+	// target Secret Data key.
+	CertificateOutputFormatPKCS7 CertificateOutputFormatType = "PKCS7"
 )
 
 // CertificateAdditionalOutputFormat defines an additional output format of a
@@ -377,6 +584,56 @@ type CertificateAdditionalOutputFormat struct {
 	Type CertificateOutputFormatType
 }
 
+// CertificateAdditionalSecretFormat denotes the format in which the private
+// key and signed certificate chain are written to an additional Secret.
+// When Format is `TLS`, a Secret of type `kubernetes.io/tls` is written with
+// the `tls.crt`, `tls.key` and `ca.crt` entries.
+// When Format is `PKCS12`, an Opaque Secret is written with the
+// `keystore.p12` and `truststore.p12` entries.
+// When Format is `JKS`, an Opaque Secret is written with the `keystore.jks`
+// and `truststore.jks` entries.
+type CertificateAdditionalSecretFormat string
+
+const (
+	// CertificateAdditionalSecretFormatTLS writes the PEM encoded private
+	// key, certificate chain and CA certificate.
+	CertificateAdditionalSecretFormatTLS CertificateAdditionalSecretFormat = "TLS"
+
+	// CertificateAdditionalSecretFormatPKCS12 writes a PKCS#12 keystore and
+	// truststore.
+	CertificateAdditionalSecretFormatPKCS12 CertificateAdditionalSecretFormat = "PKCS12"
+
+	// CertificateAdditionalSecretFormatJKS writes a JKS keystore and
+	// truststore.
+	CertificateAdditionalSecretFormatJKS CertificateAdditionalSecretFormat = "JKS"
+)
+
+// CertificateAdditionalSecret defines an additional Secret to which a
+// Certificate's private key and signed certificate chain are written.
+type CertificateAdditionalSecret struct {
+	// Name of the Secret.
+	Name string
+
+	// Namespace of the Secret. Defaults to the namespace of the Certificate.
+	// A Secret in another namespace must already exist and list this
+	// Certificate in its `cert-manager.io/allowed-certificates` annotation.
+	Namespace string
+
+	// Format in which the private key and signed certificate chain are
+	// written. If unset, defaults to `TLS`.
+	Format CertificateAdditionalSecretFormat
+
+	// PasswordSecretRef is a reference to a key in a Secret, in the namespace
+	// of the Certificate, containing the password used to encrypt the
+	// keystore. Required for the `PKCS12` and `JKS` formats.
+	PasswordSecretRef *cmmeta.SecretKeySelector
+
+	// Profile specifies the key and certificate encryption algorithms and the
+	// HMAC algorithm used to create the PKCS#12 keystore, as for
+	// `keystores.pkcs12.profile`. Only used by the `PKCS12` format.
+	Profile PKCS12Profile
+}
+
 // X509Subject Full X509 name specification
 type X509Subject struct {
 	// Organizations to be used on the Certificate.
@@ -497,7 +754,8 @@ const (
 // CertificateStatus defines the observed state of Certificate
 type CertificateStatus struct {
 	// List of status conditions to indicate the status of certificates.
-	// Known condition types are `Ready` and `Issuing`.
+	// Known condition types are `Ready`, `Issuing`, `IssuerMissing`,
+	// `UnknownIssuerKind`, `Expired` and `SecretOwnershipConflict`.
 	Conditions []CertificateCondition
 
 	// LastFailureTime is set only if the latest issuance for this
@@ -520,6 +778,15 @@ type CertificateStatus struct {
 	// If not set, no upcoming renewal is scheduled.
 	RenewalTime *metav1.Time
 
+	// The base64 encoded SHA-256 hash of the DER encoded SubjectPublicKeyInfo
+	// of the certificate stored in the secret named by this resource in
+	// `spec.secretName`, suitable for use as a `pin-sha256` public key pin.
+	// More info: https://datatracker.ietf.org/doc/html/rfc7469#section-2.4
+	//
+	// The pin only changes when the private key changes, so it will remain
+	// stable across renewals when `spec.privateKey.rotationPolicy` is `Never`.
+	SPKIPinSHA256 string
+
 	// The current 'revision' of the certificate as issued.
 	//
 	// When a CertificateRequest resource is created, it will have the
@@ -554,7 +821,8 @@ type CertificateStatus struct {
 
 // CertificateCondition contains condition information for a Certificate.
 type CertificateCondition struct {
-	// Type of the condition, known values are (`Ready`, `Issuing`).
+	// Type of the condition, known values are (`Ready`, `Issuing`, `IssuerMissing`,
+	// `UnknownIssuerKind`, `Expired`, `SecretOwnershipConflict`).
 	Type CertificateConditionType
 
 	// Status of the condition, one of (`True`, `False`, `Unknown`).
@@ -609,6 +877,49 @@ const (
 	//
 	// It will be removed by the 'issuing' controller upon completing issuance.
 	CertificateConditionIssuing CertificateConditionType = "Issuing"
+
+	// A condition added to Certificate resources when the issuer referenced by
+	// `spec.issuerRef` has not been found for a sustained period, for example
+	// because it has been deleted. The Certificate will not be renewed while
+	// its issuer is missing.
+	//
+	// It will be removed by the 'readiness' controller once the issuer exists.
+	// The condition is not set for Certificates which reference an external
+	// issuer.
+	CertificateConditionIssuerMissing CertificateConditionType = "IssuerMissing"
+
+	// A condition added to Certificate resources which set
+	// `spec.canaryIssuerRef`, reporting the outcome of the canary issuance
+	// for the current generation of the Certificate. The Certificate is only
+	// issued by `spec.issuerRef` once this condition is True.
+	//
+	// It is managed by the 'canary' controller.
+	CertificateConditionCanaryIssued CertificateConditionType = "CanaryIssued"
+
+	// A condition added to Certificate resources whose `spec.issuerRef` refers
+	// to a kind which is not known, for example because of a typo in
+	// `spec.issuerRef.kind`. The Certificate will not be issued until the
+	// reference is corrected or, for external issuers, the kind is registered.
+	//
+	// It is managed by the 'readiness' controller.
+	CertificateConditionUnknownIssuerKind CertificateConditionType = "UnknownIssuerKind"
+
+	// A condition added to Certificate resources whose Secret contains a
+	// certificate which has expired. Its reason is `FallbackCertificate` if
+	// the expired certificate has been replaced with a temporary self-signed
+	// certificate following `spec.expiredCertificatePolicy`.
+	//
+	// It is managed by the 'readiness' controller.
+	CertificateConditionExpired CertificateConditionType = "Expired"
+
+	// A condition added to Certificate resources whose `spec.secretName` is
+	// also the `spec.secretName` of other Certificates in the same namespace.
+	// Depending on the configured secret ownership conflict policy, only the
+	// Certificate owning the Secret, or none of the conflicting Certificates,
+	// are issued until the conflict is resolved.
+	//
+	// It is managed by the 'trigger' controller.
+	CertificateConditionSecretOwnershipConflict CertificateConditionType = "SecretOwnershipConflict"
 )
 
 // CertificateSecretTemplate defines the default labels and annotations
@@ -623,6 +934,120 @@ type CertificateSecretTemplate struct {
 	Labels map[string]string
 }
 
+// CertificatePolicy is a policy included in the Certificate Policies extension
+// of an issued certificate.
+type CertificatePolicy struct {
+	// OID is the object identifier of the policy, expressed as a dotted
+	// string, for example "2.23.140.1.2.1". Each policy may only be listed
+	// once.
+	OID string
+
+	// CPSURI is the URI of the Certification Practice Statement (CPS)
+	// published for the policy, encoded as a CPS pointer qualifier.
+	// +optional
+	CPSURI string
+
+	// UserNotice is the text displayed to relying parties, encoded as the
+	// explicit text of a user notice qualifier. Must be at most 200
+	// characters long.
+	// +optional
+	UserNotice string
+}
+
+// CertificatePolicyConstraints are the constraints encoded in the Policy
+// Constraints extension of a CA certificate. At least one of the fields must
+// be set.
+type CertificatePolicyConstraints struct {
+	// RequireExplicitPolicy is the number of additional certificates which
+	// may appear in a certification path before an explicit policy is
+	// required. Must not be negative.
+	// +optional
+	RequireExplicitPolicy *int32
+
+	// InhibitPolicyMapping is the number of additional certificates which
+	// may appear in a certification path before policy mapping is no longer
+	// permitted. Must not be negative.
+	// +optional
+	InhibitPolicyMapping *int32
+}
+
+// CertificateLegacyExtensions are the legacy X.509 extensions which can be
+// requested for a certificate.
+type CertificateLegacyExtensions struct {
+	// NetscapeComment is the comment encoded as the Netscape Comment extension
+	// (2.16.840.1.113730.1.13). Must only contain printable ASCII characters
+	// and be at most 256 characters long.
+	// +optional
+	NetscapeComment string
+
+	// NetscapeCertType are the purposes of the certificate encoded as the
+	// Netscape Certificate Type extension (2.16.840.1.113730.1.1). Each
+	// purpose may only be listed once.
+	// +optional
+	NetscapeCertType []NetscapeCertType
+}
+
+// NetscapeCertType is a purpose of a certificate listed in the Netscape
+// Certificate Type extension.
+type NetscapeCertType string
+
+const (
+	NetscapeCertTypeSSLClient       NetscapeCertType = "ssl client"
+	NetscapeCertTypeSSLServer       NetscapeCertType = "ssl server"
+	NetscapeCertTypeSMIME           NetscapeCertType = "s/mime"
+	NetscapeCertTypeObjectSigning   NetscapeCertType = "object signing"
+	NetscapeCertTypeSSLCA           NetscapeCertType = "ssl ca"
+	NetscapeCertTypeSMIMECA         NetscapeCertType = "s/mime ca"
+	NetscapeCertTypeObjectSigningCA NetscapeCertType = "object signing ca"
+)
+
+// CertificateChainTruncation identifies the CA certificate at which the stored
+// certificate chain is truncated.
+// Exactly one of Subject or SHA256Fingerprint must be set.
+type CertificateChainTruncation struct {
+	// Subject is the subject of the anchor certificate as an RFC 4514
+	// distinguished name, for example "CN=Example Intermediate CA,O=Example".
+	// The name is compared by its attributes, not by its exact string form.
+	// +optional
+	Subject string
+
+	// SHA256Fingerprint is the SHA-256 fingerprint of the DER encoded anchor
+	// certificate, as 64 hexadecimal characters. Colons between bytes and
+	// upper case characters are accepted.
+	// +optional
+	SHA256Fingerprint string
+}
+
+// CertificateMSTemplate identifies a Microsoft certificate template, as used by
+// Active Directory Certificate Services (AD CS).
+// Exactly one of `name` or `oid` must be set.
+type CertificateMSTemplate struct {
+	// Name is the name of the certificate template, encoded in the CSR as the
+	// Certificate Template Name extension (1.3.6.1.4.1.311.20.2).
+	// Cannot be set together with `oid`.
+	// +optional
+	Name string
+
+	// OID is the object identifier of the certificate template, encoded in
+	// the CSR together with the template version as the Certificate Template
+	// Information extension (1.3.6.1.4.1.311.21.7).
+	// The object identifier must be expressed as a dotted string, for example
+	// "1.3.6.1.4.1.311.21.8.1234.5678".
+	// Cannot be set together with `name`.
+	// +optional
+	OID string
+
+	// MajorVersion is the major version of the certificate template.
+	// Required if `oid` is set, and cannot be set otherwise.
+	// +optional
+	MajorVersion *int32
+
+	// MinorVersion is the minor version of the certificate template.
+	// Can only be set if `oid` is set.
+	// +optional
+	MinorVersion *int32
+}
+
 // NameConstraints is a type to represent x509 NameConstraints
 type NameConstraints struct {
 	// if true then the name constraints are marked critical.
@@ -82,6 +82,59 @@ type IssuerList struct {
 // configuration required for the issuer.
 type IssuerSpec struct {
 	IssuerConfig
+
+	// DefaultSecretTemplate defines labels and annotations to be copied to the
+	// Secret of every Certificate issued by this issuer. These are merged with
+	// the Certificate's own `secretTemplate`, whose values take precedence for
+	// keys that are set in both.
+	DefaultSecretTemplate *CertificateSecretTemplate
+
+	// TerminalErrors lists matchers for errors returned by this issuer which
+	// will never succeed on retry, such as policy violations reported by the
+	// signing CA. If an error returned while signing a CertificateRequest
+	// matches any of these, the CertificateRequest is marked as Failed
+	// instead of the request being retried.
+	TerminalErrors []TerminalErrorMatcher
+
+	// UsagePolicy restricts the key usages and extended key usages that may be
+	// requested from this issuer. Requests asking for a usage which is not
+	// permitted by the policy are denied, or marked as Failed without being
+	// signed if they have already been approved.
+	UsagePolicy *IssuerUsagePolicy
+
+	// DefaultExpiredCertificatePolicy is the `expiredCertificatePolicy` of
+	// Certificates issued by this issuer which do not set one.
+	// If unset, defaults to `Keep`.
+	// +optional
+	DefaultExpiredCertificatePolicy ExpiredCertificatePolicy
+}
+
+// IssuerUsagePolicy restricts the usages of the certificates signed by an
+// issuer. The usages of a request include the default usages when none are
+// requested, and `cert sign` when a CA certificate is requested.
+type IssuerUsagePolicy struct {
+	// AllowedUsages is the list of usages that may be requested. If set,
+	// requests for any other usage are rejected. If empty, all usages not
+	// listed in `forbiddenUsages` are allowed.
+	AllowedUsages []KeyUsage
+
+	// ForbiddenUsages is the list of usages that may never be requested, such
+	// as `cert sign` or `code signing`. A usage may not be both allowed and
+	// forbidden.
+	ForbiddenUsages []KeyUsage
+}
+
+// TerminalErrorMatcher matches the message of an error returned by an issuer.
+// Error codes returned by a CA are matched as part of the error message.
+// Exactly one of Contains or Regex must be set.
+type TerminalErrorMatcher struct {
+	// Contains matches errors whose message contains this string.
+	Contains string
+
+	// Regex matches errors whose message matches this regular expression,
+	// using the RE2 syntax accepted by Go. The expression is not anchored, so
+	// use `^` and `$` to match the whole message.
+	Regex string
 }
 
 // IssuerConfig is a generic wrapper around custom issuer types
@@ -106,6 +159,10 @@ type IssuerConfig struct {
 	// Venafi configures this issuer to sign certificates using a Venafi TPP
 	// or Venafi Cloud policy zone.
 	Venafi *VenafiIssuer
+
+	// GoogleCAS configures this issuer to sign certificates using a Google
+	// Cloud Certificate Authority Service (CAS) CA pool.
+	GoogleCAS *GoogleCASIssuer
 }
 
 // VenafiIssuer configures an issuer to sign certificates using a Venafi TPP
@@ -124,6 +181,12 @@ type VenafiIssuer struct {
 	// Cloud specifies the Venafi cloud configuration settings.
 	// Only one of TPP or Cloud may be specified.
 	Cloud *VenafiCloud
+
+	// HTTPHeaders are additional HTTP headers which are set on all requests
+	// made to the Venafi platform, for example to authenticate with an egress
+	// gateway. Headers which are managed by the Venafi client, such as
+	// Content-Type, User-Agent and Authorization, may not be set.
+	HTTPHeaders map[string]string
 }
 
 // VenafiTPP defines connection configuration details for a Venafi TPP instance
@@ -149,6 +212,30 @@ type VenafiTPP struct {
 	// If neither CABundle nor CABundleSecretRef is defined, the certificate bundle in
 	// the cert-manager controller container is used to validate the TLS connection.
 	CABundleSecretRef *cmmeta.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
+
+	// Approval configures cert-manager to integrate with a TPP change-approval
+	// workflow. If set, a WorkToDo custom field is set on each enrollment and
+	// certificates which are awaiting approval in TPP are reported as such,
+	// rather than as a failed or generically pending request.
+	Approval *VenafiTPPApproval
+}
+
+// VenafiTPPApproval configures how enrollments interact with a TPP
+// change-approval workflow.
+type VenafiTPPApproval struct {
+	// WorkToDoField is the name of the TPP custom field which is set on each
+	// enrollment to signal that the request should enter the approval workflow.
+	WorkToDoField string
+
+	// WorkToDoValue is the value which the WorkToDo custom field is set to.
+	// Defaults to "true".
+	WorkToDoValue string
+
+	// AwaitingApprovalStatuses is a list of TPP certificate statuses which
+	// indicate that the certificate is awaiting approval. Statuses are matched
+	// case-insensitively as substrings of the status reported by TPP.
+	// Defaults to ["approval"].
+	AwaitingApprovalStatuses []string
 }
 
 // VenafiCloud defines connection configuration details for Venafi Cloud
@@ -161,6 +248,60 @@ type VenafiCloud struct {
 	APITokenSecretRef cmmeta.SecretKeySelector
 }
 
+// GoogleCASIssuer configures an issuer to sign certificates using a Google
+// Cloud Certificate Authority Service (CAS) CA pool.
+// At most one of ServiceAccountSecretRef or WorkloadIdentity may be set.
+// If neither is set, ambient credentials are used, if they are enabled for
+// the issuer.
+type GoogleCASIssuer struct {
+	// Project is the ID of the Google Cloud project containing the CA pool.
+	Project string
+
+	// Location is the Google Cloud location of the CA pool, for example
+	// `us-central1`.
+	Location string
+
+	// CAPool is the ID of the CA pool to request certificates from.
+	CAPool string
+
+	// CertificateAuthority is the ID of the certificate authority in the CA
+	// pool which should sign certificates. If not set, CAS chooses a
+	// certificate authority from the CA pool.
+	CertificateAuthority string
+
+	// CertificateTemplate is the resource name of a CAS certificate template
+	// to apply to issued certificates, of the form
+	// `projects/*/locations/*/certificateTemplates/*`.
+	CertificateTemplate string
+
+	// ServiceAccountSecretRef references a key of a Secret containing the JSON
+	// key of a Google Cloud service account to authenticate with.
+	ServiceAccountSecretRef *cmmeta.SecretKeySelector
+
+	// WorkloadIdentity authenticates with Google Cloud using workload identity
+	// federation, by exchanging a token for a Kubernetes ServiceAccount.
+	WorkloadIdentity *GoogleCASWorkloadIdentity
+}
+
+// GoogleCASWorkloadIdentity configures authentication with Google Cloud using
+// workload identity federation.
+type GoogleCASWorkloadIdentity struct {
+	// Audience is the audience of the workload identity pool provider, of the
+	// form `//iam.googleapis.com/projects/PROJECT_NUMBER/locations/global/workloadIdentityPools/POOL_ID/providers/PROVIDER_ID`.
+	// The Kubernetes ServiceAccount token is requested with this audience.
+	Audience string
+
+	// ServiceAccountName is the name of the Kubernetes ServiceAccount to
+	// request a token for. The ServiceAccount must be in the same namespace as
+	// the Issuer, or in the cluster resource namespace for a ClusterIssuer.
+	ServiceAccountName string
+
+	// ServiceAccountEmail is the email address of a Google Cloud service
+	// account to impersonate. If not set, the federated identity is used
+	// directly.
+	ServiceAccountEmail string
+}
+
 // SelfSignedIssuer configures an issuer to 'self sign' certificates using the
 // private key used to create the CertificateRequest object.
 type SelfSignedIssuer struct {
@@ -168,6 +309,34 @@ type SelfSignedIssuer struct {
 	// the location of the CRL from which the revocation of this certificate can be checked.
 	// If not set certificate will be issued without CDP. Values are strings.
 	CRLDistributionPoints []string
+
+	// SubjectKeyIdentifierMethod selects how the subject key identifier of
+	// the certificates signed by this issuer is derived from their public key.
+	// `SHA1` uses the 160-bit SHA-1 hash of the public key (RFC 5280 section
+	// 4.2.1.2 method 1), and `TruncatedSHA1` uses a 4-bit type field followed
+	// by the least significant 60 bits of that hash (method 2).
+	// If not set, only CA certificates are given a subject key identifier,
+	// derived using method 1.
+	// +optional
+	SubjectKeyIdentifierMethod SubjectKeyIdentifierMethod
+
+	// AuthorityCertificate is a PEM encoded certificate of an external CA which
+	// cross-signs the certificates signed by this issuer. Its subject key
+	// identifier is used as the authority key identifier of the signed
+	// certificates, rather than deriving it from their own public key, so that
+	// they chain to the external CA once cross-signed. The certificate must
+	// have a subject key identifier.
+	// +optional
+	AuthorityCertificate []byte
+
+	// NotAfterBoundary rounds the expiry time of the certificates signed by
+	// this issuer down to a boundary in UTC, `Hour` or `Day`. The start of
+	// their validity period is moved back by the same amount, so that they
+	// keep approximately the requested duration. Certificates whose expiry
+	// time would be rounded down to before the start of their validity period
+	// are signed unaligned.
+	// +optional
+	NotAfterBoundary NotAfterBoundary
 }
 
 // VaultIssuer configures an issuer to sign certificates using a HashiCorp Vault
@@ -214,6 +383,31 @@ type VaultIssuer struct {
 	// Vault server requires mTLS.
 	// +optional
 	ClientKeySecretRef *cmmeta.SecretKeySelector
+
+	// ClientAuthOnly requires the certificates signed by this issuer to be
+	// valid only for client authentication, regardless of the usages
+	// requested. Vault determines the usages of the certificates it signs
+	// from the PKI role, so requests are failed if Vault signs a certificate
+	// with any other extended key usage.
+	// +optional
+	ClientAuthOnly *ClientAuthOnlyPolicy
+
+	// NotAfterBoundary rounds the expiry time of the certificates signed by
+	// this issuer down to a boundary in UTC, `Hour` or `Day`, by requesting
+	// that expiry time from Vault rather than a TTL. Vault does not allow the
+	// start of their validity period to be moved back, so their duration is
+	// shortened by up to an hour or a day. Certificates whose expiry time
+	// would be rounded down to the current time or before are signed with
+	// the requested TTL.
+	// +optional
+	NotAfterBoundary NotAfterBoundary
+
+	// HTTPHeaders are additional HTTP headers which are set on all requests
+	// made to the Vault server, for example to authenticate with an egress
+	// gateway. Headers which are managed by the Vault client, such as
+	// Content-Type, User-Agent and Authorization, may not be set.
+	// +optional
+	HTTPHeaders map[string]string
 }
 
 // VaultAuth is configuration used to authenticate with a Vault server. The
@@ -348,6 +542,113 @@ type CAIssuer struct {
 	// As an example, such a URL might be "http://ca.domain.com/ca.crt".
 	// +optional
 	IssuingCertificateURLs []string `json:"issuingCertificateURLs,omitempty"`
+
+	// RequesterDurationPolicies maps the identity of the requester of a
+	// CertificateRequest, as recorded in its `spec.username` and
+	// `spec.groups` fields, to the maximum duration of the certificates this
+	// issuer signs for them. The first policy which matches the requester is
+	// applied, and certificates requested with a longer duration are clamped
+	// to the policy's maxDuration. If no policy matches, the requested
+	// duration is used.
+	// For example, matching the group `system:serviceaccounts` allows service
+	// accounts to be limited to short-lived certificates.
+	RequesterDurationPolicies []RequesterDurationPolicy
+
+	// SubjectKeyIdentifierMethod selects how the subject key identifier of
+	// the certificates signed by this issuer is derived from their public key.
+	// `SHA1` uses the 160-bit SHA-1 hash of the public key (RFC 5280 section
+	// 4.2.1.2 method 1), and `TruncatedSHA1` uses a 4-bit type field followed
+	// by the least significant 60 bits of that hash (method 2).
+	// If not set, only CA certificates are given a subject key identifier,
+	// derived using method 1.
+	// +optional
+	SubjectKeyIdentifierMethod SubjectKeyIdentifierMethod
+
+	// ClientAuthOnly forces the certificates signed by this issuer to be
+	// valid only for client authentication, regardless of the usages
+	// requested.
+	// +optional
+	ClientAuthOnly *ClientAuthOnlyPolicy
+
+	// NotAfterBoundary rounds the expiry time of the certificates signed by
+	// this issuer down to a boundary in UTC, `Hour` or `Day`. The start of
+	// their validity period is moved back by the same amount, so that they
+	// keep approximately the requested duration. Certificates whose expiry
+	// time would be rounded down to before the start of their validity period
+	// are signed unaligned.
+	// +optional
+	NotAfterBoundary NotAfterBoundary
+
+	// RootCASecretRef references a PEM encoded bundle of CA certificates,
+	// stored in a Secret in the same namespace as the signing CA Secret, which
+	// is used to complete the chain from the signing CA up to a self-signed
+	// root. Use this when the signing CA Secret only contains an intermediate
+	// CA and its private key. The chain from the signing CA to the root must
+	// build, or the issuer will not become ready. Issued certificates then
+	// contain the chain up to, but excluding, the root, and the root is stored
+	// as the `ca.crt` of the issued certificate.
+	// If the key is not set, `ca.crt` is used.
+	RootCASecretRef *cmmeta.SecretKeySelector
+}
+
+// RequesterDurationPolicy limits the duration of certificates signed for
+// requesters matching its usernames or groups.
+type RequesterDurationPolicy struct {
+	// Usernames matches requesters whose username is equal to one of the
+	// given values.
+	Usernames []string
+
+	// Groups matches requesters which are a member of at least one of the
+	// given groups.
+	Groups []string
+
+	// MaxDuration is the maximum duration of certificates signed for a
+	// matching requester. Requests for a longer duration are clamped to this
+	// value. Must be at least 1h.
+	MaxDuration metav1.Duration
+}
+
+// SubjectKeyIdentifierMethod is a method of deriving a certificate's subject
+// key identifier from its public key, as described in RFC 5280 section
+// 4.2.1.2.
+type SubjectKeyIdentifierMethod string
+
+const (
+	// SubjectKeyIdentifierMethodSHA1 derives the subject key identifier from
+	// the 160-bit SHA-1 hash of the public key (method 1).
+	SubjectKeyIdentifierMethodSHA1 SubjectKeyIdentifierMethod = "SHA1"
+
+	// SubjectKeyIdentifierMethodTruncatedSHA1 derives the subject key
+	// identifier from a 4-bit type field with the value 0100 followed by the
+	// least significant 60 bits of the SHA-1 hash of the public key (method 2).
+	SubjectKeyIdentifierMethodTruncatedSHA1 SubjectKeyIdentifierMethod = "TruncatedSHA1"
+)
+
+// NotAfterBoundary is a boundary in UTC which the expiry time of certificates
+// is rounded down to.
+type NotAfterBoundary string
+
+const (
+	// NotAfterBoundaryHour rounds the expiry time down to the start of the
+	// hour.
+	NotAfterBoundaryHour NotAfterBoundary = "Hour"
+
+	// NotAfterBoundaryDay rounds the expiry time down to midnight UTC.
+	NotAfterBoundaryDay NotAfterBoundary = "Day"
+)
+
+// ClientAuthOnlyPolicy restricts the certificates signed by an issuer to
+// client authentication. Their extended key usages are set to only
+// `client auth`, and any other requested extended key usages such as
+// `server auth` are removed.
+type ClientAuthOnlyPolicy struct {
+	// Namespaces limits the policy to CertificateRequests in the given
+	// namespaces. If empty, the policy applies to all certificates signed by
+	// the issuer. Kubernetes CertificateSigningRequests, which are not
+	// namespaced, are always subject to the policy.
+	// +optional
+	// +listType=atomic
+	Namespaces []string
 }
 
 // IssuerStatus contains status information about an Issuer
@@ -74,6 +74,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateAdditionalSecret)(nil), (*certmanager.CertificateAdditionalSecret)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateAdditionalSecret_To_certmanager_CertificateAdditionalSecret(a.(*v1.CertificateAdditionalSecret), b.(*certmanager.CertificateAdditionalSecret), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateAdditionalSecret)(nil), (*v1.CertificateAdditionalSecret)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateAdditionalSecret_To_v1_CertificateAdditionalSecret(a.(*certmanager.CertificateAdditionalSecret), b.(*v1.CertificateAdditionalSecret), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateChainTruncation)(nil), (*certmanager.CertificateChainTruncation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateChainTruncation_To_certmanager_CertificateChainTruncation(a.(*v1.CertificateChainTruncation), b.(*certmanager.CertificateChainTruncation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateChainTruncation)(nil), (*v1.CertificateChainTruncation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateChainTruncation_To_v1_CertificateChainTruncation(a.(*certmanager.CertificateChainTruncation), b.(*v1.CertificateChainTruncation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateCondition)(nil), (*certmanager.CertificateCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateCondition_To_certmanager_CertificateCondition(a.(*v1.CertificateCondition), b.(*certmanager.CertificateCondition), scope)
 	}); err != nil {
@@ -94,6 +114,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateLegacyExtensions)(nil), (*certmanager.CertificateLegacyExtensions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateLegacyExtensions_To_certmanager_CertificateLegacyExtensions(a.(*v1.CertificateLegacyExtensions), b.(*certmanager.CertificateLegacyExtensions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateLegacyExtensions)(nil), (*v1.CertificateLegacyExtensions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateLegacyExtensions_To_v1_CertificateLegacyExtensions(a.(*certmanager.CertificateLegacyExtensions), b.(*v1.CertificateLegacyExtensions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateList)(nil), (*certmanager.CertificateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateList_To_certmanager_CertificateList(a.(*v1.CertificateList), b.(*certmanager.CertificateList), scope)
 	}); err != nil {
@@ -104,6 +134,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateMSTemplate)(nil), (*certmanager.CertificateMSTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateMSTemplate_To_certmanager_CertificateMSTemplate(a.(*v1.CertificateMSTemplate), b.(*certmanager.CertificateMSTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateMSTemplate)(nil), (*v1.CertificateMSTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateMSTemplate_To_v1_CertificateMSTemplate(a.(*certmanager.CertificateMSTemplate), b.(*v1.CertificateMSTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificatePolicy)(nil), (*certmanager.CertificatePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificatePolicy_To_certmanager_CertificatePolicy(a.(*v1.CertificatePolicy), b.(*certmanager.CertificatePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificatePolicy)(nil), (*v1.CertificatePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificatePolicy_To_v1_CertificatePolicy(a.(*certmanager.CertificatePolicy), b.(*v1.CertificatePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificatePolicyConstraints)(nil), (*certmanager.CertificatePolicyConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificatePolicyConstraints_To_certmanager_CertificatePolicyConstraints(a.(*v1.CertificatePolicyConstraints), b.(*certmanager.CertificatePolicyConstraints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificatePolicyConstraints)(nil), (*v1.CertificatePolicyConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificatePolicyConstraints_To_v1_CertificatePolicyConstraints(a.(*certmanager.CertificatePolicyConstraints), b.(*v1.CertificatePolicyConstraints), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificatePrivateKey)(nil), (*certmanager.CertificatePrivateKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(a.(*v1.CertificatePrivateKey), b.(*certmanager.CertificatePrivateKey), scope)
 	}); err != nil {
@@ -194,6 +254,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ClientAuthOnlyPolicy)(nil), (*certmanager.ClientAuthOnlyPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClientAuthOnlyPolicy_To_certmanager_ClientAuthOnlyPolicy(a.(*v1.ClientAuthOnlyPolicy), b.(*certmanager.ClientAuthOnlyPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.ClientAuthOnlyPolicy)(nil), (*v1.ClientAuthOnlyPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_ClientAuthOnlyPolicy_To_v1_ClientAuthOnlyPolicy(a.(*certmanager.ClientAuthOnlyPolicy), b.(*v1.ClientAuthOnlyPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ClusterIssuer)(nil), (*certmanager.ClusterIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(a.(*v1.ClusterIssuer), b.(*certmanager.ClusterIssuer), scope)
 	}); err != nil {
@@ -214,6 +284,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.GoogleCASIssuer)(nil), (*certmanager.GoogleCASIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer(a.(*v1.GoogleCASIssuer), b.(*certmanager.GoogleCASIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.GoogleCASIssuer)(nil), (*v1.GoogleCASIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_GoogleCASIssuer_To_v1_GoogleCASIssuer(a.(*certmanager.GoogleCASIssuer), b.(*v1.GoogleCASIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.GoogleCASWorkloadIdentity)(nil), (*certmanager.GoogleCASWorkloadIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GoogleCASWorkloadIdentity_To_certmanager_GoogleCASWorkloadIdentity(a.(*v1.GoogleCASWorkloadIdentity), b.(*certmanager.GoogleCASWorkloadIdentity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.GoogleCASWorkloadIdentity)(nil), (*v1.GoogleCASWorkloadIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_GoogleCASWorkloadIdentity_To_v1_GoogleCASWorkloadIdentity(a.(*certmanager.GoogleCASWorkloadIdentity), b.(*v1.GoogleCASWorkloadIdentity), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.Issuer)(nil), (*certmanager.Issuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Issuer_To_certmanager_Issuer(a.(*v1.Issuer), b.(*certmanager.Issuer), scope)
 	}); err != nil {
@@ -274,6 +364,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.IssuerUsagePolicy)(nil), (*certmanager.IssuerUsagePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuerUsagePolicy_To_certmanager_IssuerUsagePolicy(a.(*v1.IssuerUsagePolicy), b.(*certmanager.IssuerUsagePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerUsagePolicy)(nil), (*v1.IssuerUsagePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerUsagePolicy_To_v1_IssuerUsagePolicy(a.(*certmanager.IssuerUsagePolicy), b.(*v1.IssuerUsagePolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.JKSKeystore)(nil), (*certmanager.JKSKeystore)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_JKSKeystore_To_certmanager_JKSKeystore(a.(*v1.JKSKeystore), b.(*certmanager.JKSKeystore), scope)
 	}); err != nil {
@@ -324,6 +424,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.RequesterDurationPolicy)(nil), (*certmanager.RequesterDurationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RequesterDurationPolicy_To_certmanager_RequesterDurationPolicy(a.(*v1.RequesterDurationPolicy), b.(*certmanager.RequesterDurationPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.RequesterDurationPolicy)(nil), (*v1.RequesterDurationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_RequesterDurationPolicy_To_v1_RequesterDurationPolicy(a.(*certmanager.RequesterDurationPolicy), b.(*v1.RequesterDurationPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.SelfSignedIssuer)(nil), (*certmanager.SelfSignedIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(a.(*v1.SelfSignedIssuer), b.(*certmanager.SelfSignedIssuer), scope)
 	}); err != nil {
@@ -344,6 +454,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.TerminalErrorMatcher)(nil), (*certmanager.TerminalErrorMatcher)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TerminalErrorMatcher_To_certmanager_TerminalErrorMatcher(a.(*v1.TerminalErrorMatcher), b.(*certmanager.TerminalErrorMatcher), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.TerminalErrorMatcher)(nil), (*v1.TerminalErrorMatcher)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_TerminalErrorMatcher_To_v1_TerminalErrorMatcher(a.(*certmanager.TerminalErrorMatcher), b.(*v1.TerminalErrorMatcher), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.VaultAppRole)(nil), (*certmanager.VaultAppRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_VaultAppRole_To_certmanager_VaultAppRole(a.(*v1.VaultAppRole), b.(*certmanager.VaultAppRole), scope)
 	}); err != nil {
@@ -424,6 +544,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.VenafiTPPApproval)(nil), (*certmanager.VenafiTPPApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VenafiTPPApproval_To_certmanager_VenafiTPPApproval(a.(*v1.VenafiTPPApproval), b.(*certmanager.VenafiTPPApproval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.VenafiTPPApproval)(nil), (*v1.VenafiTPPApproval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_VenafiTPPApproval_To_v1_VenafiTPPApproval(a.(*certmanager.VenafiTPPApproval), b.(*v1.VenafiTPPApproval), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.X509Subject)(nil), (*certmanager.X509Subject)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_X509Subject_To_certmanager_X509Subject(a.(*v1.X509Subject), b.(*certmanager.X509Subject), scope)
 	}); err != nil {
@@ -442,6 +572,11 @@ func autoConvert_v1_CAIssuer_To_certmanager_CAIssuer(in *v1.CAIssuer, out *certm
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
 	out.IssuingCertificateURLs = *(*[]string)(unsafe.Pointer(&in.IssuingCertificateURLs))
+	out.RequesterDurationPolicies = *(*[]certmanager.RequesterDurationPolicy)(unsafe.Pointer(&in.RequesterDurationPolicies))
+	out.SubjectKeyIdentifierMethod = certmanager.SubjectKeyIdentifierMethod(in.SubjectKeyIdentifierMethod)
+	out.ClientAuthOnly = (*certmanager.ClientAuthOnlyPolicy)(unsafe.Pointer(in.ClientAuthOnly))
+	out.NotAfterBoundary = certmanager.NotAfterBoundary(in.NotAfterBoundary)
+	out.RootCASecretRef = (*meta.SecretKeySelector)(unsafe.Pointer(in.RootCASecretRef))
 	return nil
 }
 
@@ -455,6 +590,11 @@ func autoConvert_certmanager_CAIssuer_To_v1_CAIssuer(in *certmanager.CAIssuer, o
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
 	out.IssuingCertificateURLs = *(*[]string)(unsafe.Pointer(&in.IssuingCertificateURLs))
+	out.RequesterDurationPolicies = *(*[]v1.RequesterDurationPolicy)(unsafe.Pointer(&in.RequesterDurationPolicies))
+	out.SubjectKeyIdentifierMethod = v1.SubjectKeyIdentifierMethod(in.SubjectKeyIdentifierMethod)
+	out.ClientAuthOnly = (*v1.ClientAuthOnlyPolicy)(unsafe.Pointer(in.ClientAuthOnly))
+	out.NotAfterBoundary = v1.NotAfterBoundary(in.NotAfterBoundary)
+	out.RootCASecretRef = (*apismetav1.SecretKeySelector)(unsafe.Pointer(in.RootCASecretRef))
 	return nil
 }
 
@@ -515,6 +655,56 @@ func Convert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAddi
 	return autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in, out, s)
 }
 
+func autoConvert_v1_CertificateAdditionalSecret_To_certmanager_CertificateAdditionalSecret(in *v1.CertificateAdditionalSecret, out *certmanager.CertificateAdditionalSecret, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Format = certmanager.CertificateAdditionalSecretFormat(in.Format)
+	out.PasswordSecretRef = (*meta.SecretKeySelector)(unsafe.Pointer(in.PasswordSecretRef))
+	out.Profile = certmanager.PKCS12Profile(in.Profile)
+	return nil
+}
+
+// Convert_v1_CertificateAdditionalSecret_To_certmanager_CertificateAdditionalSecret is an autogenerated conversion function.
+func Convert_v1_CertificateAdditionalSecret_To_certmanager_CertificateAdditionalSecret(in *v1.CertificateAdditionalSecret, out *certmanager.CertificateAdditionalSecret, s conversion.Scope) error {
+	return autoConvert_v1_CertificateAdditionalSecret_To_certmanager_CertificateAdditionalSecret(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateAdditionalSecret_To_v1_CertificateAdditionalSecret(in *certmanager.CertificateAdditionalSecret, out *v1.CertificateAdditionalSecret, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Format = v1.CertificateAdditionalSecretFormat(in.Format)
+	out.PasswordSecretRef = (*apismetav1.SecretKeySelector)(unsafe.Pointer(in.PasswordSecretRef))
+	out.Profile = v1.PKCS12Profile(in.Profile)
+	return nil
+}
+
+// Convert_certmanager_CertificateAdditionalSecret_To_v1_CertificateAdditionalSecret is an autogenerated conversion function.
+func Convert_certmanager_CertificateAdditionalSecret_To_v1_CertificateAdditionalSecret(in *certmanager.CertificateAdditionalSecret, out *v1.CertificateAdditionalSecret, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateAdditionalSecret_To_v1_CertificateAdditionalSecret(in, out, s)
+}
+
+func autoConvert_v1_CertificateChainTruncation_To_certmanager_CertificateChainTruncation(in *v1.CertificateChainTruncation, out *certmanager.CertificateChainTruncation, s conversion.Scope) error {
+	out.Subject = in.Subject
+	out.SHA256Fingerprint = in.SHA256Fingerprint
+	return nil
+}
+
+// Convert_v1_CertificateChainTruncation_To_certmanager_CertificateChainTruncation is an autogenerated conversion function.
+func Convert_v1_CertificateChainTruncation_To_certmanager_CertificateChainTruncation(in *v1.CertificateChainTruncation, out *certmanager.CertificateChainTruncation, s conversion.Scope) error {
+	return autoConvert_v1_CertificateChainTruncation_To_certmanager_CertificateChainTruncation(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateChainTruncation_To_v1_CertificateChainTruncation(in *certmanager.CertificateChainTruncation, out *v1.CertificateChainTruncation, s conversion.Scope) error {
+	out.Subject = in.Subject
+	out.SHA256Fingerprint = in.SHA256Fingerprint
+	return nil
+}
+
+// Convert_certmanager_CertificateChainTruncation_To_v1_CertificateChainTruncation is an autogenerated conversion function.
+func Convert_certmanager_CertificateChainTruncation_To_v1_CertificateChainTruncation(in *certmanager.CertificateChainTruncation, out *v1.CertificateChainTruncation, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateChainTruncation_To_v1_CertificateChainTruncation(in, out, s)
+}
+
 func autoConvert_v1_CertificateCondition_To_certmanager_CertificateCondition(in *v1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
 	out.Type = certmanager.CertificateConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
@@ -599,6 +789,28 @@ func Convert_certmanager_CertificateKeystores_To_v1_CertificateKeystores(in *cer
 	return autoConvert_certmanager_CertificateKeystores_To_v1_CertificateKeystores(in, out, s)
 }
 
+func autoConvert_v1_CertificateLegacyExtensions_To_certmanager_CertificateLegacyExtensions(in *v1.CertificateLegacyExtensions, out *certmanager.CertificateLegacyExtensions, s conversion.Scope) error {
+	out.NetscapeComment = in.NetscapeComment
+	out.NetscapeCertType = *(*[]certmanager.NetscapeCertType)(unsafe.Pointer(&in.NetscapeCertType))
+	return nil
+}
+
+// Convert_v1_CertificateLegacyExtensions_To_certmanager_CertificateLegacyExtensions is an autogenerated conversion function.
+func Convert_v1_CertificateLegacyExtensions_To_certmanager_CertificateLegacyExtensions(in *v1.CertificateLegacyExtensions, out *certmanager.CertificateLegacyExtensions, s conversion.Scope) error {
+	return autoConvert_v1_CertificateLegacyExtensions_To_certmanager_CertificateLegacyExtensions(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateLegacyExtensions_To_v1_CertificateLegacyExtensions(in *certmanager.CertificateLegacyExtensions, out *v1.CertificateLegacyExtensions, s conversion.Scope) error {
+	out.NetscapeComment = in.NetscapeComment
+	out.NetscapeCertType = *(*[]v1.NetscapeCertType)(unsafe.Pointer(&in.NetscapeCertType))
+	return nil
+}
+
+// Convert_certmanager_CertificateLegacyExtensions_To_v1_CertificateLegacyExtensions is an autogenerated conversion function.
+func Convert_certmanager_CertificateLegacyExtensions_To_v1_CertificateLegacyExtensions(in *certmanager.CertificateLegacyExtensions, out *v1.CertificateLegacyExtensions, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateLegacyExtensions_To_v1_CertificateLegacyExtensions(in, out, s)
+}
+
 func autoConvert_v1_CertificateList_To_certmanager_CertificateList(in *v1.CertificateList, out *certmanager.CertificateList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -641,11 +853,84 @@ func Convert_certmanager_CertificateList_To_v1_CertificateList(in *certmanager.C
 	return autoConvert_certmanager_CertificateList_To_v1_CertificateList(in, out, s)
 }
 
+func autoConvert_v1_CertificateMSTemplate_To_certmanager_CertificateMSTemplate(in *v1.CertificateMSTemplate, out *certmanager.CertificateMSTemplate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.OID = in.OID
+	out.MajorVersion = (*int32)(unsafe.Pointer(in.MajorVersion))
+	out.MinorVersion = (*int32)(unsafe.Pointer(in.MinorVersion))
+	return nil
+}
+
+// Convert_v1_CertificateMSTemplate_To_certmanager_CertificateMSTemplate is an autogenerated conversion function.
+func Convert_v1_CertificateMSTemplate_To_certmanager_CertificateMSTemplate(in *v1.CertificateMSTemplate, out *certmanager.CertificateMSTemplate, s conversion.Scope) error {
+	return autoConvert_v1_CertificateMSTemplate_To_certmanager_CertificateMSTemplate(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateMSTemplate_To_v1_CertificateMSTemplate(in *certmanager.CertificateMSTemplate, out *v1.CertificateMSTemplate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.OID = in.OID
+	out.MajorVersion = (*int32)(unsafe.Pointer(in.MajorVersion))
+	out.MinorVersion = (*int32)(unsafe.Pointer(in.MinorVersion))
+	return nil
+}
+
+// Convert_certmanager_CertificateMSTemplate_To_v1_CertificateMSTemplate is an autogenerated conversion function.
+func Convert_certmanager_CertificateMSTemplate_To_v1_CertificateMSTemplate(in *certmanager.CertificateMSTemplate, out *v1.CertificateMSTemplate, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateMSTemplate_To_v1_CertificateMSTemplate(in, out, s)
+}
+
+func autoConvert_v1_CertificatePolicy_To_certmanager_CertificatePolicy(in *v1.CertificatePolicy, out *certmanager.CertificatePolicy, s conversion.Scope) error {
+	out.OID = in.OID
+	out.CPSURI = in.CPSURI
+	out.UserNotice = in.UserNotice
+	return nil
+}
+
+// Convert_v1_CertificatePolicy_To_certmanager_CertificatePolicy is an autogenerated conversion function.
+func Convert_v1_CertificatePolicy_To_certmanager_CertificatePolicy(in *v1.CertificatePolicy, out *certmanager.CertificatePolicy, s conversion.Scope) error {
+	return autoConvert_v1_CertificatePolicy_To_certmanager_CertificatePolicy(in, out, s)
+}
+
+func autoConvert_certmanager_CertificatePolicy_To_v1_CertificatePolicy(in *certmanager.CertificatePolicy, out *v1.CertificatePolicy, s conversion.Scope) error {
+	out.OID = in.OID
+	out.CPSURI = in.CPSURI
+	out.UserNotice = in.UserNotice
+	return nil
+}
+
+// Convert_certmanager_CertificatePolicy_To_v1_CertificatePolicy is an autogenerated conversion function.
+func Convert_certmanager_CertificatePolicy_To_v1_CertificatePolicy(in *certmanager.CertificatePolicy, out *v1.CertificatePolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificatePolicy_To_v1_CertificatePolicy(in, out, s)
+}
+
+func autoConvert_v1_CertificatePolicyConstraints_To_certmanager_CertificatePolicyConstraints(in *v1.CertificatePolicyConstraints, out *certmanager.CertificatePolicyConstraints, s conversion.Scope) error {
+	out.RequireExplicitPolicy = (*int32)(unsafe.Pointer(in.RequireExplicitPolicy))
+	out.InhibitPolicyMapping = (*int32)(unsafe.Pointer(in.InhibitPolicyMapping))
+	return nil
+}
+
+// Convert_v1_CertificatePolicyConstraints_To_certmanager_CertificatePolicyConstraints is an autogenerated conversion function.
+func Convert_v1_CertificatePolicyConstraints_To_certmanager_CertificatePolicyConstraints(in *v1.CertificatePolicyConstraints, out *certmanager.CertificatePolicyConstraints, s conversion.Scope) error {
+	return autoConvert_v1_CertificatePolicyConstraints_To_certmanager_CertificatePolicyConstraints(in, out, s)
+}
+
+func autoConvert_certmanager_CertificatePolicyConstraints_To_v1_CertificatePolicyConstraints(in *certmanager.CertificatePolicyConstraints, out *v1.CertificatePolicyConstraints, s conversion.Scope) error {
+	out.RequireExplicitPolicy = (*int32)(unsafe.Pointer(in.RequireExplicitPolicy))
+	out.InhibitPolicyMapping = (*int32)(unsafe.Pointer(in.InhibitPolicyMapping))
+	return nil
+}
+
+// Convert_certmanager_CertificatePolicyConstraints_To_v1_CertificatePolicyConstraints is an autogenerated conversion function.
+func Convert_certmanager_CertificatePolicyConstraints_To_v1_CertificatePolicyConstraints(in *certmanager.CertificatePolicyConstraints, out *v1.CertificatePolicyConstraints, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificatePolicyConstraints_To_v1_CertificatePolicyConstraints(in, out, s)
+}
+
 func autoConvert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in *v1.CertificatePrivateKey, out *certmanager.CertificatePrivateKey, s conversion.Scope) error {
 	out.RotationPolicy = certmanager.PrivateKeyRotationPolicy(in.RotationPolicy)
 	out.Encoding = certmanager.PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
+	out.SeedSecretRef = (*meta.SecretKeySelector)(unsafe.Pointer(in.SeedSecretRef))
 	return nil
 }
 
@@ -659,6 +944,7 @@ func autoConvert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(i
 	out.Encoding = v1.PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = v1.PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
+	out.SeedSecretRef = (*apismetav1.SecretKeySelector)(unsafe.Pointer(in.SeedSecretRef))
 	return nil
 }
 
@@ -864,6 +1150,8 @@ func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *v1.Certif
 	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
 	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
 	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.IssueAfter = (*metav1.Time)(unsafe.Pointer(in.IssueAfter))
+	out.IssueAhead = (*metav1.Duration)(unsafe.Pointer(in.IssueAhead))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
@@ -883,13 +1171,25 @@ func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *v1.Certif
 	if err := internalapismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
+	out.CanaryIssuerRef = (*meta.ObjectReference)(unsafe.Pointer(in.CanaryIssuerRef))
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
 	out.PrivateKey = (*certmanager.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.AdditionalSecrets = *(*[]certmanager.CertificateAdditionalSecret)(unsafe.Pointer(&in.AdditionalSecrets))
 	out.NameConstraints = (*certmanager.NameConstraints)(unsafe.Pointer(in.NameConstraints))
+	out.MSTemplate = (*certmanager.CertificateMSTemplate)(unsafe.Pointer(in.MSTemplate))
+	out.CACertificatePolicy = certmanager.CACertificatePolicy(in.CACertificatePolicy)
+	out.CertificatePolicies = *(*[]certmanager.CertificatePolicy)(unsafe.Pointer(&in.CertificatePolicies))
+	out.LegacyExtensions = (*certmanager.CertificateLegacyExtensions)(unsafe.Pointer(in.LegacyExtensions))
+	out.ChainTruncation = (*certmanager.CertificateChainTruncation)(unsafe.Pointer(in.ChainTruncation))
+	out.PolicyConstraints = (*certmanager.CertificatePolicyConstraints)(unsafe.Pointer(in.PolicyConstraints))
+	out.InhibitAnyPolicy = (*int32)(unsafe.Pointer(in.InhibitAnyPolicy))
+	out.ExpiredCertificatePolicy = certmanager.ExpiredCertificatePolicy(in.ExpiredCertificatePolicy)
+	out.SecretDeletionPolicy = certmanager.SecretDeletionPolicy(in.SecretDeletionPolicy)
+	out.MaxChainDepth = (*int32)(unsafe.Pointer(in.MaxChainDepth))
 	return nil
 }
 
@@ -905,6 +1205,8 @@ func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanag
 	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
 	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
 	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	out.IssueAfter = (*metav1.Time)(unsafe.Pointer(in.IssueAfter))
+	out.IssueAhead = (*metav1.Duration)(unsafe.Pointer(in.IssueAhead))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
 	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
 	out.URIs = *(*[]string)(unsafe.Pointer(&in.URIs))
@@ -924,13 +1226,25 @@ func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanag
 	if err := internalapismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
 		return err
 	}
+	out.CanaryIssuerRef = (*apismetav1.ObjectReference)(unsafe.Pointer(in.CanaryIssuerRef))
 	out.IsCA = in.IsCA
 	out.Usages = *(*[]v1.KeyUsage)(unsafe.Pointer(&in.Usages))
 	out.PrivateKey = (*v1.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.AdditionalOutputFormats = *(*[]v1.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.AdditionalSecrets = *(*[]v1.CertificateAdditionalSecret)(unsafe.Pointer(&in.AdditionalSecrets))
 	out.NameConstraints = (*v1.NameConstraints)(unsafe.Pointer(in.NameConstraints))
+	out.MSTemplate = (*v1.CertificateMSTemplate)(unsafe.Pointer(in.MSTemplate))
+	out.CACertificatePolicy = v1.CACertificatePolicy(in.CACertificatePolicy)
+	out.CertificatePolicies = *(*[]v1.CertificatePolicy)(unsafe.Pointer(&in.CertificatePolicies))
+	out.LegacyExtensions = (*v1.CertificateLegacyExtensions)(unsafe.Pointer(in.LegacyExtensions))
+	out.ChainTruncation = (*v1.CertificateChainTruncation)(unsafe.Pointer(in.ChainTruncation))
+	out.PolicyConstraints = (*v1.CertificatePolicyConstraints)(unsafe.Pointer(in.PolicyConstraints))
+	out.InhibitAnyPolicy = (*int32)(unsafe.Pointer(in.InhibitAnyPolicy))
+	out.ExpiredCertificatePolicy = v1.ExpiredCertificatePolicy(in.ExpiredCertificatePolicy)
+	out.SecretDeletionPolicy = v1.SecretDeletionPolicy(in.SecretDeletionPolicy)
+	out.MaxChainDepth = (*int32)(unsafe.Pointer(in.MaxChainDepth))
 	return nil
 }
 
@@ -945,6 +1259,7 @@ func autoConvert_v1_CertificateStatus_To_certmanager_CertificateStatus(in *v1.Ce
 	out.NotBefore = (*metav1.Time)(unsafe.Pointer(in.NotBefore))
 	out.NotAfter = (*metav1.Time)(unsafe.Pointer(in.NotAfter))
 	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.SPKIPinSHA256 = in.SPKIPinSHA256
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
@@ -962,6 +1277,7 @@ func autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certm
 	out.NotBefore = (*metav1.Time)(unsafe.Pointer(in.NotBefore))
 	out.NotAfter = (*metav1.Time)(unsafe.Pointer(in.NotAfter))
 	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.SPKIPinSHA256 = in.SPKIPinSHA256
 	out.Revision = (*int)(unsafe.Pointer(in.Revision))
 	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
 	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
@@ -973,6 +1289,26 @@ func Convert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certmanag
 	return autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in, out, s)
 }
 
+func autoConvert_v1_ClientAuthOnlyPolicy_To_certmanager_ClientAuthOnlyPolicy(in *v1.ClientAuthOnlyPolicy, out *certmanager.ClientAuthOnlyPolicy, s conversion.Scope) error {
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_v1_ClientAuthOnlyPolicy_To_certmanager_ClientAuthOnlyPolicy is an autogenerated conversion function.
+func Convert_v1_ClientAuthOnlyPolicy_To_certmanager_ClientAuthOnlyPolicy(in *v1.ClientAuthOnlyPolicy, out *certmanager.ClientAuthOnlyPolicy, s conversion.Scope) error {
+	return autoConvert_v1_ClientAuthOnlyPolicy_To_certmanager_ClientAuthOnlyPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_ClientAuthOnlyPolicy_To_v1_ClientAuthOnlyPolicy(in *certmanager.ClientAuthOnlyPolicy, out *v1.ClientAuthOnlyPolicy, s conversion.Scope) error {
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_certmanager_ClientAuthOnlyPolicy_To_v1_ClientAuthOnlyPolicy is an autogenerated conversion function.
+func Convert_certmanager_ClientAuthOnlyPolicy_To_v1_ClientAuthOnlyPolicy(in *certmanager.ClientAuthOnlyPolicy, out *v1.ClientAuthOnlyPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_ClientAuthOnlyPolicy_To_v1_ClientAuthOnlyPolicy(in, out, s)
+}
+
 func autoConvert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(in *v1.ClusterIssuer, out *certmanager.ClusterIssuer, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1047,6 +1383,62 @@ func Convert_certmanager_ClusterIssuerList_To_v1_ClusterIssuerList(in *certmanag
 	return autoConvert_certmanager_ClusterIssuerList_To_v1_ClusterIssuerList(in, out, s)
 }
 
+func autoConvert_v1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer(in *v1.GoogleCASIssuer, out *certmanager.GoogleCASIssuer, s conversion.Scope) error {
+	out.Project = in.Project
+	out.Location = in.Location
+	out.CAPool = in.CAPool
+	out.CertificateAuthority = in.CertificateAuthority
+	out.CertificateTemplate = in.CertificateTemplate
+	out.ServiceAccountSecretRef = (*meta.SecretKeySelector)(unsafe.Pointer(in.ServiceAccountSecretRef))
+	out.WorkloadIdentity = (*certmanager.GoogleCASWorkloadIdentity)(unsafe.Pointer(in.WorkloadIdentity))
+	return nil
+}
+
+// Convert_v1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer is an autogenerated conversion function.
+func Convert_v1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer(in *v1.GoogleCASIssuer, out *certmanager.GoogleCASIssuer, s conversion.Scope) error {
+	return autoConvert_v1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_GoogleCASIssuer_To_v1_GoogleCASIssuer(in *certmanager.GoogleCASIssuer, out *v1.GoogleCASIssuer, s conversion.Scope) error {
+	out.Project = in.Project
+	out.Location = in.Location
+	out.CAPool = in.CAPool
+	out.CertificateAuthority = in.CertificateAuthority
+	out.CertificateTemplate = in.CertificateTemplate
+	out.ServiceAccountSecretRef = (*apismetav1.SecretKeySelector)(unsafe.Pointer(in.ServiceAccountSecretRef))
+	out.WorkloadIdentity = (*v1.GoogleCASWorkloadIdentity)(unsafe.Pointer(in.WorkloadIdentity))
+	return nil
+}
+
+// Convert_certmanager_GoogleCASIssuer_To_v1_GoogleCASIssuer is an autogenerated conversion function.
+func Convert_certmanager_GoogleCASIssuer_To_v1_GoogleCASIssuer(in *certmanager.GoogleCASIssuer, out *v1.GoogleCASIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_GoogleCASIssuer_To_v1_GoogleCASIssuer(in, out, s)
+}
+
+func autoConvert_v1_GoogleCASWorkloadIdentity_To_certmanager_GoogleCASWorkloadIdentity(in *v1.GoogleCASWorkloadIdentity, out *certmanager.GoogleCASWorkloadIdentity, s conversion.Scope) error {
+	out.Audience = in.Audience
+	out.ServiceAccountName = in.ServiceAccountName
+	out.ServiceAccountEmail = in.ServiceAccountEmail
+	return nil
+}
+
+// Convert_v1_GoogleCASWorkloadIdentity_To_certmanager_GoogleCASWorkloadIdentity is an autogenerated conversion function.
+func Convert_v1_GoogleCASWorkloadIdentity_To_certmanager_GoogleCASWorkloadIdentity(in *v1.GoogleCASWorkloadIdentity, out *certmanager.GoogleCASWorkloadIdentity, s conversion.Scope) error {
+	return autoConvert_v1_GoogleCASWorkloadIdentity_To_certmanager_GoogleCASWorkloadIdentity(in, out, s)
+}
+
+func autoConvert_certmanager_GoogleCASWorkloadIdentity_To_v1_GoogleCASWorkloadIdentity(in *certmanager.GoogleCASWorkloadIdentity, out *v1.GoogleCASWorkloadIdentity, s conversion.Scope) error {
+	out.Audience = in.Audience
+	out.ServiceAccountName = in.ServiceAccountName
+	out.ServiceAccountEmail = in.ServiceAccountEmail
+	return nil
+}
+
+// Convert_certmanager_GoogleCASWorkloadIdentity_To_v1_GoogleCASWorkloadIdentity is an autogenerated conversion function.
+func Convert_certmanager_GoogleCASWorkloadIdentity_To_v1_GoogleCASWorkloadIdentity(in *certmanager.GoogleCASWorkloadIdentity, out *v1.GoogleCASWorkloadIdentity, s conversion.Scope) error {
+	return autoConvert_certmanager_GoogleCASWorkloadIdentity_To_v1_GoogleCASWorkloadIdentity(in, out, s)
+}
+
 func autoConvert_v1_Issuer_To_certmanager_Issuer(in *v1.Issuer, out *certmanager.Issuer, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1139,6 +1531,7 @@ func autoConvert_v1_IssuerConfig_To_certmanager_IssuerConfig(in *v1.IssuerConfig
 	} else {
 		out.Venafi = nil
 	}
+	out.GoogleCAS = (*certmanager.GoogleCASIssuer)(unsafe.Pointer(in.GoogleCAS))
 	return nil
 }
 
@@ -1177,6 +1570,7 @@ func autoConvert_certmanager_IssuerConfig_To_v1_IssuerConfig(in *certmanager.Iss
 	} else {
 		out.Venafi = nil
 	}
+	out.GoogleCAS = (*v1.GoogleCASIssuer)(unsafe.Pointer(in.GoogleCAS))
 	return nil
 }
 
@@ -1231,6 +1625,10 @@ func autoConvert_v1_IssuerSpec_To_certmanager_IssuerSpec(in *v1.IssuerSpec, out
 	if err := Convert_v1_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.DefaultSecretTemplate = (*certmanager.CertificateSecretTemplate)(unsafe.Pointer(in.DefaultSecretTemplate))
+	out.TerminalErrors = *(*[]certmanager.TerminalErrorMatcher)(unsafe.Pointer(&in.TerminalErrors))
+	out.UsagePolicy = (*certmanager.IssuerUsagePolicy)(unsafe.Pointer(in.UsagePolicy))
+	out.DefaultExpiredCertificatePolicy = certmanager.ExpiredCertificatePolicy(in.DefaultExpiredCertificatePolicy)
 	return nil
 }
 
@@ -1243,6 +1641,10 @@ func autoConvert_certmanager_IssuerSpec_To_v1_IssuerSpec(in *certmanager.IssuerS
 	if err := Convert_certmanager_IssuerConfig_To_v1_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
 		return err
 	}
+	out.DefaultSecretTemplate = (*v1.CertificateSecretTemplate)(unsafe.Pointer(in.DefaultSecretTemplate))
+	out.TerminalErrors = *(*[]v1.TerminalErrorMatcher)(unsafe.Pointer(&in.TerminalErrors))
+	out.UsagePolicy = (*v1.IssuerUsagePolicy)(unsafe.Pointer(in.UsagePolicy))
+	out.DefaultExpiredCertificatePolicy = v1.ExpiredCertificatePolicy(in.DefaultExpiredCertificatePolicy)
 	return nil
 }
 
@@ -1273,6 +1675,28 @@ func Convert_certmanager_IssuerStatus_To_v1_IssuerStatus(in *certmanager.IssuerS
 	return autoConvert_certmanager_IssuerStatus_To_v1_IssuerStatus(in, out, s)
 }
 
+func autoConvert_v1_IssuerUsagePolicy_To_certmanager_IssuerUsagePolicy(in *v1.IssuerUsagePolicy, out *certmanager.IssuerUsagePolicy, s conversion.Scope) error {
+	out.AllowedUsages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.AllowedUsages))
+	out.ForbiddenUsages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.ForbiddenUsages))
+	return nil
+}
+
+// Convert_v1_IssuerUsagePolicy_To_certmanager_IssuerUsagePolicy is an autogenerated conversion function.
+func Convert_v1_IssuerUsagePolicy_To_certmanager_IssuerUsagePolicy(in *v1.IssuerUsagePolicy, out *certmanager.IssuerUsagePolicy, s conversion.Scope) error {
+	return autoConvert_v1_IssuerUsagePolicy_To_certmanager_IssuerUsagePolicy(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerUsagePolicy_To_v1_IssuerUsagePolicy(in *certmanager.IssuerUsagePolicy, out *v1.IssuerUsagePolicy, s conversion.Scope) error {
+	out.AllowedUsages = *(*[]v1.KeyUsage)(unsafe.Pointer(&in.AllowedUsages))
+	out.ForbiddenUsages = *(*[]v1.KeyUsage)(unsafe.Pointer(&in.ForbiddenUsages))
+	return nil
+}
+
+// Convert_certmanager_IssuerUsagePolicy_To_v1_IssuerUsagePolicy is an autogenerated conversion function.
+func Convert_certmanager_IssuerUsagePolicy_To_v1_IssuerUsagePolicy(in *certmanager.IssuerUsagePolicy, out *v1.IssuerUsagePolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerUsagePolicy_To_v1_IssuerUsagePolicy(in, out, s)
+}
+
 func autoConvert_v1_JKSKeystore_To_certmanager_JKSKeystore(in *v1.JKSKeystore, out *certmanager.JKSKeystore, s conversion.Scope) error {
 	out.Create = in.Create
 	out.Alias = (*string)(unsafe.Pointer(in.Alias))
@@ -1405,8 +1829,35 @@ func Convert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in *certmanager.PKC
 	return autoConvert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in, out, s)
 }
 
+func autoConvert_v1_RequesterDurationPolicy_To_certmanager_RequesterDurationPolicy(in *v1.RequesterDurationPolicy, out *certmanager.RequesterDurationPolicy, s conversion.Scope) error {
+	out.Usernames = *(*[]string)(unsafe.Pointer(&in.Usernames))
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.MaxDuration = in.MaxDuration
+	return nil
+}
+
+// Convert_v1_RequesterDurationPolicy_To_certmanager_RequesterDurationPolicy is an autogenerated conversion function.
+func Convert_v1_RequesterDurationPolicy_To_certmanager_RequesterDurationPolicy(in *v1.RequesterDurationPolicy, out *certmanager.RequesterDurationPolicy, s conversion.Scope) error {
+	return autoConvert_v1_RequesterDurationPolicy_To_certmanager_RequesterDurationPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_RequesterDurationPolicy_To_v1_RequesterDurationPolicy(in *certmanager.RequesterDurationPolicy, out *v1.RequesterDurationPolicy, s conversion.Scope) error {
+	out.Usernames = *(*[]string)(unsafe.Pointer(&in.Usernames))
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.MaxDuration = in.MaxDuration
+	return nil
+}
+
+// Convert_certmanager_RequesterDurationPolicy_To_v1_RequesterDurationPolicy is an autogenerated conversion function.
+func Convert_certmanager_RequesterDurationPolicy_To_v1_RequesterDurationPolicy(in *certmanager.RequesterDurationPolicy, out *v1.RequesterDurationPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_RequesterDurationPolicy_To_v1_RequesterDurationPolicy(in, out, s)
+}
+
 func autoConvert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *v1.SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.SubjectKeyIdentifierMethod = certmanager.SubjectKeyIdentifierMethod(in.SubjectKeyIdentifierMethod)
+	out.AuthorityCertificate = *(*[]byte)(unsafe.Pointer(&in.AuthorityCertificate))
+	out.NotAfterBoundary = certmanager.NotAfterBoundary(in.NotAfterBoundary)
 	return nil
 }
 
@@ -1417,6 +1868,9 @@ func Convert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *v1.SelfSign
 
 func autoConvert_certmanager_SelfSignedIssuer_To_v1_SelfSignedIssuer(in *certmanager.SelfSignedIssuer, out *v1.SelfSignedIssuer, s conversion.Scope) error {
 	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.SubjectKeyIdentifierMethod = v1.SubjectKeyIdentifierMethod(in.SubjectKeyIdentifierMethod)
+	out.AuthorityCertificate = *(*[]byte)(unsafe.Pointer(&in.AuthorityCertificate))
+	out.NotAfterBoundary = v1.NotAfterBoundary(in.NotAfterBoundary)
 	return nil
 }
 
@@ -1447,6 +1901,28 @@ func Convert_certmanager_ServiceAccountRef_To_v1_ServiceAccountRef(in *certmanag
 	return autoConvert_certmanager_ServiceAccountRef_To_v1_ServiceAccountRef(in, out, s)
 }
 
+func autoConvert_v1_TerminalErrorMatcher_To_certmanager_TerminalErrorMatcher(in *v1.TerminalErrorMatcher, out *certmanager.TerminalErrorMatcher, s conversion.Scope) error {
+	out.Contains = in.Contains
+	out.Regex = in.Regex
+	return nil
+}
+
+// Convert_v1_TerminalErrorMatcher_To_certmanager_TerminalErrorMatcher is an autogenerated conversion function.
+func Convert_v1_TerminalErrorMatcher_To_certmanager_TerminalErrorMatcher(in *v1.TerminalErrorMatcher, out *certmanager.TerminalErrorMatcher, s conversion.Scope) error {
+	return autoConvert_v1_TerminalErrorMatcher_To_certmanager_TerminalErrorMatcher(in, out, s)
+}
+
+func autoConvert_certmanager_TerminalErrorMatcher_To_v1_TerminalErrorMatcher(in *certmanager.TerminalErrorMatcher, out *v1.TerminalErrorMatcher, s conversion.Scope) error {
+	out.Contains = in.Contains
+	out.Regex = in.Regex
+	return nil
+}
+
+// Convert_certmanager_TerminalErrorMatcher_To_v1_TerminalErrorMatcher is an autogenerated conversion function.
+func Convert_certmanager_TerminalErrorMatcher_To_v1_TerminalErrorMatcher(in *certmanager.TerminalErrorMatcher, out *v1.TerminalErrorMatcher, s conversion.Scope) error {
+	return autoConvert_certmanager_TerminalErrorMatcher_To_v1_TerminalErrorMatcher(in, out, s)
+}
+
 func autoConvert_v1_VaultAppRole_To_certmanager_VaultAppRole(in *v1.VaultAppRole, out *certmanager.VaultAppRole, s conversion.Scope) error {
 	out.Path = in.Path
 	out.RoleId = in.RoleId
@@ -1608,6 +2084,9 @@ func autoConvert_v1_VaultIssuer_To_certmanager_VaultIssuer(in *v1.VaultIssuer, o
 	} else {
 		out.ClientKeySecretRef = nil
 	}
+	out.ClientAuthOnly = (*certmanager.ClientAuthOnlyPolicy)(unsafe.Pointer(in.ClientAuthOnly))
+	out.NotAfterBoundary = certmanager.NotAfterBoundary(in.NotAfterBoundary)
+	out.HTTPHeaders = *(*map[string]string)(unsafe.Pointer(&in.HTTPHeaders))
 	return nil
 }
 
@@ -1651,6 +2130,9 @@ func autoConvert_certmanager_VaultIssuer_To_v1_VaultIssuer(in *certmanager.Vault
 	} else {
 		out.ClientKeySecretRef = nil
 	}
+	out.ClientAuthOnly = (*v1.ClientAuthOnlyPolicy)(unsafe.Pointer(in.ClientAuthOnly))
+	out.NotAfterBoundary = v1.NotAfterBoundary(in.NotAfterBoundary)
+	out.HTTPHeaders = *(*map[string]string)(unsafe.Pointer(&in.HTTPHeaders))
 	return nil
 }
 
@@ -1735,6 +2217,7 @@ func autoConvert_v1_VenafiIssuer_To_certmanager_VenafiIssuer(in *v1.VenafiIssuer
 	} else {
 		out.Cloud = nil
 	}
+	out.HTTPHeaders = *(*map[string]string)(unsafe.Pointer(&in.HTTPHeaders))
 	return nil
 }
 
@@ -1763,6 +2246,7 @@ func autoConvert_certmanager_VenafiIssuer_To_v1_VenafiIssuer(in *certmanager.Ven
 	} else {
 		out.Cloud = nil
 	}
+	out.HTTPHeaders = *(*map[string]string)(unsafe.Pointer(&in.HTTPHeaders))
 	return nil
 }
 
@@ -1786,6 +2270,7 @@ func autoConvert_v1_VenafiTPP_To_certmanager_VenafiTPP(in *v1.VenafiTPP, out *ce
 	} else {
 		out.CABundleSecretRef = nil
 	}
+	out.Approval = (*certmanager.VenafiTPPApproval)(unsafe.Pointer(in.Approval))
 	return nil
 }
 
@@ -1809,6 +2294,7 @@ func autoConvert_certmanager_VenafiTPP_To_v1_VenafiTPP(in *certmanager.VenafiTPP
 	} else {
 		out.CABundleSecretRef = nil
 	}
+	out.Approval = (*v1.VenafiTPPApproval)(unsafe.Pointer(in.Approval))
 	return nil
 }
 
@@ -1817,6 +2303,30 @@ func Convert_certmanager_VenafiTPP_To_v1_VenafiTPP(in *certmanager.VenafiTPP, ou
 	return autoConvert_certmanager_VenafiTPP_To_v1_VenafiTPP(in, out, s)
 }
 
+func autoConvert_v1_VenafiTPPApproval_To_certmanager_VenafiTPPApproval(in *v1.VenafiTPPApproval, out *certmanager.VenafiTPPApproval, s conversion.Scope) error {
+	out.WorkToDoField = in.WorkToDoField
+	out.WorkToDoValue = in.WorkToDoValue
+	out.AwaitingApprovalStatuses = *(*[]string)(unsafe.Pointer(&in.AwaitingApprovalStatuses))
+	return nil
+}
+
+// Convert_v1_VenafiTPPApproval_To_certmanager_VenafiTPPApproval is an autogenerated conversion function.
+func Convert_v1_VenafiTPPApproval_To_certmanager_VenafiTPPApproval(in *v1.VenafiTPPApproval, out *certmanager.VenafiTPPApproval, s conversion.Scope) error {
+	return autoConvert_v1_VenafiTPPApproval_To_certmanager_VenafiTPPApproval(in, out, s)
+}
+
+func autoConvert_certmanager_VenafiTPPApproval_To_v1_VenafiTPPApproval(in *certmanager.VenafiTPPApproval, out *v1.VenafiTPPApproval, s conversion.Scope) error {
+	out.WorkToDoField = in.WorkToDoField
+	out.WorkToDoValue = in.WorkToDoValue
+	out.AwaitingApprovalStatuses = *(*[]string)(unsafe.Pointer(&in.AwaitingApprovalStatuses))
+	return nil
+}
+
+// Convert_certmanager_VenafiTPPApproval_To_v1_VenafiTPPApproval is an autogenerated conversion function.
+func Convert_certmanager_VenafiTPPApproval_To_v1_VenafiTPPApproval(in *certmanager.VenafiTPPApproval, out *v1.VenafiTPPApproval, s conversion.Scope) error {
+	return autoConvert_certmanager_VenafiTPPApproval_To_v1_VenafiTPPApproval(in, out, s)
+}
+
 func autoConvert_v1_X509Subject_To_certmanager_X509Subject(in *v1.X509Subject, out *certmanager.X509Subject, s conversion.Scope) error {
 	out.Organizations = *(*[]string)(unsafe.Pointer(&in.Organizations))
 	out.Countries = *(*[]string)(unsafe.Pointer(&in.Countries))
@@ -17,6 +17,7 @@ limitations under the License.
 package validation
 
 import (
+	"encoding/asn1"
 	"fmt"
 	"net"
 	"net/mail"
@@ -25,6 +26,7 @@ import (
 	"unicode/utf8"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -52,7 +54,10 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 		}
 	}
 
-	el = append(el, validateIssuerRef(crt.IssuerRef, fldPath)...)
+	el = append(el, validateIssuerRef(crt.IssuerRef, fldPath.Child("issuerRef"))...)
+	if crt.CanaryIssuerRef != nil {
+		el = append(el, validateCanaryIssuerRef(*crt.CanaryIssuerRef, crt.IssuerRef, fldPath.Child("canaryIssuerRef"))...)
+	}
 
 	var commonName = crt.CommonName
 	if crt.LiteralSubject != "" {
@@ -147,7 +152,7 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 
 	if crt.PrivateKey != nil {
 		switch crt.PrivateKey.Algorithm {
-		case "", internalcmapi.RSAKeyAlgorithm:
+		case "", internalcmapi.RSAKeyAlgorithm, internalcmapi.RSAPSSKeyAlgorithm:
 			if crt.PrivateKey.Size > 0 && (crt.PrivateKey.Size < pki.MinRSAKeySize || crt.PrivateKey.Size > pki.MaxRSAKeySize) {
 				el = append(el, field.Invalid(fldPath.Child("privateKey", "size"), crt.PrivateKey.Size, fmt.Sprintf("must be between %d and %d for rsa keyAlgorithm", pki.MinRSAKeySize, pki.MaxRSAKeySize)))
 			}
@@ -158,13 +163,20 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 		case internalcmapi.Ed25519KeyAlgorithm:
 			break
 		default:
-			el = append(el, field.Invalid(fldPath.Child("privateKey", "algorithm"), crt.PrivateKey.Algorithm, "must be either empty or one of rsa, ecdsa or ed25519"))
+			el = append(el, field.Invalid(fldPath.Child("privateKey", "algorithm"), crt.PrivateKey.Algorithm, "must be either empty or one of rsa, rsapss, ecdsa or ed25519"))
+		}
+
+		if crt.PrivateKey.SeedSecretRef != nil {
+			el = append(el, validateSeedSecretRef(crt.PrivateKey, fldPath.Child("privateKey"))...)
 		}
 	}
 
 	if crt.Duration != nil || crt.RenewBefore != nil {
 		el = append(el, ValidateDuration(crt, fldPath)...)
 	}
+	if crt.IssueAfter != nil || crt.IssueAhead != nil {
+		el = append(el, validateIssueAfter(crt, fldPath)...)
+	}
 	if len(crt.Usages) > 0 {
 		el = append(el, validateUsages(crt, fldPath)...)
 	}
@@ -173,12 +185,7 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 	}
 
 	if crt.SecretTemplate != nil {
-		if len(crt.SecretTemplate.Labels) > 0 {
-			el = append(el, validateSecretTemplateLabels(crt, fldPath)...)
-		}
-		if len(crt.SecretTemplate.Annotations) > 0 {
-			el = append(el, validateSecretTemplateAnnotations(crt, fldPath)...)
-		}
+		el = append(el, validateSecretTemplate(crt.SecretTemplate, fldPath.Child("secretTemplate"))...)
 	}
 
 	if crt.NameConstraints != nil {
@@ -195,31 +202,123 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 		}
 	}
 
+	if crt.MSTemplate != nil {
+		el = append(el, validateMSTemplate(crt.MSTemplate, fldPath.Child("msTemplate"))...)
+	}
+
+	el = append(el, validateCertificatePolicies(crt.CertificatePolicies, fldPath.Child("certificatePolicies"))...)
+
+	if crt.LegacyExtensions != nil {
+		el = append(el, validateLegacyExtensions(crt.LegacyExtensions, fldPath.Child("legacyExtensions"))...)
+	}
+
+	if crt.ChainTruncation != nil {
+		el = append(el, validateChainTruncation(crt.ChainTruncation, fldPath.Child("chainTruncation"))...)
+	}
+
+	if crt.PolicyConstraints != nil {
+		if !crt.IsCA {
+			el = append(el, field.Invalid(fldPath.Child("policyConstraints"), crt.PolicyConstraints, "isCA should be true when policyConstraints is set"))
+		}
+		el = append(el, validatePolicyConstraints(crt.PolicyConstraints, fldPath.Child("policyConstraints"))...)
+	}
+
+	if crt.InhibitAnyPolicy != nil {
+		if !crt.IsCA {
+			el = append(el, field.Invalid(fldPath.Child("inhibitAnyPolicy"), *crt.InhibitAnyPolicy, "isCA should be true when inhibitAnyPolicy is set"))
+		}
+		if err := pki.ValidateSkipCerts(*crt.InhibitAnyPolicy); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("inhibitAnyPolicy"), *crt.InhibitAnyPolicy, err.Error()))
+		}
+	}
+
+	switch crt.CACertificatePolicy {
+	case "", internalcmapi.CACertificatePolicyIssuer, internalcmapi.CACertificatePolicyChainRoot, internalcmapi.CACertificatePolicyOmit:
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("caCertificatePolicy"), crt.CACertificatePolicy, []string{
+			string(internalcmapi.CACertificatePolicyIssuer),
+			string(internalcmapi.CACertificatePolicyChainRoot),
+			string(internalcmapi.CACertificatePolicyOmit),
+		}))
+	}
+
+	el = append(el, validateExpiredCertificatePolicy(crt.ExpiredCertificatePolicy, fldPath.Child("expiredCertificatePolicy"))...)
+
+	switch crt.SecretDeletionPolicy {
+	case "", internalcmapi.SecretDeletionPolicyRetain, internalcmapi.SecretDeletionPolicyDelete:
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("secretDeletionPolicy"), crt.SecretDeletionPolicy, []string{
+			string(internalcmapi.SecretDeletionPolicyRetain),
+			string(internalcmapi.SecretDeletionPolicyDelete),
+		}))
+	}
+
+	if crt.MaxChainDepth != nil && *crt.MaxChainDepth < 0 {
+		el = append(el, field.Invalid(fldPath.Child("maxChainDepth"), *crt.MaxChainDepth, "must not be negative"))
+	}
+
 	el = append(el, validateAdditionalOutputFormats(crt, fldPath)...)
+	el = append(el, validateAdditionalSecrets(crt, fldPath)...)
 
 	if crt.Keystores != nil {
 		el = append(el, validateKeystores(crt, fldPath)...)
 	}
 
+	el = append(el, validateSecretDataKeys(crt, fldPath)...)
+
 	return el
 }
 
 func ValidateCertificate(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
 	crt := obj.(*internalcmapi.Certificate)
 	allErrs := ValidateCertificateSpec(&crt.Spec, field.NewPath("spec"))
-	return allErrs, nil
+	allErrs = append(allErrs, validateCertificateAnnotations(crt.Annotations, field.NewPath("metadata", "annotations"))...)
+	return allErrs, certificateWarnings(&crt.Spec)
 }
 
 func ValidateUpdateCertificate(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
 	crt := obj.(*internalcmapi.Certificate)
 	allErrs := ValidateCertificateSpec(&crt.Spec, field.NewPath("spec"))
-	return allErrs, nil
+	allErrs = append(allErrs, validateCertificateAnnotations(crt.Annotations, field.NewPath("metadata", "annotations"))...)
+	return allErrs, certificateWarnings(&crt.Spec)
 }
 
-func validateIssuerRef(issuerRef cmmeta.ObjectReference, fldPath *field.Path) field.ErrorList {
+// validateCertificateAnnotations validates the annotations which configure
+// how a Certificate is managed.
+func validateCertificateAnnotations(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if v, ok := annotations[internalcmapi.SharedSecretOwnershipAnnotation]; ok && v != "true" && v != "false" {
+		el = append(el, field.Invalid(fldPath.Key(internalcmapi.SharedSecretOwnershipAnnotation), v, `must be either "true" or "false"`))
+	}
+
+	return el
+}
+
+// minimumRenewalInterval is the shortest interval between the renewals of a
+// Certificate which is not warned about. Short-lived certificates are
+// supported, but must still leave enough time between issuances for the
+// issuer to keep up.
+const minimumRenewalInterval = 10 * time.Minute
+
+func certificateWarnings(crt *internalcmapi.CertificateSpec) []string {
+	var warnings []string
+	if crt.PrivateKey != nil && crt.PrivateKey.SeedSecretRef != nil {
+		warnings = append(warnings, deterministicPrivateKeyInUse)
+	}
+
+	duration := util.DefaultCertDuration(crt.Duration)
+	interval := duration - pki.RenewBefore(duration, crt.RenewBefore, crt.RenewBeforePercentage)
+	if interval < minimumRenewalInterval {
+		warnings = append(warnings, fmt.Sprintf(frequentRenewalTemplate, interval, minimumRenewalInterval))
+	}
+
+	return warnings
+}
+
+func validateIssuerRef(issuerRef cmmeta.ObjectReference, issuerRefPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
-	issuerRefPath := fldPath.Child("issuerRef")
 	if issuerRef.Name == "" {
 		// all issuerRefs must specify a name
 		el = append(el, field.Required(issuerRefPath.Child("name"), "must be specified"))
@@ -258,6 +357,31 @@ func validateIssuerRef(issuerRef cmmeta.ObjectReference, fldPath *field.Path) fi
 	return el
 }
 
+// validateCanaryIssuerRef validates the canary issuer reference of a
+// Certificate, which must be a valid reference to an issuer other than the
+// one referenced by issuerRef.
+func validateCanaryIssuerRef(canaryIssuerRef, issuerRef cmmeta.ObjectReference, fldPath *field.Path) field.ErrorList {
+	el := validateIssuerRef(canaryIssuerRef, fldPath)
+
+	if normalizeIssuerRef(canaryIssuerRef) == normalizeIssuerRef(issuerRef) {
+		el = append(el, field.Invalid(fldPath, canaryIssuerRef, "must reference a different issuer than issuerRef"))
+	}
+
+	return el
+}
+
+// normalizeIssuerRef fills in the defaulted kind and group of an issuer
+// reference so that references to the same issuer compare as equal.
+func normalizeIssuerRef(ref cmmeta.ObjectReference) cmmeta.ObjectReference {
+	if ref.Group == "" {
+		ref.Group = internalcmapi.SchemeGroupVersion.Group
+	}
+	if ref.Kind == "" && ref.Group == internalcmapi.SchemeGroupVersion.Group {
+		ref.Kind = internalcmapi.IssuerKind
+	}
+	return ref
+}
+
 func validateIPAddresses(a *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
 	if len(a.IPAddresses) == 0 {
 		return nil
@@ -302,24 +426,193 @@ func validateUsages(a *internalcmapi.CertificateSpec, fldPath *field.Path) field
 	return el
 }
 
-func validateSecretTemplateLabels(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
-	return metavalidation.ValidateLabels(crt.SecretTemplate.Labels, fldPath.Child("secretTemplate", "labels"))
+// validateSecretTemplate validates the labels and annotations of a secret
+// template, which may appear on a Certificate or as the default secret
+// template of an Issuer.
+func validateSecretTemplate(tmpl *internalcmapi.CertificateSecretTemplate, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(tmpl.Labels) > 0 {
+		el = append(el, validateSecretTemplateLabels(tmpl, fldPath)...)
+	}
+	if len(tmpl.Annotations) > 0 {
+		el = append(el, validateSecretTemplateAnnotations(tmpl, fldPath)...)
+	}
+	return el
 }
 
-func validateSecretTemplateAnnotations(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+func validateSecretTemplateLabels(tmpl *internalcmapi.CertificateSecretTemplate, fldPath *field.Path) field.ErrorList {
+	return metavalidation.ValidateLabels(tmpl.Labels, fldPath.Child("labels"))
+}
+
+func validateSecretTemplateAnnotations(tmpl *internalcmapi.CertificateSecretTemplate, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
-	secretTemplateAnnotationsPath := fldPath.Child("secretTemplate", "annotations")
-	for a := range crt.SecretTemplate.Annotations {
+	secretTemplateAnnotationsPath := fldPath.Child("annotations")
+	for a := range tmpl.Annotations {
 		if strings.HasPrefix(a, "cert-manager.io/") && a != "cert-manager.io/allow-direct-injection" {
 			el = append(el, field.Invalid(secretTemplateAnnotationsPath, a, "cert-manager.io/* annotations are not allowed"))
 		}
 	}
 
-	el = append(el, apivalidation.ValidateAnnotations(crt.SecretTemplate.Annotations, secretTemplateAnnotationsPath)...)
+	el = append(el, apivalidation.ValidateAnnotations(tmpl.Annotations, secretTemplateAnnotationsPath)...)
+	return el
+}
+
+func validateMSTemplate(tmpl *internalcmapi.CertificateMSTemplate, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	switch {
+	case tmpl.Name == "" && tmpl.OID == "":
+		return append(el, field.Required(fldPath, "exactly one of name or oid must be set"))
+	case tmpl.Name != "" && tmpl.OID != "":
+		return append(el, field.Forbidden(fldPath, "exactly one of name or oid must be set"))
+	}
+
+	if tmpl.Name != "" {
+		if err := pki.ValidateMSTemplateName(tmpl.Name); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("name"), tmpl.Name, err.Error()))
+		}
+		if tmpl.MajorVersion != nil {
+			el = append(el, field.Forbidden(fldPath.Child("majorVersion"), "can only be set if oid is set"))
+		}
+		if tmpl.MinorVersion != nil {
+			el = append(el, field.Forbidden(fldPath.Child("minorVersion"), "can only be set if oid is set"))
+		}
+		return el
+	}
+
+	if oid, err := pki.ParseObjectIdentifier(tmpl.OID); err != nil {
+		el = append(el, field.Invalid(fldPath.Child("oid"), tmpl.OID, "oid syntax invalid"))
+	} else if _, err := asn1.Marshal(oid); err != nil {
+		// encoding/asn1 rejects object identifiers which cannot be DER encoded,
+		// such as ones with fewer than two arcs.
+		el = append(el, field.Invalid(fldPath.Child("oid"), tmpl.OID, "oid is not a valid object identifier"))
+	}
+	if tmpl.MajorVersion == nil {
+		el = append(el, field.Required(fldPath.Child("majorVersion"), "must be set if oid is set"))
+	} else if *tmpl.MajorVersion < 0 {
+		el = append(el, field.Invalid(fldPath.Child("majorVersion"), *tmpl.MajorVersion, "must not be negative"))
+	}
+	if tmpl.MinorVersion != nil && *tmpl.MinorVersion < 0 {
+		el = append(el, field.Invalid(fldPath.Child("minorVersion"), *tmpl.MinorVersion, "must not be negative"))
+	}
+
+	return el
+}
+
+func validateCertificatePolicies(policies []internalcmapi.CertificatePolicy, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	seen := make(map[string]bool, len(policies))
+	for i, policy := range policies {
+		idxPath := fldPath.Index(i)
+
+		if policy.OID == "" {
+			el = append(el, field.Required(idxPath.Child("oid"), "must be set"))
+		} else if oid, err := pki.ParseObjectIdentifier(policy.OID); err != nil {
+			el = append(el, field.Invalid(idxPath.Child("oid"), policy.OID, "oid syntax invalid"))
+		} else if _, err := asn1.Marshal(oid); err != nil {
+			el = append(el, field.Invalid(idxPath.Child("oid"), policy.OID, "oid is not a valid object identifier"))
+		} else if seen[oid.String()] {
+			// A policy must not appear more than once (RFC 5280, 4.2.1.4).
+			el = append(el, field.Duplicate(idxPath.Child("oid"), policy.OID))
+		} else {
+			seen[oid.String()] = true
+		}
+
+		if policy.CPSURI != "" {
+			if err := pki.ValidateCertificatePolicyCPSURI(policy.CPSURI); err != nil {
+				el = append(el, field.Invalid(idxPath.Child("cpsURI"), policy.CPSURI, err.Error()))
+			}
+		}
+		if policy.UserNotice != "" {
+			if err := pki.ValidateCertificatePolicyUserNotice(policy.UserNotice); err != nil {
+				el = append(el, field.Invalid(idxPath.Child("userNotice"), policy.UserNotice, err.Error()))
+			}
+		}
+	}
+
+	return el
+}
+
+func validateLegacyExtensions(legacy *internalcmapi.CertificateLegacyExtensions, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	if legacy.NetscapeComment != "" {
+		if err := pki.ValidateNetscapeComment(legacy.NetscapeComment); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("netscapeComment"), legacy.NetscapeComment, err.Error()))
+		}
+	}
+
+	seen := make(map[internalcmapi.NetscapeCertType]bool, len(legacy.NetscapeCertType))
+	for i, certType := range legacy.NetscapeCertType {
+		idxPath := fldPath.Child("netscapeCertType").Index(i)
+
+		if err := pki.ValidateNetscapeCertType(cmapi.NetscapeCertType(certType)); err != nil {
+			el = append(el, field.NotSupported(idxPath, certType, netscapeCertTypes))
+		} else if seen[certType] {
+			el = append(el, field.Duplicate(idxPath, certType))
+		} else {
+			seen[certType] = true
+		}
+	}
+
+	return el
+}
+
+func validateChainTruncation(anchor *internalcmapi.CertificateChainTruncation, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	switch {
+	case anchor.Subject == "" && anchor.SHA256Fingerprint == "":
+		el = append(el, field.Required(fldPath, "one of subject or sha256Fingerprint must be specified"))
+	case anchor.Subject != "" && anchor.SHA256Fingerprint != "":
+		el = append(el, field.Forbidden(fldPath, "only one of subject or sha256Fingerprint may be specified"))
+	case anchor.Subject != "":
+		if _, err := pki.ParseChainAnchorSubject(anchor.Subject); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("subject"), anchor.Subject, err.Error()))
+		}
+	default:
+		if _, err := pki.DecodeSHA256Fingerprint(anchor.SHA256Fingerprint); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("sha256Fingerprint"), anchor.SHA256Fingerprint, err.Error()))
+		}
+	}
+
+	return el
+}
+
+func validatePolicyConstraints(constraints *internalcmapi.CertificatePolicyConstraints, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	if constraints.RequireExplicitPolicy == nil && constraints.InhibitPolicyMapping == nil {
+		el = append(el, field.Required(fldPath, "one of requireExplicitPolicy or inhibitPolicyMapping must be specified"))
+	}
+	if constraints.RequireExplicitPolicy != nil {
+		if err := pki.ValidateSkipCerts(*constraints.RequireExplicitPolicy); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("requireExplicitPolicy"), *constraints.RequireExplicitPolicy, err.Error()))
+		}
+	}
+	if constraints.InhibitPolicyMapping != nil {
+		if err := pki.ValidateSkipCerts(*constraints.InhibitPolicyMapping); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("inhibitPolicyMapping"), *constraints.InhibitPolicyMapping, err.Error()))
+		}
+	}
+
 	return el
 }
 
+// netscapeCertTypes are the supported types of the Netscape Certificate Type
+// extension.
+var netscapeCertTypes = []string{
+	string(internalcmapi.NetscapeCertTypeSSLClient),
+	string(internalcmapi.NetscapeCertTypeSSLServer),
+	string(internalcmapi.NetscapeCertTypeSMIME),
+	string(internalcmapi.NetscapeCertTypeObjectSigning),
+	string(internalcmapi.NetscapeCertTypeSSLCA),
+	string(internalcmapi.NetscapeCertTypeSMIMECA),
+	string(internalcmapi.NetscapeCertTypeObjectSigningCA),
+}
+
 func ValidateDuration(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
@@ -358,6 +651,29 @@ func ValidateDuration(crt *internalcmapi.CertificateSpec, fldPath *field.Path) f
 	return el
 }
 
+// validateIssueAfter validates the issueAfter and issueAhead fields, which
+// defer the initial issuance of a certificate until it is about to be needed.
+func validateIssueAfter(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if crt.IssueAhead == nil {
+		return el
+	}
+	if crt.IssueAfter == nil {
+		el = append(el, field.Invalid(fldPath.Child("issueAhead"), crt.IssueAhead.Duration, "issueAhead can only be set if issueAfter is set"))
+	}
+	if crt.IssueAhead.Duration < 0 {
+		el = append(el, field.Invalid(fldPath.Child("issueAhead"), crt.IssueAhead.Duration, "issueAhead must not be negative"))
+	}
+	// A certificate issued issueAhead before it is needed must still be valid
+	// once it is needed.
+	if duration := util.DefaultCertDuration(crt.Duration); crt.IssueAhead.Duration >= duration {
+		el = append(el, field.Invalid(fldPath.Child("issueAhead"), crt.IssueAhead.Duration, fmt.Sprintf("certificate duration %s must be greater than issueAhead %s", duration, crt.IssueAhead.Duration)))
+	}
+
+	return el
+}
+
 func validateAdditionalOutputFormats(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
 	var el field.ErrorList
 
@@ -381,6 +697,99 @@ func validateAdditionalOutputFormats(crt *internalcmapi.CertificateSpec, fldPath
 	return el
 }
 
+var supportedAdditionalSecretFormats = []string{
+	string(internalcmapi.CertificateAdditionalSecretFormatTLS),
+	string(internalcmapi.CertificateAdditionalSecretFormatPKCS12),
+	string(internalcmapi.CertificateAdditionalSecretFormatJKS),
+}
+
+func validateAdditionalSecrets(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	if !utilfeature.DefaultFeatureGate.Enabled(feature.AdditionalCertificateSecrets) {
+		if len(crt.AdditionalSecrets) > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("additionalSecrets"), "feature gate AdditionalCertificateSecrets must be enabled"))
+		}
+		return el
+	}
+
+	// Ensure each additional Secret is only written once, keyed on
+	// "namespace/name".
+	secretSet := sets.NewString()
+	for i, secret := range crt.AdditionalSecrets {
+		fldPath := fldPath.Child("additionalSecrets").Index(i)
+
+		if secret.Name == "" {
+			el = append(el, field.Required(fldPath.Child("name"), "must be specified"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSSubdomain(secret.Name, false) {
+				el = append(el, field.Invalid(fldPath.Child("name"), secret.Name, msg))
+			}
+		}
+		if secret.Namespace != "" {
+			for _, msg := range apivalidation.ValidateNamespaceName(secret.Namespace, false) {
+				el = append(el, field.Invalid(fldPath.Child("namespace"), secret.Namespace, msg))
+			}
+		}
+		if secret.Namespace == "" && secret.Name == crt.SecretName {
+			el = append(el, field.Invalid(fldPath.Child("name"), secret.Name, "must not be the same as secretName"))
+		}
+
+		key := secret.Namespace + "/" + secret.Name
+		if secretSet.Has(key) {
+			el = append(el, field.Duplicate(fldPath, key))
+		}
+		secretSet.Insert(key)
+
+		switch secret.Format {
+		case "", internalcmapi.CertificateAdditionalSecretFormatTLS:
+			if secret.PasswordSecretRef != nil {
+				el = append(el, field.Forbidden(fldPath.Child("passwordSecretRef"), "may only be specified for the PKCS12 and JKS formats"))
+			}
+		case internalcmapi.CertificateAdditionalSecretFormatPKCS12, internalcmapi.CertificateAdditionalSecretFormatJKS:
+			if secret.PasswordSecretRef == nil {
+				el = append(el, field.Required(fldPath.Child("passwordSecretRef"), fmt.Sprintf("must be specified for the %s format", secret.Format)))
+			} else {
+				el = append(el, ValidateSecretKeySelector(secret.PasswordSecretRef, fldPath.Child("passwordSecretRef"))...)
+			}
+		default:
+			el = append(el, field.NotSupported(fldPath.Child("format"), secret.Format, supportedAdditionalSecretFormats))
+		}
+
+		if secret.Profile != "" && secret.Format != internalcmapi.CertificateAdditionalSecretFormatPKCS12 {
+			el = append(el, field.Forbidden(fldPath.Child("profile"), "may only be specified for the PKCS12 format"))
+		}
+	}
+
+	return el
+}
+
+// validateSeedSecretRef validates a private key which is derived from a seed.
+// Whether the Certificate's namespace allows deterministic private keys is
+// checked by the controller, since the namespace is not available here.
+func validateSeedSecretRef(pk *internalcmapi.CertificatePrivateKey, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	if !utilfeature.DefaultFeatureGate.Enabled(feature.DeterministicPrivateKeys) {
+		return append(el, field.Forbidden(fldPath.Child("seedSecretRef"), "feature gate DeterministicPrivateKeys must be enabled"))
+	}
+
+	if pk.SeedSecretRef.Name == "" {
+		el = append(el, field.Required(fldPath.Child("seedSecretRef", "name"), "secret name is required"))
+	}
+	if pk.SeedSecretRef.Key == "" {
+		el = append(el, field.Required(fldPath.Child("seedSecretRef", "key"), "secret key is required"))
+	}
+
+	switch pk.Algorithm {
+	case internalcmapi.ECDSAKeyAlgorithm, internalcmapi.Ed25519KeyAlgorithm:
+	default:
+		el = append(el, field.Forbidden(fldPath.Child("seedSecretRef"), "deterministic private keys are only supported for the ECDSA and Ed25519 key algorithms"))
+	}
+
+	return el
+}
+
 const (
 	keystoresMutuallyExclusivePasswordsFmt = "exactly one of passwordSecretRef and password must be provided for %s keystores; cannot set both"
 
@@ -422,3 +831,71 @@ func validateKeystores(crt *internalcmapi.CertificateSpec, fldPath *field.Path)
 
 	return el
 }
+
+// additionalOutputFormatKeys maps each additional output format to the Secret
+// data key it is written to.
+var additionalOutputFormatKeys = map[internalcmapi.CertificateOutputFormatType]string{
+	internalcmapi.CertificateOutputFormatDER:         cmapi.CertificateOutputFormatDERKey,
+	internalcmapi.CertificateOutputFormatCombinedPEM: cmapi.CertificateOutputFormatCombinedPEMKey,
+	internalcmapi.CertificateOutputFormatPKCS7:       cmapi.CertificateOutputFormatPKCS7Key,
+}
+
+// validateSecretDataKeys ensures that no two of the requested additional
+// output formats and keystores write the same key of the Certificate's
+// Secret, and that keystore passwords are not read from a key of the Secret
+// which is written by cert-manager.
+func validateSecretDataKeys(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	written := make(map[string]*field.Path)
+	write := func(path *field.Path, keys ...string) {
+		for _, key := range keys {
+			if other, ok := written[key]; ok {
+				el = append(el, field.Invalid(path, key, fmt.Sprintf("writes the Secret key %q which is also written by %s", key, other)))
+				continue
+			}
+			written[key] = path
+		}
+	}
+
+	write(fldPath.Child("secretName"), corev1.TLSCertKey, corev1.TLSPrivateKeyKey, cmmeta.TLSCAKey)
+
+	// Duplicate output formats are reported by validateAdditionalOutputFormats.
+	formats := sets.New[internalcmapi.CertificateOutputFormatType]()
+	for i, format := range crt.AdditionalOutputFormats {
+		key, ok := additionalOutputFormatKeys[format.Type]
+		if !ok || formats.Has(format.Type) {
+			continue
+		}
+		formats.Insert(format.Type)
+		write(fldPath.Child("additionalOutputFormats").Index(i), key)
+	}
+
+	if crt.Keystores == nil {
+		return el
+	}
+
+	jks, pkcs12 := crt.Keystores.JKS, crt.Keystores.PKCS12
+	if jks != nil && jks.Create {
+		write(fldPath.Child("keystores", "jks"), cmapi.JKSSecretKey, cmapi.JKSTruststoreKey)
+	}
+	if pkcs12 != nil && pkcs12.Create {
+		write(fldPath.Child("keystores", "pkcs12"), cmapi.PKCS12SecretKey, cmapi.PKCS12TruststoreKey)
+	}
+
+	// Passwords are checked once all written keys are known, since either
+	// keystore may read its password from a key written for the other.
+	checkPassword := func(path *field.Path, ref cmmeta.SecretKeySelector) {
+		if _, ok := written[ref.Key]; ok && ref.Name == crt.SecretName {
+			el = append(el, field.Invalid(path.Child("passwordSecretRef", "key"), ref.Key, "must not be a key written by cert-manager to the Certificate's Secret"))
+		}
+	}
+	if jks != nil && jks.Create {
+		checkPassword(fldPath.Child("keystores", "jks"), jks.PasswordSecretRef)
+	}
+	if pkcs12 != nil && pkcs12.Create {
+		checkPassword(fldPath.Child("keystores", "pkcs12"), pkcs12.PasswordSecretRef)
+	}
+
+	return el
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
@@ -365,6 +366,37 @@ func TestValidateCertificate(t *testing.T) {
 				field.Invalid(fldPath.Child("privateKey", "size"), 1024, "must be between 2048 and 8192 for rsa keyAlgorithm"),
 			},
 		},
+		"valid certificate with rsapss keyAlgorithm specified and keysize 4096": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm: internalcmapi.RSAPSSKeyAlgorithm,
+						Size:      4096,
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"certificate with rsapss keyAlgorithm specified and invalid keysize 1024": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm: internalcmapi.RSAPSSKeyAlgorithm,
+						Size:      1024,
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("privateKey", "size"), 1024, "must be between 2048 and 8192 for rsa keyAlgorithm"),
+			},
+		},
 		"certificate with rsa keyAlgorithm specified and invalid keysize 8196": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
@@ -412,7 +444,7 @@ func TestValidateCertificate(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.Invalid(fldPath.Child("privateKey", "algorithm"), internalcmapi.PrivateKeyAlgorithm("blah"), "must be either empty or one of rsa, ecdsa or ed25519"),
+				field.Invalid(fldPath.Child("privateKey", "algorithm"), internalcmapi.PrivateKeyAlgorithm("blah"), "must be either empty or one of rsa, rsapss, ecdsa or ed25519"),
 			},
 		},
 		"valid certificate with ipAddresses": {
@@ -610,154 +642,658 @@ func TestValidateCertificate(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.Invalid(fldPath.Child("revisionHistoryLimit"), int32(0), "must not be less than 1"),
+				field.Invalid(fldPath.Child("revisionHistoryLimit"), int32(0), "must not be less than 1"),
+			},
+		},
+		"valid with empty secretTemplate": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Annotations: map[string]string{},
+						Labels:      map[string]string{},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"valid with 'CertificateSecretTemplate' labels and annotations": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Annotations: map[string]string{
+							"my-annotation.com/foo": "app=bar",
+						},
+						Labels: map[string]string{
+							"my-label.com/foo": "evn-production",
+						},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid with disallowed 'CertificateSecretTemplate' annotations": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Annotations: map[string]string{
+							"app.com/valid":                          "valid",
+							"cert-manager.io/alt-names":              "example.com",
+							"cert-manager.io/certificate-name":       "selfsigned-cert",
+							"cert-manager.io/allow-direct-injection": "true",
+						},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("secretTemplate", "annotations"), "cert-manager.io/alt-names", "cert-manager.io/* annotations are not allowed"),
+				field.Invalid(fldPath.Child("secretTemplate", "annotations"), "cert-manager.io/certificate-name", "cert-manager.io/* annotations are not allowed"),
+			},
+		},
+		"invalid due to too long 'CertificateSecretTemplate' annotations": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Annotations: map[string]string{
+							"app.com/invalid": strings.Repeat("0", maxSecretTemplateAnnotationsBytesLimit),
+						},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.TooLong(fldPath.Child("secretTemplate", "annotations"), "", maxSecretTemplateAnnotationsBytesLimit),
+			},
+		},
+		"invalid due to not allowed 'CertificateSecretTemplate' labels": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
+						Labels: map[string]string{
+							"app.com/invalid-chars": "invalid=chars",
+						},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(
+					fldPath.Child("secretTemplate", "labels"),
+					"invalid=chars", "a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an "+
+						"alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')"),
+			},
+		},
+		"valid with name constraints": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IsCA:       true,
+					NameConstraints: &internalcmapi.NameConstraints{
+						Permitted: &internalcmapi.NameConstraintItem{
+							DNSDomains: []string{"example.com"},
+						},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a:                             someAdmissionRequest,
+			nameConstraintsFeatureEnabled: true,
+		},
+		"invalid with name constraints": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:      "testcn",
+					SecretName:      "abc",
+					IsCA:            true,
+					NameConstraints: &internalcmapi.NameConstraints{},
+					IssuerRef:       validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(
+					fldPath.Child("nameConstraints"), &internalcmapi.NameConstraints{}, "either permitted or excluded must be set"),
+			},
+			nameConstraintsFeatureEnabled: true,
+		},
+		"valid name constraints with feature gate disabled": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IsCA:       true,
+					NameConstraints: &internalcmapi.NameConstraints{
+						Permitted: &internalcmapi.NameConstraintItem{
+							DNSDomains: []string{"example.com"},
+						},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Forbidden(
+					fldPath.Child("nameConstraints"), "feature gate NameConstraints must be enabled"),
+			},
+		},
+		"valid caCertificatePolicy": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:          "testcn",
+					SecretName:          "abc",
+					CACertificatePolicy: internalcmapi.CACertificatePolicyChainRoot,
+					IssuerRef:           validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"unsupported caCertificatePolicy": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:          "testcn",
+					SecretName:          "abc",
+					CACertificatePolicy: "Root",
+					IssuerRef:           validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("caCertificatePolicy"), internalcmapi.CACertificatePolicy("Root"), []string{"Issuer", "ChainRoot", "Omit"}),
+			},
+		},
+		"valid expiredCertificatePolicy": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:               "testcn",
+					SecretName:               "abc",
+					ExpiredCertificatePolicy: internalcmapi.ExpiredCertificatePolicyFallback,
+					IssuerRef:                validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"unsupported expiredCertificatePolicy": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:               "testcn",
+					SecretName:               "abc",
+					ExpiredCertificatePolicy: "Delete",
+					IssuerRef:                validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("expiredCertificatePolicy"), internalcmapi.ExpiredCertificatePolicy("Delete"), []string{"Keep", "Fallback"}),
+			},
+		},
+		"valid secretDeletionPolicy": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:           "testcn",
+					SecretName:           "abc",
+					SecretDeletionPolicy: internalcmapi.SecretDeletionPolicyDelete,
+					IssuerRef:            validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"unsupported secretDeletionPolicy": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:           "testcn",
+					SecretName:           "abc",
+					SecretDeletionPolicy: "Orphan",
+					IssuerRef:            validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("secretDeletionPolicy"), internalcmapi.SecretDeletionPolicy("Orphan"), []string{"Retain", "Delete"}),
+			},
+		},
+		"valid maxChainDepth of zero": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:    "testcn",
+					SecretName:    "abc",
+					MaxChainDepth: ptr.To(int32(0)),
+					IssuerRef:     validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"negative maxChainDepth": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:    "testcn",
+					SecretName:    "abc",
+					MaxChainDepth: ptr.To(int32(-1)),
+					IssuerRef:     validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("maxChainDepth"), int32(-1), "must not be negative"),
+			},
+		},
+		"valid msTemplate with a template name": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					MSTemplate: &internalcmapi.CertificateMSTemplate{Name: "WebServer"},
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"valid msTemplate with a template oid and version": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					MSTemplate: &internalcmapi.CertificateMSTemplate{
+						OID:          "1.3.6.1.4.1.311.21.8.1.2",
+						MajorVersion: ptr.To[int32](100),
+						MinorVersion: ptr.To[int32](4),
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"msTemplate without a template name or oid": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					MSTemplate: &internalcmapi.CertificateMSTemplate{},
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("msTemplate"), "exactly one of name or oid must be set"),
+			},
+		},
+		"msTemplate with both a template name and oid": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					MSTemplate: &internalcmapi.CertificateMSTemplate{Name: "WebServer", OID: "1.3.6.1.4.1.311.21.8.1.2", MajorVersion: ptr.To[int32](100)},
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("msTemplate"), "exactly one of name or oid must be set"),
+			},
+		},
+		"msTemplate with a template name and a version": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					MSTemplate: &internalcmapi.CertificateMSTemplate{Name: "WebServer", MajorVersion: ptr.To[int32](100), MinorVersion: ptr.To[int32](4)},
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("msTemplate", "majorVersion"), "can only be set if oid is set"),
+				field.Forbidden(fldPath.Child("msTemplate", "minorVersion"), "can only be set if oid is set"),
+			},
+		},
+		"msTemplate with a template name which cannot be encoded": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					MSTemplate: &internalcmapi.CertificateMSTemplate{Name: "web-\U0001F512"},
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("msTemplate", "name"), "web-\U0001F512", "must only contain characters of the Basic Multilingual Plane, got '\U0001F512'"),
+			},
+		},
+		"msTemplate with an invalid template oid and no major version": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					MSTemplate: &internalcmapi.CertificateMSTemplate{OID: "1"},
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("msTemplate", "oid"), "1", "oid is not a valid object identifier"),
+				field.Required(fldPath.Child("msTemplate", "majorVersion"), "must be set if oid is set"),
+			},
+		},
+		"msTemplate with negative template versions": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					MSTemplate: &internalcmapi.CertificateMSTemplate{OID: "1.3.6.1.4.1.311.21.8.1.2", MajorVersion: ptr.To[int32](-1), MinorVersion: ptr.To[int32](-2)},
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("msTemplate", "majorVersion"), int32(-1), "must not be negative"),
+				field.Invalid(fldPath.Child("msTemplate", "minorVersion"), int32(-2), "must not be negative"),
+			},
+		},
+		"valid certificatePolicies with qualifiers": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					CertificatePolicies: []internalcmapi.CertificatePolicy{
+						{OID: "2.23.140.1.2.1"},
+						{OID: "1.3.6.1.4.1.99999.1", CPSURI: "https://pki.example.com/cps", UserNotice: "For internal use only"},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"certificatePolicies with missing, invalid and duplicate oids": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					CertificatePolicies: []internalcmapi.CertificatePolicy{
+						{},
+						{OID: "2.23.140.x"},
+						{OID: "1"},
+						{OID: "2.23.140.1.2.1"},
+						{OID: "2.23.140.01.2.1"},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("certificatePolicies").Index(0).Child("oid"), "must be set"),
+				field.Invalid(fldPath.Child("certificatePolicies").Index(1).Child("oid"), "2.23.140.x", "oid syntax invalid"),
+				field.Invalid(fldPath.Child("certificatePolicies").Index(2).Child("oid"), "1", "oid is not a valid object identifier"),
+				field.Duplicate(fldPath.Child("certificatePolicies").Index(4).Child("oid"), "2.23.140.01.2.1"),
+			},
+		},
+		"certificatePolicies with invalid qualifiers": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					CertificatePolicies: []internalcmapi.CertificatePolicy{
+						{OID: "2.23.140.1.2.1", CPSURI: "/cps", UserNotice: strings.Repeat("a", 201)},
+						{OID: "2.23.140.1.2.2", CPSURI: "https://pki.example.com/cps-é"},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("certificatePolicies").Index(0).Child("cpsURI"), "/cps", "must be an absolute URI"),
+				field.Invalid(fldPath.Child("certificatePolicies").Index(0).Child("userNotice"), strings.Repeat("a", 201), "must be at most 200 characters long, got 201"),
+				field.Invalid(fldPath.Child("certificatePolicies").Index(1).Child("cpsURI"), "https://pki.example.com/cps-é", "must only contain ASCII characters, got 'é'"),
+			},
+		},
+		"valid canaryIssuerRef": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:      "testcn",
+					SecretName:      "abc",
+					IssuerRef:       validIssuerRef,
+					CanaryIssuerRef: &cmmeta.ObjectReference{Name: "staging", Kind: "ClusterIssuer"},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"canaryIssuerRef without a name and with an invalid kind": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:      "testcn",
+					SecretName:      "abc",
+					IssuerRef:       validIssuerRef,
+					CanaryIssuerRef: &cmmeta.ObjectReference{Kind: "StagingIssuer", Group: "cert-manager.io"},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("canaryIssuerRef", "name"), "must be specified"),
+				field.Invalid(fldPath.Child("canaryIssuerRef", "kind"), "StagingIssuer", "must be one of Issuer or ClusterIssuer"),
+			},
+		},
+		"canaryIssuerRef referencing the same issuer as issuerRef": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:      "testcn",
+					SecretName:      "abc",
+					IssuerRef:       cmmeta.ObjectReference{Name: "name"},
+					CanaryIssuerRef: &cmmeta.ObjectReference{Name: "name", Kind: "Issuer", Group: "cert-manager.io"},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("canaryIssuerRef"), cmmeta.ObjectReference{Name: "name", Kind: "Issuer", Group: "cert-manager.io"}, "must reference a different issuer than issuerRef"),
+			},
+		},
+		"valid legacyExtensions": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					LegacyExtensions: &internalcmapi.CertificateLegacyExtensions{
+						NetscapeComment:  "Issued for the legacy appliance",
+						NetscapeCertType: []internalcmapi.NetscapeCertType{internalcmapi.NetscapeCertTypeSSLClient, internalcmapi.NetscapeCertTypeSSLServer},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid legacyExtensions": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					LegacyExtensions: &internalcmapi.CertificateLegacyExtensions{
+						NetscapeComment:  "Zugelassen für Ämter",
+						NetscapeCertType: []internalcmapi.NetscapeCertType{internalcmapi.NetscapeCertTypeSSLServer, "reserved", internalcmapi.NetscapeCertTypeSSLServer},
+					},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("legacyExtensions", "netscapeComment"), "Zugelassen für Ämter", "must only contain printable ASCII characters, got 'ü'"),
+				field.NotSupported(fldPath.Child("legacyExtensions", "netscapeCertType").Index(1), internalcmapi.NetscapeCertType("reserved"), []string{"ssl client", "ssl server", "s/mime", "object signing", "ssl ca", "s/mime ca", "object signing ca"}),
+				field.Duplicate(fldPath.Child("legacyExtensions", "netscapeCertType").Index(2), internalcmapi.NetscapeCertTypeSSLServer),
 			},
 		},
-		"valid with empty secretTemplate": {
+		"valid chainTruncation": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
 					CommonName: "testcn",
 					SecretName: "abc",
-					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
-						Annotations: map[string]string{},
-						Labels:      map[string]string{},
+					ChainTruncation: &internalcmapi.CertificateChainTruncation{
+						Subject: "CN=Example Intermediate CA,O=Example",
 					},
 					IssuerRef: validIssuerRef,
 				},
 			},
 			a: someAdmissionRequest,
 		},
-		"valid with 'CertificateSecretTemplate' labels and annotations": {
+		"chainTruncation with both subject and sha256Fingerprint": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
 					CommonName: "testcn",
 					SecretName: "abc",
-					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
-						Annotations: map[string]string{
-							"my-annotation.com/foo": "app=bar",
-						},
-						Labels: map[string]string{
-							"my-label.com/foo": "evn-production",
-						},
+					ChainTruncation: &internalcmapi.CertificateChainTruncation{
+						Subject:           "CN=Example Intermediate CA",
+						SHA256Fingerprint: strings.Repeat("ab", 32),
 					},
 					IssuerRef: validIssuerRef,
 				},
 			},
 			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("chainTruncation"), "only one of subject or sha256Fingerprint may be specified"),
+			},
 		},
-		"invalid with disallowed 'CertificateSecretTemplate' annotations": {
+		"chainTruncation with an invalid sha256Fingerprint": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
 					CommonName: "testcn",
 					SecretName: "abc",
-					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
-						Annotations: map[string]string{
-							"app.com/valid":                          "valid",
-							"cert-manager.io/alt-names":              "example.com",
-							"cert-manager.io/certificate-name":       "selfsigned-cert",
-							"cert-manager.io/allow-direct-injection": "true",
-						},
+					ChainTruncation: &internalcmapi.CertificateChainTruncation{
+						SHA256Fingerprint: "ab:cd",
 					},
 					IssuerRef: validIssuerRef,
 				},
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.Invalid(fldPath.Child("secretTemplate", "annotations"), "cert-manager.io/alt-names", "cert-manager.io/* annotations are not allowed"),
-				field.Invalid(fldPath.Child("secretTemplate", "annotations"), "cert-manager.io/certificate-name", "cert-manager.io/* annotations are not allowed"),
+				field.Invalid(fldPath.Child("chainTruncation", "sha256Fingerprint"), "ab:cd", "fingerprint must be 32 bytes long, got 2"),
 			},
 		},
-		"invalid due to too long 'CertificateSecretTemplate' annotations": {
+		"chainTruncation with a subject with an unknown attribute": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
 					CommonName: "testcn",
 					SecretName: "abc",
-					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
-						Annotations: map[string]string{
-							"app.com/invalid": strings.Repeat("0", maxSecretTemplateAnnotationsBytesLimit),
-						},
+					ChainTruncation: &internalcmapi.CertificateChainTruncation{
+						Subject: "CN=Example,FOO=bar",
 					},
 					IssuerRef: validIssuerRef,
 				},
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.TooLong(fldPath.Child("secretTemplate", "annotations"), "", maxSecretTemplateAnnotationsBytesLimit),
+				field.Invalid(fldPath.Child("chainTruncation", "subject"), "CN=Example,FOO=bar", "subject contains unrecognized key with value [bar]"),
 			},
 		},
-		"invalid due to not allowed 'CertificateSecretTemplate' labels": {
+		"empty chainTruncation": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:      "testcn",
+					SecretName:      "abc",
+					ChainTruncation: &internalcmapi.CertificateChainTruncation{},
+					IssuerRef:       validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("chainTruncation"), "one of subject or sha256Fingerprint must be specified"),
+			},
+		},
+		"legacyExtensions with a netscapeComment which is too long": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
 					CommonName: "testcn",
 					SecretName: "abc",
-					SecretTemplate: &internalcmapi.CertificateSecretTemplate{
-						Labels: map[string]string{
-							"app.com/invalid-chars": "invalid=chars",
-						},
+					LegacyExtensions: &internalcmapi.CertificateLegacyExtensions{
+						NetscapeComment: strings.Repeat("a", 257),
 					},
 					IssuerRef: validIssuerRef,
 				},
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.Invalid(
-					fldPath.Child("secretTemplate", "labels"),
-					"invalid=chars", "a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an "+
-						"alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')"),
+				field.Invalid(fldPath.Child("legacyExtensions", "netscapeComment"), strings.Repeat("a", 257), "must be at most 256 characters long, got 257"),
 			},
 		},
-		"valid with name constraints": {
+		"valid policyConstraints and inhibitAnyPolicy on a CA": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
 					CommonName: "testcn",
 					SecretName: "abc",
 					IsCA:       true,
-					NameConstraints: &internalcmapi.NameConstraints{
-						Permitted: &internalcmapi.NameConstraintItem{
-							DNSDomains: []string{"example.com"},
-						},
+					PolicyConstraints: &internalcmapi.CertificatePolicyConstraints{
+						RequireExplicitPolicy: ptr.To(int32(0)),
+						InhibitPolicyMapping:  ptr.To(int32(1)),
 					},
-					IssuerRef: validIssuerRef,
+					InhibitAnyPolicy: ptr.To(int32(0)),
+					IssuerRef:        validIssuerRef,
 				},
 			},
-			a:                             someAdmissionRequest,
-			nameConstraintsFeatureEnabled: true,
+			a: someAdmissionRequest,
 		},
-		"invalid with name constraints": {
+		"policyConstraints and inhibitAnyPolicy on a certificate which is not a CA": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
-					CommonName:      "testcn",
-					SecretName:      "abc",
-					IsCA:            true,
-					NameConstraints: &internalcmapi.NameConstraints{},
-					IssuerRef:       validIssuerRef,
+					CommonName: "testcn",
+					SecretName: "abc",
+					PolicyConstraints: &internalcmapi.CertificatePolicyConstraints{
+						RequireExplicitPolicy: ptr.To(int32(0)),
+					},
+					InhibitAnyPolicy: ptr.To(int32(0)),
+					IssuerRef:        validIssuerRef,
 				},
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.Invalid(
-					fldPath.Child("nameConstraints"), &internalcmapi.NameConstraints{}, "either permitted or excluded must be set"),
+				field.Invalid(fldPath.Child("policyConstraints"), &internalcmapi.CertificatePolicyConstraints{RequireExplicitPolicy: ptr.To(int32(0))}, "isCA should be true when policyConstraints is set"),
+				field.Invalid(fldPath.Child("inhibitAnyPolicy"), int32(0), "isCA should be true when inhibitAnyPolicy is set"),
 			},
-			nameConstraintsFeatureEnabled: true,
 		},
-		"valid name constraints with feature gate disabled": {
+		"empty policyConstraints and negative skip counts": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:        "testcn",
+					SecretName:        "abc",
+					IsCA:              true,
+					PolicyConstraints: &internalcmapi.CertificatePolicyConstraints{},
+					InhibitAnyPolicy:  ptr.To(int32(-1)),
+					IssuerRef:         validIssuerRef,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("policyConstraints"), "one of requireExplicitPolicy or inhibitPolicyMapping must be specified"),
+				field.Invalid(fldPath.Child("inhibitAnyPolicy"), int32(-1), "must not be negative"),
+			},
+		},
+		"policyConstraints with negative skip counts": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
 					CommonName: "testcn",
 					SecretName: "abc",
 					IsCA:       true,
-					NameConstraints: &internalcmapi.NameConstraints{
-						Permitted: &internalcmapi.NameConstraintItem{
-							DNSDomains: []string{"example.com"},
-						},
+					PolicyConstraints: &internalcmapi.CertificatePolicyConstraints{
+						RequireExplicitPolicy: ptr.To(int32(-1)),
+						InhibitPolicyMapping:  ptr.To(int32(-2)),
 					},
 					IssuerRef: validIssuerRef,
 				},
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.Forbidden(
-					fldPath.Child("nameConstraints"), "feature gate NameConstraints must be enabled"),
+				field.Invalid(fldPath.Child("policyConstraints", "requireExplicitPolicy"), int32(-1), "must not be negative"),
+				field.Invalid(fldPath.Child("policyConstraints", "inhibitPolicyMapping"), int32(-2), "must not be negative"),
 			},
 		},
 	}
@@ -941,6 +1477,92 @@ func TestValidateDuration(t *testing.T) {
 	}
 }
 
+func Test_certificateWarnings(t *testing.T) {
+	scenarios := map[string]struct {
+		spec     internalcmapi.CertificateSpec
+		warnings []string
+	}{
+		"default duration and renewBefore": {},
+		"short-lived certificate with the default renewBefore": {
+			spec: internalcmapi.CertificateSpec{
+				Duration: &metav1.Duration{Duration: time.Hour},
+			},
+		},
+		"short-lived certificate renewed more often than the minimum interval": {
+			spec: internalcmapi.CertificateSpec{
+				Duration:    &metav1.Duration{Duration: time.Hour},
+				RenewBefore: &metav1.Duration{Duration: 55 * time.Minute},
+			},
+			warnings: []string{fmt.Sprintf(frequentRenewalTemplate, 5*time.Minute, minimumRenewalInterval)},
+		},
+		"short-lived certificate with a renewBeforePercentage renewed more often than the minimum interval": {
+			spec: internalcmapi.CertificateSpec{
+				Duration:              &metav1.Duration{Duration: time.Hour},
+				RenewBeforePercentage: ptr.To(int32(90)),
+			},
+			warnings: []string{fmt.Sprintf(frequentRenewalTemplate, 6*time.Minute, minimumRenewalInterval)},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, s.warnings, certificateWarnings(&s.spec))
+		})
+	}
+}
+
+func TestValidateIssueAfter(t *testing.T) {
+	issueAfter := metav1.NewTime(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	fldPath := field.NewPath("spec")
+	scenarios := map[string]struct {
+		spec internalcmapi.CertificateSpec
+		errs []*field.Error
+	}{
+		"issueAfter without issueAhead": {
+			spec: internalcmapi.CertificateSpec{IssueAfter: &issueAfter},
+		},
+		"issueAfter with issueAhead": {
+			spec: internalcmapi.CertificateSpec{
+				IssueAfter: &issueAfter,
+				IssueAhead: &metav1.Duration{Duration: 48 * time.Hour},
+			},
+		},
+		"issueAhead without issueAfter": {
+			spec: internalcmapi.CertificateSpec{
+				IssueAhead: &metav1.Duration{Duration: 48 * time.Hour},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("issueAhead"), 48*time.Hour, "issueAhead can only be set if issueAfter is set"),
+			},
+		},
+		"negative issueAhead": {
+			spec: internalcmapi.CertificateSpec{
+				IssueAfter: &issueAfter,
+				IssueAhead: &metav1.Duration{Duration: -time.Hour},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("issueAhead"), -time.Hour, "issueAhead must not be negative"),
+			},
+		},
+		"issueAhead not less than the duration": {
+			spec: internalcmapi.CertificateSpec{
+				Duration:   &metav1.Duration{Duration: 24 * time.Hour},
+				IssueAfter: &issueAfter,
+				IssueAhead: &metav1.Duration{Duration: 48 * time.Hour},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("issueAhead"), 48*time.Hour, "certificate duration 24h0m0s must be greater than issueAhead 48h0m0s"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := validateIssueAfter(&s.spec, fldPath)
+			assert.ElementsMatch(t, errs, s.errs)
+		})
+	}
+}
+
 func Test_validateAdditionalOutputFormats(t *testing.T) {
 	tests := map[string]struct {
 		featureEnabled bool
@@ -1052,6 +1674,141 @@ func Test_validateAdditionalOutputFormats(t *testing.T) {
 	}
 }
 
+func Test_validateAdditionalSecrets(t *testing.T) {
+	fldPath := field.NewPath("spec", "additionalSecrets")
+	passwordRef := &cmmeta.SecretKeySelector{
+		LocalObjectReference: cmmeta.LocalObjectReference{Name: "keystore-password"},
+		Key:                  "password",
+	}
+
+	tests := map[string]struct {
+		featureEnabled bool
+		spec           *internalcmapi.CertificateSpec
+		expErr         field.ErrorList
+	}{
+		"if feature disabled and no secrets defined, expect no error": {
+			featureEnabled: false,
+			spec:           &internalcmapi.CertificateSpec{SecretName: "tls"},
+			expErr:         nil,
+		},
+		"if feature disabled and a secret defined, expect error": {
+			featureEnabled: false,
+			spec: &internalcmapi.CertificateSpec{
+				SecretName: "tls",
+				AdditionalSecrets: []internalcmapi.CertificateAdditionalSecret{
+					{Name: "ingress-tls", Namespace: "ingress"},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Forbidden(fldPath, "feature gate AdditionalCertificateSecrets must be enabled"),
+			},
+		},
+		"if feature enabled and secrets defined with a format each, expect no error": {
+			featureEnabled: true,
+			spec: &internalcmapi.CertificateSpec{
+				SecretName: "tls",
+				AdditionalSecrets: []internalcmapi.CertificateAdditionalSecret{
+					{Name: "ingress-tls", Namespace: "ingress"},
+					{Name: "app-tls", Format: internalcmapi.CertificateAdditionalSecretFormatTLS},
+					{Name: "app-keystore", Namespace: "java", Format: internalcmapi.CertificateAdditionalSecretFormatPKCS12, PasswordSecretRef: passwordRef, Profile: internalcmapi.Modern2023PKCS12Profile},
+					{Name: "app-keystore", Namespace: "legacy-java", Format: internalcmapi.CertificateAdditionalSecretFormatJKS, PasswordSecretRef: passwordRef},
+				},
+			},
+			expErr: nil,
+		},
+		"if feature enabled and secrets are invalid, expect errors": {
+			featureEnabled: true,
+			spec: &internalcmapi.CertificateSpec{
+				SecretName: "tls",
+				AdditionalSecrets: []internalcmapi.CertificateAdditionalSecret{
+					{Namespace: "ingress"},
+					{Name: "tls"},
+					{Name: "Invalid_Name", Namespace: "Invalid_Namespace"},
+					{Name: "ingress-tls", Namespace: "ingress", Format: "PEM"},
+					{Name: "ingress-tls", Namespace: "ingress", PasswordSecretRef: passwordRef, Profile: internalcmapi.Modern2023PKCS12Profile},
+					{Name: "app-keystore", Format: internalcmapi.CertificateAdditionalSecretFormatPKCS12},
+					{Name: "app-truststore", Format: internalcmapi.CertificateAdditionalSecretFormatJKS, PasswordSecretRef: &cmmeta.SecretKeySelector{}},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Required(fldPath.Index(0).Child("name"), "must be specified"),
+				field.Invalid(fldPath.Index(1).Child("name"), "tls", "must not be the same as secretName"),
+				field.Invalid(fldPath.Index(2).Child("name"), "Invalid_Name", "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+				field.Invalid(fldPath.Index(2).Child("namespace"), "Invalid_Namespace", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+				field.NotSupported(fldPath.Index(3).Child("format"), internalcmapi.CertificateAdditionalSecretFormat("PEM"), []string{"TLS", "PKCS12", "JKS"}),
+				field.Duplicate(fldPath.Index(4), "ingress/ingress-tls"),
+				field.Forbidden(fldPath.Index(4).Child("passwordSecretRef"), "may only be specified for the PKCS12 and JKS formats"),
+				field.Forbidden(fldPath.Index(4).Child("profile"), "may only be specified for the PKCS12 format"),
+				field.Required(fldPath.Index(5).Child("passwordSecretRef"), "must be specified for the PKCS12 format"),
+				field.Required(fldPath.Index(6).Child("passwordSecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Index(6).Child("passwordSecretRef", "key"), "secret key is required"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultMutableFeatureGate, feature.AdditionalCertificateSecrets, test.featureEnabled)
+			gotErr := validateAdditionalSecrets(test.spec, field.NewPath("spec"))
+			assert.Equal(t, test.expErr, gotErr)
+		})
+	}
+}
+
+func Test_validateSeedSecretRef(t *testing.T) {
+	fldPath := field.NewPath("spec", "privateKey")
+	seedRef := &cmmeta.SecretKeySelector{
+		LocalObjectReference: cmmeta.LocalObjectReference{Name: "seed"},
+		Key:                  "seed",
+	}
+
+	tests := map[string]struct {
+		featureEnabled bool
+		privateKey     *internalcmapi.CertificatePrivateKey
+		expErr         field.ErrorList
+	}{
+		"if feature disabled, expect error": {
+			featureEnabled: false,
+			privateKey:     &internalcmapi.CertificatePrivateKey{Algorithm: internalcmapi.ECDSAKeyAlgorithm, SeedSecretRef: seedRef},
+			expErr: field.ErrorList{
+				field.Forbidden(fldPath.Child("seedSecretRef"), "feature gate DeterministicPrivateKeys must be enabled"),
+			},
+		},
+		"if feature enabled with an ECDSA key, expect no error": {
+			featureEnabled: true,
+			privateKey:     &internalcmapi.CertificatePrivateKey{Algorithm: internalcmapi.ECDSAKeyAlgorithm, Size: 384, SeedSecretRef: seedRef},
+			expErr:         nil,
+		},
+		"if feature enabled with an Ed25519 key, expect no error": {
+			featureEnabled: true,
+			privateKey:     &internalcmapi.CertificatePrivateKey{Algorithm: internalcmapi.Ed25519KeyAlgorithm, SeedSecretRef: seedRef},
+			expErr:         nil,
+		},
+		"if feature enabled with an RSA key and an empty reference, expect errors": {
+			featureEnabled: true,
+			privateKey:     &internalcmapi.CertificatePrivateKey{SeedSecretRef: &cmmeta.SecretKeySelector{}},
+			expErr: field.ErrorList{
+				field.Required(fldPath.Child("seedSecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("seedSecretRef", "key"), "secret key is required"),
+				field.Forbidden(fldPath.Child("seedSecretRef"), "deterministic private keys are only supported for the ECDSA and Ed25519 key algorithms"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultMutableFeatureGate, feature.DeterministicPrivateKeys, test.featureEnabled)
+			gotErr := validateSeedSecretRef(test.privateKey, fldPath)
+			assert.Equal(t, test.expErr, gotErr)
+
+			_, warnings := ValidateCertificate(nil, &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{PrivateKey: test.privateKey},
+			})
+			assert.Equal(t, []string{deterministicPrivateKeyInUse}, warnings)
+		})
+	}
+}
+
 func Test_validateLiteralSubject(t *testing.T) {
 	fldPath := field.NewPath("spec")
 	tests := map[string]struct {
@@ -1339,3 +2096,109 @@ func Test_validateKeystores(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateSecretDataKeys(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	passwordRef := func(name, key string) cmmeta.SecretKeySelector {
+		return cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: name}, Key: key}
+	}
+
+	tests := map[string]struct {
+		spec   *internalcmapi.CertificateSpec
+		expErr field.ErrorList
+	}{
+		"output formats and keystores which write distinct keys are valid": {
+			spec: &internalcmapi.CertificateSpec{
+				SecretName: "tls",
+				AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+					{Type: internalcmapi.CertificateOutputFormatDER},
+					{Type: internalcmapi.CertificateOutputFormatCombinedPEM},
+					{Type: internalcmapi.CertificateOutputFormatPKCS7},
+				},
+				Keystores: &internalcmapi.CertificateKeystores{
+					JKS:    &internalcmapi.JKSKeystore{Create: true, PasswordSecretRef: passwordRef("tls", "jks-password")},
+					PKCS12: &internalcmapi.PKCS12Keystore{Create: true, PasswordSecretRef: passwordRef("keystore-password", cmapi.PKCS12SecretKey)},
+				},
+			},
+		},
+		"duplicate output formats are not reported as conflicting keys": {
+			spec: &internalcmapi.CertificateSpec{
+				SecretName: "tls",
+				AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+					{Type: internalcmapi.CertificateOutputFormatDER},
+					{Type: internalcmapi.CertificateOutputFormatDER},
+				},
+			},
+		},
+		"a keystore password read from a key written to the Certificate's Secret is invalid": {
+			spec: &internalcmapi.CertificateSpec{
+				SecretName: "tls",
+				Keystores: &internalcmapi.CertificateKeystores{
+					JKS: &internalcmapi.JKSKeystore{Create: true, PasswordSecretRef: passwordRef("tls", corev1.TLSPrivateKeyKey)},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Invalid(fldPath.Child("keystores", "jks", "passwordSecretRef", "key"), corev1.TLSPrivateKeyKey, "must not be a key written by cert-manager to the Certificate's Secret"),
+			},
+		},
+		"a keystore password read from a key written by the other keystore is invalid": {
+			spec: &internalcmapi.CertificateSpec{
+				SecretName: "tls",
+				Keystores: &internalcmapi.CertificateKeystores{
+					JKS:    &internalcmapi.JKSKeystore{Create: true, PasswordSecretRef: passwordRef("tls", cmapi.PKCS12TruststoreKey)},
+					PKCS12: &internalcmapi.PKCS12Keystore{Create: true, PasswordSecretRef: passwordRef("keystore-password", "password")},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Invalid(fldPath.Child("keystores", "jks", "passwordSecretRef", "key"), cmapi.PKCS12TruststoreKey, "must not be a key written by cert-manager to the Certificate's Secret"),
+			},
+		},
+		"a keystore password read from a key which is not written because the keystore is disabled is valid": {
+			spec: &internalcmapi.CertificateSpec{
+				SecretName: "tls",
+				Keystores: &internalcmapi.CertificateKeystores{
+					JKS:    &internalcmapi.JKSKeystore{Create: true, PasswordSecretRef: passwordRef("tls", cmapi.PKCS12SecretKey)},
+					PKCS12: &internalcmapi.PKCS12Keystore{Create: false, PasswordSecretRef: passwordRef("keystore-password", "password")},
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			errs := validateSecretDataKeys(test.spec, fldPath)
+			assert.ElementsMatch(t, errs, test.expErr)
+		})
+	}
+}
+
+func Test_validateCertificateAnnotations(t *testing.T) {
+	fldPath := field.NewPath("metadata", "annotations")
+	scenarios := map[string]struct {
+		annotations map[string]string
+		errs        field.ErrorList
+	}{
+		"no annotations": {
+			errs: field.ErrorList{},
+		},
+		"shared secret ownership enabled": {
+			annotations: map[string]string{cmapi.SharedSecretOwnershipAnnotation: "true"},
+			errs:        field.ErrorList{},
+		},
+		"shared secret ownership disabled": {
+			annotations: map[string]string{cmapi.SharedSecretOwnershipAnnotation: "false"},
+			errs:        field.ErrorList{},
+		},
+		"shared secret ownership with an invalid value": {
+			annotations: map[string]string{cmapi.SharedSecretOwnershipAnnotation: "yes"},
+			errs: field.ErrorList{
+				field.Invalid(fldPath.Key(cmapi.SharedSecretOwnershipAnnotation), "yes", `must be either "true" or "false"`),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, s.errs, validateCertificateAnnotations(s.annotations, fldPath))
+		})
+	}
+}
@@ -81,7 +81,7 @@ func validateCertificateRequestAnnotations(objA, objB *cmapi.CertificateRequest,
 func ValidateCertificateRequestSpec(crSpec *cmapi.CertificateRequestSpec, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
-	el = append(el, validateIssuerRef(crSpec.IssuerRef, fldPath)...)
+	el = append(el, validateIssuerRef(crSpec.IssuerRef, fldPath.Child("issuerRef"))...)
 
 	el = append(el, validateCertificateRequestSpecRequest(crSpec, fldPath)...)
 
@@ -29,11 +29,13 @@ import (
 func ValidateClusterIssuer(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
 	iss := obj.(*cmapi.ClusterIssuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, ValidateIssuerAlias(iss.Name, iss.Annotations, field.NewPath("metadata", "annotations"))...)
 	return allErrs, warnings
 }
 
 func ValidateUpdateClusterIssuer(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
 	iss := obj.(*cmapi.ClusterIssuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, ValidateIssuerAlias(iss.Name, iss.Annotations, field.NewPath("metadata", "annotations"))...)
 	return allErrs, warnings
 }
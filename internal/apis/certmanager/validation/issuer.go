@@ -19,11 +19,17 @@ package validation
 import (
 	"crypto/x509"
 	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
 	"strings"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -31,6 +37,9 @@ import (
 	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
 	"github.com/cert-manager/cert-manager/internal/apis/certmanager/validation/util"
 	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
 // Validation functions for cert-manager Issuer types.
@@ -38,18 +47,129 @@ import (
 func ValidateIssuer(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
 	iss := obj.(*certmanager.Issuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, ValidateIssuerAlias(iss.Name, iss.Annotations, field.NewPath("metadata", "annotations"))...)
 	return allErrs, warnings
 }
 
 func ValidateUpdateIssuer(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
 	iss := obj.(*certmanager.Issuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, ValidateIssuerAlias(iss.Name, iss.Annotations, field.NewPath("metadata", "annotations"))...)
 	// Admission request should never be nil
 	return allErrs, warnings
 }
 
+// ValidateIssuerAlias validates the `cert-manager.io/issuer-alias` annotation
+// of an Issuer or ClusterIssuer. The alias is used in place of the issuer's
+// name in issuerRefs, so it must be a valid resource name.
+func ValidateIssuerAlias(name string, annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	alias, ok := annotations[certmanager.IssuerAliasAnnotationKey]
+	if !ok {
+		return nil
+	}
+
+	el := field.ErrorList{}
+	fldPath = fldPath.Key(certmanager.IssuerAliasAnnotationKey)
+	if alias == name {
+		el = append(el, field.Invalid(fldPath, alias, "alias must not be the same as the issuer's name"))
+	}
+	for _, msg := range validation.IsDNS1123Subdomain(alias) {
+		el = append(el, field.Invalid(fldPath, alias, msg))
+	}
+	return el
+}
+
 func ValidateIssuerSpec(iss *certmanager.IssuerSpec, fldPath *field.Path) (field.ErrorList, []string) {
-	return ValidateIssuerConfig(&iss.IssuerConfig, fldPath)
+	el, warnings := ValidateIssuerConfig(&iss.IssuerConfig, fldPath)
+	if iss.DefaultSecretTemplate != nil {
+		el = append(el, validateSecretTemplate(iss.DefaultSecretTemplate, fldPath.Child("defaultSecretTemplate"))...)
+	}
+	for i, matcher := range iss.TerminalErrors {
+		el = append(el, validateTerminalErrorMatcher(matcher, fldPath.Child("terminalErrors").Index(i))...)
+	}
+	if iss.UsagePolicy != nil {
+		el = append(el, validateUsagePolicy(iss.UsagePolicy, fldPath.Child("usagePolicy"))...)
+	}
+	el = append(el, validateExpiredCertificatePolicy(iss.DefaultExpiredCertificatePolicy, fldPath.Child("defaultExpiredCertificatePolicy"))...)
+	return el, warnings
+}
+
+var supportedExpiredCertificatePolicies = []string{
+	string(certmanager.ExpiredCertificatePolicyKeep),
+	string(certmanager.ExpiredCertificatePolicyFallback),
+}
+
+func validateExpiredCertificatePolicy(policy certmanager.ExpiredCertificatePolicy, fldPath *field.Path) field.ErrorList {
+	switch policy {
+	case "", certmanager.ExpiredCertificatePolicyKeep, certmanager.ExpiredCertificatePolicyFallback:
+		return nil
+	default:
+		return field.ErrorList{field.NotSupported(fldPath, policy, supportedExpiredCertificatePolicies)}
+	}
+}
+
+// validateUsagePolicy validates that the usages of an issuer usage policy are
+// known, and that no usage is listed twice or is both allowed and forbidden.
+// Aliases such as `signing` and `digital signature` are the same usage.
+func validateUsagePolicy(policy *certmanager.IssuerUsagePolicy, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	allowed := sets.New[string]()
+	for i, u := range policy.AllowedUsages {
+		idxPath := fldPath.Child("allowedUsages").Index(i)
+		key, ok := usageKey(u)
+		switch {
+		case !ok:
+			el = append(el, field.Invalid(idxPath, u, "unknown keyusage"))
+		case allowed.Has(key):
+			el = append(el, field.Duplicate(idxPath, u))
+		default:
+			allowed.Insert(key)
+		}
+	}
+
+	forbidden := sets.New[string]()
+	for i, u := range policy.ForbiddenUsages {
+		idxPath := fldPath.Child("forbiddenUsages").Index(i)
+		key, ok := usageKey(u)
+		switch {
+		case !ok:
+			el = append(el, field.Invalid(idxPath, u, "unknown keyusage"))
+		case forbidden.Has(key):
+			el = append(el, field.Duplicate(idxPath, u))
+		case allowed.Has(key):
+			el = append(el, field.Invalid(idxPath, u, "usage may not be both allowed and forbidden"))
+		default:
+			forbidden.Insert(key)
+		}
+	}
+	return el
+}
+
+// usageKey returns a key identifying the x509 usage that the given usage maps
+// to, or false if the usage is unknown.
+func usageKey(usage certmanager.KeyUsage) (string, bool) {
+	if ku, ok := apiutil.KeyUsageType(cmapi.KeyUsage(usage)); ok {
+		return fmt.Sprintf("ku:%d", ku), true
+	}
+	if eku, ok := apiutil.ExtKeyUsageType(cmapi.KeyUsage(usage)); ok {
+		return fmt.Sprintf("eku:%d", eku), true
+	}
+	return "", false
+}
+
+func validateTerminalErrorMatcher(matcher certmanager.TerminalErrorMatcher, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	switch {
+	case matcher.Contains == "" && matcher.Regex == "":
+		el = append(el, field.Required(fldPath, "one of contains or regex must be specified"))
+	case matcher.Contains != "" && matcher.Regex != "":
+		el = append(el, field.Forbidden(fldPath, "only one of contains or regex may be specified"))
+	case matcher.Regex != "":
+		if _, err := regexp.Compile(matcher.Regex); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("regex"), matcher.Regex, err.Error()))
+		}
+	}
+	return el
 }
 
 func ValidateIssuerConfig(iss *certmanager.IssuerConfig, fldPath *field.Path) (field.ErrorList, []string) {
@@ -97,6 +217,14 @@ func ValidateIssuerConfig(iss *certmanager.IssuerConfig, fldPath *field.Path) (f
 			el = append(el, ValidateVenafiIssuerConfig(iss.Venafi, fldPath.Child("venafi"))...)
 		}
 	}
+	if iss.GoogleCAS != nil {
+		if numConfigs > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("googleCAS"), "may not specify more than one issuer type"))
+		} else {
+			numConfigs++
+			el = append(el, ValidateGoogleCASIssuerConfig(iss.GoogleCAS, fldPath.Child("googleCAS"))...)
+		}
+	}
 	if numConfigs == 0 {
 		el = append(el, field.Required(fldPath, "at least one issuer must be configured"))
 	}
@@ -146,6 +274,8 @@ func ValidateACMEIssuerConfig(iss *cmacme.ACMEIssuer, fldPath *field.Path) (fiel
 		el = append(el, ValidateACMEIssuerChallengeSolverConfig(&sol, fldPath.Child("solvers").Index(i))...) // #nosec G601 -- False positive. See https://github.com/golang/go/discussions/56010
 	}
 
+	el = append(el, validateHTTPHeaders(iss.HTTPHeaders, nil, fldPath.Child("httpHeaders"))...)
+
 	return el, warnings
 }
 
@@ -169,6 +299,33 @@ func ValidateACMEIssuerChallengeSolverConfig(sol *cmacme.ACMEChallengeSolver, fl
 		el = append(el, field.Required(fldPath, "no solver type configured"))
 	}
 
+	if sol.Selector != nil {
+		el = append(el, validateDNSZoneCNAMEStrategies(sol.Selector.DNSZoneCNAMEStrategies, fldPath.Child("selector", "dnsZoneCNAMEStrategies"))...)
+	}
+
+	return el
+}
+
+func validateDNSZoneCNAMEStrategies(strategies []cmacme.DNSZoneCNAMEStrategy, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	zones := sets.New[string]()
+	for i, strategy := range strategies {
+		fldPath := fldPath.Index(i)
+		zone := strings.ToLower(strings.TrimSuffix(strategy.DNSZone, "."))
+		switch {
+		case zone == "":
+			el = append(el, field.Required(fldPath.Child("dnsZone"), ""))
+		case zones.Has(zone):
+			el = append(el, field.Duplicate(fldPath.Child("dnsZone"), strategy.DNSZone))
+		}
+		zones.Insert(zone)
+
+		switch strategy.CNAMEStrategy {
+		case cmacme.NoneStrategy, cmacme.FollowStrategy:
+		default:
+			el = append(el, field.Invalid(fldPath.Child("cnameStrategy"), strategy.CNAMEStrategy, fmt.Sprintf("must be one of %q or %q", cmacme.NoneStrategy, cmacme.FollowStrategy)))
+		}
+	}
 	return el
 }
 
@@ -190,6 +347,31 @@ func ValidateACMEIssuerChallengeSolverHTTP01Config(http01 *cmacme.ACMEChallengeS
 	if numDefined > 1 {
 		el = append(el, field.Required(fldPath, "only 1 HTTP01 solver type may be configured"))
 	}
+	if http01.SelfCheck != nil {
+		el = append(el, ValidateACMEIssuerChallengeSolverHTTP01SelfCheckConfig(http01.SelfCheck, fldPath.Child("selfCheck"))...)
+	}
+
+	return el
+}
+
+// maxHTTP01SelfCheckDuration is the overall deadline for the HTTP01 self
+// check, which no single request or wait may exceed.
+// This must be kept in sync with HTTP01Timeout in pkg/issuer/acme/http/http.go
+const maxHTTP01SelfCheckDuration = 15 * time.Minute
+
+func ValidateACMEIssuerChallengeSolverHTTP01SelfCheckConfig(selfCheck *cmacme.ACMEChallengeSolverHTTP01SelfCheck, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	validateDuration := func(d *metav1.Duration, fldPath *field.Path) {
+		if d == nil {
+			return
+		}
+		if d.Duration <= 0 || d.Duration > maxHTTP01SelfCheckDuration {
+			el = append(el, field.Invalid(fldPath, d.Duration, fmt.Sprintf("must be greater than 0s and not greater than the %s HTTP01 self check deadline", maxHTTP01SelfCheckDuration)))
+		}
+	}
+	validateDuration(selfCheck.Timeout, fldPath.Child("timeout"))
+	validateDuration(selfCheck.Interval, fldPath.Child("interval"))
 
 	return el
 }
@@ -250,11 +432,91 @@ func ValidateCAIssuerConfig(iss *certmanager.CAIssuer, fldPath *field.Path) fiel
 			el = append(el, field.Invalid(fldPath.Child("issuingCertificateURLs").Index(i), issuerURL, "must be a valid URL"))
 		}
 	}
+	for i, policy := range iss.RequesterDurationPolicies {
+		el = append(el, validateRequesterDurationPolicy(policy, fldPath.Child("requesterDurationPolicies").Index(i))...)
+	}
+	el = append(el, validateSubjectKeyIdentifierMethod(iss.SubjectKeyIdentifierMethod, fldPath.Child("subjectKeyIdentifierMethod"))...)
+	el = append(el, validateClientAuthOnlyPolicy(iss.ClientAuthOnly, fldPath.Child("clientAuthOnly"))...)
+	el = append(el, validateNotAfterBoundary(iss.NotAfterBoundary, fldPath.Child("notAfterBoundary"))...)
+	// The key of the root CA secret defaults to ca.crt, so only the name is
+	// required.
+	if iss.RootCASecretRef != nil && iss.RootCASecretRef.Name == "" {
+		el = append(el, field.Required(fldPath.Child("rootCASecretRef", "name"), "secret name is required"))
+	}
+	return el
+}
+
+func validateClientAuthOnlyPolicy(policy *certmanager.ClientAuthOnlyPolicy, fldPath *field.Path) field.ErrorList {
+	if policy == nil {
+		return nil
+	}
+	el := field.ErrorList{}
+	for i, namespace := range policy.Namespaces {
+		for _, msg := range validation.IsDNS1123Label(namespace) {
+			el = append(el, field.Invalid(fldPath.Child("namespaces").Index(i), namespace, msg))
+		}
+	}
+	return el
+}
+
+var supportedSubjectKeyIdentifierMethods = []string{
+	string(certmanager.SubjectKeyIdentifierMethodSHA1),
+	string(certmanager.SubjectKeyIdentifierMethodTruncatedSHA1),
+}
+
+func validateSubjectKeyIdentifierMethod(method certmanager.SubjectKeyIdentifierMethod, fldPath *field.Path) field.ErrorList {
+	switch method {
+	case "", certmanager.SubjectKeyIdentifierMethodSHA1, certmanager.SubjectKeyIdentifierMethodTruncatedSHA1:
+		return nil
+	default:
+		return field.ErrorList{field.NotSupported(fldPath, method, supportedSubjectKeyIdentifierMethods)}
+	}
+}
+
+var supportedNotAfterBoundaries = []string{
+	string(certmanager.NotAfterBoundaryHour),
+	string(certmanager.NotAfterBoundaryDay),
+}
+
+func validateNotAfterBoundary(boundary certmanager.NotAfterBoundary, fldPath *field.Path) field.ErrorList {
+	switch boundary {
+	case "", certmanager.NotAfterBoundaryHour, certmanager.NotAfterBoundaryDay:
+		return nil
+	default:
+		return field.ErrorList{field.NotSupported(fldPath, boundary, supportedNotAfterBoundaries)}
+	}
+}
+
+func validateRequesterDurationPolicy(policy certmanager.RequesterDurationPolicy, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(policy.Usernames) == 0 && len(policy.Groups) == 0 {
+		el = append(el, field.Required(fldPath, "at least one of usernames or groups must be specified"))
+	}
+	for i, username := range policy.Usernames {
+		if username == "" {
+			el = append(el, field.Invalid(fldPath.Child("usernames").Index(i), username, "must not be empty"))
+		}
+	}
+	for i, group := range policy.Groups {
+		if group == "" {
+			el = append(el, field.Invalid(fldPath.Child("groups").Index(i), group, "must not be empty"))
+		}
+	}
+	if policy.MaxDuration.Duration < cmapi.MinimumCertificateDuration {
+		el = append(el, field.Invalid(fldPath.Child("maxDuration"), policy.MaxDuration.Duration, fmt.Sprintf("must be greater than or equal to %s", cmapi.MinimumCertificateDuration)))
+	}
 	return el
 }
 
 func ValidateSelfSignedIssuerConfig(iss *certmanager.SelfSignedIssuer, fldPath *field.Path) field.ErrorList {
-	return nil
+	el := validateSubjectKeyIdentifierMethod(iss.SubjectKeyIdentifierMethod, fldPath.Child("subjectKeyIdentifierMethod"))
+	if len(iss.AuthorityCertificate) > 0 {
+		if _, err := pki.AuthorityKeyIdentifier(iss.AuthorityCertificate); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("authorityCertificate"), "", err.Error()))
+		}
+	}
+	el = append(el, validateNotAfterBoundary(iss.NotAfterBoundary, fldPath.Child("notAfterBoundary"))...)
+	return el
 }
 
 func ValidateVaultIssuerConfig(iss *certmanager.VaultIssuer, fldPath *field.Path) field.ErrorList {
@@ -287,6 +549,12 @@ func ValidateVaultIssuerConfig(iss *certmanager.VaultIssuer, fldPath *field.Path
 		el = append(el, field.Invalid(fldPath.Child("clientCertSecretRef"), "<snip>", "clientCertSecretRef must be provided when defining the clientKeySecretRef"))
 	}
 
+	el = append(el, validateClientAuthOnlyPolicy(iss.ClientAuthOnly, fldPath.Child("clientAuthOnly"))...)
+
+	el = append(el, validateNotAfterBoundary(iss.NotAfterBoundary, fldPath.Child("notAfterBoundary"))...)
+
+	el = append(el, validateHTTPHeaders(iss.HTTPHeaders, vaultManagedHTTPHeaders, fldPath.Child("httpHeaders"))...)
+
 	el = append(el, ValidateVaultIssuerAuth(&iss.Auth, fldPath.Child("auth"))...)
 
 	return el
@@ -366,6 +634,24 @@ func ValidateVenafiTPP(tpp *certmanager.VenafiTPP, fldPath *field.Path) (el fiel
 	// Validate only one of CABundle/CABundleSecretRef is passed
 	el = append(el, validateVenafiTPPCABundleUnique(tpp, fldPath)...)
 
+	if tpp.Approval != nil {
+		el = append(el, validateVenafiTPPApproval(tpp.Approval, fldPath.Child("approval"))...)
+	}
+
+	return el
+}
+
+func validateVenafiTPPApproval(approval *certmanager.VenafiTPPApproval, fldPath *field.Path) (el field.ErrorList) {
+	if approval.WorkToDoField == "" {
+		el = append(el, field.Required(fldPath.Child("workToDoField"), ""))
+	}
+
+	for i, status := range approval.AwaitingApprovalStatuses {
+		if strings.TrimSpace(status) == "" {
+			el = append(el, field.Invalid(fldPath.Child("awaitingApprovalStatuses").Index(i), status, "must not be empty"))
+		}
+	}
+
 	return el
 }
 
@@ -410,6 +696,39 @@ func ValidateVenafiIssuerConfig(iss *certmanager.VenafiIssuer, fldPath *field.Pa
 		el = append(el, field.Forbidden(fldPath, "please supply one of: tpp, cloud"))
 	}
 
+	el = append(el, validateHTTPHeaders(iss.HTTPHeaders, nil, fldPath.Child("httpHeaders"))...)
+
+	return el
+}
+
+func ValidateGoogleCASIssuerConfig(iss *certmanager.GoogleCASIssuer, fldPath *field.Path) (el field.ErrorList) {
+	if iss.Project == "" {
+		el = append(el, field.Required(fldPath.Child("project"), ""))
+	}
+	if iss.Location == "" {
+		el = append(el, field.Required(fldPath.Child("location"), ""))
+	}
+	if iss.CAPool == "" {
+		el = append(el, field.Required(fldPath.Child("caPool"), ""))
+	}
+
+	// Ambient credentials are used if neither serviceAccountSecretRef nor
+	// workloadIdentity is set.
+	if iss.ServiceAccountSecretRef != nil {
+		el = append(el, ValidateSecretKeySelector(iss.ServiceAccountSecretRef, fldPath.Child("serviceAccountSecretRef"))...)
+	}
+	if wi := iss.WorkloadIdentity; wi != nil {
+		if iss.ServiceAccountSecretRef != nil {
+			el = append(el, field.Forbidden(fldPath, "please supply at most one of: serviceAccountSecretRef, workloadIdentity"))
+		}
+		if wi.Audience == "" {
+			el = append(el, field.Required(fldPath.Child("workloadIdentity", "audience"), ""))
+		}
+		if wi.ServiceAccountName == "" {
+			el = append(el, field.Required(fldPath.Child("workloadIdentity", "serviceAccountName"), ""))
+		}
+	}
+
 	return el
 }
 
@@ -421,6 +740,27 @@ var supportedTSIGAlgorithms = []string{
 	"HMACSHA512",
 }
 
+// validateDNS01FederatedCredentials validates the federated credentials of a
+// DNS01 provider. The audience is only supported, and then required, by
+// providers which set requireAudience.
+func validateDNS01FederatedCredentials(fc *cmacme.ACMEIssuerDNS01FederatedCredentials, requireAudience bool, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(fc.ServiceAccountRef.Name) == 0 {
+		el = append(el, field.Required(fldPath.Child("serviceAccountRef", "name"), ""))
+	}
+	switch {
+	case requireAudience && len(fc.Audience) == 0:
+		el = append(el, field.Required(fldPath.Child("audience"), ""))
+	case !requireAudience && len(fc.Audience) > 0:
+		el = append(el, field.Forbidden(fldPath.Child("audience"), "audience is not supported by this provider"))
+	}
+	return el
+}
+
+// maxDNS01CleanupDelay is the longest a DNS01 challenge record may be kept
+// after the challenge has been validated.
+const maxDNS01CleanupDelay = time.Hour
+
 func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
@@ -434,6 +774,11 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			el = append(el, field.Invalid(fldPath.Child("cnameStrategy"), p.CNAMEStrategy, fmt.Sprintf("must be one of %q or %q", cmacme.NoneStrategy, cmacme.FollowStrategy)))
 		}
 	}
+	if p.CleanupDelay != nil {
+		if d := p.CleanupDelay.Duration; d < 0 || d > maxDNS01CleanupDelay {
+			el = append(el, field.Invalid(fldPath.Child("cleanupDelay"), d, fmt.Sprintf("must be between 0s and %s", maxDNS01CleanupDelay)))
+		}
+	}
 	numProviders := 0
 	if p.Akamai != nil {
 		numProviders++
@@ -449,9 +794,25 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			el = append(el, field.Forbidden(fldPath.Child("azureDNS"), "may not specify more than one provider type"))
 		} else {
 			numProviders++
-			// if ClientID or ClientSecret or TenantID are defined then all of ClientID, ClientSecret and tenantID must be defined
-			// We check things separately because
-			if len(p.AzureDNS.ClientID) > 0 || len(p.AzureDNS.TenantID) > 0 || p.AzureDNS.ClientSecret != nil {
+			if fc := p.AzureDNS.FederatedCredentials; fc != nil {
+				// federated credentials are issued for the application given by
+				// ClientID and TenantID, and replace the ClientSecret
+				el = append(el, validateDNS01FederatedCredentials(fc, false, fldPath.Child("azureDNS", "federatedCredentials"))...)
+				if len(p.AzureDNS.ClientID) == 0 {
+					el = append(el, field.Required(fldPath.Child("azureDNS", "clientID"), "clientID is required when using federatedCredentials"))
+				}
+				if len(p.AzureDNS.TenantID) == 0 {
+					el = append(el, field.Required(fldPath.Child("azureDNS", "tenantID"), "tenantID is required when using federatedCredentials"))
+				}
+				if p.AzureDNS.ClientSecret != nil {
+					el = append(el, field.Forbidden(fldPath.Child("azureDNS", "clientSecretSecretRef"), "clientSecretSecretRef cannot be used at the same time as federatedCredentials"))
+				}
+				if p.AzureDNS.ManagedIdentity != nil {
+					el = append(el, field.Forbidden(fldPath.Child("azureDNS", "managedIdentity"), "managed identity cannot be used at the same time as federatedCredentials"))
+				}
+			} else if len(p.AzureDNS.ClientID) > 0 || len(p.AzureDNS.TenantID) > 0 || p.AzureDNS.ClientSecret != nil {
+				// if ClientID or ClientSecret or TenantID are defined then all of ClientID, ClientSecret and tenantID must be defined
+				// We check things separately because
 				if len(p.AzureDNS.ClientID) == 0 {
 					el = append(el, field.Required(fldPath.Child("azureDNS", "clientID"), ""))
 				}
@@ -507,6 +868,12 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			if len(p.CloudDNS.Project) == 0 {
 				el = append(el, field.Required(fldPath.Child("cloudDNS", "project"), ""))
 			}
+			if fc := p.CloudDNS.FederatedCredentials; fc != nil {
+				el = append(el, validateDNS01FederatedCredentials(fc, true, fldPath.Child("cloudDNS", "federatedCredentials"))...)
+				if p.CloudDNS.ServiceAccount != nil {
+					el = append(el, field.Forbidden(fldPath.Child("cloudDNS", "serviceAccountSecretRef"), "serviceAccountSecretRef cannot be used at the same time as federatedCredentials"))
+				}
+			}
 		}
 	}
 	if p.Cloudflare != nil {
@@ -546,6 +913,18 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			if p.Route53.SecretAccessKeyID != nil {
 				el = append(el, ValidateSecretKeySelector(p.Route53.SecretAccessKeyID, fldPath.Child("route53", "accessKeyIDSecretRef"))...)
 			}
+			if fc := p.Route53.FederatedCredentials; fc != nil {
+				el = append(el, validateDNS01FederatedCredentials(fc, false, fldPath.Child("route53", "federatedCredentials"))...)
+				if len(p.Route53.Role) == 0 {
+					el = append(el, field.Required(fldPath.Child("route53", "role"), "role is required when using federatedCredentials"))
+				}
+				if len(p.Route53.AccessKeyID) > 0 || p.Route53.SecretAccessKeyID != nil || len(p.Route53.SecretAccessKey.Name) > 0 {
+					el = append(el, field.Forbidden(fldPath.Child("route53", "federatedCredentials"), "access keys cannot be used at the same time as federatedCredentials"))
+				}
+				if p.Route53.Auth != nil {
+					el = append(el, field.Forbidden(fldPath.Child("route53", "auth"), "auth cannot be used at the same time as federatedCredentials"))
+				}
+			}
 		}
 	}
 	if p.AcmeDNS != nil {
@@ -617,6 +996,82 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 	return el
 }
 
+var (
+	// managedHTTPHeaders are the headers which are set by the HTTP clients of
+	// all issuers, and so may not be set as custom headers.
+	managedHTTPHeaders = []string{
+		"Authorization",
+		"Connection",
+		"Content-Length",
+		"Content-Type",
+		"Host",
+		"Transfer-Encoding",
+		"User-Agent",
+	}
+
+	// vaultManagedHTTPHeaders are the headers which are additionally set by
+	// the Vault client.
+	vaultManagedHTTPHeaders = []string{
+		"X-Vault-Namespace",
+		"X-Vault-Request",
+		"X-Vault-Token",
+	}
+)
+
+// validateHTTPHeaders validates the custom HTTP headers of an issuer. The
+// values of the headers may be credentials, so they are never included in the
+// returned errors.
+func validateHTTPHeaders(headers map[string]string, managed []string, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	forbidden := sets.New[string]()
+	for _, name := range append(managedHTTPHeaders, managed...) {
+		forbidden.Insert(http.CanonicalHeaderKey(name))
+	}
+
+	seen := sets.New[string]()
+	for _, name := range names {
+		namePath := fldPath.Key(name)
+
+		if errs := validation.IsHTTPHeaderName(name); len(errs) > 0 {
+			el = append(el, field.Invalid(namePath, name, strings.Join(errs, "; ")))
+			continue
+		}
+
+		canonical := http.CanonicalHeaderKey(name)
+		if forbidden.Has(canonical) {
+			el = append(el, field.Forbidden(namePath, fmt.Sprintf("the %s header is managed by cert-manager and may not be set", canonical)))
+		}
+		if seen.Has(canonical) {
+			el = append(el, field.Duplicate(namePath, name))
+		}
+		seen.Insert(canonical)
+
+		if !isHTTPHeaderValue(headers[name]) {
+			el = append(el, field.Invalid(namePath, "<redacted>", "header values may not contain control characters"))
+		}
+	}
+
+	return el
+}
+
+// isHTTPHeaderValue returns true if the value does not contain any control
+// characters other than horizontal tabs, as described in RFC 9110, section 5.5.
+func isHTTPHeaderValue(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if b := value[i]; (b < ' ' && b != '\t') || b == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
 func ValidateSecretKeySelector(sks *cmmeta.SecretKeySelector, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 	if sks.Name == "" {
@@ -19,10 +19,12 @@ package validation
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -169,6 +171,75 @@ func TestValidateVaultIssuerConfig(t *testing.T) {
 				field.Invalid(fldPath.Child("clientCertSecretRef"), "<snip>", "clientCertSecretRef must be provided when defining the clientKeySecretRef"),
 			},
 		},
+		"vault issuer with a client auth only policy with an invalid namespace": {
+			spec: &cmapi.VaultIssuer{
+				Server: "something",
+				Path:   "a/b/c",
+				Auth: cmapi.VaultAuth{
+					TokenSecretRef: &validSecretKeyRef,
+				},
+				ClientAuthOnly: &cmapi.ClientAuthOnlyPolicy{
+					Namespaces: []string{"zero-trust", "Not_Valid"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("clientAuthOnly", "namespaces").Index(1), "Not_Valid", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+			},
+		},
+		"vault issuer with a notAfter boundary": {
+			spec: &cmapi.VaultIssuer{
+				Server: "something",
+				Path:   "a/b/c",
+				Auth: cmapi.VaultAuth{
+					TokenSecretRef: &validSecretKeyRef,
+				},
+				NotAfterBoundary: cmapi.NotAfterBoundaryDay,
+			},
+		},
+		"vault issuer with an unsupported notAfter boundary": {
+			spec: &cmapi.VaultIssuer{
+				Server: "something",
+				Path:   "a/b/c",
+				Auth: cmapi.VaultAuth{
+					TokenSecretRef: &validSecretKeyRef,
+				},
+				NotAfterBoundary: "Week",
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("notAfterBoundary"), cmapi.NotAfterBoundary("Week"), []string{"Hour", "Day"}),
+			},
+		},
+		"vault issuer with valid custom HTTP headers": {
+			spec: &cmapi.VaultIssuer{
+				Server: "something",
+				Path:   "a/b/c",
+				Auth: cmapi.VaultAuth{
+					TokenSecretRef: &validSecretKeyRef,
+				},
+				HTTPHeaders: map[string]string{
+					"X-API-Gateway-Key": "secret",
+				},
+			},
+		},
+		"vault issuer with invalid custom HTTP headers": {
+			spec: &cmapi.VaultIssuer{
+				Server: "something",
+				Path:   "a/b/c",
+				Auth: cmapi.VaultAuth{
+					TokenSecretRef: &validSecretKeyRef,
+				},
+				HTTPHeaders: map[string]string{
+					"Bad Header":        "value",
+					"X-Api-Gateway-Key": "secret\r\nX-Injected: true",
+					"x-vault-token":     "token",
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("httpHeaders").Key("Bad Header"), "Bad Header", "a valid HTTP header must consist of alphanumeric characters or '-' (e.g. 'X-Header-Name', regex used for validation is '[-A-Za-z0-9]+')"),
+				field.Invalid(fldPath.Child("httpHeaders").Key("X-Api-Gateway-Key"), "<redacted>", "header values may not contain control characters"),
+				field.Forbidden(fldPath.Child("httpHeaders").Key("x-vault-token"), "the X-Vault-Token header is managed by cert-manager and may not be set"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -701,6 +772,15 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 func TestValidateIssuerSpec(t *testing.T) {
 	fldPath := (*field.Path)(nil)
 
+	authorityCertificate := unitcrypto.MustCreateCryptoBundle(t,
+		&pubcmapi.Certificate{Spec: pubcmapi.CertificateSpec{CommonName: "external-root", IsCA: true}},
+		clock.RealClock{},
+	).CertBytes
+	leafCertificate := unitcrypto.MustCreateCryptoBundle(t,
+		&pubcmapi.Certificate{Spec: pubcmapi.CertificateSpec{CommonName: "leaf"}},
+		clock.RealClock{},
+	).CertBytes
+
 	scenarios := map[string]struct {
 		spec     *cmapi.IssuerSpec
 		errs     field.ErrorList
@@ -732,6 +812,199 @@ func TestValidateIssuerSpec(t *testing.T) {
 			},
 			errs: []*field.Error{},
 		},
+		"ca issuer with method 2 subject key identifiers": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName:                 "valid",
+						SubjectKeyIdentifierMethod: cmapi.SubjectKeyIdentifierMethodTruncatedSHA1,
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"ca issuer with an unsupported subject key identifier method": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName:                 "valid",
+						SubjectKeyIdentifierMethod: "SHA256",
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("ca", "subjectKeyIdentifierMethod"), cmapi.SubjectKeyIdentifierMethod("SHA256"), []string{"SHA1", "TruncatedSHA1"}),
+			},
+		},
+		"ca issuer with a client auth only policy for all namespaces": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName:     "valid",
+						ClientAuthOnly: &cmapi.ClientAuthOnlyPolicy{},
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"ca issuer with a client auth only policy for a namespace": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "valid",
+						ClientAuthOnly: &cmapi.ClientAuthOnlyPolicy{
+							Namespaces: []string{"zero-trust"},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"ca issuer with a client auth only policy with an invalid namespace": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "valid",
+						ClientAuthOnly: &cmapi.ClientAuthOnlyPolicy{
+							Namespaces: []string{"-invalid"},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ca", "clientAuthOnly", "namespaces").Index(0), "-invalid", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+			},
+		},
+		"ca issuer with a notAfter boundary": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName:       "valid",
+						NotAfterBoundary: cmapi.NotAfterBoundaryHour,
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"ca issuer with an unsupported notAfter boundary": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName:       "valid",
+						NotAfterBoundary: "Minute",
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("ca", "notAfterBoundary"), cmapi.NotAfterBoundary("Minute"), []string{"Hour", "Day"}),
+			},
+		},
+		"ca issuer with a root CA secret": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "valid",
+						RootCASecretRef: &cmmeta.SecretKeySelector{
+							LocalObjectReference: cmmeta.LocalObjectReference{Name: "root-ca"},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"ca issuer with a root CA secret without a name": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "valid",
+						RootCASecretRef: &cmmeta.SecretKeySelector{
+							Key: "root.crt",
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("ca", "rootCASecretRef", "name"), "secret name is required"),
+			},
+		},
+		"self-signed issuer with method 1 subject key identifiers": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{
+						SubjectKeyIdentifierMethod: cmapi.SubjectKeyIdentifierMethodSHA1,
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"self-signed issuer with an unsupported subject key identifier method": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{
+						SubjectKeyIdentifierMethod: "sha1",
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("selfSigned", "subjectKeyIdentifierMethod"), cmapi.SubjectKeyIdentifierMethod("sha1"), []string{"SHA1", "TruncatedSHA1"}),
+			},
+		},
+		"self-signed issuer with a notAfter boundary": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{
+						NotAfterBoundary: cmapi.NotAfterBoundaryDay,
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"self-signed issuer with an unsupported notAfter boundary": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{
+						NotAfterBoundary: "day",
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("selfSigned", "notAfterBoundary"), cmapi.NotAfterBoundary("day"), []string{"Hour", "Day"}),
+			},
+		},
+		"self-signed issuer with an authority certificate": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{
+						AuthorityCertificate: authorityCertificate,
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"self-signed issuer with an authority certificate without a subject key identifier": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{
+						AuthorityCertificate: leafCertificate,
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("selfSigned", "authorityCertificate"), "", `certificate "CN=leaf" does not have a subject key identifier`),
+			},
+		},
+		"self-signed issuer with an invalid authority certificate": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{
+						AuthorityCertificate: []byte("not a certificate"),
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("selfSigned", "authorityCertificate"), "", "error decoding certificate PEM block"),
+			},
+		},
 		"valid acme issuer": {
 			spec: &cmapi.IssuerSpec{
 				IssuerConfig: cmapi.IssuerConfig{
@@ -793,6 +1066,39 @@ func TestValidateIssuerSpec(t *testing.T) {
 				field.Invalid(fldPath.Child("ca", "ocspServer").Index(0), "", `must be a valid URL, e.g., http://ocsp.int-x3.letsencrypt.org`),
 			},
 		},
+		"valid requester duration policies": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "valid",
+						RequesterDurationPolicies: []cmapi.RequesterDurationPolicy{
+							{Groups: []string{"system:serviceaccounts"}, MaxDuration: metav1.Duration{Duration: time.Hour * 24}},
+							{Usernames: []string{"admin"}, MaxDuration: metav1.Duration{Duration: time.Hour * 24 * 90}},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"invalid requester duration policies": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "valid",
+						RequesterDurationPolicies: []cmapi.RequesterDurationPolicy{
+							{MaxDuration: metav1.Duration{Duration: time.Hour}},
+							{Usernames: []string{""}, Groups: []string{""}, MaxDuration: metav1.Duration{Duration: time.Minute}},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("ca", "requesterDurationPolicies").Index(0), "at least one of usernames or groups must be specified"),
+				field.Invalid(fldPath.Child("ca", "requesterDurationPolicies").Index(1).Child("usernames").Index(0), "", "must not be empty"),
+				field.Invalid(fldPath.Child("ca", "requesterDurationPolicies").Index(1).Child("groups").Index(0), "", "must not be empty"),
+				field.Invalid(fldPath.Child("ca", "requesterDurationPolicies").Index(1).Child("maxDuration"), time.Minute, "must be greater than or equal to 1h0m0s"),
+			},
+		},
 		"valid IssuingCertificateURLs": {
 			spec: &cmapi.IssuerSpec{
 				IssuerConfig: cmapi.IssuerConfig{
@@ -817,6 +1123,111 @@ func TestValidateIssuerSpec(t *testing.T) {
 				field.Invalid(fldPath.Child("ca", "issuingCertificateURLs").Index(0), "", `must be a valid URL`),
 			},
 		},
+		"valid default secret template": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				DefaultSecretTemplate: &cmapi.CertificateSecretTemplate{
+					Labels:      map[string]string{"example.com/team": "platform"},
+					Annotations: map[string]string{"example.com/owner": "platform@example.com"},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"invalid default secret template": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				DefaultSecretTemplate: &cmapi.CertificateSecretTemplate{
+					Labels:      map[string]string{"example.com/team": "invalid value"},
+					Annotations: map[string]string{"cert-manager.io/issuer-name": "foo"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("defaultSecretTemplate", "labels"), "invalid value", "a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')"),
+				field.Invalid(fldPath.Child("defaultSecretTemplate", "annotations"), "cert-manager.io/issuer-name", "cert-manager.io/* annotations are not allowed"),
+			},
+		},
+		"valid terminal errors": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				TerminalErrors: []cmapi.TerminalErrorMatcher{
+					{Contains: "policy violation"},
+					{Regex: `^error code (4001|4002):`},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"invalid terminal errors": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				TerminalErrors: []cmapi.TerminalErrorMatcher{
+					{},
+					{Contains: "policy violation", Regex: "policy"},
+					{Regex: "error code ("},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("terminalErrors").Index(0), "one of contains or regex must be specified"),
+				field.Forbidden(fldPath.Child("terminalErrors").Index(1), "only one of contains or regex may be specified"),
+				field.Invalid(fldPath.Child("terminalErrors").Index(2).Child("regex"), "error code (", "error parsing regexp: missing closing ): `error code (`"),
+			},
+		},
+		"valid usage policy": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				UsagePolicy: &cmapi.IssuerUsagePolicy{
+					AllowedUsages:   []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment, cmapi.UsageServerAuth},
+					ForbiddenUsages: []cmapi.KeyUsage{cmapi.UsageCertSign, cmapi.UsageCodeSigning},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"invalid usage policy": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				UsagePolicy: &cmapi.IssuerUsagePolicy{
+					AllowedUsages:   []cmapi.KeyUsage{cmapi.UsageSigning, "unknown", cmapi.UsageDigitalSignature, cmapi.UsageServerAuth},
+					ForbiddenUsages: []cmapi.KeyUsage{cmapi.UsageCertSign, cmapi.UsageCertSign, cmapi.UsageServerAuth},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("usagePolicy", "allowedUsages").Index(1), cmapi.KeyUsage("unknown"), "unknown keyusage"),
+				field.Duplicate(fldPath.Child("usagePolicy", "allowedUsages").Index(2), cmapi.UsageDigitalSignature),
+				field.Duplicate(fldPath.Child("usagePolicy", "forbiddenUsages").Index(1), cmapi.UsageCertSign),
+				field.Invalid(fldPath.Child("usagePolicy", "forbiddenUsages").Index(2), cmapi.UsageServerAuth, "usage may not be both allowed and forbidden"),
+			},
+		},
+		"valid default expired certificate policy": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				DefaultExpiredCertificatePolicy: cmapi.ExpiredCertificatePolicyFallback,
+			},
+			errs: []*field.Error{},
+		},
+		"unsupported default expired certificate policy": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				DefaultExpiredCertificatePolicy: "Delete",
+			},
+			errs: []*field.Error{
+				field.NotSupported(fldPath.Child("defaultExpiredCertificatePolicy"), cmapi.ExpiredCertificatePolicy("Delete"), []string{"Keep", "Fallback"}),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -914,6 +1325,39 @@ func TestValidateACMEIssuerHTTP01Config(t *testing.T) {
 				field.Invalid(fldPath.Child("ingress", "serviceType"), corev1.ServiceType("InvalidServiceType"), `must be empty, "ClusterIP" or "NodePort"`),
 			},
 		},
+		"valid self check timeout and interval": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+				SelfCheck: &cmacme.ACMEChallengeSolverHTTP01SelfCheck{
+					Timeout:  &metav1.Duration{Duration: time.Minute},
+					Interval: &metav1.Duration{Duration: 10 * time.Second},
+				},
+			},
+		},
+		"self check timeout and interval must be positive": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+				SelfCheck: &cmacme.ACMEChallengeSolverHTTP01SelfCheck{
+					Timeout:  &metav1.Duration{Duration: 0},
+					Interval: &metav1.Duration{Duration: -time.Second},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("selfCheck", "timeout"), time.Duration(0), "must be greater than 0s and not greater than the 15m0s HTTP01 self check deadline"),
+				field.Invalid(fldPath.Child("selfCheck", "interval"), -time.Second, "must be greater than 0s and not greater than the 15m0s HTTP01 self check deadline"),
+			},
+		},
+		"self check timeout must not exceed the self check deadline": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+				SelfCheck: &cmacme.ACMEChallengeSolverHTTP01SelfCheck{
+					Timeout: &metav1.Duration{Duration: 20 * time.Minute},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("selfCheck", "timeout"), 20*time.Minute, "must be greater than 0s and not greater than the 15m0s HTTP01 self check deadline"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -932,12 +1376,97 @@ func TestValidateACMEIssuerHTTP01Config(t *testing.T) {
 	}
 }
 
+func TestValidateACMEIssuerChallengeSolverConfig(t *testing.T) {
+	fldPath := field.NewPath("test")
+	dns01 := &cmacme.ACMEChallengeSolverDNS01{
+		CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
+			Project:        "valid",
+			ServiceAccount: &validSecretKeyRef,
+		},
+	}
+	scenarios := map[string]struct {
+		sol  *cmacme.ACMEChallengeSolver
+		errs field.ErrorList
+	}{
+		"valid dns zone cname strategies": {
+			sol: &cmacme.ACMEChallengeSolver{
+				Selector: &cmacme.CertificateDNSNameSelector{
+					DNSZones: []string{"example.com"},
+					DNSZoneCNAMEStrategies: []cmacme.DNSZoneCNAMEStrategy{
+						{DNSZone: "example.com", CNAMEStrategy: cmacme.NoneStrategy},
+						{DNSZone: "delegated.example.com", CNAMEStrategy: cmacme.FollowStrategy},
+					},
+				},
+				DNS01: dns01,
+			},
+			errs: field.ErrorList{},
+		},
+		"invalid dns zone cname strategies": {
+			sol: &cmacme.ACMEChallengeSolver{
+				Selector: &cmacme.CertificateDNSNameSelector{
+					DNSZoneCNAMEStrategies: []cmacme.DNSZoneCNAMEStrategy{
+						{CNAMEStrategy: cmacme.FollowStrategy},
+						{DNSZone: "example.com", CNAMEStrategy: "FollowCNAME"},
+						{DNSZone: "Example.com.", CNAMEStrategy: cmacme.NoneStrategy},
+					},
+				},
+				DNS01: dns01,
+			},
+			errs: field.ErrorList{
+				field.Required(fldPath.Child("selector", "dnsZoneCNAMEStrategies").Index(0).Child("dnsZone"), ""),
+				field.Invalid(fldPath.Child("selector", "dnsZoneCNAMEStrategies").Index(1).Child("cnameStrategy"), cmacme.CNAMEStrategy("FollowCNAME"), `must be one of "None" or "Follow"`),
+				field.Duplicate(fldPath.Child("selector", "dnsZoneCNAMEStrategies").Index(2).Child("dnsZone"), "Example.com."),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateACMEIssuerChallengeSolverConfig(s.sol, fldPath)
+			assert.Equal(t, s.errs, errs)
+		})
+	}
+}
+
 func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 	fldPath := field.NewPath("test")
 	scenarios := map[string]struct {
 		cfg  *cmacme.ACMEChallengeSolverDNS01
 		errs []*field.Error
 	}{
+		"valid cleanup delay": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CleanupDelay: &metav1.Duration{Duration: 5 * time.Minute},
+				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
+					Project:        "valid",
+					ServiceAccount: &validSecretKeyRef,
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"negative cleanup delay": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CleanupDelay: &metav1.Duration{Duration: -time.Minute},
+				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
+					Project:        "valid",
+					ServiceAccount: &validSecretKeyRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("cleanupDelay"), -time.Minute, "must be between 0s and 1h0m0s"),
+			},
+		},
+		"cleanup delay too long": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CleanupDelay: &metav1.Duration{Duration: 2 * time.Hour},
+				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
+					Project:        "valid",
+					ServiceAccount: &validSecretKeyRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("cleanupDelay"), 2*time.Hour, "must be between 0s and 1h0m0s"),
+			},
+		},
 		"missing clouddns project": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
 				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
@@ -1085,6 +1614,118 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 				field.Required(fldPath.Child("route53", "accessKeyIDSecretRef", "key"), "secret key is required"),
 			},
 		},
+		"valid route53 federatedCredentials": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{
+					Role: "arn:aws:iam::123456789012:role/cert-manager",
+					FederatedCredentials: &cmacme.ACMEIssuerDNS01FederatedCredentials{
+						ServiceAccountRef: cmacme.ServiceAccountRef{Name: "dns01"},
+					},
+				},
+			},
+		},
+		"invalid route53 federatedCredentials without role, with access key and audience": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{
+					AccessKeyID: "some-access-key-id",
+					FederatedCredentials: &cmacme.ACMEIssuerDNS01FederatedCredentials{
+						ServiceAccountRef: cmacme.ServiceAccountRef{Name: "dns01"},
+						Audience:          "sts.amazonaws.com",
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("route53", "federatedCredentials", "audience"), "audience is not supported by this provider"),
+				field.Required(fldPath.Child("route53", "role"), "role is required when using federatedCredentials"),
+				field.Forbidden(fldPath.Child("route53", "federatedCredentials"), "access keys cannot be used at the same time as federatedCredentials"),
+			},
+		},
+		"invalid route53 federatedCredentials with auth and without a service account name": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{
+					Role: "arn:aws:iam::123456789012:role/cert-manager",
+					Auth: &cmacme.Route53Auth{
+						Kubernetes: &cmacme.Route53KubernetesAuth{
+							ServiceAccountRef: &cmacme.ServiceAccountRef{Name: "cert-manager"},
+						},
+					},
+					FederatedCredentials: &cmacme.ACMEIssuerDNS01FederatedCredentials{
+						ServiceAccountRef: cmacme.ServiceAccountRef{TokenAudiences: []string{"sts.amazonaws.com"}},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("route53", "federatedCredentials", "serviceAccountRef", "name"), ""),
+				field.Forbidden(fldPath.Child("route53", "auth"), "auth cannot be used at the same time as federatedCredentials"),
+			},
+		},
+		"valid azuredns federatedCredentials": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{
+					ClientID:          "some-client-id",
+					TenantID:          "some-tenant-id",
+					SubscriptionID:    "some-subscription-id",
+					ResourceGroupName: "some-resource-group",
+					FederatedCredentials: &cmacme.ACMEIssuerDNS01FederatedCredentials{
+						ServiceAccountRef: cmacme.ServiceAccountRef{Name: "dns01"},
+					},
+				},
+			},
+		},
+		"invalid azuredns federatedCredentials without serviceAccountRef, tenantID and with clientSecret and managedIdentity": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{
+					ClientID: "some-client-id",
+					ClientSecret: &cmmeta.SecretKeySelector{
+						Key: "some-key",
+						LocalObjectReference: cmmeta.LocalObjectReference{
+							Name: "some-secret-name",
+						},
+					},
+					ManagedIdentity:      &cmacme.AzureManagedIdentity{},
+					SubscriptionID:       "some-subscription-id",
+					ResourceGroupName:    "some-resource-group",
+					FederatedCredentials: &cmacme.ACMEIssuerDNS01FederatedCredentials{},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("azureDNS", "federatedCredentials", "serviceAccountRef", "name"), ""),
+				field.Required(fldPath.Child("azureDNS", "tenantID"), "tenantID is required when using federatedCredentials"),
+				field.Forbidden(fldPath.Child("azureDNS", "clientSecretSecretRef"), "clientSecretSecretRef cannot be used at the same time as federatedCredentials"),
+				field.Forbidden(fldPath.Child("azureDNS", "managedIdentity"), "managed identity cannot be used at the same time as federatedCredentials"),
+			},
+		},
+		"valid clouddns federatedCredentials": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
+					Project: "some-project",
+					FederatedCredentials: &cmacme.ACMEIssuerDNS01FederatedCredentials{
+						ServiceAccountRef: cmacme.ServiceAccountRef{Name: "dns01"},
+						Audience:          "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool/providers/provider",
+					},
+				},
+			},
+		},
+		"invalid clouddns federatedCredentials without audience and with serviceAccountSecretRef": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
+					Project: "some-project",
+					ServiceAccount: &cmmeta.SecretKeySelector{
+						Key: "some-key",
+						LocalObjectReference: cmmeta.LocalObjectReference{
+							Name: "some-secret-name",
+						},
+					},
+					FederatedCredentials: &cmacme.ACMEIssuerDNS01FederatedCredentials{
+						ServiceAccountRef: cmacme.ServiceAccountRef{Name: "dns01"},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("cloudDNS", "federatedCredentials", "audience"), ""),
+				field.Forbidden(fldPath.Child("cloudDNS", "serviceAccountSecretRef"), "serviceAccountSecretRef cannot be used at the same time as federatedCredentials"),
+			},
+		},
 		"missing provider config": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{},
 			errs: []*field.Error{
@@ -1653,6 +2294,23 @@ func TestValidateVenafiIssuerConfig(t *testing.T) {
 				field.Forbidden(fldPath, "please supply one of: tpp, cloud"),
 			},
 		},
+		"custom HTTP headers": {
+			cfg: &cmapi.VenafiIssuer{
+				Zone: "a\\b\\c",
+				TPP: &cmapi.VenafiTPP{
+					URL: "https://tpp.example.com/vedsdk",
+				},
+				HTTPHeaders: map[string]string{
+					"X-API-Gateway-Key": "secret",
+					"x-api-gateway-key": "secret",
+					"User-Agent":        "custom",
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("httpHeaders").Key("User-Agent"), "the User-Agent header is managed by cert-manager and may not be set"),
+				field.Duplicate(fldPath.Child("httpHeaders").Key("x-api-gateway-key"), "x-api-gateway-key"),
+			},
+		},
 	}
 
 	for n, s := range scenarios {
@@ -1671,6 +2329,104 @@ func TestValidateVenafiIssuerConfig(t *testing.T) {
 	}
 }
 
+func TestValidateGoogleCASIssuerConfig(t *testing.T) {
+	fldPath := field.NewPath("test")
+	scenarios := map[string]struct {
+		cfg  *cmapi.GoogleCASIssuer
+		errs []*field.Error
+	}{
+		"valid with ambient credentials": {
+			cfg: &cmapi.GoogleCASIssuer{
+				Project:  "my-project",
+				Location: "us-central1",
+				CAPool:   "my-pool",
+			},
+		},
+		"valid with a service account key": {
+			cfg: &cmapi.GoogleCASIssuer{
+				Project:                 "my-project",
+				Location:                "us-central1",
+				CAPool:                  "my-pool",
+				ServiceAccountSecretRef: &validSecretKeyRef,
+			},
+		},
+		"valid with workload identity": {
+			cfg: &cmapi.GoogleCASIssuer{
+				Project:  "my-project",
+				Location: "us-central1",
+				CAPool:   "my-pool",
+				WorkloadIdentity: &cmapi.GoogleCASWorkloadIdentity{
+					Audience:           "//iam.googleapis.com/projects/1234/locations/global/workloadIdentityPools/pool/providers/provider",
+					ServiceAccountName: "cas-issuer",
+				},
+			},
+		},
+		"missing project, location and caPool": {
+			cfg: &cmapi.GoogleCASIssuer{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("project"), ""),
+				field.Required(fldPath.Child("location"), ""),
+				field.Required(fldPath.Child("caPool"), ""),
+			},
+		},
+		"service account key without a key": {
+			cfg: &cmapi.GoogleCASIssuer{
+				Project:  "my-project",
+				Location: "us-central1",
+				CAPool:   "my-pool",
+				ServiceAccountSecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "cas-credentials"},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("serviceAccountSecretRef", "key"), "secret key is required"),
+			},
+		},
+		"workload identity missing audience and service account name": {
+			cfg: &cmapi.GoogleCASIssuer{
+				Project:          "my-project",
+				Location:         "us-central1",
+				CAPool:           "my-pool",
+				WorkloadIdentity: &cmapi.GoogleCASWorkloadIdentity{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("workloadIdentity", "audience"), ""),
+				field.Required(fldPath.Child("workloadIdentity", "serviceAccountName"), ""),
+			},
+		},
+		"both a service account key and workload identity": {
+			cfg: &cmapi.GoogleCASIssuer{
+				Project:                 "my-project",
+				Location:                "us-central1",
+				CAPool:                  "my-pool",
+				ServiceAccountSecretRef: &validSecretKeyRef,
+				WorkloadIdentity: &cmapi.GoogleCASWorkloadIdentity{
+					Audience:           "//iam.googleapis.com/projects/1234/locations/global/workloadIdentityPools/pool/providers/provider",
+					ServiceAccountName: "cas-issuer",
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath, "please supply at most one of: serviceAccountSecretRef, workloadIdentity"),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateGoogleCASIssuerConfig(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
 func TestValidateVenafiTPP(t *testing.T) {
 	caBundle := unitcrypto.MustCreateCryptoBundle(t,
 		&pubcmapi.Certificate{Spec: pubcmapi.CertificateSpec{CommonName: "test"}},
@@ -1707,6 +2463,36 @@ func TestValidateVenafiTPP(t *testing.T) {
 				field.Forbidden(fldPath, "may not specify more than one of caBundle/caBundleSecretRef as TPP CA Bundle"),
 			},
 		},
+		"valid approval": {
+			cfg: &cmapi.VenafiTPP{
+				URL: "https://tpp.example.com/vedsdk",
+				Approval: &cmapi.VenafiTPPApproval{
+					WorkToDoField:            "Change Ticket Required",
+					AwaitingApprovalStatuses: []string{"Pending Approval"},
+				},
+			},
+		},
+		"approval missing workToDoField": {
+			cfg: &cmapi.VenafiTPP{
+				URL:      "https://tpp.example.com/vedsdk",
+				Approval: &cmapi.VenafiTPPApproval{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("approval", "workToDoField"), ""),
+			},
+		},
+		"approval with empty awaiting approval status": {
+			cfg: &cmapi.VenafiTPP{
+				URL: "https://tpp.example.com/vedsdk",
+				Approval: &cmapi.VenafiTPPApproval{
+					WorkToDoField:            "Change Ticket Required",
+					AwaitingApprovalStatuses: []string{"Pending Approval", " "},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("approval", "awaitingApprovalStatuses").Index(1), " ", "must not be empty"),
+			},
+		},
 	}
 
 	for n, s := range scenarios {
@@ -1797,3 +2583,50 @@ func TestUpdateValidateIssuer(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateIssuerAlias(t *testing.T) {
+	fldPath := field.NewPath("metadata", "annotations")
+	aliasPath := fldPath.Key(cmapi.IssuerAliasAnnotationKey)
+	scenarios := map[string]struct {
+		name        string
+		annotations map[string]string
+		errs        []*field.Error
+	}{
+		"no alias annotation": {
+			name:        "le-prod-v2",
+			annotations: map[string]string{"foo": "bar"},
+		},
+		"valid alias": {
+			name:        "le-prod-v2",
+			annotations: map[string]string{cmapi.IssuerAliasAnnotationKey: "le-prod"},
+		},
+		"alias equal to the issuer's name": {
+			name:        "le-prod",
+			annotations: map[string]string{cmapi.IssuerAliasAnnotationKey: "le-prod"},
+			errs: []*field.Error{
+				field.Invalid(aliasPath, "le-prod", "alias must not be the same as the issuer's name"),
+			},
+		},
+		"alias that is not a valid resource name": {
+			name:        "le-prod-v2",
+			annotations: map[string]string{cmapi.IssuerAliasAnnotationKey: "LE_Prod"},
+			errs: []*field.Error{
+				field.Invalid(aliasPath, "LE_Prod", "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+			},
+		},
+	}
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateIssuerAlias(s.name, s.annotations, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
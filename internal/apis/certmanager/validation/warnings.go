@@ -21,4 +21,11 @@ package validation
 const (
 	// deprecatedACMEEABKeyAlgorithmField is raised when the deprecated keyAlgorithm field for an ACME issuer's external account binding (EAB) is set.
 	deprecatedACMEEABKeyAlgorithmField = "ACME issuer spec field 'externalAccount.keyAlgorithm' is deprecated. The value of this field will be ignored."
+
+	// deterministicPrivateKeyInUse is raised when a Certificate's private key is derived from a seed Secret.
+	deterministicPrivateKeyInUse = "Certificate spec field 'privateKey.seedSecretRef' is set. The private key can be recreated by anyone able to read the seed Secret and this must never be used in production."
+
+	// frequentRenewalTemplate is raised when a Certificate would be renewed
+	// more often than minimumRenewalInterval.
+	frequentRenewalTemplate = "Certificate would be renewed every %s, which is less than %s. Renewing this often puts a sustained load on the issuer and may exceed its rate limits; increase spec.duration or decrease spec.renewBefore."
 )
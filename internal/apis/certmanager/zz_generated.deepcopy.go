@@ -46,6 +46,23 @@ func (in *CAIssuer) DeepCopyInto(out *CAIssuer) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RequesterDurationPolicies != nil {
+		in, out := &in.RequesterDurationPolicies, &out.RequesterDurationPolicies
+		*out = make([]RequesterDurationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClientAuthOnly != nil {
+		in, out := &in.ClientAuthOnly, &out.ClientAuthOnly
+		*out = new(ClientAuthOnlyPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RootCASecretRef != nil {
+		in, out := &in.RootCASecretRef, &out.RootCASecretRef
+		*out = new(meta.SecretKeySelector)
+		**out = **in
+	}
 	return
 }
 
@@ -103,6 +120,43 @@ func (in *CertificateAdditionalOutputFormat) DeepCopy() *CertificateAdditionalOu
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateAdditionalSecret) DeepCopyInto(out *CertificateAdditionalSecret) {
+	*out = *in
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(meta.SecretKeySelector)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAdditionalSecret.
+func (in *CertificateAdditionalSecret) DeepCopy() *CertificateAdditionalSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateAdditionalSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateChainTruncation) DeepCopyInto(out *CertificateChainTruncation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateChainTruncation.
+func (in *CertificateChainTruncation) DeepCopy() *CertificateChainTruncation {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateChainTruncation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateCondition) DeepCopyInto(out *CertificateCondition) {
 	*out = *in
@@ -149,6 +203,27 @@ func (in *CertificateKeystores) DeepCopy() *CertificateKeystores {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateLegacyExtensions) DeepCopyInto(out *CertificateLegacyExtensions) {
+	*out = *in
+	if in.NetscapeCertType != nil {
+		in, out := &in.NetscapeCertType, &out.NetscapeCertType
+		*out = make([]NetscapeCertType, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateLegacyExtensions.
+func (in *CertificateLegacyExtensions) DeepCopy() *CertificateLegacyExtensions {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateLegacyExtensions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateList) DeepCopyInto(out *CertificateList) {
 	*out = *in
@@ -182,9 +257,82 @@ func (in *CertificateList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateMSTemplate) DeepCopyInto(out *CertificateMSTemplate) {
+	*out = *in
+	if in.MajorVersion != nil {
+		in, out := &in.MajorVersion, &out.MajorVersion
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinorVersion != nil {
+		in, out := &in.MinorVersion, &out.MinorVersion
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateMSTemplate.
+func (in *CertificateMSTemplate) DeepCopy() *CertificateMSTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateMSTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatePolicy) DeepCopyInto(out *CertificatePolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatePolicy.
+func (in *CertificatePolicy) DeepCopy() *CertificatePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatePolicyConstraints) DeepCopyInto(out *CertificatePolicyConstraints) {
+	*out = *in
+	if in.RequireExplicitPolicy != nil {
+		in, out := &in.RequireExplicitPolicy, &out.RequireExplicitPolicy
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InhibitPolicyMapping != nil {
+		in, out := &in.InhibitPolicyMapping, &out.InhibitPolicyMapping
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatePolicyConstraints.
+func (in *CertificatePolicyConstraints) DeepCopy() *CertificatePolicyConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatePolicyConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificatePrivateKey) DeepCopyInto(out *CertificatePrivateKey) {
 	*out = *in
+	if in.SeedSecretRef != nil {
+		in, out := &in.SeedSecretRef, &out.SeedSecretRef
+		*out = new(meta.SecretKeySelector)
+		**out = **in
+	}
 	return
 }
 
@@ -421,6 +569,15 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.IssueAfter != nil {
+		in, out := &in.IssueAfter, &out.IssueAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.IssueAhead != nil {
+		in, out := &in.IssueAhead, &out.IssueAhead
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.DNSNames != nil {
 		in, out := &in.DNSNames, &out.DNSNames
 		*out = make([]string, len(*in))
@@ -457,6 +614,11 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	out.IssuerRef = in.IssuerRef
+	if in.CanaryIssuerRef != nil {
+		in, out := &in.CanaryIssuerRef, &out.CanaryIssuerRef
+		*out = new(meta.ObjectReference)
+		**out = **in
+	}
 	if in.Usages != nil {
 		in, out := &in.Usages, &out.Usages
 		*out = make([]KeyUsage, len(*in))
@@ -465,7 +627,7 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 	if in.PrivateKey != nil {
 		in, out := &in.PrivateKey, &out.PrivateKey
 		*out = new(CertificatePrivateKey)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.EncodeUsagesInRequest != nil {
 		in, out := &in.EncodeUsagesInRequest, &out.EncodeUsagesInRequest
@@ -482,11 +644,53 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 		*out = make([]CertificateAdditionalOutputFormat, len(*in))
 		copy(*out, *in)
 	}
+	if in.AdditionalSecrets != nil {
+		in, out := &in.AdditionalSecrets, &out.AdditionalSecrets
+		*out = make([]CertificateAdditionalSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.NameConstraints != nil {
 		in, out := &in.NameConstraints, &out.NameConstraints
 		*out = new(NameConstraints)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MSTemplate != nil {
+		in, out := &in.MSTemplate, &out.MSTemplate
+		*out = new(CertificateMSTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertificatePolicies != nil {
+		in, out := &in.CertificatePolicies, &out.CertificatePolicies
+		*out = make([]CertificatePolicy, len(*in))
+		copy(*out, *in)
+	}
+	if in.LegacyExtensions != nil {
+		in, out := &in.LegacyExtensions, &out.LegacyExtensions
+		*out = new(CertificateLegacyExtensions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChainTruncation != nil {
+		in, out := &in.ChainTruncation, &out.ChainTruncation
+		*out = new(CertificateChainTruncation)
+		**out = **in
+	}
+	if in.PolicyConstraints != nil {
+		in, out := &in.PolicyConstraints, &out.PolicyConstraints
+		*out = new(CertificatePolicyConstraints)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InhibitAnyPolicy != nil {
+		in, out := &in.InhibitAnyPolicy, &out.InhibitAnyPolicy
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxChainDepth != nil {
+		in, out := &in.MaxChainDepth, &out.MaxChainDepth
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -554,6 +758,27 @@ func (in *CertificateStatus) DeepCopy() *CertificateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientAuthOnlyPolicy) DeepCopyInto(out *ClientAuthOnlyPolicy) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientAuthOnlyPolicy.
+func (in *ClientAuthOnlyPolicy) DeepCopy() *ClientAuthOnlyPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientAuthOnlyPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterIssuer) DeepCopyInto(out *ClusterIssuer) {
 	*out = *in
@@ -615,6 +840,48 @@ func (in *ClusterIssuerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCASIssuer) DeepCopyInto(out *GoogleCASIssuer) {
+	*out = *in
+	if in.ServiceAccountSecretRef != nil {
+		in, out := &in.ServiceAccountSecretRef, &out.ServiceAccountSecretRef
+		*out = new(meta.SecretKeySelector)
+		**out = **in
+	}
+	if in.WorkloadIdentity != nil {
+		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
+		*out = new(GoogleCASWorkloadIdentity)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCASIssuer.
+func (in *GoogleCASIssuer) DeepCopy() *GoogleCASIssuer {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCASIssuer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCASWorkloadIdentity) DeepCopyInto(out *GoogleCASWorkloadIdentity) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCASWorkloadIdentity.
+func (in *GoogleCASWorkloadIdentity) DeepCopy() *GoogleCASWorkloadIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCASWorkloadIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Issuer) DeepCopyInto(out *Issuer) {
 	*out = *in
@@ -691,6 +958,11 @@ func (in *IssuerConfig) DeepCopyInto(out *IssuerConfig) {
 		*out = new(VenafiIssuer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GoogleCAS != nil {
+		in, out := &in.GoogleCAS, &out.GoogleCAS
+		*out = new(GoogleCASIssuer)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -741,6 +1013,21 @@ func (in *IssuerList) DeepCopyObject() runtime.Object {
 func (in *IssuerSpec) DeepCopyInto(out *IssuerSpec) {
 	*out = *in
 	in.IssuerConfig.DeepCopyInto(&out.IssuerConfig)
+	if in.DefaultSecretTemplate != nil {
+		in, out := &in.DefaultSecretTemplate, &out.DefaultSecretTemplate
+		*out = new(CertificateSecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TerminalErrors != nil {
+		in, out := &in.TerminalErrors, &out.TerminalErrors
+		*out = make([]TerminalErrorMatcher, len(*in))
+		copy(*out, *in)
+	}
+	if in.UsagePolicy != nil {
+		in, out := &in.UsagePolicy, &out.UsagePolicy
+		*out = new(IssuerUsagePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -782,6 +1069,32 @@ func (in *IssuerStatus) DeepCopy() *IssuerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssuerUsagePolicy) DeepCopyInto(out *IssuerUsagePolicy) {
+	*out = *in
+	if in.AllowedUsages != nil {
+		in, out := &in.AllowedUsages, &out.AllowedUsages
+		*out = make([]KeyUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForbiddenUsages != nil {
+		in, out := &in.ForbiddenUsages, &out.ForbiddenUsages
+		*out = make([]KeyUsage, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssuerUsagePolicy.
+func (in *IssuerUsagePolicy) DeepCopy() *IssuerUsagePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(IssuerUsagePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JKSKeystore) DeepCopyInto(out *JKSKeystore) {
 	*out = *in
@@ -909,6 +1222,33 @@ func (in *PKCS12Keystore) DeepCopy() *PKCS12Keystore {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequesterDurationPolicy) DeepCopyInto(out *RequesterDurationPolicy) {
+	*out = *in
+	if in.Usernames != nil {
+		in, out := &in.Usernames, &out.Usernames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.MaxDuration = in.MaxDuration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequesterDurationPolicy.
+func (in *RequesterDurationPolicy) DeepCopy() *RequesterDurationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RequesterDurationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SelfSignedIssuer) DeepCopyInto(out *SelfSignedIssuer) {
 	*out = *in
@@ -917,6 +1257,11 @@ func (in *SelfSignedIssuer) DeepCopyInto(out *SelfSignedIssuer) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AuthorityCertificate != nil {
+		in, out := &in.AuthorityCertificate, &out.AuthorityCertificate
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -951,6 +1296,22 @@ func (in *ServiceAccountRef) DeepCopy() *ServiceAccountRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TerminalErrorMatcher) DeepCopyInto(out *TerminalErrorMatcher) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerminalErrorMatcher.
+func (in *TerminalErrorMatcher) DeepCopy() *TerminalErrorMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(TerminalErrorMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultAppRole) DeepCopyInto(out *VaultAppRole) {
 	*out = *in
@@ -1044,6 +1405,18 @@ func (in *VaultIssuer) DeepCopyInto(out *VaultIssuer) {
 		*out = new(meta.SecretKeySelector)
 		**out = **in
 	}
+	if in.ClientAuthOnly != nil {
+		in, out := &in.ClientAuthOnly, &out.ClientAuthOnly
+		*out = new(ClientAuthOnlyPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPHeaders != nil {
+		in, out := &in.HTTPHeaders, &out.HTTPHeaders
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1109,6 +1482,13 @@ func (in *VenafiIssuer) DeepCopyInto(out *VenafiIssuer) {
 		*out = new(VenafiCloud)
 		**out = **in
 	}
+	if in.HTTPHeaders != nil {
+		in, out := &in.HTTPHeaders, &out.HTTPHeaders
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1136,6 +1516,11 @@ func (in *VenafiTPP) DeepCopyInto(out *VenafiTPP) {
 		*out = new(meta.SecretKeySelector)
 		**out = **in
 	}
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(VenafiTPPApproval)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1149,6 +1534,27 @@ func (in *VenafiTPP) DeepCopy() *VenafiTPP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VenafiTPPApproval) DeepCopyInto(out *VenafiTPPApproval) {
+	*out = *in
+	if in.AwaitingApprovalStatuses != nil {
+		in, out := &in.AwaitingApprovalStatuses, &out.AwaitingApprovalStatuses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VenafiTPPApproval.
+func (in *VenafiTPPApproval) DeepCopy() *VenafiTPPApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(VenafiTPPApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *X509Subject) DeepCopyInto(out *X509Subject) {
 	*out = *in
@@ -41,6 +41,22 @@ var Funcs = func(codecs runtimeserializer.CodecFactory) []interface{} {
 				s.Controllers = []string{"test-roundtrip"}
 			}
 
+			if s.SecretTypeMismatchPolicy == "" {
+				s.SecretTypeMismatchPolicy = "test-roundtrip"
+			}
+
+			if s.IssuedCertificateVerificationPolicy == "" {
+				s.IssuedCertificateVerificationPolicy = "test-roundtrip"
+			}
+
+			if s.SecretOwnershipConflictPolicy == "" {
+				s.SecretOwnershipConflictPolicy = "test-roundtrip"
+			}
+
+			if s.SecretDeletionPolicy == "" {
+				s.SecretDeletionPolicy = "test-roundtrip"
+			}
+
 			if len(s.CopiedAnnotationPrefixes) == 0 {
 				s.CopiedAnnotationPrefixes = []string{"test-roundtrip"}
 			}
@@ -111,9 +127,17 @@ var Funcs = func(codecs runtimeserializer.CodecFactory) []interface{} {
 				s.ACMEHTTP01Config.SolverResourceLimitsMemory = "test-roundtrip"
 			}
 
+			if s.ChallengeProcessingTimeout == time.Duration(0) {
+				s.ChallengeProcessingTimeout = time.Second * 8875
+			}
+
 			if s.ACMEDNS01Config.CheckRetryPeriod == time.Duration(0) {
 				s.ACMEDNS01Config.CheckRetryPeriod = time.Second * 8875
 			}
+
+			if s.ACMEDNS01Config.PreValidationTimeout == time.Duration(0) {
+				s.ACMEDNS01Config.PreValidationTimeout = time.Second * 8875
+			}
 		},
 	}
 }
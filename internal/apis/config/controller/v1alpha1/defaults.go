@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	logsapi "k8s.io/component-base/logs/api/v1"
 
+	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
 	cm "github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	"github.com/cert-manager/cert-manager/pkg/apis/config/controller/v1alpha1"
 	sharedv1alpha1 "github.com/cert-manager/cert-manager/pkg/apis/config/shared/v1alpha1"
@@ -33,15 +34,19 @@ import (
 	cracmecontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/acme"
 	crapprovercontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/approver"
 	crcacontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/ca"
+	crgooglecascontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/googlecas"
 	crselfsignedcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/selfsigned"
 	crvaultcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/vault"
 	crvenaficontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/venafi"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/canary"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/keymanager"
 	certificatesmetricscontroller "github.com/cert-manager/cert-manager/pkg/controller/certificates/metrics"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/ocspstaple"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/readiness"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/requestmanager"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/revisionmanager"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/secretdeletion"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/trigger"
 	csracmecontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/acme"
 	csrcacontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/ca"
@@ -68,18 +73,46 @@ var (
 	defaultClusterIssuerAmbientCredentials = true
 	defaultIssuerAmbientCredentials        = false
 
-	defaultTLSACMEIssuerName         = ""
-	defaultTLSACMEIssuerKind         = "Issuer"
-	defaultTLSACMEIssuerGroup        = cm.GroupName
-	defaultEnableCertificateOwnerRef = false
-	defaultEnableGatewayAPI          = false
-
-	defaultDNS01RecursiveNameserversOnly = false
-	defaultDNS01RecursiveNameservers     = []string{}
-	defaultDNS01CheckRetryPeriod         = 10 * time.Second
-
-	defaultNumberOfConcurrentWorkers int32 = 5
-	defaultMaxConcurrentChallenges   int32 = 60
+	defaultTLSACMEIssuerName                   = ""
+	defaultTLSACMEIssuerKind                   = "Issuer"
+	defaultTLSACMEIssuerGroup                  = cm.GroupName
+	defaultEnableCertificateOwnerRef           = false
+	defaultSecretTypeMismatchPolicy            = string(config.SecretTypeMismatchPolicyPreserve)
+	defaultIssuedCertificateVerificationPolicy = string(config.IssuedCertificateVerificationPolicyNone)
+	defaultSecretOwnershipConflictPolicy       = string(config.SecretOwnershipConflictPolicyOwner)
+	defaultSecretDeletionPolicy                = string(config.SecretDeletionPolicyRetain)
+	defaultEnableGatewayAPI                    = false
+
+	defaultDNS01RecursiveNameserversOnly       = false
+	defaultDNS01RecursiveNameservers           = []string{}
+	defaultDNS01CheckRetryPeriod               = 10 * time.Second
+	defaultDNS01PreValidationTimeout           = 10 * time.Second
+	defaultDNS01CleanUpParallelism       int32 = 0
+
+	defaultNumberOfConcurrentWorkers     int32   = 5
+	defaultInitialSyncItemsPerSecond     float32 = 0
+	defaultMaxConcurrentIssuances        int32   = 0
+	defaultMaxConcurrentChallenges       int32   = 60
+	defaultNamespaceACMEOrdersPerHour    int32   = 0
+	defaultNamespaceACMEOrderBurst       int32   = 0
+	defaultSecretWritesPerSecond         float32 = 0
+	defaultMaxConcurrentSecretWrites     int32   = 0
+	defaultSecretRecreateConflictRetries int32   = 5
+	defaultRenewalsPaused                        = false
+	defaultOCSPStapleRefreshInterval             = 12 * time.Hour
+
+	defaultDeprecatedCryptoMinimumRSAKeySize        int32 = 2048
+	defaultDeprecatedCryptoMinimumECDSAKeySize      int32 = 256
+	defaultDeprecatedCryptoMinimumSignatureHashSize int32 = 256
+
+	// The Event recorder defaults match those used by client-go.
+	defaultEventRecorderQPS                 float32 = 1. / 300.
+	defaultEventRecorderBurst               int32   = 25
+	defaultEventRecorderDeduplicationWindow         = 10 * time.Minute
+
+	defaultChallengeProcessingTimeout = 10 * time.Minute
+
+	defaultACMEAuthorizationRefreshWindow = time.Duration(0)
 
 	defaultPrometheusMetricsServerAddress = "0.0.0.0:9402"
 
@@ -114,6 +147,7 @@ var (
 		crselfsignedcontroller.CRControllerName,
 		crvaultcontroller.CRControllerName,
 		crvenaficontroller.CRControllerName,
+		crgooglecascontroller.CRControllerName,
 		// certificate controllers
 		trigger.ControllerName,
 		issuing.ControllerName,
@@ -121,6 +155,9 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
+		ocspstaple.ControllerName,
+		secretdeletion.ControllerName,
+		canary.ControllerName,
 	}
 
 	DefaultEnabledControllers = []string{
@@ -136,6 +173,7 @@ var (
 		crselfsignedcontroller.CRControllerName,
 		crvaultcontroller.CRControllerName,
 		crvenaficontroller.CRControllerName,
+		crgooglecascontroller.CRControllerName,
 		// certificate controllers
 		trigger.ControllerName,
 		issuing.ControllerName,
@@ -143,6 +181,9 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
+		ocspstaple.ControllerName,
+		secretdeletion.ControllerName,
+		canary.ControllerName,
 	}
 
 	ExperimentalCertificateSigningRequestControllers = []string{
@@ -208,6 +249,34 @@ func SetDefaults_ControllerConfiguration(obj *v1alpha1.ControllerConfiguration)
 		obj.EnableCertificateOwnerRef = &defaultEnableCertificateOwnerRef
 	}
 
+	if obj.SecretTypeMismatchPolicy == "" {
+		obj.SecretTypeMismatchPolicy = defaultSecretTypeMismatchPolicy
+	}
+
+	if obj.IssuedCertificateVerificationPolicy == "" {
+		obj.IssuedCertificateVerificationPolicy = defaultIssuedCertificateVerificationPolicy
+	}
+
+	if obj.SecretOwnershipConflictPolicy == "" {
+		obj.SecretOwnershipConflictPolicy = defaultSecretOwnershipConflictPolicy
+	}
+
+	if obj.SecretDeletionPolicy == "" {
+		obj.SecretDeletionPolicy = defaultSecretDeletionPolicy
+	}
+
+	if obj.DeprecatedCryptoMinimumRSAKeySize == nil {
+		obj.DeprecatedCryptoMinimumRSAKeySize = &defaultDeprecatedCryptoMinimumRSAKeySize
+	}
+
+	if obj.DeprecatedCryptoMinimumECDSAKeySize == nil {
+		obj.DeprecatedCryptoMinimumECDSAKeySize = &defaultDeprecatedCryptoMinimumECDSAKeySize
+	}
+
+	if obj.DeprecatedCryptoMinimumSignatureHashSize == nil {
+		obj.DeprecatedCryptoMinimumSignatureHashSize = &defaultDeprecatedCryptoMinimumSignatureHashSize
+	}
+
 	if obj.EnableGatewayAPI == nil {
 		obj.EnableGatewayAPI = &defaultEnableGatewayAPI
 	}
@@ -220,10 +289,66 @@ func SetDefaults_ControllerConfiguration(obj *v1alpha1.ControllerConfiguration)
 		obj.NumberOfConcurrentWorkers = &defaultNumberOfConcurrentWorkers
 	}
 
+	if obj.InitialSyncItemsPerSecond == nil {
+		obj.InitialSyncItemsPerSecond = &defaultInitialSyncItemsPerSecond
+	}
+
+	if obj.MaxConcurrentIssuances == nil {
+		obj.MaxConcurrentIssuances = &defaultMaxConcurrentIssuances
+	}
+
 	if obj.MaxConcurrentChallenges == nil {
 		obj.MaxConcurrentChallenges = &defaultMaxConcurrentChallenges
 	}
 
+	if obj.ChallengeProcessingTimeout.IsZero() {
+		obj.ChallengeProcessingTimeout = sharedv1alpha1.DurationFromTime(defaultChallengeProcessingTimeout)
+	}
+
+	if obj.NamespaceACMEOrdersPerHour == nil {
+		obj.NamespaceACMEOrdersPerHour = &defaultNamespaceACMEOrdersPerHour
+	}
+
+	if obj.NamespaceACMEOrderBurst == nil {
+		obj.NamespaceACMEOrderBurst = &defaultNamespaceACMEOrderBurst
+	}
+
+	if obj.ACMEAuthorizationRefreshWindow == nil {
+		obj.ACMEAuthorizationRefreshWindow = sharedv1alpha1.DurationFromTime(defaultACMEAuthorizationRefreshWindow)
+	}
+
+	if obj.SecretWritesPerSecond == nil {
+		obj.SecretWritesPerSecond = &defaultSecretWritesPerSecond
+	}
+
+	if obj.MaxConcurrentSecretWrites == nil {
+		obj.MaxConcurrentSecretWrites = &defaultMaxConcurrentSecretWrites
+	}
+
+	if obj.SecretRecreateConflictRetries == nil {
+		obj.SecretRecreateConflictRetries = &defaultSecretRecreateConflictRetries
+	}
+
+	if obj.RenewalsPaused == nil {
+		obj.RenewalsPaused = &defaultRenewalsPaused
+	}
+
+	if obj.OCSPStapleRefreshInterval == nil {
+		obj.OCSPStapleRefreshInterval = sharedv1alpha1.DurationFromTime(defaultOCSPStapleRefreshInterval)
+	}
+
+	if obj.EventRecorderQPS == nil {
+		obj.EventRecorderQPS = &defaultEventRecorderQPS
+	}
+
+	if obj.EventRecorderBurst == nil {
+		obj.EventRecorderBurst = &defaultEventRecorderBurst
+	}
+
+	if obj.EventRecorderDeduplicationWindow == nil {
+		obj.EventRecorderDeduplicationWindow = sharedv1alpha1.DurationFromTime(defaultEventRecorderDeduplicationWindow)
+	}
+
 	if obj.MetricsListenAddress == "" {
 		obj.MetricsListenAddress = defaultPrometheusMetricsServerAddress
 	}
@@ -310,4 +435,12 @@ func SetDefaults_ACMEDNS01Config(obj *v1alpha1.ACMEDNS01Config) {
 	if obj.CheckRetryPeriod.IsZero() {
 		obj.CheckRetryPeriod = sharedv1alpha1.DurationFromTime(defaultDNS01CheckRetryPeriod)
 	}
+
+	if obj.PreValidationTimeout.IsZero() {
+		obj.PreValidationTimeout = sharedv1alpha1.DurationFromTime(defaultDNS01PreValidationTimeout)
+	}
+
+	if obj.CleanUpParallelism == nil {
+		obj.CleanUpParallelism = &defaultDNS01CleanUpParallelism
+	}
 }
@@ -59,6 +59,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.CertificateRequestApprovalRule)(nil), (*controller.CertificateRequestApprovalRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CertificateRequestApprovalRule_To_controller_CertificateRequestApprovalRule(a.(*v1alpha1.CertificateRequestApprovalRule), b.(*controller.CertificateRequestApprovalRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controller.CertificateRequestApprovalRule)(nil), (*v1alpha1.CertificateRequestApprovalRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controller_CertificateRequestApprovalRule_To_v1alpha1_CertificateRequestApprovalRule(a.(*controller.CertificateRequestApprovalRule), b.(*v1alpha1.CertificateRequestApprovalRule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.ControllerConfiguration)(nil), (*controller.ControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerConfiguration_To_controller_ControllerConfiguration(a.(*v1alpha1.ControllerConfiguration), b.(*controller.ControllerConfiguration), scope)
 	}); err != nil {
@@ -69,6 +79,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1alpha1.DefaultIssuerRule)(nil), (*controller.DefaultIssuerRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DefaultIssuerRule_To_controller_DefaultIssuerRule(a.(*v1alpha1.DefaultIssuerRule), b.(*controller.DefaultIssuerRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controller.DefaultIssuerRule)(nil), (*v1alpha1.DefaultIssuerRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controller_DefaultIssuerRule_To_v1alpha1_DefaultIssuerRule(a.(*controller.DefaultIssuerRule), b.(*v1alpha1.DefaultIssuerRule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1alpha1.IngressShimConfig)(nil), (*controller.IngressShimConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_IngressShimConfig_To_controller_IngressShimConfig(a.(*v1alpha1.IngressShimConfig), b.(*controller.IngressShimConfig), scope)
 	}); err != nil {
@@ -100,6 +120,14 @@ func autoConvert_v1alpha1_ACMEDNS01Config_To_controller_ACMEDNS01Config(in *v1al
 	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.CheckRetryPeriod, &out.CheckRetryPeriod, s); err != nil {
 		return err
 	}
+	out.PreValidationURL = in.PreValidationURL
+	out.PreValidationCAFile = in.PreValidationCAFile
+	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.PreValidationTimeout, &out.PreValidationTimeout, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.CleanUpParallelism, &out.CleanUpParallelism, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -116,6 +144,14 @@ func autoConvert_controller_ACMEDNS01Config_To_v1alpha1_ACMEDNS01Config(in *cont
 	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.CheckRetryPeriod, &out.CheckRetryPeriod, s); err != nil {
 		return err
 	}
+	out.PreValidationURL = in.PreValidationURL
+	out.PreValidationCAFile = in.PreValidationCAFile
+	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.PreValidationTimeout, &out.PreValidationTimeout, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.CleanUpParallelism, &out.CleanUpParallelism, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -160,6 +196,32 @@ func Convert_controller_ACMEHTTP01Config_To_v1alpha1_ACMEHTTP01Config(in *contro
 	return autoConvert_controller_ACMEHTTP01Config_To_v1alpha1_ACMEHTTP01Config(in, out, s)
 }
 
+func autoConvert_v1alpha1_CertificateRequestApprovalRule_To_controller_CertificateRequestApprovalRule(in *v1alpha1.CertificateRequestApprovalRule, out *controller.CertificateRequestApprovalRule, s conversion.Scope) error {
+	out.IssuerName = in.IssuerName
+	out.IssuerKind = in.IssuerKind
+	out.IssuerGroup = in.IssuerGroup
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_v1alpha1_CertificateRequestApprovalRule_To_controller_CertificateRequestApprovalRule is an autogenerated conversion function.
+func Convert_v1alpha1_CertificateRequestApprovalRule_To_controller_CertificateRequestApprovalRule(in *v1alpha1.CertificateRequestApprovalRule, out *controller.CertificateRequestApprovalRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CertificateRequestApprovalRule_To_controller_CertificateRequestApprovalRule(in, out, s)
+}
+
+func autoConvert_controller_CertificateRequestApprovalRule_To_v1alpha1_CertificateRequestApprovalRule(in *controller.CertificateRequestApprovalRule, out *v1alpha1.CertificateRequestApprovalRule, s conversion.Scope) error {
+	out.IssuerName = in.IssuerName
+	out.IssuerKind = in.IssuerKind
+	out.IssuerGroup = in.IssuerGroup
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	return nil
+}
+
+// Convert_controller_CertificateRequestApprovalRule_To_v1alpha1_CertificateRequestApprovalRule is an autogenerated conversion function.
+func Convert_controller_CertificateRequestApprovalRule_To_v1alpha1_CertificateRequestApprovalRule(in *controller.CertificateRequestApprovalRule, out *v1alpha1.CertificateRequestApprovalRule, s conversion.Scope) error {
+	return autoConvert_controller_CertificateRequestApprovalRule_To_v1alpha1_CertificateRequestApprovalRule(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerConfiguration_To_controller_ControllerConfiguration(in *v1alpha1.ControllerConfiguration, out *controller.ControllerConfiguration, s conversion.Scope) error {
 	out.KubeConfig = in.KubeConfig
 	out.APIServerHost = in.APIServerHost
@@ -184,6 +246,19 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_controller_ControllerConfig
 	if err := v1.Convert_Pointer_bool_To_bool(&in.EnableCertificateOwnerRef, &out.EnableCertificateOwnerRef, s); err != nil {
 		return err
 	}
+	out.SecretTypeMismatchPolicy = controller.SecretTypeMismatchPolicy(in.SecretTypeMismatchPolicy)
+	out.IssuedCertificateVerificationPolicy = controller.IssuedCertificateVerificationPolicy(in.IssuedCertificateVerificationPolicy)
+	out.SecretOwnershipConflictPolicy = controller.SecretOwnershipConflictPolicy(in.SecretOwnershipConflictPolicy)
+	out.SecretDeletionPolicy = controller.SecretDeletionPolicy(in.SecretDeletionPolicy)
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.DeprecatedCryptoMinimumRSAKeySize, &out.DeprecatedCryptoMinimumRSAKeySize, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.DeprecatedCryptoMinimumECDSAKeySize, &out.DeprecatedCryptoMinimumECDSAKeySize, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.DeprecatedCryptoMinimumSignatureHashSize, &out.DeprecatedCryptoMinimumSignatureHashSize, s); err != nil {
+		return err
+	}
 	if err := v1.Convert_Pointer_bool_To_bool(&in.EnableGatewayAPI, &out.EnableGatewayAPI, s); err != nil {
 		return err
 	}
@@ -191,9 +266,52 @@ func autoConvert_v1alpha1_ControllerConfiguration_To_controller_ControllerConfig
 	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.NumberOfConcurrentWorkers, &out.NumberOfConcurrentWorkers, s); err != nil {
 		return err
 	}
+	if err := sharedv1alpha1.Convert_Pointer_float32_To_float32(&in.InitialSyncItemsPerSecond, &out.InitialSyncItemsPerSecond, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.MaxConcurrentIssuances, &out.MaxConcurrentIssuances, s); err != nil {
+		return err
+	}
 	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.MaxConcurrentChallenges, &out.MaxConcurrentChallenges, s); err != nil {
 		return err
 	}
+	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.ChallengeProcessingTimeout, &out.ChallengeProcessingTimeout, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.NamespaceACMEOrdersPerHour, &out.NamespaceACMEOrdersPerHour, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.NamespaceACMEOrderBurst, &out.NamespaceACMEOrderBurst, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.ACMEAuthorizationRefreshWindow, &out.ACMEAuthorizationRefreshWindow, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_float32_To_float32(&in.SecretWritesPerSecond, &out.SecretWritesPerSecond, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.MaxConcurrentSecretWrites, &out.MaxConcurrentSecretWrites, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.SecretRecreateConflictRetries, &out.SecretRecreateConflictRetries, s); err != nil {
+		return err
+	}
+	if err := v1.Convert_Pointer_bool_To_bool(&in.RenewalsPaused, &out.RenewalsPaused, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.OCSPStapleRefreshInterval, &out.OCSPStapleRefreshInterval, s); err != nil {
+		return err
+	}
+	out.CertificateRequestApprovalRules = *(*[]controller.CertificateRequestApprovalRule)(unsafe.Pointer(&in.CertificateRequestApprovalRules))
+	if err := sharedv1alpha1.Convert_Pointer_float32_To_float32(&in.EventRecorderQPS, &out.EventRecorderQPS, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_int32_To_int(&in.EventRecorderBurst, &out.EventRecorderBurst, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_Pointer_v1alpha1_Duration_To_time_Duration(&in.EventRecorderDeduplicationWindow, &out.EventRecorderDeduplicationWindow, s); err != nil {
+		return err
+	}
 	out.MetricsListenAddress = in.MetricsListenAddress
 	if err := sharedv1alpha1.Convert_v1alpha1_TLSConfig_To_shared_TLSConfig(&in.MetricsTLSConfig, &out.MetricsTLSConfig, s); err != nil {
 		return err
@@ -246,6 +364,19 @@ func autoConvert_controller_ControllerConfiguration_To_v1alpha1_ControllerConfig
 	if err := v1.Convert_bool_To_Pointer_bool(&in.EnableCertificateOwnerRef, &out.EnableCertificateOwnerRef, s); err != nil {
 		return err
 	}
+	out.SecretTypeMismatchPolicy = string(in.SecretTypeMismatchPolicy)
+	out.IssuedCertificateVerificationPolicy = string(in.IssuedCertificateVerificationPolicy)
+	out.SecretOwnershipConflictPolicy = string(in.SecretOwnershipConflictPolicy)
+	out.SecretDeletionPolicy = string(in.SecretDeletionPolicy)
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.DeprecatedCryptoMinimumRSAKeySize, &out.DeprecatedCryptoMinimumRSAKeySize, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.DeprecatedCryptoMinimumECDSAKeySize, &out.DeprecatedCryptoMinimumECDSAKeySize, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.DeprecatedCryptoMinimumSignatureHashSize, &out.DeprecatedCryptoMinimumSignatureHashSize, s); err != nil {
+		return err
+	}
 	if err := v1.Convert_bool_To_Pointer_bool(&in.EnableGatewayAPI, &out.EnableGatewayAPI, s); err != nil {
 		return err
 	}
@@ -253,9 +384,52 @@ func autoConvert_controller_ControllerConfiguration_To_v1alpha1_ControllerConfig
 	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.NumberOfConcurrentWorkers, &out.NumberOfConcurrentWorkers, s); err != nil {
 		return err
 	}
+	if err := sharedv1alpha1.Convert_float32_To_Pointer_float32(&in.InitialSyncItemsPerSecond, &out.InitialSyncItemsPerSecond, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.MaxConcurrentIssuances, &out.MaxConcurrentIssuances, s); err != nil {
+		return err
+	}
 	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.MaxConcurrentChallenges, &out.MaxConcurrentChallenges, s); err != nil {
 		return err
 	}
+	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.ChallengeProcessingTimeout, &out.ChallengeProcessingTimeout, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.NamespaceACMEOrdersPerHour, &out.NamespaceACMEOrdersPerHour, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.NamespaceACMEOrderBurst, &out.NamespaceACMEOrderBurst, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.ACMEAuthorizationRefreshWindow, &out.ACMEAuthorizationRefreshWindow, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_float32_To_Pointer_float32(&in.SecretWritesPerSecond, &out.SecretWritesPerSecond, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.MaxConcurrentSecretWrites, &out.MaxConcurrentSecretWrites, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.SecretRecreateConflictRetries, &out.SecretRecreateConflictRetries, s); err != nil {
+		return err
+	}
+	if err := v1.Convert_bool_To_Pointer_bool(&in.RenewalsPaused, &out.RenewalsPaused, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.OCSPStapleRefreshInterval, &out.OCSPStapleRefreshInterval, s); err != nil {
+		return err
+	}
+	out.CertificateRequestApprovalRules = *(*[]v1alpha1.CertificateRequestApprovalRule)(unsafe.Pointer(&in.CertificateRequestApprovalRules))
+	if err := sharedv1alpha1.Convert_float32_To_Pointer_float32(&in.EventRecorderQPS, &out.EventRecorderQPS, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_int_To_Pointer_int32(&in.EventRecorderBurst, &out.EventRecorderBurst, s); err != nil {
+		return err
+	}
+	if err := sharedv1alpha1.Convert_time_Duration_To_Pointer_v1alpha1_Duration(&in.EventRecorderDeduplicationWindow, &out.EventRecorderDeduplicationWindow, s); err != nil {
+		return err
+	}
 	out.MetricsListenAddress = in.MetricsListenAddress
 	if err := sharedv1alpha1.Convert_shared_TLSConfig_To_v1alpha1_TLSConfig(&in.MetricsTLSConfig, &out.MetricsTLSConfig, s); err != nil {
 		return err
@@ -284,11 +458,42 @@ func Convert_controller_ControllerConfiguration_To_v1alpha1_ControllerConfigurat
 	return autoConvert_controller_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_DefaultIssuerRule_To_controller_DefaultIssuerRule(in *v1alpha1.DefaultIssuerRule, out *controller.DefaultIssuerRule, s conversion.Scope) error {
+	out.DNSNamePattern = in.DNSNamePattern
+	out.IssuerName = in.IssuerName
+	out.IssuerKind = in.IssuerKind
+	out.IssuerGroup = in.IssuerGroup
+	return nil
+}
+
+// Convert_v1alpha1_DefaultIssuerRule_To_controller_DefaultIssuerRule is an autogenerated conversion function.
+func Convert_v1alpha1_DefaultIssuerRule_To_controller_DefaultIssuerRule(in *v1alpha1.DefaultIssuerRule, out *controller.DefaultIssuerRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DefaultIssuerRule_To_controller_DefaultIssuerRule(in, out, s)
+}
+
+func autoConvert_controller_DefaultIssuerRule_To_v1alpha1_DefaultIssuerRule(in *controller.DefaultIssuerRule, out *v1alpha1.DefaultIssuerRule, s conversion.Scope) error {
+	out.DNSNamePattern = in.DNSNamePattern
+	out.IssuerName = in.IssuerName
+	out.IssuerKind = in.IssuerKind
+	out.IssuerGroup = in.IssuerGroup
+	return nil
+}
+
+// Convert_controller_DefaultIssuerRule_To_v1alpha1_DefaultIssuerRule is an autogenerated conversion function.
+func Convert_controller_DefaultIssuerRule_To_v1alpha1_DefaultIssuerRule(in *controller.DefaultIssuerRule, out *v1alpha1.DefaultIssuerRule, s conversion.Scope) error {
+	return autoConvert_controller_DefaultIssuerRule_To_v1alpha1_DefaultIssuerRule(in, out, s)
+}
+
 func autoConvert_v1alpha1_IngressShimConfig_To_controller_IngressShimConfig(in *v1alpha1.IngressShimConfig, out *controller.IngressShimConfig, s conversion.Scope) error {
 	out.DefaultIssuerName = in.DefaultIssuerName
 	out.DefaultIssuerKind = in.DefaultIssuerKind
 	out.DefaultIssuerGroup = in.DefaultIssuerGroup
 	out.DefaultAutoCertificateAnnotations = *(*[]string)(unsafe.Pointer(&in.DefaultAutoCertificateAnnotations))
+	out.DefaultIssuerRules = *(*[]controller.DefaultIssuerRule)(unsafe.Pointer(&in.DefaultIssuerRules))
+	out.AllowedIngressClasses = *(*[]string)(unsafe.Pointer(&in.AllowedIngressClasses))
+	out.DeniedIngressClasses = *(*[]string)(unsafe.Pointer(&in.DeniedIngressClasses))
+	out.AllowedGatewayClasses = *(*[]string)(unsafe.Pointer(&in.AllowedGatewayClasses))
+	out.DeniedGatewayClasses = *(*[]string)(unsafe.Pointer(&in.DeniedGatewayClasses))
 	return nil
 }
 
@@ -302,6 +507,11 @@ func autoConvert_controller_IngressShimConfig_To_v1alpha1_IngressShimConfig(in *
 	out.DefaultIssuerKind = in.DefaultIssuerKind
 	out.DefaultIssuerGroup = in.DefaultIssuerGroup
 	out.DefaultAutoCertificateAnnotations = *(*[]string)(unsafe.Pointer(&in.DefaultAutoCertificateAnnotations))
+	out.DefaultIssuerRules = *(*[]v1alpha1.DefaultIssuerRule)(unsafe.Pointer(&in.DefaultIssuerRules))
+	out.AllowedIngressClasses = *(*[]string)(unsafe.Pointer(&in.AllowedIngressClasses))
+	out.DeniedIngressClasses = *(*[]string)(unsafe.Pointer(&in.DeniedIngressClasses))
+	out.AllowedGatewayClasses = *(*[]string)(unsafe.Pointer(&in.AllowedGatewayClasses))
+	out.DeniedGatewayClasses = *(*[]string)(unsafe.Pointer(&in.DeniedGatewayClasses))
 	return nil
 }
 
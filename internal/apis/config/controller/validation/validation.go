@@ -20,16 +20,24 @@ import (
 	"net"
 	"net/url"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	logsapi "k8s.io/component-base/logs/api/v1"
 
 	config "github.com/cert-manager/cert-manager/internal/apis/config/controller"
 	defaults "github.com/cert-manager/cert-manager/internal/apis/config/controller/v1alpha1"
 	sharedvalidation "github.com/cert-manager/cert-manager/internal/apis/config/shared/validation"
+	"github.com/cert-manager/cert-manager/internal/dnsglob"
 )
 
+// maxDeprecatedCryptoRSAKeySize is the largest RSA key size which may be
+// configured as the threshold for deprecated RSA keys, matching the largest
+// RSA key size which can be requested by a Certificate.
+const maxDeprecatedCryptoRSAKeySize = 8192
+
 func ValidateControllerConfiguration(cfg *config.ControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	var allErrors field.ErrorList
 
@@ -45,6 +53,12 @@ func ValidateControllerConfiguration(cfg *config.ControllerConfiguration, fldPat
 		allErrors = append(allErrors, field.Required(fldPath.Child("ingressShimConfig").Child("defaultIssuerKind"), "must not be empty"))
 	}
 
+	allErrors = append(allErrors, validateDefaultIssuerRules(cfg.IngressShimConfig.DefaultIssuerRules, fldPath.Child("ingressShimConfig").Child("defaultIssuerRules"))...)
+	allErrors = append(allErrors, validateShimClasses(cfg.IngressShimConfig.AllowedIngressClasses, cfg.IngressShimConfig.DeniedIngressClasses,
+		fldPath.Child("ingressShimConfig").Child("allowedIngressClasses"), fldPath.Child("ingressShimConfig").Child("deniedIngressClasses"))...)
+	allErrors = append(allErrors, validateShimClasses(cfg.IngressShimConfig.AllowedGatewayClasses, cfg.IngressShimConfig.DeniedGatewayClasses,
+		fldPath.Child("ingressShimConfig").Child("allowedGatewayClasses"), fldPath.Child("ingressShimConfig").Child("deniedGatewayClasses"))...)
+
 	if cfg.KubernetesAPIBurst <= 0 {
 		allErrors = append(allErrors, field.Invalid(fldPath.Child("kubernetesAPIBurst"), cfg.KubernetesAPIBurst, "must be greater than 0"))
 	}
@@ -57,6 +71,115 @@ func ValidateControllerConfiguration(cfg *config.ControllerConfiguration, fldPat
 		allErrors = append(allErrors, field.Invalid(fldPath.Child("kubernetesAPIBurst"), cfg.KubernetesAPIBurst, "must be higher or equal to kubernetesAPIQPS"))
 	}
 
+	if cfg.InitialSyncItemsPerSecond < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("initialSyncItemsPerSecond"), cfg.InitialSyncItemsPerSecond, "must not be negative"))
+	}
+
+	if cfg.ChallengeProcessingTimeout < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("challengeProcessingTimeout"), cfg.ChallengeProcessingTimeout, "must not be negative"))
+	}
+
+	if cfg.MaxConcurrentIssuances < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("maxConcurrentIssuances"), cfg.MaxConcurrentIssuances, "must not be negative"))
+	}
+
+	if cfg.NamespaceACMEOrdersPerHour < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("namespaceACMEOrdersPerHour"), cfg.NamespaceACMEOrdersPerHour, "must not be negative"))
+	}
+
+	if cfg.NamespaceACMEOrderBurst < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("namespaceACMEOrderBurst"), cfg.NamespaceACMEOrderBurst, "must not be negative"))
+	}
+
+	// Authorizations are commonly valid for at least 7 days after they have
+	// been validated. A longer window could refresh an authorization which was
+	// just validated again, creating a new order each time it is reused.
+	if cfg.ACMEAuthorizationRefreshWindow < 0 || cfg.ACMEAuthorizationRefreshWindow > 7*24*time.Hour {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("acmeAuthorizationRefreshWindow"), cfg.ACMEAuthorizationRefreshWindow, "must be between 0s and 168h"))
+	}
+
+	if cfg.SecretWritesPerSecond < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("secretWritesPerSecond"), cfg.SecretWritesPerSecond, "must not be negative"))
+	}
+
+	if cfg.MaxConcurrentSecretWrites < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("maxConcurrentSecretWrites"), cfg.MaxConcurrentSecretWrites, "must not be negative"))
+	}
+
+	if cfg.SecretRecreateConflictRetries < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("secretRecreateConflictRetries"), cfg.SecretRecreateConflictRetries, "must not be negative"))
+	}
+
+	if cfg.OCSPStapleRefreshInterval < 0 || (cfg.OCSPStapleRefreshInterval > 0 && cfg.OCSPStapleRefreshInterval < time.Minute) {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("ocspStapleRefreshInterval"), cfg.OCSPStapleRefreshInterval, "must be at least 1m"))
+	}
+
+	if cfg.EventRecorderQPS < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("eventRecorderQPS"), cfg.EventRecorderQPS, "must not be negative"))
+	}
+
+	if cfg.EventRecorderBurst < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("eventRecorderBurst"), cfg.EventRecorderBurst, "must not be negative"))
+	}
+
+	if cfg.EventRecorderDeduplicationWindow < 0 || (cfg.EventRecorderDeduplicationWindow > 0 && cfg.EventRecorderDeduplicationWindow < time.Second) {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("eventRecorderDeduplicationWindow"), cfg.EventRecorderDeduplicationWindow, "must be at least 1s"))
+	}
+
+	switch cfg.SecretTypeMismatchPolicy {
+	case "", config.SecretTypeMismatchPolicyPreserve, config.SecretTypeMismatchPolicyFail, config.SecretTypeMismatchPolicyRecreate:
+	default:
+		allErrors = append(allErrors, field.NotSupported(fldPath.Child("secretTypeMismatchPolicy"), cfg.SecretTypeMismatchPolicy, []string{
+			string(config.SecretTypeMismatchPolicyPreserve),
+			string(config.SecretTypeMismatchPolicyFail),
+			string(config.SecretTypeMismatchPolicyRecreate),
+		}))
+	}
+
+	switch cfg.IssuedCertificateVerificationPolicy {
+	case "", config.IssuedCertificateVerificationPolicyNone, config.IssuedCertificateVerificationPolicyWarn, config.IssuedCertificateVerificationPolicyStrict:
+	default:
+		allErrors = append(allErrors, field.NotSupported(fldPath.Child("issuedCertificateVerificationPolicy"), cfg.IssuedCertificateVerificationPolicy, []string{
+			string(config.IssuedCertificateVerificationPolicyNone),
+			string(config.IssuedCertificateVerificationPolicyWarn),
+			string(config.IssuedCertificateVerificationPolicyStrict),
+		}))
+	}
+
+	switch cfg.SecretOwnershipConflictPolicy {
+	case "", config.SecretOwnershipConflictPolicyOwner, config.SecretOwnershipConflictPolicyRefuse:
+	default:
+		allErrors = append(allErrors, field.NotSupported(fldPath.Child("secretOwnershipConflictPolicy"), cfg.SecretOwnershipConflictPolicy, []string{
+			string(config.SecretOwnershipConflictPolicyOwner),
+			string(config.SecretOwnershipConflictPolicyRefuse),
+		}))
+	}
+
+	switch cfg.SecretDeletionPolicy {
+	case "", config.SecretDeletionPolicyRetain, config.SecretDeletionPolicyDelete:
+	default:
+		allErrors = append(allErrors, field.NotSupported(fldPath.Child("secretDeletionPolicy"), cfg.SecretDeletionPolicy, []string{
+			string(config.SecretDeletionPolicyRetain),
+			string(config.SecretDeletionPolicyDelete),
+		}))
+	}
+
+	if cfg.DeprecatedCryptoMinimumRSAKeySize < 0 || cfg.DeprecatedCryptoMinimumRSAKeySize > maxDeprecatedCryptoRSAKeySize {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("deprecatedCryptoMinimumRSAKeySize"), cfg.DeprecatedCryptoMinimumRSAKeySize, "must be between 0 and 8192"))
+	}
+
+	switch cfg.DeprecatedCryptoMinimumECDSAKeySize {
+	case 0, 256, 384, 521:
+	default:
+		allErrors = append(allErrors, field.NotSupported(fldPath.Child("deprecatedCryptoMinimumECDSAKeySize"), cfg.DeprecatedCryptoMinimumECDSAKeySize, []string{"0", "256", "384", "521"}))
+	}
+
+	switch cfg.DeprecatedCryptoMinimumSignatureHashSize {
+	case 0, 160, 224, 256, 384, 512:
+	default:
+		allErrors = append(allErrors, field.NotSupported(fldPath.Child("deprecatedCryptoMinimumSignatureHashSize"), cfg.DeprecatedCryptoMinimumSignatureHashSize, []string{"0", "160", "224", "256", "384", "512"}))
+	}
+
 	for i, server := range cfg.ACMEHTTP01Config.SolverNameservers {
 		// ensure all servers have a port number
 		_, _, err := net.SplitHostPort(server)
@@ -81,6 +204,24 @@ func ValidateControllerConfiguration(cfg *config.ControllerConfiguration, fldPat
 		}
 	}
 
+	if server := cfg.ACMEDNS01Config.PreValidationURL; server != "" {
+		if u, err := url.ParseRequestURI(server); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("acmeDNS01Config").Child("preValidationURL"), server, "must be an absolute http or https URL"))
+		}
+	}
+
+	if cfg.ACMEDNS01Config.PreValidationTimeout < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("acmeDNS01Config").Child("preValidationTimeout"), cfg.ACMEDNS01Config.PreValidationTimeout, "must not be negative"))
+	}
+
+	if cfg.ACMEDNS01Config.CleanUpParallelism < 0 {
+		allErrors = append(allErrors, field.Invalid(fldPath.Child("acmeDNS01Config").Child("cleanUpParallelism"), cfg.ACMEDNS01Config.CleanUpParallelism, "must not be negative"))
+	}
+
+	for i, rule := range cfg.CertificateRequestApprovalRules {
+		allErrors = append(allErrors, validateCertificateRequestApprovalRule(rule, fldPath.Child("certificateRequestApprovalRules").Index(i))...)
+	}
+
 	allControllersSet := sets.NewString(defaults.AllControllers...)
 	for i, controller := range cfg.Controllers {
 		if controller == "*" {
@@ -95,3 +236,79 @@ func ValidateControllerConfiguration(cfg *config.ControllerConfiguration, fldPat
 
 	return allErrors
 }
+
+func validateDefaultIssuerRules(rules []config.DefaultIssuerRule, fldPath *field.Path) field.ErrorList {
+	var allErrors field.ErrorList
+
+	patterns := sets.New[string]()
+	for i, rule := range rules {
+		rulePath := fldPath.Index(i)
+
+		if rule.DNSNamePattern == "" {
+			allErrors = append(allErrors, field.Required(rulePath.Child("dnsNamePattern"), "must not be empty"))
+		} else if _, err := dnsglob.Parse(rule.DNSNamePattern); err != nil {
+			allErrors = append(allErrors, field.Invalid(rulePath.Child("dnsNamePattern"), rule.DNSNamePattern, err.Error()))
+		} else if patterns.Has(strings.ToLower(rule.DNSNamePattern)) {
+			// Rules with the same pattern are equally specific, so only the
+			// first of them would ever be used.
+			allErrors = append(allErrors, field.Duplicate(rulePath.Child("dnsNamePattern"), rule.DNSNamePattern))
+		}
+		patterns.Insert(strings.ToLower(rule.DNSNamePattern))
+
+		if rule.IssuerName == "" {
+			allErrors = append(allErrors, field.Required(rulePath.Child("issuerName"), "must not be empty"))
+		}
+	}
+
+	return allErrors
+}
+
+// validateShimClasses validates the allowed and denied IngressClasses or
+// GatewayClasses of the certificate-shim controllers. A class which is both
+// allowed and denied is rejected, as the deny list would always win.
+func validateShimClasses(allowed, denied []string, allowedPath, deniedPath *field.Path) field.ErrorList {
+	var allErrors field.ErrorList
+
+	validateClassNames := func(classes []string, fldPath *field.Path) sets.Set[string] {
+		names := sets.New[string]()
+		for i, class := range classes {
+			for _, msg := range validation.IsDNS1123Subdomain(class) {
+				allErrors = append(allErrors, field.Invalid(fldPath.Index(i), class, msg))
+			}
+			if names.Has(class) {
+				allErrors = append(allErrors, field.Duplicate(fldPath.Index(i), class))
+			}
+			names.Insert(class)
+		}
+		return names
+	}
+
+	allowedNames := validateClassNames(allowed, allowedPath)
+	validateClassNames(denied, deniedPath)
+
+	for i, class := range denied {
+		if allowedNames.Has(class) {
+			allErrors = append(allErrors, field.Invalid(deniedPath.Index(i), class, "must not also be in "+allowedPath.String()))
+		}
+	}
+
+	return allErrors
+}
+
+func validateCertificateRequestApprovalRule(rule config.CertificateRequestApprovalRule, fldPath *field.Path) field.ErrorList {
+	var allErrors field.ErrorList
+
+	// A rule without any matcher would approve every CertificateRequest,
+	// which is the behaviour when no rules are configured at all.
+	if rule.IssuerName == "" && rule.IssuerKind == "" && rule.IssuerGroup == "" && len(rule.Namespaces) == 0 {
+		allErrors = append(allErrors, field.Required(fldPath, "at least one of issuerName, issuerKind, issuerGroup or namespaces must be set"))
+	}
+
+	for i, namespace := range rule.Namespaces {
+		for _, msg := range validation.IsDNS1123Label(namespace) {
+			allErrors = append(allErrors, field.Invalid(fldPath.Child("namespaces").Index(i), namespace, msg))
+		}
+	}
+
+	return allErrors
+}
@@ -330,6 +330,68 @@ func TestValidateControllerConfiguration(t *testing.T) {
 				}
 			},
 		},
+		{
+			"with negative acme dns cleanup parallelism",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				ACMEDNS01Config: config.ACMEDNS01Config{
+					CleanUpParallelism: -1,
+				},
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("acmeDNS01Config.cleanUpParallelism"), cc.ACMEDNS01Config.CleanUpParallelism, "must not be negative"),
+				}
+			},
+		},
+		{
+			"with valid acme dns pre-validation config",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				ACMEDNS01Config: config.ACMEDNS01Config{
+					PreValidationURL:     "https://dns-api.example.com/validate",
+					PreValidationTimeout: time.Second,
+				},
+			},
+			nil,
+		},
+		{
+			"with invalid acme dns pre-validation config",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				ACMEDNS01Config: config.ACMEDNS01Config{
+					PreValidationURL:     "dns-api.example.com/validate",
+					PreValidationTimeout: -time.Second,
+				},
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("acmeDNS01Config.preValidationURL"), cc.ACMEDNS01Config.PreValidationURL, "must be an absolute http or https URL"),
+					field.Invalid(field.NewPath("acmeDNS01Config.preValidationTimeout"), cc.ACMEDNS01Config.PreValidationTimeout, "must not be negative"),
+				}
+			},
+		},
 		{
 			"with valid controllers named",
 			&config.ControllerConfiguration{
@@ -379,6 +441,636 @@ func TestValidateControllerConfiguration(t *testing.T) {
 				}
 			},
 		},
+		{
+			"with valid secret type mismatch policy",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:       1,
+				KubernetesAPIQPS:         1,
+				SecretTypeMismatchPolicy: config.SecretTypeMismatchPolicyRecreate,
+			},
+			nil,
+		},
+		{
+			"with invalid secret type mismatch policy",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:       1,
+				KubernetesAPIQPS:         1,
+				SecretTypeMismatchPolicy: "Overwrite",
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.NotSupported(field.NewPath("secretTypeMismatchPolicy"), cc.SecretTypeMismatchPolicy, []string{"Preserve", "Fail", "Recreate"}),
+				}
+			},
+		},
+		{
+			"with valid secret write limits",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:        1,
+				KubernetesAPIQPS:          1,
+				SecretWritesPerSecond:     10,
+				MaxConcurrentSecretWrites: 5,
+			},
+			nil,
+		},
+		{
+			"with negative secret write limits",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:            1,
+				KubernetesAPIQPS:              1,
+				SecretWritesPerSecond:         -1,
+				MaxConcurrentSecretWrites:     -5,
+				SecretRecreateConflictRetries: -1,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("secretWritesPerSecond"), cc.SecretWritesPerSecond, "must not be negative"),
+					field.Invalid(field.NewPath("maxConcurrentSecretWrites"), cc.MaxConcurrentSecretWrites, "must not be negative"),
+					field.Invalid(field.NewPath("secretRecreateConflictRetries"), cc.SecretRecreateConflictRetries, "must not be negative"),
+				}
+			},
+		},
+		{
+			"with a valid maximum number of concurrent issuances",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:     1,
+				KubernetesAPIQPS:       1,
+				MaxConcurrentIssuances: 50,
+			},
+			nil,
+		},
+		{
+			"with a negative maximum number of concurrent issuances",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:     1,
+				KubernetesAPIQPS:       1,
+				MaxConcurrentIssuances: -1,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("maxConcurrentIssuances"), cc.MaxConcurrentIssuances, "must not be negative"),
+				}
+			},
+		},
+		{
+			"with valid namespace ACME order limits",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:         1,
+				KubernetesAPIQPS:           1,
+				NamespaceACMEOrdersPerHour: 30,
+				NamespaceACMEOrderBurst:    5,
+			},
+			nil,
+		},
+		{
+			"with negative namespace ACME order limits",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:         1,
+				KubernetesAPIQPS:           1,
+				NamespaceACMEOrdersPerHour: -1,
+				NamespaceACMEOrderBurst:    -5,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("namespaceACMEOrdersPerHour"), cc.NamespaceACMEOrdersPerHour, "must not be negative"),
+					field.Invalid(field.NewPath("namespaceACMEOrderBurst"), cc.NamespaceACMEOrderBurst, "must not be negative"),
+				}
+			},
+		},
+		{
+			"with a valid ACME authorization refresh window",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:             1,
+				KubernetesAPIQPS:               1,
+				ACMEAuthorizationRefreshWindow: 24 * time.Hour,
+			},
+			nil,
+		},
+		{
+			"with a negative ACME authorization refresh window",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:             1,
+				KubernetesAPIQPS:               1,
+				ACMEAuthorizationRefreshWindow: -time.Hour,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("acmeAuthorizationRefreshWindow"), cc.ACMEAuthorizationRefreshWindow, "must be between 0s and 168h"),
+				}
+			},
+		},
+		{
+			"with an ACME authorization refresh window longer than 7 days",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:             1,
+				KubernetesAPIQPS:               1,
+				ACMEAuthorizationRefreshWindow: 8 * 24 * time.Hour,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("acmeAuthorizationRefreshWindow"), cc.ACMEAuthorizationRefreshWindow, "must be between 0s and 168h"),
+				}
+			},
+		},
+		{
+			"with a valid initial sync rate",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:        1,
+				KubernetesAPIQPS:          1,
+				InitialSyncItemsPerSecond: 2.5,
+			},
+			nil,
+		},
+		{
+			"with a negative initial sync rate",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:        1,
+				KubernetesAPIQPS:          1,
+				InitialSyncItemsPerSecond: -1,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("initialSyncItemsPerSecond"), cc.InitialSyncItemsPerSecond, "must not be negative"),
+				}
+			},
+		},
+		{
+			"with valid certificate request approval rules",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				CertificateRequestApprovalRules: []config.CertificateRequestApprovalRule{
+					{IssuerName: "internal-ca", IssuerKind: "ClusterIssuer"},
+					{IssuerGroup: "awspca.cert-manager.io", Namespaces: []string{"team-a", "team-b"}},
+				},
+			},
+			nil,
+		},
+		{
+			"with invalid certificate request approval rules",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+				CertificateRequestApprovalRules: []config.CertificateRequestApprovalRule{
+					{},
+					{IssuerName: "internal-ca", Namespaces: []string{"Team_A"}},
+				},
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Required(field.NewPath("certificateRequestApprovalRules").Index(0), "at least one of issuerName, issuerKind, issuerGroup or namespaces must be set"),
+					field.Invalid(field.NewPath("certificateRequestApprovalRules").Index(1).Child("namespaces").Index(0), "Team_A", "a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')"),
+				}
+			},
+		},
+		{
+			"with valid default issuer rules",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+					DefaultIssuerRules: []config.DefaultIssuerRule{
+						{DNSNamePattern: "**.internal.example.com", IssuerName: "internal-ca", IssuerKind: "ClusterIssuer"},
+						{DNSNamePattern: "api-*.example.com", IssuerName: "api-ca"},
+					},
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+			},
+			nil,
+		},
+		{
+			"with invalid default issuer rules",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+					DefaultIssuerRules: []config.DefaultIssuerRule{
+						{IssuerName: "internal-ca"},
+						{DNSNamePattern: "a.**.example.com", IssuerName: "internal-ca"},
+						{DNSNamePattern: "*.example.com"},
+						{DNSNamePattern: "*.Example.com", IssuerName: "other-ca"},
+					},
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				rulesPath := field.NewPath("ingressShimConfig").Child("defaultIssuerRules")
+				return field.ErrorList{
+					field.Required(rulesPath.Index(0).Child("dnsNamePattern"), "must not be empty"),
+					field.Invalid(rulesPath.Index(1).Child("dnsNamePattern"), "a.**.example.com", "`**` is only allowed as the leftmost label"),
+					field.Required(rulesPath.Index(2).Child("issuerName"), "must not be empty"),
+					field.Duplicate(rulesPath.Index(3).Child("dnsNamePattern"), "*.Example.com"),
+				}
+			},
+		},
+		{
+			"with valid shim classes",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind:     "Issuer",
+					AllowedIngressClasses: []string{"public", "public.example.com"},
+					DeniedIngressClasses:  []string{"internal"},
+					AllowedGatewayClasses: []string{"public"},
+					DeniedGatewayClasses:  []string{"internal"},
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+			},
+			nil,
+		},
+		{
+			"with invalid shim classes",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind:     "Issuer",
+					AllowedIngressClasses: []string{"public", "Public_Class", "public"},
+					DeniedIngressClasses:  []string{"internal"},
+					AllowedGatewayClasses: []string{"public"},
+					DeniedGatewayClasses:  []string{"public"},
+				},
+				KubernetesAPIBurst: 1,
+				KubernetesAPIQPS:   1,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				shimPath := field.NewPath("ingressShimConfig")
+				return field.ErrorList{
+					field.Invalid(shimPath.Child("allowedIngressClasses").Index(1), "Public_Class", "a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')"),
+					field.Duplicate(shimPath.Child("allowedIngressClasses").Index(2), "public"),
+					field.Invalid(shimPath.Child("deniedGatewayClasses").Index(0), "public", "must not also be in ingressShimConfig.allowedGatewayClasses"),
+				}
+			},
+		},
+		{
+			"with valid event recorder limits",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:               1,
+				KubernetesAPIQPS:                 1,
+				EventRecorderQPS:                 0.1,
+				EventRecorderBurst:               10,
+				EventRecorderDeduplicationWindow: time.Minute,
+			},
+			nil,
+		},
+		{
+			"with valid OCSP staple refresh interval",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:        1,
+				KubernetesAPIQPS:          1,
+				OCSPStapleRefreshInterval: 12 * time.Hour,
+			},
+			nil,
+		},
+		{
+			"with too short OCSP staple refresh interval",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:        1,
+				KubernetesAPIQPS:          1,
+				OCSPStapleRefreshInterval: time.Second,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("ocspStapleRefreshInterval"), cc.OCSPStapleRefreshInterval, "must be at least 1m"),
+				}
+			},
+		},
+		{
+			"with invalid event recorder limits",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:               1,
+				KubernetesAPIQPS:                 1,
+				EventRecorderQPS:                 -1,
+				EventRecorderBurst:               -1,
+				EventRecorderDeduplicationWindow: 500 * time.Millisecond,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("eventRecorderQPS"), cc.EventRecorderQPS, "must not be negative"),
+					field.Invalid(field.NewPath("eventRecorderBurst"), cc.EventRecorderBurst, "must not be negative"),
+					field.Invalid(field.NewPath("eventRecorderDeduplicationWindow"), cc.EventRecorderDeduplicationWindow, "must be at least 1s"),
+				}
+			},
+		},
+		{
+			"with valid issued certificate verification policy",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:                  1,
+				KubernetesAPIQPS:                    1,
+				IssuedCertificateVerificationPolicy: config.IssuedCertificateVerificationPolicyStrict,
+			},
+			nil,
+		},
+		{
+			"with invalid issued certificate verification policy",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:                  1,
+				KubernetesAPIQPS:                    1,
+				IssuedCertificateVerificationPolicy: "Reject",
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.NotSupported(field.NewPath("issuedCertificateVerificationPolicy"), cc.IssuedCertificateVerificationPolicy, []string{"None", "Warn", "Strict"}),
+				}
+			},
+		},
+		{
+			"with valid secret ownership conflict policy",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:            1,
+				KubernetesAPIQPS:              1,
+				SecretOwnershipConflictPolicy: config.SecretOwnershipConflictPolicyRefuse,
+			},
+			nil,
+		},
+		{
+			"with invalid secret ownership conflict policy",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:            1,
+				KubernetesAPIQPS:              1,
+				SecretOwnershipConflictPolicy: "Oldest",
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.NotSupported(field.NewPath("secretOwnershipConflictPolicy"), cc.SecretOwnershipConflictPolicy, []string{"Owner", "Refuse"}),
+				}
+			},
+		},
+		{
+			"with valid secret deletion policy",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:   1,
+				KubernetesAPIQPS:     1,
+				SecretDeletionPolicy: config.SecretDeletionPolicyDelete,
+			},
+			nil,
+		},
+		{
+			"with invalid secret deletion policy",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:   1,
+				KubernetesAPIQPS:     1,
+				SecretDeletionPolicy: "Orphan",
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.NotSupported(field.NewPath("secretDeletionPolicy"), cc.SecretDeletionPolicy, []string{"Retain", "Delete"}),
+				}
+			},
+		},
+		{
+			"with valid deprecated crypto thresholds",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:                       1,
+				KubernetesAPIQPS:                         1,
+				DeprecatedCryptoMinimumRSAKeySize:        3072,
+				DeprecatedCryptoMinimumECDSAKeySize:      384,
+				DeprecatedCryptoMinimumSignatureHashSize: 256,
+			},
+			nil,
+		},
+		{
+			"with invalid deprecated crypto thresholds",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:                       1,
+				KubernetesAPIQPS:                         1,
+				DeprecatedCryptoMinimumRSAKeySize:        -1,
+				DeprecatedCryptoMinimumECDSAKeySize:      255,
+				DeprecatedCryptoMinimumSignatureHashSize: 1,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("deprecatedCryptoMinimumRSAKeySize"), cc.DeprecatedCryptoMinimumRSAKeySize, "must be between 0 and 8192"),
+					field.NotSupported(field.NewPath("deprecatedCryptoMinimumECDSAKeySize"), cc.DeprecatedCryptoMinimumECDSAKeySize, []string{"0", "256", "384", "521"}),
+					field.NotSupported(field.NewPath("deprecatedCryptoMinimumSignatureHashSize"), cc.DeprecatedCryptoMinimumSignatureHashSize, []string{"0", "160", "224", "256", "384", "512"}),
+				}
+			},
+		},
+		{
+			"with an RSA deprecated crypto threshold larger than the largest RSA key",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:                1,
+				KubernetesAPIQPS:                  1,
+				DeprecatedCryptoMinimumRSAKeySize: 16384,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("deprecatedCryptoMinimumRSAKeySize"), cc.DeprecatedCryptoMinimumRSAKeySize, "must be between 0 and 8192"),
+				}
+			},
+		},
+		{
+			"with valid challenge processing timeout",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:         1,
+				KubernetesAPIQPS:           1,
+				ChallengeProcessingTimeout: time.Minute,
+			},
+			nil,
+		},
+		{
+			"with negative challenge processing timeout",
+			&config.ControllerConfiguration{
+				Logging: logsapi.LoggingConfiguration{
+					Format: "text",
+				},
+				IngressShimConfig: config.IngressShimConfig{
+					DefaultIssuerKind: "Issuer",
+				},
+				KubernetesAPIBurst:         1,
+				KubernetesAPIQPS:           1,
+				ChallengeProcessingTimeout: -time.Minute,
+			},
+			func(cc *config.ControllerConfiguration) field.ErrorList {
+				return field.ErrorList{
+					field.Invalid(field.NewPath("challengeProcessingTimeout"), cc.ChallengeProcessingTimeout, "must not be negative"),
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -67,6 +67,27 @@ func (in *ACMEHTTP01Config) DeepCopy() *ACMEHTTP01Config {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestApprovalRule) DeepCopyInto(out *CertificateRequestApprovalRule) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestApprovalRule.
+func (in *CertificateRequestApprovalRule) DeepCopy() *CertificateRequestApprovalRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestApprovalRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
@@ -82,6 +103,13 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.CertificateRequestApprovalRules != nil {
+		in, out := &in.CertificateRequestApprovalRules, &out.CertificateRequestApprovalRules
+		*out = make([]CertificateRequestApprovalRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	in.MetricsTLSConfig.DeepCopyInto(&out.MetricsTLSConfig)
 	in.Logging.DeepCopyInto(&out.Logging)
 	if in.FeatureGates != nil {
@@ -115,6 +143,22 @@ func (in *ControllerConfiguration) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultIssuerRule) DeepCopyInto(out *DefaultIssuerRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultIssuerRule.
+func (in *DefaultIssuerRule) DeepCopy() *DefaultIssuerRule {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultIssuerRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressShimConfig) DeepCopyInto(out *IngressShimConfig) {
 	*out = *in
@@ -123,6 +167,31 @@ func (in *IngressShimConfig) DeepCopyInto(out *IngressShimConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DefaultIssuerRules != nil {
+		in, out := &in.DefaultIssuerRules, &out.DefaultIssuerRules
+		*out = make([]DefaultIssuerRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedIngressClasses != nil {
+		in, out := &in.AllowedIngressClasses, &out.AllowedIngressClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedIngressClasses != nil {
+		in, out := &in.DeniedIngressClasses, &out.DeniedIngressClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedGatewayClasses != nil {
+		in, out := &in.AllowedGatewayClasses, &out.AllowedGatewayClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedGatewayClasses != nil {
+		in, out := &in.DeniedGatewayClasses, &out.DeniedGatewayClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -0,0 +1,34 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificaterequests
+
+import (
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// UsagesViolatingIssuerPolicy returns the usages requested by the
+// CertificateRequest which are not permitted by the usage policy of the given
+// issuer. Requests with unknown usages are left to the issuer to reject.
+func UsagesViolatingIssuerPolicy(req *cmapi.CertificateRequest, iss cmapi.GenericIssuer) []cmapi.KeyUsage {
+	keyUsage, extKeyUsage, err := pki.KeyUsagesForCertificateOrCertificateRequest(req.Spec.Usages, req.Spec.IsCA)
+	if err != nil {
+		return nil
+	}
+	return apiutil.UsagesViolatingPolicy(iss.GetSpec().UsagePolicy, keyUsage, extKeyUsage)
+}
@@ -111,3 +111,18 @@ func CertificateOwnsSecret(
 	})
 	return isOwner, otherCertificatesWithSameSecretName, nil
 }
+
+// SecretNameIndex is the name of the Certificate informer index which maps
+// the 'namespace/name' of a Secret to the Certificates which target it as
+// their spec.secretName.
+const SecretNameIndex = "spec.secretName"
+
+// SecretNameIndexFunc indexes Certificates by the 'namespace/name' of the
+// Secret they target.
+func SecretNameIndexFunc(obj interface{}) ([]string, error) {
+	crt, ok := obj.(*cmapi.Certificate)
+	if !ok || crt.Spec.SecretName == "" {
+		return nil, nil
+	}
+	return []string{crt.Namespace + "/" + crt.Spec.SecretName}, nil
+}
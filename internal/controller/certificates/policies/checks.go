@@ -182,6 +182,28 @@ func SecretCertificateNameAnnotationsMismatch(input Input) (string, string, bool
 	return "", "", false
 }
 
+// SecretIsFallbackCertificate - When the Secret contains a fallback
+// certificate, issuance must be retried until a certificate signed by the
+// issuer replaces it.
+func SecretIsFallbackCertificate(input Input) (string, string, bool) {
+	if input.Secret.Annotations[cmapi.FallbackCertificateAnnotationKey] == "true" {
+		return FallbackCertificate, "Issuing certificate as Secret contains a temporary self-signed fallback certificate", true
+	}
+	return "", "", false
+}
+
+// SecretRotationSignalMismatch - When the Certificate requests rotation
+// signals, the Secret must be annotated with the revision of its certificate.
+func SecretRotationSignalMismatch(input Input) (string, string, bool) {
+	if !internalcertificates.SignalsRotation(input.Certificate) {
+		return "", "", false
+	}
+	if _, ok := input.Secret.Annotations[cmapi.SecretCertificateRevisionAnnotationKey]; !ok {
+		return SecretManagedMetadataMismatch, fmt.Sprintf("Secret is missing the %s annotation", cmapi.SecretCertificateRevisionAnnotationKey), true
+	}
+	return "", "", false
+}
+
 // SecretPublicKeyDiffersFromCurrentCertificateRequest checks that the current CertificateRequest
 // contains a CSR that is signed by the key stored in the Secret. A failure is often caused by the
 // Secret being changed outside of the control of cert-manager, causing the current CertificateRequest
@@ -437,10 +459,11 @@ func SecretManagedLabelsAndAnnotationsManagedFieldsMismatch(fieldManager string)
 
 		// Ignore the CertificateName and IssuerRef annotations as these cannot be set by the postIssuance controller.
 		managedAnnotations.Delete(
-			cmapi.CertificateNameKey,       // SecretCertificateNameAnnotationMismatch checks the value
-			cmapi.IssuerNameAnnotationKey,  // SecretIssuerAnnotationsMismatch checks the value
-			cmapi.IssuerKindAnnotationKey,  // SecretIssuerAnnotationsMismatch checks the value
-			cmapi.IssuerGroupAnnotationKey, // SecretIssuerAnnotationsMismatch checks the value
+			cmapi.CertificateNameKey,               // SecretCertificateNameAnnotationMismatch checks the value
+			cmapi.IssuerNameAnnotationKey,          // SecretIssuerAnnotationsMismatch checks the value
+			cmapi.IssuerKindAnnotationKey,          // SecretIssuerAnnotationsMismatch checks the value
+			cmapi.IssuerGroupAnnotationKey,         // SecretIssuerAnnotationsMismatch checks the value
+			cmapi.FallbackCertificateAnnotationKey, // set by the issuing controller for fallback certificates
 		)
 
 		// Remove the non cert-manager labels from the managed labels so we can compare
@@ -466,6 +489,9 @@ func SecretManagedLabelsAndAnnotationsManagedFieldsMismatch(fieldManager string)
 		for k := range expCertificateDataAnnotations { // SecretCertificateDetailsAnnotationsMismatch checks the value
 			expAnnotations.Insert(k)
 		}
+		if internalcertificates.SignalsRotation(input.Certificate) {
+			expAnnotations.Insert(cmapi.SecretCertificateRevisionAnnotationKey) // SecretRotationSignalMismatch checks the key
+		}
 
 		if !managedLabels.Equal(expLabels) {
 			missingLabels := expLabels.Difference(managedLabels)
@@ -621,12 +647,69 @@ func SecretAdditionalOutputFormatsMismatch(input Input) (string, string, bool) {
 			if !ok || !bytes.Equal(v, internalcertificates.OutputFormatDER(input.Secret.Data[corev1.TLSPrivateKeyKey])) {
 				return AdditionalOutputFormatsMismatch, message, true
 			}
+
+		case cmapi.CertificateOutputFormatPKCS7:
+			v, ok := input.Secret.Data[cmapi.CertificateOutputFormatPKCS7Key]
+			if !ok {
+				return AdditionalOutputFormatsMismatch, message, true
+			}
+			expected, err := internalcertificates.OutputFormatPKCS7(input.Secret.Data[corev1.TLSCertKey])
+			if err != nil || !bytes.Equal(v, expected) {
+				return AdditionalOutputFormatsMismatch, message, true
+			}
 		}
 	}
 
 	return "", "", false
 }
 
+// SecretCACertificatePolicyMismatch validates that the `ca.crt` key of the
+// Secret matches the Certificate's caCertificatePolicy.
+// Returns true (violation) if any of the following:
+//   - the policy is `Omit` and the Secret has a `ca.crt` key
+//   - the policy is `ChainRoot`, the Secret has no `ca.crt` key and a CA
+//     certificate can be derived from the Secret's certificate chain
+func SecretCACertificatePolicyMismatch(input Input) (string, string, bool) {
+	ca, hasCA := input.Secret.Data[cmmeta.TLSCAKey]
+	switch input.Certificate.Spec.CACertificatePolicy {
+	case cmapi.CACertificatePolicyOmit:
+		if hasCA {
+			return SecretMismatch, "Secret contains a CA certificate but the Certificate's caCertificatePolicy is Omit", true
+		}
+
+	case cmapi.CACertificatePolicyChainRoot:
+		if len(ca) > 0 {
+			return "", "", false
+		}
+		expected, err := internalcertificates.CACertificateForPolicy(cmapi.CACertificatePolicyChainRoot, input.Secret.Data[corev1.TLSCertKey], nil)
+		if err == nil && len(expected) > 0 {
+			return SecretMismatch, "Secret is missing the CA certificate derived from the certificate chain", true
+		}
+	}
+
+	return "", "", false
+}
+
+// SecretCertificateChainTruncationMismatch validates that the certificate chain
+// stored in the `tls.crt` key of the Secret is truncated at the anchor of the
+// Certificate's chainTruncation, if set.
+// Returns true (violation) if the anchor is part of the stored chain but the
+// chain continues past it. Chains which do not contain the anchor are not a
+// violation, since re-writing the Secret would not truncate them.
+func SecretCertificateChainTruncationMismatch(input Input) (string, string, bool) {
+	if input.Certificate.Spec.ChainTruncation == nil {
+		return "", "", false
+	}
+
+	stored := input.Secret.Data[corev1.TLSCertKey]
+	expected, err := pki.TruncateCertificateChainPEM(stored, input.Certificate.Spec.ChainTruncation)
+	if err == nil && !bytes.Equal(expected, stored) {
+		return SecretMismatch, "Secret's certificate chain is not truncated at the Certificate's chainTruncation anchor", true
+	}
+
+	return "", "", false
+}
+
 // SecretAdditionalOutputFormatsManagedFieldsMismatch validates that the field manager
 // owns the correct Certificate's AdditionalOutputFormats in the Secret.
 // Returns true (violation) if:
@@ -639,8 +722,8 @@ func SecretAdditionalOutputFormatsManagedFieldsMismatch(fieldManager string) Fun
 	const message = "Certificate's AdditionalOutputFormats doesn't match Secret ManagedFields"
 	return func(input Input) (string, string, bool) {
 		var (
-			crtHasCombinedPEM, crtHasDER       bool
-			secretHasCombinedPEM, secretHasDER bool
+			crtHasCombinedPEM, crtHasDER, crtHasPKCS7          bool
+			secretHasCombinedPEM, secretHasDER, secretHasPKCS7 bool
 		)
 
 		// Gather which additional output formats have been defined on the
@@ -651,6 +734,8 @@ func SecretAdditionalOutputFormatsManagedFieldsMismatch(fieldManager string) Fun
 				crtHasCombinedPEM = true
 			case cmapi.CertificateOutputFormatDER:
 				crtHasDER = true
+			case cmapi.CertificateOutputFormatPKCS7:
+				crtHasPKCS7 = true
 			}
 		}
 
@@ -679,11 +764,18 @@ func SecretAdditionalOutputFormatsManagedFieldsMismatch(fieldManager string) Fun
 			}) {
 				secretHasDER = true
 			}
+
+			if fieldset.Has(fieldpath.Path{
+				{FieldName: ptr.To("data")},
+				{FieldName: ptr.To(cmapi.CertificateOutputFormatPKCS7Key)},
+			}) {
+				secretHasPKCS7 = true
+			}
 		}
 
 		// Format present or missing on the Certificate should be reflected on the
 		// Secret.
-		if crtHasCombinedPEM != secretHasCombinedPEM || crtHasDER != secretHasDER {
+		if crtHasCombinedPEM != secretHasCombinedPEM || crtHasDER != secretHasDER || crtHasPKCS7 != secretHasPKCS7 {
 			return AdditionalOutputFormatsMismatch, message, true
 		}
 
@@ -17,6 +17,7 @@ limitations under the License.
 package policies
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
@@ -27,6 +28,7 @@ import (
 	fakeclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/pem"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -214,6 +216,34 @@ func Test_NewTriggerPolicyChain(t *testing.T) {
 			message: "Issuing certificate as Secret was previously issued by \"IssuerKind.new.example.com/testissuer\"",
 			reissue: true,
 		},
+		"trigger issuance as Secret contains a fallback certificate": {
+			certificate: &cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Name: "test"}, Spec: cmapi.CertificateSpec{
+				SecretName: "something",
+				IssuerRef: cmmeta.ObjectReference{
+					Name: "testissuer",
+				},
+			}},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.CertificateNameKey:               "test",
+						cmapi.FallbackCertificateAnnotationKey: "true",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					// the fallback certificate is valid, so is not nearing
+					// expiry
+					corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						clock.Now(), clock.Now().Add(time.Hour),
+					),
+				},
+			},
+			reason:  FallbackCertificate,
+			message: "Issuing certificate as Secret contains a temporary self-signed fallback certificate",
+			reissue: true,
+		},
 		"trigger issuance as private key properties do not meet the requested properties": {
 			certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{SecretName: "something"}},
 			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "something"},
@@ -476,6 +506,85 @@ func Test_NewTriggerPolicyChain(t *testing.T) {
 			message: "Renewing certificate as renewal was scheduled at 0000-12-31 23:59:00 +0000 UTC",
 			reissue: true,
 		},
+		// The actual duration of a backdated certificate is 75 minutes, so it is
+		// renewed 25 minutes before expiry, rather than 20 minutes before
+		// expiry as the requested duration of an hour would imply. The
+		// Certificate's renewal time is set to the one implied by the requested
+		// duration to check that the decision follows the x509 certificate.
+		"trigger renewal of a backdated certificate based on its actual duration": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+					Duration: &metav1.Duration{Duration: time.Hour},
+				},
+				Status: cmapi.CertificateStatus{
+					RenewalTime: &metav1.Time{Time: clock.Now().Add(time.Minute * 5)},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						// issued 35 minutes ago, backdated by 15 minutes
+						clock.Now().Add(time.Minute*-50),
+						clock.Now().Add(time.Minute*25),
+					),
+				},
+			},
+			reason:  Renewing,
+			message: "Renewing certificate as renewal was scheduled at 0001-01-01 00:05:00 +0000 UTC",
+			reissue: true,
+		},
+		// The renewal time computed from the backdated certificate is a minute
+		// from now, so a stale renewal time in the past on the Certificate
+		// does not trigger renewal.
+		"does not trigger renewal of a backdated certificate before its renewal time": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+					Duration: &metav1.Duration{Duration: time.Hour},
+				},
+				Status: cmapi.CertificateStatus{
+					RenewalTime: &metav1.Time{Time: clock.Now().Add(-time.Minute)},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						// issued 34 minutes ago, backdated by 15 minutes
+						clock.Now().Add(time.Minute*-49),
+						clock.Now().Add(time.Minute*26),
+					),
+				},
+			},
+		},
 		"does not trigger renewal if the x509 cert has been re-issued, but Certificate's renewal time has not been updated yet": {
 			certificate: &cmapi.Certificate{
 				Spec: cmapi.CertificateSpec{
@@ -578,8 +687,9 @@ func Test_SecretManagedLabelsAndAnnotationsManagedFieldsMismatch(t *testing.T) {
 	)
 
 	tests := map[string]struct {
-		secretManagedFields []metav1.ManagedFieldsEntry
-		secretData          map[string][]byte
+		certificateAnnotations map[string]string
+		secretManagedFields    []metav1.ManagedFieldsEntry
+		secretData             map[string][]byte
 
 		expReason    string
 		expMessage   string
@@ -672,12 +782,50 @@ func Test_SecretManagedLabelsAndAnnotationsManagedFieldsMismatch(t *testing.T) {
 			expMessage:   "Secret has these extra Annotations: [cert-manager.io/ip-sans cert-manager.io/uri-sans]",
 			expViolation: true,
 		},
+		"if the certificate revision annotation is present and the Certificate signals rotation, should return false": {
+			certificateAnnotations: map[string]string{cmapi.RotationSignalAnnotation: "true"},
+			secretManagedFields: []metav1.ManagedFieldsEntry{
+				{Manager: fieldManager, FieldsV1: &metav1.FieldsV1{
+					Raw: []byte(`{"f:metadata": {
+							"f:labels": {
+								"f:controller.cert-manager.io/fao": {}
+							},
+							"f:annotations": {
+								"f:cert-manager.io/certificate-name": {},
+								"f:cert-manager.io/certificate-revision": {}
+							}
+						}}`),
+				}},
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
+		"if the certificate revision annotation is present but the Certificate no longer signals rotation, should return true": {
+			secretManagedFields: []metav1.ManagedFieldsEntry{
+				{Manager: fieldManager, FieldsV1: &metav1.FieldsV1{
+					Raw: []byte(`{"f:metadata": {
+							"f:labels": {
+								"f:controller.cert-manager.io/fao": {}
+							},
+							"f:annotations": {
+								"f:cert-manager.io/certificate-name": {},
+								"f:cert-manager.io/certificate-revision": {}
+							}
+						}}`),
+				}},
+			},
+			expReason:    SecretManagedMetadataMismatch,
+			expMessage:   "Secret has these extra Annotations: [cert-manager.io/certificate-revision]",
+			expViolation: true,
+		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			gotReason, gotMessage, gotViolation := SecretManagedLabelsAndAnnotationsManagedFieldsMismatch(fieldManager)(Input{
-				Secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{ManagedFields: test.secretManagedFields}, Data: test.secretData},
+				Certificate: gen.Certificate("test-certificate", gen.AddCertificateAnnotations(test.certificateAnnotations)),
+				Secret:      &corev1.Secret{ObjectMeta: metav1.ObjectMeta{ManagedFields: test.secretManagedFields}, Data: test.secretData},
 			})
 
 			assert.Equal(t, test.expReason, gotReason, "unexpected reason")
@@ -1133,6 +1281,12 @@ func Test_SecretAdditionalOutputFormatsMismatch(t *testing.T) {
 	pkDER := block.Bytes
 	combinedPEM := append(append(pk, '\n'), cert...)
 
+	x509Cert := testcrypto.MustCreateCert(t, pk, gen.Certificate("test", gen.SetCertificateDNSNames("example.com")))
+	p7b, err := internalcertificates.OutputFormatPKCS7(x509Cert)
+	if err != nil {
+		t.Fatalf("got unexpected error encoding PKCS#7: %s", err)
+	}
+
 	tests := map[string]struct {
 		input        Input
 		expReason    string
@@ -1220,6 +1374,62 @@ func Test_SecretAdditionalOutputFormatsMismatch(t *testing.T) {
 			expMessage:   "",
 			expViolation: false,
 		},
+		"if additional output has pkcs7 and Secret has no pkcs7, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "PKCS7"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					Data: map[string][]byte{
+						"tls.crt": x509Cert,
+						"tls.key": pk,
+					},
+				},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
+			expViolation: true,
+		},
+		"if additional output has pkcs7 and Secret has pkcs7 of a previous certificate, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "PKCS7"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					Data: map[string][]byte{
+						"tls.crt": testcrypto.MustCreateCert(t, pk, gen.Certificate("renewed", gen.SetCertificateDNSNames("example.com"))),
+						"tls.key": pk,
+						"tls.p7b": p7b,
+					},
+				},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
+			expViolation: true,
+		},
+		"if additional output has pkcs7 and Secret has correct pkcs7, should return false": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "PKCS7"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					Data: map[string][]byte{
+						"tls.crt": x509Cert,
+						"tls.key": pk,
+						"tls.p7b": p7b,
+					},
+				},
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
 		"if additional output has der and Secret has no key, should return true": {
 			input: Input{
 				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
@@ -1435,6 +1645,59 @@ func Test_SecretAdditionalOutputFormatsManagedFieldsMismatch(t *testing.T) {
 			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret ManagedFields",
 			expViolation: true,
 		},
+		"if additional output formats has pkcs7 and secret has no managed fields, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "PKCS7"},
+					}},
+				},
+				Secret: &corev1.Secret{},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret ManagedFields",
+			expViolation: true,
+		},
+		"if additional output formats is empty, and secret has managed fields for pkcs7, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{}},
+				},
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						ManagedFields: []metav1.ManagedFieldsEntry{
+							{Manager: fieldManager, FieldsV1: &metav1.FieldsV1{
+								Raw: []byte(`{"f:data": {".": {}, "f:tls.p7b": {}}}`),
+							}},
+						},
+					},
+				},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret ManagedFields",
+			expViolation: true,
+		},
+		"if additional output formats has pkcs7, and secret has managed fields for pkcs7, should return false": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "PKCS7"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						ManagedFields: []metav1.ManagedFieldsEntry{
+							{Manager: fieldManager, FieldsV1: &metav1.FieldsV1{
+								Raw: []byte(`{"f:data": {".": {}, "f:tls.p7b": {}}}`),
+							}},
+						},
+					},
+				},
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
 		"if additional output formats has combined pem and der, and secret has no managed fields, should return true": {
 			input: Input{
 				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
@@ -2259,6 +2522,132 @@ func Test_SecretOwnerReferenceMismatch(t *testing.T) {
 	}
 }
 
+func Test_SecretCACertificatePolicyMismatch(t *testing.T) {
+	leaf, intermediate, _ := testcrypto.MustCreateCertificateChain(t)
+	chain := append(leaf, intermediate...)
+
+	crtWithPolicy := func(policy cmapi.CACertificatePolicy) *cmapi.Certificate {
+		return gen.Certificate("test-certificate", gen.SetCertificateCACertificatePolicy(policy))
+	}
+
+	tests := map[string]struct {
+		input Input
+
+		expReason    string
+		expMessage   string
+		expViolation bool
+	}{
+		"unset policy with no CA should return false": {
+			input: Input{
+				Certificate: crtWithPolicy(""),
+				Secret:      &corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: chain}},
+			},
+		},
+		"Omit policy with a CA should return true": {
+			input: Input{
+				Certificate: crtWithPolicy(cmapi.CACertificatePolicyOmit),
+				Secret:      &corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: chain, cmmeta.TLSCAKey: chain}},
+			},
+			expReason:    SecretMismatch,
+			expMessage:   "Secret contains a CA certificate but the Certificate's caCertificatePolicy is Omit",
+			expViolation: true,
+		},
+		"Omit policy with no CA should return false": {
+			input: Input{
+				Certificate: crtWithPolicy(cmapi.CACertificatePolicyOmit),
+				Secret:      &corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: chain}},
+			},
+		},
+		"ChainRoot policy with a CA should return false": {
+			input: Input{
+				Certificate: crtWithPolicy(cmapi.CACertificatePolicyChainRoot),
+				Secret:      &corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: chain, cmmeta.TLSCAKey: []byte("test-ca")}},
+			},
+		},
+		"ChainRoot policy with no CA and a chain to derive it from should return true": {
+			input: Input{
+				Certificate: crtWithPolicy(cmapi.CACertificatePolicyChainRoot),
+				Secret:      &corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: chain}},
+			},
+			expReason:    SecretMismatch,
+			expMessage:   "Secret is missing the CA certificate derived from the certificate chain",
+			expViolation: true,
+		},
+		"ChainRoot policy with no CA and an invalid chain should return false": {
+			input: Input{
+				Certificate: crtWithPolicy(cmapi.CACertificatePolicyChainRoot),
+				Secret:      &corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: []byte("invalid")}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotReason, gotMessage, gotViolation := SecretCACertificatePolicyMismatch(test.input)
+			assert.Equal(t, test.expReason, gotReason)
+			assert.Equal(t, test.expMessage, gotMessage)
+			assert.Equal(t, test.expViolation, gotViolation)
+		})
+	}
+}
+
+func Test_SecretCertificateChainTruncationMismatch(t *testing.T) {
+	leaf, intermediate, root := testcrypto.MustCreateCertificateChain(t)
+	fullChain := bytes.Join([][]byte{leaf, intermediate, root}, nil)
+	truncatedChain := bytes.Join([][]byte{leaf, intermediate}, nil)
+
+	crtWithAnchor := gen.Certificate("test-certificate",
+		gen.SetCertificateChainTruncation(cmapi.CertificateChainTruncation{Subject: "CN=intermediate"}),
+	)
+
+	tests := map[string]struct {
+		input Input
+
+		expReason    string
+		expMessage   string
+		expViolation bool
+	}{
+		"no chain truncation should return false": {
+			input: Input{
+				Certificate: gen.Certificate("test-certificate"),
+				Secret:      &corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: fullChain}},
+			},
+		},
+		"chain truncated at the anchor should return false": {
+			input: Input{
+				Certificate: crtWithAnchor,
+				Secret:      &corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: truncatedChain}},
+			},
+		},
+		"chain continuing past the anchor should return true": {
+			input: Input{
+				Certificate: crtWithAnchor,
+				Secret:      &corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: fullChain}},
+			},
+			expReason:    SecretMismatch,
+			expMessage:   "Secret's certificate chain is not truncated at the Certificate's chainTruncation anchor",
+			expViolation: true,
+		},
+		"chain without the anchor should return false": {
+			input: Input{
+				Certificate: gen.Certificate("test-certificate",
+					gen.SetCertificateChainTruncation(cmapi.CertificateChainTruncation{Subject: "CN=other"}),
+				),
+				Secret: &corev1.Secret{Data: map[string][]byte{corev1.TLSCertKey: fullChain}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotReason, gotMessage, gotViolation := SecretCertificateChainTruncationMismatch(test.input)
+			assert.Equal(t, test.expReason, gotReason)
+			assert.Equal(t, test.expMessage, gotMessage)
+			assert.Equal(t, test.expViolation, gotViolation)
+		})
+	}
+}
+
 func Test_SecretCertificateNameAnnotationsMismatch(t *testing.T) {
 	crt := gen.Certificate("test-certificate")
 
@@ -2319,3 +2708,101 @@ func Test_SecretCertificateNameAnnotationsMismatch(t *testing.T) {
 		})
 	}
 }
+
+func Test_SecretRotationSignalMismatch(t *testing.T) {
+	crt := gen.Certificate("test-certificate")
+	signallingCrt := gen.CertificateFrom(crt,
+		gen.AddCertificateAnnotations(map[string]string{cmapi.RotationSignalAnnotation: "true"}),
+	)
+	annotatedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				cmapi.SecretCertificateRevisionAnnotationKey: "1",
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		input Input
+
+		expReason    string
+		expMessage   string
+		expViolation bool
+	}{
+		"without the rotation signal annotation, should return false": {
+			input: Input{
+				Certificate: crt,
+				Secret:      &corev1.Secret{},
+			},
+		},
+		"with the rotation signal annotation and an annotated Secret, should return false": {
+			input: Input{
+				Certificate: signallingCrt,
+				Secret:      annotatedSecret,
+			},
+		},
+		"with the rotation signal annotation and a Secret missing the revision, should return true": {
+			input: Input{
+				Certificate: signallingCrt,
+				Secret:      &corev1.Secret{},
+			},
+			expReason:    SecretManagedMetadataMismatch,
+			expMessage:   "Secret is missing the cert-manager.io/certificate-revision annotation",
+			expViolation: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotReason, gotMessage, gotViolation := SecretRotationSignalMismatch(test.input)
+			assert.Equal(t, test.expReason, gotReason)
+			assert.Equal(t, test.expMessage, gotMessage)
+			assert.Equal(t, test.expViolation, gotViolation)
+		})
+	}
+}
+
+func Test_unlessSecretShared(t *testing.T) {
+	violation := func(Input) (string, string, bool) {
+		return "Reason", "message", true
+	}
+
+	tests := map[string]struct {
+		annotations map[string]string
+
+		expReason    string
+		expMessage   string
+		expViolation bool
+	}{
+		"if the Certificate does not share its Secret, should run the check": {
+			expReason:    "Reason",
+			expMessage:   "message",
+			expViolation: true,
+		},
+		"if shared Secret ownership is disabled, should run the check": {
+			annotations:  map[string]string{cmapi.SharedSecretOwnershipAnnotation: "false"},
+			expReason:    "Reason",
+			expMessage:   "message",
+			expViolation: true,
+		},
+		"if the Certificate shares its Secret, should skip the check": {
+			annotations:  map[string]string{cmapi.SharedSecretOwnershipAnnotation: "true"},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotReason, gotMessage, gotViolation := unlessSecretShared(violation)(Input{
+				Certificate: gen.Certificate("test-certificate", gen.AddCertificateAnnotations(test.annotations)),
+				Secret:      &corev1.Secret{},
+			})
+
+			assert.Equal(t, test.expReason, gotReason, "unexpected reason")
+			assert.Equal(t, test.expMessage, gotMessage, "unexpected message")
+			assert.Equal(t, test.expViolation, gotViolation, "unexpected violation")
+		})
+	}
+}
@@ -53,6 +53,10 @@ const (
 	// Expired is a policy violation reason for a scenario where Certificate has
 	// expired.
 	Expired string = "Expired"
+	// FallbackCertificate is a policy violation reason for a scenario where
+	// Certificate's spec.secretName secret contains a temporary self-signed
+	// certificate which replaced an expired certificate.
+	FallbackCertificate string = "FallbackCertificate"
 	// SecretTemplateMisMatch is a policy violation whereby the Certificate's
 	// SecretTemplate is not reflected on the target Secret, either by having
 	// extra, missing, or wrong Annotations or Labels.
@@ -21,6 +21,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/clock"
 
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
@@ -73,6 +74,7 @@ func NewTriggerPolicyChain(c clock.Clock) Chain {
 
 		SecretIssuerAnnotationsMismatch,          // Make sure the Secret's IssuerRef annotations match the Certificate spec
 		SecretCertificateNameAnnotationsMismatch, // Make sure the Secret's CertificateName annotation matches the Certificate's name
+		SecretIsFallbackCertificate,              // Make sure the Secret does not contain a fallback certificate
 
 		SecretPrivateKeyMismatchesSpec,                      // Make sure the PrivateKey Type and Size match the Certificate spec
 		SecretPublicKeyDiffersFromCurrentCertificateRequest, // Make sure the Secret's PublicKey matches the current CertificateRequest
@@ -91,6 +93,7 @@ func NewReadinessPolicyChain(c clock.Clock) Chain {
 
 		SecretIssuerAnnotationsMismatch,          // Make sure the Secret's IssuerRef annotations match the Certificate spec
 		SecretCertificateNameAnnotationsMismatch, // Make sure the Secret's CertificateName annotation matches the Certificate's name
+		SecretIsFallbackCertificate,              // Make sure the Secret does not contain a fallback certificate
 
 		SecretPrivateKeyMismatchesSpec,                      // Make sure the PrivateKey Type and Size match the Certificate spec
 		SecretPublicKeyDiffersFromCurrentCertificateRequest, // Make sure the Secret's PublicKey matches the current CertificateRequest
@@ -104,15 +107,20 @@ func NewReadinessPolicyChain(c clock.Clock) Chain {
 // correctness of metadata and output formats of Certificate's Secrets.
 func NewSecretPostIssuancePolicyChain(ownerRefEnabled bool, fieldManager string) Chain {
 	return Chain{
-		SecretBaseLabelsMismatch,                                             // Make sure the managed labels have the correct values
-		SecretCertificateDetailsAnnotationsMismatch,                          // Make sure the managed certificate details annotations have the correct values
-		SecretManagedLabelsAndAnnotationsManagedFieldsMismatch(fieldManager), // Make sure only the expected managed labels and annotations exist
-		SecretSecretTemplateMismatch,                                         // Make sure the template label and annotation values match the secret
-		SecretSecretTemplateManagedFieldsMismatch(fieldManager),              // Make sure only the expected template labels and annotations exist
+		SecretBaseLabelsMismatch,                    // Make sure the managed labels have the correct values
+		SecretCertificateDetailsAnnotationsMismatch, // Make sure the managed certificate details annotations have the correct values
+		SecretRotationSignalMismatch,                // Make sure the certificate revision annotation is set if requested
+		// Make sure only the expected managed labels and annotations exist
+		unlessSecretShared(SecretManagedLabelsAndAnnotationsManagedFieldsMismatch(fieldManager)),
+		SecretSecretTemplateMismatch, // Make sure the template label and annotation values match the secret
+		// Make sure only the expected template labels and annotations exist
+		unlessSecretShared(SecretSecretTemplateManagedFieldsMismatch(fieldManager)),
 		SecretAdditionalOutputFormatsMismatch,
-		SecretAdditionalOutputFormatsManagedFieldsMismatch(fieldManager),
+		unlessSecretShared(SecretAdditionalOutputFormatsManagedFieldsMismatch(fieldManager)),
 		SecretOwnerReferenceMismatch(ownerRefEnabled),
-		SecretOwnerReferenceManagedFieldMismatch(ownerRefEnabled, fieldManager),
+		unlessSecretShared(SecretOwnerReferenceManagedFieldMismatch(ownerRefEnabled, fieldManager)),
+		SecretCACertificatePolicyMismatch,
+		SecretCertificateChainTruncationMismatch,
 
 		SecretKeystoreFormatMismatch,
 	}
@@ -127,3 +135,16 @@ func NewTemporaryCertificatePolicyChain() Chain {
 		SecretPublicKeysDiffer, // Make sure the PrivateKey and PublicKey match in the Secret
 	}
 }
+
+// unlessSecretShared skips the given check for Certificates which share their
+// Secret with another controller. Checks of the managed fields of a Secret
+// would otherwise fire each time the other controller takes over a field,
+// causing both controllers to update the Secret in turn.
+func unlessSecretShared(check Func) Func {
+	return func(input Input) (string, string, bool) {
+		if internalcertificates.SharesSecret(input.Certificate) {
+			return "", "", false
+		}
+		return check(input)
+	}
+}
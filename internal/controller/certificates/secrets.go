@@ -19,6 +19,9 @@ package certificates
 import (
 	"bytes"
 	"crypto/x509"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/cert-manager/cert-manager/internal/pem"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -102,3 +105,64 @@ func OutputFormatDER(privateKey []byte) []byte {
 func OutputFormatCombinedPEM(privateKey, certificate []byte) []byte {
 	return bytes.Join([][]byte{privateKey, certificate}, []byte("\n"))
 }
+
+// OutputFormatPKCS7 returns the DER encoded "certs-only" PKCS#7 structure
+// containing the signed certificate chain. To be used for Certificate's
+// Additional Output Format PKCS7.
+func OutputFormatPKCS7(certificate []byte) ([]byte, error) {
+	certs, err := utilpki.DecodeX509CertificateChainBytes(certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodePKCS7Certificates(certs)
+}
+
+// CACertificateForPolicy returns the CA certificate to be stored in the
+// `ca.crt` key of a Certificate's Secret, given the Certificate's
+// caCertificatePolicy, the PEM encoded signed certificate chain and the PEM
+// encoded CA certificate provided by the issuer, which may be empty.
+func CACertificateForPolicy(policy cmapi.CACertificatePolicy, certificate, ca []byte) ([]byte, error) {
+	switch policy {
+	case cmapi.CACertificatePolicyOmit:
+		return nil, nil
+
+	case cmapi.CACertificatePolicyChainRoot:
+		if len(ca) > 0 || len(certificate) == 0 {
+			return ca, nil
+		}
+
+		bundle, err := utilpki.ParseSingleCertificateChainPEM(certificate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive the CA certificate from the certificate chain: %w", err)
+		}
+		return bundle.CAPEM, nil
+
+	default:
+		return ca, nil
+	}
+}
+
+// AdoptsSecret returns true if the Certificate has requested to adopt its
+// existing Secret and the Secret has not yet been issued or adopted by
+// cert-manager, i.e. it is not annotated with the name of a Certificate.
+func AdoptsSecret(crt *cmapi.Certificate, secret *corev1.Secret) bool {
+	if crt.Annotations[cmapi.AdoptExistingSecretAnnotation] != "true" || crt.Status.Revision != nil || secret == nil {
+		return false
+	}
+	_, ok := secret.Annotations[cmapi.CertificateNameKey]
+	return !ok
+}
+
+// SignalsRotation returns true if the Certificate has requested its Secret to
+// be annotated with the revision of the stored certificate, so that consumers
+// watching the Secret can detect when the certificate is rotated.
+func SignalsRotation(crt *cmapi.Certificate) bool {
+	return crt.Annotations[cmapi.RotationSignalAnnotation] == "true"
+}
+
+// SharesSecret returns true if the Certificate has declared that its Secret
+// is also written by another controller.
+func SharesSecret(crt *cmapi.Certificate) bool {
+	return crt.Annotations[cmapi.SharedSecretOwnershipAnnotation] == "true"
+}
@@ -17,13 +17,23 @@ limitations under the License.
 package certificates
 
 import (
+	"bytes"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/asn1"
 	"net"
 	"net/url"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
 
 func Test_AnnotationsForCertificateSecret(t *testing.T) {
@@ -137,3 +147,165 @@ func Test_AnnotationsForCertificateSecret(t *testing.T) {
 		})
 	}
 }
+
+func Test_OutputFormatPKCS7(t *testing.T) {
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	leaf := testcrypto.MustCreateCert(t, pk, gen.Certificate("leaf", gen.SetCertificateDNSNames("example.com")))
+	intermediate := testcrypto.MustCreateCert(t, pk, gen.Certificate("intermediate", gen.SetCertificateCommonName("intermediate"), gen.SetCertificateIsCA(true)))
+	chain := append(append(leaf, '\n'), intermediate...)
+
+	expCerts, err := pki.DecodeX509CertificateChainBytes(chain)
+	require.NoError(t, err)
+
+	p7b, err := OutputFormatPKCS7(chain)
+	require.NoError(t, err)
+
+	var contentInfo pkcs7ContentInfo
+	rest, err := asn1.Unmarshal(p7b, &contentInfo)
+	require.NoError(t, err)
+	assert.Empty(t, rest)
+	assert.True(t, contentInfo.ContentType.Equal(oidPKCS7SignedData))
+	assert.Equal(t, asn1.ClassContextSpecific, contentInfo.Content.Class)
+	assert.Equal(t, 0, contentInfo.Content.Tag)
+
+	var signedData pkcs7SignedData
+	rest, err = asn1.Unmarshal(contentInfo.Content.Bytes, &signedData)
+	require.NoError(t, err)
+	assert.Empty(t, rest)
+	assert.Equal(t, 1, signedData.Version)
+	assert.Empty(t, signedData.DigestAlgorithms)
+	assert.Empty(t, signedData.SignerInfos)
+	assert.True(t, signedData.ContentInfo.ContentType.Equal(oidPKCS7Data))
+
+	// The certificates are a DER SET OF, so are sorted by their encoding
+	// rather than in the order of the chain.
+	require.Len(t, signedData.Certificates, len(expCerts))
+	var gotCerts []*x509.Certificate
+	for i, rawCert := range signedData.Certificates {
+		if i > 0 {
+			assert.LessOrEqual(t, bytes.Compare(signedData.Certificates[i-1].FullBytes, rawCert.FullBytes), 0, "certificates are not sorted")
+		}
+		cert, err := x509.ParseCertificate(rawCert.FullBytes)
+		require.NoError(t, err)
+		gotCerts = append(gotCerts, cert)
+	}
+	for i := range expCerts {
+		assert.True(t, slices.ContainsFunc(gotCerts, expCerts[i].Equal), "certificate %d is missing", i)
+	}
+
+	_, err = OutputFormatPKCS7([]byte("invalid"))
+	assert.Error(t, err)
+}
+
+func Test_CACertificateForPolicy(t *testing.T) {
+	leaf, intermediate, root := testcrypto.MustCreateCertificateChain(t)
+	chainWithRoot := bytes.Join([][]byte{leaf, intermediate, root}, nil)
+	chainWithoutRoot := bytes.Join([][]byte{leaf, intermediate}, nil)
+	issuerCA := []byte("issuer-provided-ca")
+
+	tests := map[string]struct {
+		policy      cmapi.CACertificatePolicy
+		certificate []byte
+		ca          []byte
+		expCA       []byte
+		expErr      bool
+	}{
+		"unset policy stores the issuer provided CA": {
+			certificate: chainWithRoot,
+			ca:          issuerCA,
+			expCA:       issuerCA,
+		},
+		"unset policy does not derive a CA if the issuer provides none": {
+			certificate: chainWithRoot,
+		},
+		"Issuer policy does not derive a CA if the issuer provides none": {
+			policy:      cmapi.CACertificatePolicyIssuer,
+			certificate: chainWithRoot,
+		},
+		"ChainRoot policy prefers the issuer provided CA": {
+			policy:      cmapi.CACertificatePolicyChainRoot,
+			certificate: chainWithRoot,
+			ca:          issuerCA,
+			expCA:       issuerCA,
+		},
+		"ChainRoot policy derives the root of the chain if the issuer provides no CA": {
+			policy:      cmapi.CACertificatePolicyChainRoot,
+			certificate: chainWithRoot,
+			expCA:       root,
+		},
+		"ChainRoot policy derives the highest certificate of a chain without a root": {
+			policy:      cmapi.CACertificatePolicyChainRoot,
+			certificate: chainWithoutRoot,
+			expCA:       intermediate,
+		},
+		"ChainRoot policy derives no CA from a single certificate": {
+			policy:      cmapi.CACertificatePolicyChainRoot,
+			certificate: leaf,
+		},
+		"ChainRoot policy returns an error for a broken chain": {
+			policy:      cmapi.CACertificatePolicyChainRoot,
+			certificate: bytes.Join([][]byte{leaf, root}, nil),
+			expErr:      true,
+		},
+		"Omit policy drops the issuer provided CA": {
+			policy:      cmapi.CACertificatePolicyOmit,
+			certificate: chainWithRoot,
+			ca:          issuerCA,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ca, err := CACertificateForPolicy(test.policy, test.certificate, test.ca)
+			if test.expErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, string(test.expCA), string(ca))
+		})
+	}
+}
+
+func Test_AdoptsSecret(t *testing.T) {
+	adopting := gen.Certificate("test", gen.AddCertificateAnnotations(map[string]string{cmapi.AdoptExistingSecretAnnotation: "true"}))
+	unmanagedSecret := gen.Secret("test")
+	issuedSecret := gen.Secret("test", gen.SetSecretAnnotations(map[string]string{cmapi.CertificateNameKey: "test"}))
+
+	tests := map[string]struct {
+		crt    *cmapi.Certificate
+		secret *corev1.Secret
+		exp    bool
+	}{
+		"adopts a Secret which has not been issued by cert-manager": {
+			crt:    adopting,
+			secret: unmanagedSecret,
+			exp:    true,
+		},
+		"does not adopt without the annotation": {
+			crt:    gen.Certificate("test"),
+			secret: unmanagedSecret,
+		},
+		"does not adopt if the annotation is not true": {
+			crt:    gen.Certificate("test", gen.AddCertificateAnnotations(map[string]string{cmapi.AdoptExistingSecretAnnotation: "false"})),
+			secret: unmanagedSecret,
+		},
+		"does not adopt a Secret which does not exist": {
+			crt: adopting,
+		},
+		"does not adopt a Secret which has been issued for a Certificate": {
+			crt:    adopting,
+			secret: issuedSecret,
+		},
+		"does not adopt once the Certificate has been issued": {
+			crt:    gen.CertificateFrom(adopting, gen.SetCertificateRevision(1)),
+			secret: unmanagedSecret,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.exp, AdoptsSecret(test.crt, test.secret))
+		})
+	}
+}
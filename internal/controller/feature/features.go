@@ -142,6 +142,23 @@ const (
 	// GitHub Issue: https://github.com/cert-manager/cert-manager/issues/7266
 	UseDomainQualifiedFinalizer featuregate.Feature = "UseDomainQualifiedFinalizer"
 
+	// Owner: @peschmae
+	// Alpha: v1.18
+	//
+	// AdditionalCertificateSecrets enables writing a Certificate's private key
+	// and signed certificate chain to additional Secrets, each in its own
+	// format, using the `additionalSecrets` field on the Certificate's spec.
+	AdditionalCertificateSecrets featuregate.Feature = "AdditionalCertificateSecrets"
+
+	// Owner: @peschmae
+	// Alpha: v1.18
+	//
+	// DeterministicPrivateKeys enables deriving a Certificate's private key
+	// from a seed Secret using the `privateKey.seedSecretRef` field, so that
+	// recreating an environment results in the same private key.
+	// This must never be enabled in production clusters.
+	DeterministicPrivateKeys featuregate.Feature = "DeterministicPrivateKeys"
+
 	// Owner: N/A
 	// Alpha: v0.7.2
 	// Deprecated: v1.17
@@ -177,6 +194,8 @@ var defaultCertManagerFeatureGates = map[featuregate.Feature]featuregate.Feature
 	NameConstraints:                                  {Default: true, PreRelease: featuregate.Beta},
 	OtherNames:                                       {Default: false, PreRelease: featuregate.Alpha},
 	UseDomainQualifiedFinalizer:                      {Default: true, PreRelease: featuregate.Beta},
+	AdditionalCertificateSecrets:                     {Default: false, PreRelease: featuregate.Alpha},
+	DeterministicPrivateKeys:                         {Default: false, PreRelease: featuregate.Alpha},
 
 	// NB: Deprecated + removed feature gates are kept here.
 	// `featuregate.Deprecated` exists, but will cause the featuregate library
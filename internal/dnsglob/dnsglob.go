@@ -0,0 +1,137 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dnsglob matches DNS names against glob patterns.
+//
+// A pattern is matched against a DNS name label by label, ignoring case. Each
+// label of the pattern is a glob as understood by path.Match, so `*` matches
+// any sequence of characters within a single label, `?` matches a single
+// character, and `[...]` matches a character class. The leftmost label of a
+// pattern may also be `**`, which matches one or more labels.
+//
+// For example, `*.example.com` matches `www.example.com` but not
+// `a.b.example.com`, which is matched by `**.example.com`.
+package dnsglob
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// anyLabels is the leftmost label of a pattern which matches one or more
+// labels.
+const anyLabels = "**"
+
+// Pattern is a parsed DNS name glob pattern.
+type Pattern struct {
+	pattern string
+
+	// labels are the labels of the pattern, excluding a leading `**`.
+	labels []string
+
+	// anyDepth is true if the pattern starts with `**`.
+	anyDepth bool
+
+	// literals is the number of characters of the pattern's labels which are
+	// not wildcards, used to rank the specificity of patterns.
+	literals int
+}
+
+// Parse parses the given DNS name glob pattern.
+func Parse(pattern string) (*Pattern, error) {
+	if pattern == "" {
+		return nil, errors.New("pattern must not be empty")
+	}
+
+	labels := strings.Split(strings.ToLower(pattern), ".")
+	p := &Pattern{pattern: pattern}
+	if labels[0] == anyLabels {
+		p.anyDepth = true
+		labels = labels[1:]
+		if len(labels) == 0 {
+			return nil, errors.New("pattern must contain at least one label after `**`")
+		}
+	}
+
+	for _, label := range labels {
+		if label == "" {
+			return nil, errors.New("pattern must not contain empty labels")
+		}
+		if strings.Contains(label, anyLabels) {
+			return nil, errors.New("`**` is only allowed as the leftmost label")
+		}
+		for _, r := range label {
+			switch {
+			case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_':
+				p.literals++
+			case r == '*', r == '?', r == '[', r == ']', r == '^':
+			default:
+				return nil, fmt.Errorf("invalid character %q", r)
+			}
+		}
+		// Matching against an empty name reports whether the pattern is
+		// well-formed, e.g. that its character classes are closed.
+		if _, err := path.Match(label, ""); err != nil {
+			return nil, fmt.Errorf("invalid label %q: %w", label, err)
+		}
+	}
+	p.labels = labels
+
+	return p, nil
+}
+
+// String returns the pattern as it was given to Parse.
+func (p *Pattern) String() string {
+	return p.pattern
+}
+
+// Match returns true if the given DNS name matches the pattern.
+func (p *Pattern) Match(name string) bool {
+	nameLabels := strings.Split(strings.ToLower(strings.TrimSuffix(name, ".")), ".")
+
+	switch {
+	case p.anyDepth && len(nameLabels) <= len(p.labels):
+		return false
+	case !p.anyDepth && len(nameLabels) != len(p.labels):
+		return false
+	}
+
+	// Compare the rightmost labels, so that any extra labels of the name are
+	// matched by a leading `**`.
+	nameLabels = nameLabels[len(nameLabels)-len(p.labels):]
+	for i, label := range p.labels {
+		if ok, _ := path.Match(label, nameLabels[i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// MoreSpecificThan returns true if the pattern matches a narrower set of DNS
+// names than the other pattern. Patterns are ranked by the number of
+// characters which are not wildcards, then patterns without a leading `**`
+// rank above those with one, then patterns with more labels rank higher.
+func (p *Pattern) MoreSpecificThan(other *Pattern) bool {
+	if p.literals != other.literals {
+		return p.literals > other.literals
+	}
+	if p.anyDepth != other.anyDepth {
+		return other.anyDepth
+	}
+	return len(p.labels) > len(other.labels)
+}
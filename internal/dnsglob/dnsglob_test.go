@@ -0,0 +1,122 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnsglob
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for _, pattern := range []string{
+		"example.com",
+		"*.example.com",
+		"**.example.com",
+		"api-*.example.com",
+		"web-?.[a-c]*.example.com",
+		"*",
+		"Example.COM",
+	} {
+		_, err := Parse(pattern)
+		assert.NoError(t, err, "pattern %q should be valid", pattern)
+	}
+
+	for _, pattern := range []string{
+		"",
+		"**",
+		"example..com",
+		".example.com",
+		"example.com.",
+		"a.**.example.com",
+		"***.example.com",
+		"exa mple.com",
+		"example.com/path",
+		`\*.example.com`,
+		"[a-.example.com",
+	} {
+		_, err := Parse(pattern)
+		assert.Error(t, err, "pattern %q should be invalid", pattern)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	tests := map[string]struct {
+		matches, doesNotMatch []string
+	}{
+		"example.com": {
+			matches:      []string{"example.com", "EXAMPLE.com", "example.com."},
+			doesNotMatch: []string{"www.example.com", "example.org", "com"},
+		},
+		"*.example.com": {
+			matches:      []string{"www.example.com", "*.example.com"},
+			doesNotMatch: []string{"example.com", "a.b.example.com", "www.example.org"},
+		},
+		"**.example.com": {
+			matches:      []string{"www.example.com", "a.b.example.com"},
+			doesNotMatch: []string{"example.com", "www.example.org", "wwwexample.com"},
+		},
+		"api-*.internal.example.com": {
+			matches:      []string{"api-1.internal.example.com", "api-.internal.example.com"},
+			doesNotMatch: []string{"web-1.internal.example.com", "api-1.external.example.com"},
+		},
+		"web-?.example.com": {
+			matches:      []string{"web-1.example.com"},
+			doesNotMatch: []string{"web-10.example.com", "web-.example.com"},
+		},
+	}
+
+	for pattern, test := range tests {
+		t.Run(pattern, func(t *testing.T) {
+			p, err := Parse(pattern)
+			require.NoError(t, err)
+
+			for _, name := range test.matches {
+				assert.True(t, p.Match(name), "%q should match %q", pattern, name)
+			}
+			for _, name := range test.doesNotMatch {
+				assert.False(t, p.Match(name), "%q should not match %q", pattern, name)
+			}
+		})
+	}
+}
+
+func TestMoreSpecificThan(t *testing.T) {
+	// Each pattern is more specific than all of the patterns following it.
+	ordered := []string{
+		"www.internal.example.com",
+		"w*.internal.example.com",
+		"*.internal.example.com",
+		"**.internal.example.com",
+		"*.example.com",
+		"**.example.com",
+		"**.com",
+	}
+
+	for i := range ordered {
+		p, err := Parse(ordered[i])
+		require.NoError(t, err)
+
+		for j := range ordered {
+			other, err := Parse(ordered[j])
+			require.NoError(t, err)
+
+			assert.Equal(t, i < j, p.MoreSpecificThan(other), "%q more specific than %q", ordered[i], ordered[j])
+		}
+	}
+}
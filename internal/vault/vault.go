@@ -36,8 +36,11 @@ import (
 	"k8s.io/utils/ptr"
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util"
 	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
@@ -115,6 +118,14 @@ func New(ctx context.Context, namespace string, createTokenFn func(ns string) Cr
 		return nil, fmt.Errorf("error initializing Vault client: %s", err.Error())
 	}
 
+	if headers := issuer.GetSpec().Vault.HTTPHeaders; len(headers) > 0 {
+		// Only the names of the headers are logged, as their values may be
+		// credentials.
+		logf.FromContext(ctx).V(logf.DebugLevel).Info("setting custom HTTP headers on requests to Vault", "headers", util.HTTPHeaderNames(headers))
+	}
+	// The headers are copied to the namespaced clients below.
+	v.addHTTPHeaders(client)
+
 	// Set the Vault namespace.
 	// An empty namespace string will cause the client to not send the namespace related HTTP headers to Vault.
 	clientNS := client.WithNamespace(issuer.GetSpec().Vault.Namespace)
@@ -158,6 +169,17 @@ func (v *Vault) Sign(csrPEM []byte, duration time.Duration) (cert []byte, ca []b
 	}
 
 	vaultIssuer := v.issuer.GetSpec().Vault
+
+	// Vault cannot round the expiry time of the certificates it signs, so it
+	// is given the rounded expiry time instead of the TTL.
+	if boundary := vaultIssuer.NotAfterBoundary; boundary != "" {
+		now := time.Now()
+		if notAfter := apiutil.AlignNotAfter(now.Add(duration), boundary); notAfter.After(now) {
+			delete(parameters, "ttl")
+			parameters["not_after"] = notAfter.Format(time.RFC3339)
+		}
+	}
+
 	url := path.Join("/v1", vaultIssuer.Path)
 
 	request := v.client.NewRequest("POST", url)
@@ -236,6 +258,14 @@ func (v *Vault) setToken(ctx context.Context, client Client) error {
 	return cmerrors.NewInvalidData("error initializing Vault client: tokenSecretRef, appRoleSecretRef, clientCertificate, or Kubernetes auth role not set")
 }
 
+// addHTTPHeaders adds the custom HTTP headers of the issuer to the headers the
+// client sets on all requests.
+func (v *Vault) addHTTPHeaders(client *vault.Client) {
+	for name, value := range v.issuer.GetSpec().Vault.HTTPHeaders {
+		client.AddHeader(name, value)
+	}
+}
+
 func (v *Vault) newConfig() (*vault.Config, error) {
 	cfg := vault.DefaultConfig()
 	cfg.Address = v.issuer.GetSpec().Vault.Server
@@ -473,10 +503,12 @@ func (v *Vault) requestTokenWithClientCertificate(client Client, clientCertifica
 		tmpTransport := cfg.HttpClient.Transport.(*http.Transport).Clone()
 		tmpTransport.TLSClientConfig.Certificates = append(tmpTransport.TLSClientConfig.Certificates, clientCertificate)
 		cfg.HttpClient.Transport = tmpTransport
-		client, err = vault.NewClient(cfg)
+		tmpClient, err := vault.NewClient(cfg)
 		if err != nil {
 			return "", fmt.Errorf("error initializing intermediary Vault client: %s", err.Error())
 		}
+		v.addHTTPHeaders(tmpClient)
+		client = tmpClient
 	}
 
 	parameters := map[string]string{
@@ -616,6 +648,22 @@ func (v *Vault) requestTokenWithKubernetesAuth(ctx context.Context, client Clien
 	return token, nil
 }
 
+// VerifyClientAuthOnly returns an error if the given PEM encoded certificate
+// is not valid only for client authentication. cert-manager cannot change the
+// usages of the certificates signed by Vault, which are determined by the
+// Vault PKI role.
+func VerifyClientAuthOnly(certPEM []byte) error {
+	cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		return err
+	}
+	if !apiutil.IsClientAuthOnly(cert) {
+		return fmt.Errorf("the certificate has the extended key usages %v, but the Vault PKI role must only allow %q",
+			apiutil.ExtKeyUsageStrings(cert.ExtKeyUsage), v1.UsageClientAuth)
+	}
+	return nil
+}
+
 func extractCertificatesFromVaultCertificateSecret(secret *certutil.Secret) ([]byte, []byte, error) {
 	parsedBundle, err := certutil.ParsePKIMap(secret.Data)
 	if err != nil {
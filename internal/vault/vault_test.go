@@ -22,9 +22,11 @@ import (
 	"crypto"
 	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -378,6 +380,42 @@ func TestSign(t *testing.T) {
 	}
 }
 
+func TestSignNotAfterBoundary(t *testing.T) {
+	privatekey := generateRSAPrivateKey(t)
+	csrPEM := generateCSR(t, privatekey)
+
+	bundleData, err := bundlePEM(testIntermediateCa)
+	require.NoError(t, err)
+
+	duration := 90 * 24 * time.Hour
+	var gotParameters map[string]string
+	fakeClient := vaultfake.NewFakeClient().WithRawRequestFn(func(t *testing.T, req *vault.Request) (*vault.Response, error) {
+		gotParameters = req.Obj.(map[string]string)
+		return &vault.Response{Response: &http.Response{Body: io.NopCloser(bytes.NewReader(bundleData))}}, nil
+	})
+	fakeClient.T = t
+
+	v := &Vault{
+		namespace: "test-namespace",
+		issuer: gen.Issuer("vault-issuer",
+			gen.SetIssuerVault(cmapi.VaultIssuer{NotAfterBoundary: cmapi.NotAfterBoundaryDay}),
+		),
+		client: fakeClient,
+	}
+
+	before := time.Now()
+	_, _, err = v.Sign(csrPEM, duration)
+	require.NoError(t, err)
+
+	// The expiry time is requested from Vault instead of the TTL.
+	assert.NotContains(t, gotParameters, "ttl")
+	notAfter, err := time.Parse(time.RFC3339, gotParameters["not_after"])
+	require.NoError(t, err)
+	assert.Equal(t, notAfter, notAfter.Truncate(24*time.Hour), "expected not_after to be rounded down to midnight UTC")
+	assert.True(t, notAfter.After(before.Add(duration-24*time.Hour)), "expected not_after to be less than a day before the requested expiry")
+	assert.False(t, notAfter.After(time.Now().Add(duration)), "expected not_after not to be after the requested expiry")
+}
+
 type testExtractCertificatesFromVaultCertT struct {
 	secret       *certutil.Secret
 	expectedCert string
@@ -418,6 +456,51 @@ func TestExtractCertificatesFromVaultCertificateSecret(t *testing.T) {
 	}
 }
 
+func TestVerifyClientAuthOnly(t *testing.T) {
+	pk := generateRSAPrivateKey(t)
+
+	signedCertPEM := func(usages ...x509.ExtKeyUsage) []byte {
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "test"},
+			NotBefore:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+			ExtKeyUsage:  usages,
+		}
+		certPEM, _, err := pki.SignCertificate(template, template, pk.Public(), pk)
+		require.NoError(t, err)
+		return certPEM
+	}
+
+	tests := map[string]struct {
+		certPEM     []byte
+		expectedErr string
+	}{
+		"certificate with only client auth": {
+			certPEM: signedCertPEM(x509.ExtKeyUsageClientAuth),
+		},
+		"certificate with server and client auth": {
+			certPEM:     signedCertPEM(x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth),
+			expectedErr: `the certificate has the extended key usages [server auth client auth], but the Vault PKI role must only allow "client auth"`,
+		},
+		"certificate without extended key usages": {
+			certPEM:     signedCertPEM(),
+			expectedErr: `the certificate has the extended key usages [], but the Vault PKI role must only allow "client auth"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := VerifyClientAuthOnly(test.certPEM)
+			if test.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.expectedErr)
+			}
+		})
+	}
+}
+
 func TestSetToken(t *testing.T) {
 	tokenSecret := &corev1.Secret{
 		Data: map[string][]byte{
@@ -1639,15 +1722,18 @@ func TestNewWithVaultNamespaces(t *testing.T) {
 }
 
 // TestIsVaultInitiatedAndUnsealedIntegration demonstrates that it interacts only with the
-// sys/health endpoint and that it supplies the Vault token but not a Vault namespace header.
+// sys/health endpoint and that it supplies the Vault token and custom HTTP headers
+// but not a Vault namespace header.
 func TestIsVaultInitiatedAndUnsealedIntegration(t *testing.T) {
 
 	const vaultToken = "token1"
+	const gatewayKey = "gateway-key"
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/v1/sys/health", func(response http.ResponseWriter, request *http.Request) {
 		assert.Empty(t, request.Header.Values("X-Vault-Namespace"), "Unexpected Vault namespace header for root-only API path")
 		assert.Equal(t, vaultToken, request.Header.Get("X-Vault-Token"), "Expected the Vault token for root-only API path")
+		assert.Equal(t, gatewayKey, request.Header.Get("X-Api-Gateway-Key"), "Expected the custom HTTP header for root-only API path")
 	})
 	server := httptest.NewServer(mux)
 	defer server.Close()
@@ -1674,6 +1760,9 @@ func TestIsVaultInitiatedAndUnsealedIntegration(t *testing.T) {
 					Vault: &v1.VaultIssuer{
 						Server:    server.URL,
 						Namespace: "ns1",
+						HTTPHeaders: map[string]string{
+							"X-API-Gateway-Key": gatewayKey,
+						},
 						Auth: cmapi.VaultAuth{
 							TokenSecretRef: &cmmeta.SecretKeySelector{
 								LocalObjectReference: cmmeta.LocalObjectReference{
@@ -1693,13 +1782,14 @@ func TestIsVaultInitiatedAndUnsealedIntegration(t *testing.T) {
 }
 
 // TestSignIntegration demonstrates that it interacts only with the API endpoint
-// path supplied in the Issuer resource and that it supplies the Vault namespace
-// and token to that endpoint.
+// path supplied in the Issuer resource and that it supplies the Vault namespace,
+// token and custom HTTP headers to that endpoint.
 func TestSignIntegration(t *testing.T) {
 	const (
 		vaultToken     = "token1"
 		vaultNamespace = "vault-ns-1"
 		vaultPath      = "my_pki_mount/sign/my-role-name"
+		gatewayKey     = "gateway-key"
 	)
 
 	privatekey := generateRSAPrivateKey(t)
@@ -1712,6 +1802,7 @@ func TestSignIntegration(t *testing.T) {
 	mux.HandleFunc(fmt.Sprintf("/v1/%s", vaultPath), func(response http.ResponseWriter, request *http.Request) {
 		assert.Equal(t, vaultNamespace, request.Header.Get("X-Vault-Namespace"), "Expected Vault namespace header for namespaced API path")
 		assert.Equal(t, vaultToken, request.Header.Get("X-Vault-Token"), "Expected the Vault token for root-only API path")
+		assert.Equal(t, gatewayKey, request.Header.Get("X-Api-Gateway-Key"), "Expected the custom HTTP header for namespaced API path")
 		_, err := response.Write(rootBundleData)
 		require.NoError(t, err)
 	})
@@ -1741,6 +1832,9 @@ func TestSignIntegration(t *testing.T) {
 						Server:    server.URL,
 						Path:      vaultPath,
 						Namespace: vaultNamespace,
+						HTTPHeaders: map[string]string{
+							"X-API-Gateway-Key": gatewayKey,
+						},
 						Auth: cmapi.VaultAuth{
 							TokenSecretRef: &cmmeta.SecretKeySelector{
 								LocalObjectReference: cmmeta.LocalObjectReference{
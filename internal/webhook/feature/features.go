@@ -81,6 +81,23 @@ const (
 	// Certificate resources.
 	// Github Issue: https://github.com/cert-manager/cert-manager/issues/6393
 	OtherNames featuregate.Feature = "OtherNames"
+
+	// Owner: @peschmae
+	// Alpha: v1.18
+	//
+	// AdditionalCertificateSecrets enables writing a Certificate's private key
+	// and signed certificate chain to additional Secrets, each in its own
+	// format, using the `additionalSecrets` field on the Certificate's spec.
+	AdditionalCertificateSecrets featuregate.Feature = "AdditionalCertificateSecrets"
+
+	// Owner: @peschmae
+	// Alpha: v1.18
+	//
+	// DeterministicPrivateKeys enables deriving a Certificate's private key
+	// from a seed Secret using the `privateKey.seedSecretRef` field, so that
+	// recreating an environment results in the same private key.
+	// This must never be enabled in production clusters.
+	DeterministicPrivateKeys featuregate.Feature = "DeterministicPrivateKeys"
 )
 
 func init() {
@@ -101,4 +118,6 @@ var webhookFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	LiteralCertificateSubject:          {Default: true, PreRelease: featuregate.Beta},
 	NameConstraints:                    {Default: true, PreRelease: featuregate.Beta},
 	OtherNames:                         {Default: false, PreRelease: featuregate.Alpha},
+	AdditionalCertificateSecrets:       {Default: false, PreRelease: featuregate.Alpha},
+	DeterministicPrivateKeys:           {Default: false, PreRelease: featuregate.Alpha},
 }
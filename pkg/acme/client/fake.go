@@ -38,6 +38,7 @@ type FakeACME struct {
 	FakeGetChallenge            func(ctx context.Context, url string) (*acme.Challenge, error)
 	FakeGetAuthorization        func(ctx context.Context, url string) (*acme.Authorization, error)
 	FakeWaitAuthorization       func(ctx context.Context, url string) (*acme.Authorization, error)
+	FakeRevokeAuthorization     func(ctx context.Context, url string) error
 	FakeRegister                func(ctx context.Context, a *acme.Account, prompt func(tosURL string) bool) (*acme.Account, error)
 	FakeGetReg                  func(ctx context.Context, url string) (*acme.Account, error)
 	FakeHTTP01ChallengeResponse func(token string) (string, error)
@@ -111,6 +112,13 @@ func (f *FakeACME) WaitAuthorization(ctx context.Context, url string) (*acme.Aut
 	return nil, fmt.Errorf("WaitAuthorization not implemented")
 }
 
+func (f *FakeACME) RevokeAuthorization(ctx context.Context, url string) error {
+	if f.FakeRevokeAuthorization != nil {
+		return f.FakeRevokeAuthorization(ctx, url)
+	}
+	return fmt.Errorf("RevokeAuthorization not implemented")
+}
+
 func (f *FakeACME) Register(ctx context.Context, a *acme.Account, prompt func(tosURL string) bool) (*acme.Account, error) {
 	if f.FakeRegister != nil {
 		return f.FakeRegister(ctx, a, prompt)
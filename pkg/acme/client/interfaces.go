@@ -47,6 +47,10 @@ type Interface interface {
 	// WaitAuthorization will, in success cases, be called once per
 	// Challenge after it has been accepted.
 	WaitAuthorization(ctx context.Context, url string) (*acme.Authorization, error)
+	// RevokeAuthorization deactivates an authorization. It will be called
+	// when an authorization has remained in the 'processing' state for longer
+	// than the configured challenge processing timeout.
+	RevokeAuthorization(ctx context.Context, url string) error
 	Register(ctx context.Context, acct *acme.Account, prompt func(tosURL string) bool) (*acme.Account, error)
 	GetReg(ctx context.Context, url string) (*acme.Account, error)
 	// HTTP01ChallengeResponse will be called once when a cert-manager.io
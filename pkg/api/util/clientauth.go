@@ -0,0 +1,68 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/x509"
+	"slices"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// ClientAuthOnlyApplies returns true if the given policy applies to a request
+// in the given namespace. Requests which are not namespaced, such as
+// Kubernetes CertificateSigningRequests, are passed an empty namespace and
+// are always subject to a policy.
+func ClientAuthOnlyApplies(policy *v1.ClientAuthOnlyPolicy, namespace string) bool {
+	if policy == nil {
+		return false
+	}
+	if namespace == "" || len(policy.Namespaces) == 0 {
+		return true
+	}
+	return slices.Contains(policy.Namespaces, namespace)
+}
+
+// ClientAuthOnlyPolicyForIssuer returns the ClientAuthOnly policy of the given
+// issuer, or nil if it has none. Only CA and Vault issuers support the policy.
+func ClientAuthOnlyPolicyForIssuer(issuer v1.GenericIssuer) *v1.ClientAuthOnlyPolicy {
+	spec := issuer.GetSpec()
+	switch {
+	case spec.CA != nil:
+		return spec.CA.ClientAuthOnly
+	case spec.Vault != nil:
+		return spec.Vault.ClientAuthOnly
+	default:
+		return nil
+	}
+}
+
+// RestrictCertificateTemplateToClientAuth replaces the extended key usages of
+// the given certificate template with only client authentication, removing
+// any other requested extended key usages such as server authentication.
+func RestrictCertificateTemplateToClientAuth(template *x509.Certificate) {
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	template.UnknownExtKeyUsage = nil
+}
+
+// IsClientAuthOnly returns true if the given certificate is valid only for
+// client authentication. A certificate without any extended key usages is
+// valid for any purpose, so is not client authentication only.
+func IsClientAuthOnly(cert *x509.Certificate) bool {
+	return len(cert.UnknownExtKeyUsage) == 0 &&
+		slices.Equal(cert.ExtKeyUsage, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth})
+}
@@ -0,0 +1,151 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"reflect"
+	"testing"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestClientAuthOnlyApplies(t *testing.T) {
+	tests := map[string]struct {
+		policy    *v1.ClientAuthOnlyPolicy
+		namespace string
+		expApply  bool
+	}{
+		"no policy": {
+			namespace: "zero-trust",
+		},
+		"policy without namespaces applies to all namespaces": {
+			policy:    &v1.ClientAuthOnlyPolicy{},
+			namespace: "default",
+			expApply:  true,
+		},
+		"policy applies to listed namespace": {
+			policy:    &v1.ClientAuthOnlyPolicy{Namespaces: []string{"default", "zero-trust"}},
+			namespace: "zero-trust",
+			expApply:  true,
+		},
+		"policy does not apply to other namespaces": {
+			policy:    &v1.ClientAuthOnlyPolicy{Namespaces: []string{"zero-trust"}},
+			namespace: "default",
+		},
+		"policy always applies to requests which are not namespaced": {
+			policy:   &v1.ClientAuthOnlyPolicy{Namespaces: []string{"zero-trust"}},
+			expApply: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if apply := ClientAuthOnlyApplies(test.policy, test.namespace); apply != test.expApply {
+				t.Errorf("unexpected result, exp=%t got=%t", test.expApply, apply)
+			}
+		})
+	}
+}
+
+func TestClientAuthOnlyPolicyForIssuer(t *testing.T) {
+	policy := &v1.ClientAuthOnlyPolicy{Namespaces: []string{"zero-trust"}}
+
+	tests := map[string]struct {
+		spec      v1.IssuerSpec
+		expPolicy *v1.ClientAuthOnlyPolicy
+	}{
+		"CA issuer with a policy": {
+			spec:      v1.IssuerSpec{IssuerConfig: v1.IssuerConfig{CA: &v1.CAIssuer{ClientAuthOnly: policy}}},
+			expPolicy: policy,
+		},
+		"Vault issuer with a policy": {
+			spec:      v1.IssuerSpec{IssuerConfig: v1.IssuerConfig{Vault: &v1.VaultIssuer{ClientAuthOnly: policy}}},
+			expPolicy: policy,
+		},
+		"CA issuer without a policy": {
+			spec: v1.IssuerSpec{IssuerConfig: v1.IssuerConfig{CA: &v1.CAIssuer{}}},
+		},
+		"issuer type which does not support the policy": {
+			spec: v1.IssuerSpec{IssuerConfig: v1.IssuerConfig{SelfSigned: &v1.SelfSignedIssuer{}}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			issuer := &v1.Issuer{Spec: test.spec}
+			if got := ClientAuthOnlyPolicyForIssuer(issuer); got != test.expPolicy {
+				t.Errorf("unexpected policy, exp=%v got=%v", test.expPolicy, got)
+			}
+		})
+	}
+}
+
+func TestRestrictCertificateTemplateToClientAuth(t *testing.T) {
+	template := &x509.Certificate{
+		KeyUsage:           x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:        []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		UnknownExtKeyUsage: []asn1.ObjectIdentifier{{1, 2, 3, 4}},
+	}
+	RestrictCertificateTemplateToClientAuth(template)
+
+	if exp := []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}; !reflect.DeepEqual(template.ExtKeyUsage, exp) {
+		t.Errorf("unexpected extended key usages, exp=%v got=%v", exp, template.ExtKeyUsage)
+	}
+	if len(template.UnknownExtKeyUsage) != 0 {
+		t.Errorf("expected unknown extended key usages to be removed, got=%v", template.UnknownExtKeyUsage)
+	}
+	if template.KeyUsage != x509.KeyUsageDigitalSignature {
+		t.Errorf("expected key usage to be unchanged, got=%v", template.KeyUsage)
+	}
+	if !IsClientAuthOnly(template) {
+		t.Errorf("expected restricted template to be client auth only")
+	}
+}
+
+func TestIsClientAuthOnly(t *testing.T) {
+	tests := map[string]struct {
+		cert *x509.Certificate
+		exp  bool
+	}{
+		"client auth only": {
+			cert: &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}},
+			exp:  true,
+		},
+		"server and client auth": {
+			cert: &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}},
+		},
+		"no extended key usages is valid for any purpose": {
+			cert: &x509.Certificate{},
+		},
+		"client auth and an unknown extended key usage": {
+			cert: &x509.Certificate{
+				ExtKeyUsage:        []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+				UnknownExtKeyUsage: []asn1.ObjectIdentifier{{1, 2, 3, 4}},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := IsClientAuthOnly(test.cert); got != test.exp {
+				t.Errorf("unexpected result, exp=%t got=%t", test.exp, got)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package util
 
 import (
+	"crypto/x509"
+	"slices"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,3 +36,57 @@ func DefaultCertDuration(d *metav1.Duration) time.Duration {
 
 	return certDuration
 }
+
+// RequesterMaxDuration returns the MaxDuration of the first policy which
+// matches the requester with the given username and groups. The boolean
+// return value is false if no policy matches.
+func RequesterMaxDuration(policies []v1.RequesterDurationPolicy, username string, groups []string) (time.Duration, bool) {
+	for _, policy := range policies {
+		if username != "" && slices.Contains(policy.Usernames, username) {
+			return policy.MaxDuration.Duration, true
+		}
+		for _, group := range groups {
+			if slices.Contains(policy.Groups, group) {
+				return policy.MaxDuration.Duration, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// ClampCertificateTemplateDuration shortens the validity of the given
+// certificate template such that it does not exceed maxDuration.
+func ClampCertificateTemplateDuration(template *x509.Certificate, maxDuration time.Duration) {
+	if template.NotAfter.Sub(template.NotBefore) > maxDuration {
+		template.NotAfter = template.NotBefore.Add(maxDuration)
+	}
+}
+
+// AlignNotAfter rounds the given expiry time down to the given boundary in
+// UTC. The expiry time is returned unchanged if the boundary is not set.
+func AlignNotAfter(notAfter time.Time, boundary v1.NotAfterBoundary) time.Time {
+	notAfter = notAfter.UTC()
+	switch boundary {
+	case v1.NotAfterBoundaryHour:
+		return notAfter.Truncate(time.Hour)
+	case v1.NotAfterBoundaryDay:
+		return time.Date(notAfter.Year(), notAfter.Month(), notAfter.Day(), 0, 0, 0, 0, time.UTC)
+	default:
+		return notAfter
+	}
+}
+
+// AlignCertificateTemplateNotAfter rounds the expiry time of the given
+// certificate template down to the given boundary in UTC, and moves its start
+// time back by the same amount so that its duration is unchanged. The
+// template is left unchanged if its expiry time would be rounded down to its
+// start time or before.
+func AlignCertificateTemplateNotAfter(template *x509.Certificate, boundary v1.NotAfterBoundary) {
+	notAfter := AlignNotAfter(template.NotAfter, boundary)
+	if !notAfter.After(template.NotBefore) {
+		return
+	}
+	template.NotBefore = template.NotBefore.Add(notAfter.Sub(template.NotAfter))
+	template.NotAfter = notAfter
+}
@@ -0,0 +1,125 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/x509"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestRequesterMaxDuration(t *testing.T) {
+	policies := []v1.RequesterDurationPolicy{
+		{Groups: []string{"system:serviceaccounts"}, MaxDuration: metav1.Duration{Duration: time.Hour * 24}},
+		{Usernames: []string{"alice"}, Groups: []string{"admins"}, MaxDuration: metav1.Duration{Duration: time.Hour * 24 * 90}},
+	}
+
+	tests := map[string]struct {
+		username    string
+		groups      []string
+		expDuration time.Duration
+		expMatch    bool
+	}{
+		"service account matches by group": {
+			username:    "system:serviceaccount:default:app",
+			groups:      []string{"system:serviceaccounts", "system:serviceaccounts:default", "system:authenticated"},
+			expDuration: time.Hour * 24,
+			expMatch:    true,
+		},
+		"human matches by username": {
+			username:    "alice",
+			groups:      []string{"system:authenticated"},
+			expDuration: time.Hour * 24 * 90,
+			expMatch:    true,
+		},
+		"first matching policy wins": {
+			username:    "alice",
+			groups:      []string{"system:serviceaccounts"},
+			expDuration: time.Hour * 24,
+			expMatch:    true,
+		},
+		"no policy matches": {
+			username: "bob",
+			groups:   []string{"system:authenticated"},
+		},
+		"empty username does not match": {},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			duration, match := RequesterMaxDuration(policies, test.username, test.groups)
+			if match != test.expMatch || duration != test.expDuration {
+				t.Errorf("unexpected result, exp=(%s, %t) got=(%s, %t)", test.expDuration, test.expMatch, duration, match)
+			}
+		})
+	}
+}
+
+func TestClampCertificateTemplateDuration(t *testing.T) {
+	notBefore := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	template := &x509.Certificate{NotBefore: notBefore, NotAfter: notBefore.Add(time.Hour * 24 * 90)}
+	ClampCertificateTemplateDuration(template, time.Hour*24)
+	if exp := notBefore.Add(time.Hour * 24); !template.NotAfter.Equal(exp) {
+		t.Errorf("expected NotAfter to be clamped to %s, got %s", exp, template.NotAfter)
+	}
+
+	template = &x509.Certificate{NotBefore: notBefore, NotAfter: notBefore.Add(time.Hour)}
+	ClampCertificateTemplateDuration(template, time.Hour*24)
+	if exp := notBefore.Add(time.Hour); !template.NotAfter.Equal(exp) {
+		t.Errorf("expected NotAfter to be unchanged at %s, got %s", exp, template.NotAfter)
+	}
+}
+
+func TestAlignNotAfter(t *testing.T) {
+	notAfter := time.Date(2024, 3, 14, 15, 9, 26, 535, time.FixedZone("UTC+2", 2*60*60))
+
+	if got, exp := AlignNotAfter(notAfter, ""), notAfter; !got.Equal(exp) {
+		t.Errorf("expected NotAfter to be unchanged at %s, got %s", exp, got)
+	}
+	if got, exp := AlignNotAfter(notAfter, v1.NotAfterBoundaryHour), time.Date(2024, 3, 14, 13, 0, 0, 0, time.UTC); !got.Equal(exp) {
+		t.Errorf("expected NotAfter to be rounded down to %s, got %s", exp, got)
+	}
+	if got, exp := AlignNotAfter(notAfter, v1.NotAfterBoundaryDay), time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC); !got.Equal(exp) {
+		t.Errorf("expected NotAfter to be rounded down to %s, got %s", exp, got)
+	}
+}
+
+func TestAlignCertificateTemplateNotAfter(t *testing.T) {
+	notBefore := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+
+	template := &x509.Certificate{NotBefore: notBefore, NotAfter: notBefore.Add(time.Hour * 24 * 90)}
+	AlignCertificateTemplateNotAfter(template, v1.NotAfterBoundaryDay)
+	if exp := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC); !template.NotAfter.Equal(exp) {
+		t.Errorf("expected NotAfter to be rounded down to %s, got %s", exp, template.NotAfter)
+	}
+	if exp := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC); !template.NotBefore.Equal(exp) {
+		t.Errorf("expected NotBefore to be moved back to %s, got %s", exp, template.NotBefore)
+	}
+
+	// A certificate which expires before the next boundary after it starts
+	// cannot be aligned.
+	template = &x509.Certificate{NotBefore: notBefore, NotAfter: notBefore.Add(time.Hour)}
+	AlignCertificateTemplateNotAfter(template, v1.NotAfterBoundaryDay)
+	if exp := notBefore.Add(time.Hour); !template.NotAfter.Equal(exp) {
+		t.Errorf("expected NotAfter to be unchanged at %s, got %s", exp, template.NotAfter)
+	}
+}
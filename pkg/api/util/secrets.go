@@ -0,0 +1,68 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+// DNS01SolverSecretNames returns the names of the Secrets holding the
+// credentials of the given DNS01 solver. The Secrets are located in the
+// resource namespace of the solver's issuer. Secrets referenced by the
+// config of webhook solvers are not known, and are not returned.
+func DNS01SolverSecretNames(dns01 *cmacme.ACMEChallengeSolverDNS01) []string {
+	if dns01 == nil {
+		return nil
+	}
+
+	var names []string
+	add := func(selectors ...*cmmeta.SecretKeySelector) {
+		for _, selector := range selectors {
+			if selector != nil && selector.Name != "" {
+				names = append(names, selector.Name)
+			}
+		}
+	}
+
+	if p := dns01.Akamai; p != nil {
+		add(&p.ClientToken, &p.ClientSecret, &p.AccessToken)
+	}
+	if p := dns01.CloudDNS; p != nil {
+		add(p.ServiceAccount)
+	}
+	if p := dns01.Cloudflare; p != nil {
+		add(p.APIKey, p.APIToken)
+	}
+	if p := dns01.Route53; p != nil {
+		add(p.SecretAccessKeyID, &p.SecretAccessKey)
+	}
+	if p := dns01.AzureDNS; p != nil {
+		add(p.ClientSecret)
+	}
+	if p := dns01.DigitalOcean; p != nil {
+		add(&p.Token)
+	}
+	if p := dns01.AcmeDNS; p != nil {
+		add(&p.AccountSecret)
+	}
+	if p := dns01.RFC2136; p != nil {
+		add(&p.TSIGSecret)
+	}
+
+	return names
+}
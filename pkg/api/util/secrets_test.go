@@ -0,0 +1,89 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+func TestDNS01SolverSecretNames(t *testing.T) {
+	selector := func(name string) cmmeta.SecretKeySelector {
+		return cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: name}, Key: "key"}
+	}
+	selectorRef := func(name string) *cmmeta.SecretKeySelector {
+		s := selector(name)
+		return &s
+	}
+
+	tests := map[string]struct {
+		dns01    *cmacme.ACMEChallengeSolverDNS01
+		expNames []string
+	}{
+		"no solver": {},
+		"solver without credentials": {
+			dns01: &cmacme.ACMEChallengeSolverDNS01{
+				Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{Region: "eu-west-1"},
+			},
+		},
+		"akamai": {
+			dns01: &cmacme.ACMEChallengeSolverDNS01{
+				Akamai: &cmacme.ACMEIssuerDNS01ProviderAkamai{
+					ClientToken:  selector("client-token"),
+					ClientSecret: selector("client-secret"),
+					AccessToken:  selector("access-token"),
+				},
+			},
+			expNames: []string{"client-token", "client-secret", "access-token"},
+		},
+		"cloudflare with an api token": {
+			dns01: &cmacme.ACMEChallengeSolverDNS01{
+				Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{APIToken: selectorRef("cloudflare")},
+			},
+			expNames: []string{"cloudflare"},
+		},
+		"route53 with static credentials": {
+			dns01: &cmacme.ACMEChallengeSolverDNS01{
+				Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{
+					SecretAccessKeyID: selectorRef("route53-id"),
+					SecretAccessKey:   selector("route53-key"),
+				},
+			},
+			expNames: []string{"route53-id", "route53-key"},
+		},
+		"rfc2136": {
+			dns01: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver: "1.2.3.4:53",
+					TSIGSecret: selector("tsig"),
+				},
+			},
+			expNames: []string{"tsig"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if names := DNS01SolverSecretNames(test.dns01); !reflect.DeepEqual(names, test.expNames) {
+				t.Errorf("unexpected secret names, exp=%v got=%v", test.expNames, names)
+			}
+		})
+	}
+}
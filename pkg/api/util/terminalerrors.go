@@ -0,0 +1,49 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"regexp"
+	"strings"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// MatchesTerminalError returns true if the message of the given error matches
+// any of the given matchers. Matchers with an invalid regular expression never
+// match, since they are rejected by the webhook and can only be present if
+// validation was bypassed.
+func MatchesTerminalError(matchers []v1.TerminalErrorMatcher, err error) bool {
+	if err == nil {
+		return false
+	}
+	message := err.Error()
+	for _, matcher := range matchers {
+		switch {
+		case matcher.Contains != "":
+			if strings.Contains(message, matcher.Contains) {
+				return true
+			}
+		case matcher.Regex != "":
+			re, reErr := regexp.Compile(matcher.Regex)
+			if reErr == nil && re.MatchString(message) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"errors"
+	"testing"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestMatchesTerminalError(t *testing.T) {
+	matchers := []v1.TerminalErrorMatcher{
+		{Contains: "policy violation"},
+		{Regex: `^error code (4001|4002):`},
+		{Regex: "invalid ("},
+	}
+
+	tests := map[string]struct {
+		matchers []v1.TerminalErrorMatcher
+		err      error
+		exp      bool
+	}{
+		"no error": {
+			matchers: matchers,
+		},
+		"no matchers": {
+			err: errors.New("policy violation: key too small"),
+		},
+		"error containing substring": {
+			matchers: matchers,
+			err:      errors.New("CA rejected request: policy violation: key too small"),
+			exp:      true,
+		},
+		"error matching regex": {
+			matchers: matchers,
+			err:      errors.New("error code 4002: unsupported key type"),
+			exp:      true,
+		},
+		"error code not at start of message": {
+			matchers: matchers,
+			err:      errors.New("request failed, error code 4002: unsupported key type"),
+		},
+		"unmatched error": {
+			matchers: matchers,
+			err:      errors.New("connection refused"),
+		},
+		"invalid regex never matches": {
+			matchers: matchers,
+			err:      errors.New("invalid (request)"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := MatchesTerminalError(test.matchers, test.err); got != test.exp {
+				t.Errorf("unexpected result, exp=%t got=%t", test.exp, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/x509"
+	"math/bits"
+	"slices"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// UsagesViolatingPolicy returns the requested usages which are not permitted
+// by the given issuer usage policy. Usages are compared by the x509 usage they
+// map to, so aliases such as `signing` and `digital signature` are treated as
+// the same usage.
+func UsagesViolatingPolicy(policy *v1.IssuerUsagePolicy, keyUsage x509.KeyUsage, extKeyUsage []x509.ExtKeyUsage) []v1.KeyUsage {
+	if policy == nil {
+		return nil
+	}
+
+	allowedKU, allowedEKU := x509Usages(policy.AllowedUsages)
+	forbiddenKU, forbiddenEKU := x509Usages(policy.ForbiddenUsages)
+	restricted := len(policy.AllowedUsages) > 0
+
+	var violations []v1.KeyUsage
+	for i := uint(0); i < bits.UintSize; i++ {
+		ku := keyUsage & (1 << i)
+		if ku == 0 {
+			continue
+		}
+		if ku&forbiddenKU != 0 || (restricted && ku&allowedKU == 0) {
+			violations = append(violations, keyUsageString(ku))
+		}
+	}
+	for _, eku := range extKeyUsage {
+		if slices.Contains(forbiddenEKU, eku) || (restricted && !slices.Contains(allowedEKU, eku)) {
+			if usage := extKeyUsageString(eku); !slices.Contains(violations, usage) {
+				violations = append(violations, usage)
+			}
+		}
+	}
+
+	return violations
+}
+
+// x509Usages returns the x509 key usages and extended key usages for the given
+// usages. Unknown usages are ignored.
+func x509Usages(usages []v1.KeyUsage) (x509.KeyUsage, []x509.ExtKeyUsage) {
+	var (
+		ku  x509.KeyUsage
+		eku []x509.ExtKeyUsage
+	)
+	for _, usage := range usages {
+		if u, ok := KeyUsageType(usage); ok {
+			ku |= u
+		} else if u, ok := ExtKeyUsageType(usage); ok {
+			eku = append(eku, u)
+		}
+	}
+	return ku, eku
+}
@@ -0,0 +1,90 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"crypto/x509"
+	"reflect"
+	"testing"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestUsagesViolatingPolicy(t *testing.T) {
+	forbidding := &v1.IssuerUsagePolicy{
+		ForbiddenUsages: []v1.KeyUsage{v1.UsageCertSign, v1.UsageCodeSigning},
+	}
+	allowing := &v1.IssuerUsagePolicy{
+		AllowedUsages: []v1.KeyUsage{v1.UsageSigning, v1.UsageKeyEncipherment, v1.UsageServerAuth, v1.UsageSMIME},
+	}
+
+	tests := map[string]struct {
+		policy      *v1.IssuerUsagePolicy
+		keyUsage    x509.KeyUsage
+		extKeyUsage []x509.ExtKeyUsage
+		exp         []v1.KeyUsage
+	}{
+		"no policy": {
+			keyUsage:    x509.KeyUsageCertSign,
+			extKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		},
+		"no forbidden usages requested": {
+			policy:      forbidding,
+			keyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			extKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		},
+		"forbidden key usage requested": {
+			policy:   forbidding,
+			keyUsage: x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+			exp:      []v1.KeyUsage{v1.UsageCertSign},
+		},
+		"forbidden extended key usage requested": {
+			policy:      forbidding,
+			keyUsage:    x509.KeyUsageDigitalSignature,
+			extKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageCodeSigning},
+			exp:         []v1.KeyUsage{v1.UsageCodeSigning},
+		},
+		"only allowed usages requested, matching aliases": {
+			policy:      allowing,
+			keyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			extKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageEmailProtection},
+		},
+		"usages which are not allowed requested": {
+			policy:      allowing,
+			keyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageCRLSign,
+			extKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageClientAuth},
+			exp:         []v1.KeyUsage{v1.UsageCRLSign, v1.UsageClientAuth},
+		},
+		"allowed and forbidden usages": {
+			policy: &v1.IssuerUsagePolicy{
+				AllowedUsages:   []v1.KeyUsage{v1.UsageDigitalSignature, v1.UsageServerAuth},
+				ForbiddenUsages: []v1.KeyUsage{v1.UsageCertSign},
+			},
+			keyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+			extKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			exp:         []v1.KeyUsage{v1.UsageCertSign},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := UsagesViolatingPolicy(test.policy, test.keyUsage, test.extKeyUsage); !reflect.DeepEqual(got, test.exp) {
+				t.Errorf("unexpected violating usages, exp=%v got=%v", test.exp, got)
+			}
+		})
+	}
+}
@@ -143,4 +143,26 @@ type ChallengeStatus struct {
 	// If not set, the state of the challenge is unknown.
 	// +optional
 	State State `json:"state,omitempty"`
+
+	// Identifies the solver configuration that was selected for this
+	// challenge from the issuer's list of solvers.
+	// This field is set by the 'challenges' controller when the challenge is
+	// first presented.
+	// +optional
+	Solver *ChallengeSolverStatus `json:"solver,omitempty"`
+}
+
+// ChallengeSolverStatus identifies the solver configuration that was selected
+// for a challenge.
+type ChallengeSolverStatus struct {
+	// The type of the selected solver, given as the path of the configured
+	// solver within the solver configuration, for example `http01.ingress`,
+	// `http01.gatewayHTTPRoute` or `dns01.route53`.
+	Type string `json:"type"`
+
+	// The selector of the selected solver.
+	// If not set, the selected solver has no selector and is used for any
+	// challenge that a more specific solver does not match.
+	// +optional
+	Selector *CertificateDNSNameSelector `json:"selector,omitempty"`
 }
@@ -19,6 +19,7 @@ package v1
 import (
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwapi "sigs.k8s.io/gateway-api/apis/v1"
 
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -114,6 +115,13 @@ type ACMEIssuer struct {
 	// Defaults to false.
 	// +optional
 	EnableDurationFeature bool `json:"enableDurationFeature,omitempty"`
+
+	// HTTPHeaders are additional HTTP headers which are set on all requests
+	// made to the ACME server, for example to authenticate with an egress
+	// gateway. Headers which are managed by the ACME client, such as
+	// Content-Type, User-Agent and Authorization, may not be set.
+	// +optional
+	HTTPHeaders map[string]string `json:"httpHeaders,omitempty"`
 }
 
 // ACMEExternalAccountBinding is a reference to a CA external account of the ACME
@@ -204,6 +212,26 @@ type CertificateDNSNameSelector struct {
 	// will be selected.
 	// +optional
 	DNSZones []string `json:"dnsZones,omitempty"`
+
+	// List of DNS zones whose CNAME strategy overrides the `cnameStrategy`
+	// of this solver's dns01 configuration, for challenged domains within
+	// that zone. This allows a single solver to handle both delegated and
+	// non-delegated zones. If multiple zones match a domain, the most
+	// specific zone's strategy applies.
+	// +optional
+	// +listType=atomic
+	DNSZoneCNAMEStrategies []DNSZoneCNAMEStrategy `json:"dnsZoneCNAMEStrategies,omitempty"`
+}
+
+// DNSZoneCNAMEStrategy configures the CNAME strategy used for challenged
+// domains within a DNS zone.
+type DNSZoneCNAMEStrategy struct {
+	// The DNS zone that this strategy applies to, for example example.com.
+	DNSZone string `json:"dnsZone"`
+
+	// CNAMEStrategy configures how the DNS01 provider should handle CNAME
+	// records when found for domains within the DNS zone.
+	CNAMEStrategy CNAMEStrategy `json:"cnameStrategy"`
 }
 
 // ACMEChallengeSolverHTTP01 contains configuration detailing how to solve
@@ -226,6 +254,28 @@ type ACMEChallengeSolverHTTP01 struct {
 	// This solver is experimental, and fields / behaviour may change in the future.
 	// +optional
 	GatewayHTTPRoute *ACMEChallengeSolverHTTP01GatewayHTTPRoute `json:"gatewayHTTPRoute,omitempty"`
+
+	// SelfCheck configures the self check cert-manager performs to verify that
+	// the challenge token is reachable before asking the ACME server to
+	// validate the challenge.
+	// +optional
+	SelfCheck *ACMEChallengeSolverHTTP01SelfCheck `json:"selfCheck,omitempty"`
+}
+
+// ACMEChallengeSolverHTTP01SelfCheck configures the HTTP01 self check.
+// The self check is made up of several requests to the challenge URL which must
+// all succeed, and must complete within 15 minutes.
+type ACMEChallengeSolverHTTP01SelfCheck struct {
+	// Timeout is the time to wait for a response to each self check request.
+	// Increase this for validation targets which are slow to respond.
+	// Must be greater than 0s and not greater than 15m. Defaults to 10s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Interval is the time to wait between self check requests.
+	// Must be greater than 0s and not greater than 15m. Defaults to 2s.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
 }
 
 type ACMEChallengeSolverHTTP01Ingress struct {
@@ -378,6 +428,15 @@ type ACMEChallengeSolverDNS01 struct {
 	// +optional
 	CNAMEStrategy CNAMEStrategy `json:"cnameStrategy,omitempty"`
 
+	// CleanupDelay is the amount of time to wait after a challenge has been
+	// successfully validated before the DNS01 challenge record is removed.
+	// Challenge records of challenges which failed are removed immediately.
+	// The challenge continues to count towards the maximum number of
+	// concurrent challenges until its record has been removed.
+	// Must not be greater than 1h. Defaults to no delay.
+	// +optional
+	CleanupDelay *metav1.Duration `json:"cleanupDelay,omitempty"`
+
 	// Use the Akamai DNS zone management API to manage DNS01 challenge records.
 	// +optional
 	Akamai *ACMEIssuerDNS01ProviderAkamai `json:"akamai,omitempty"`
@@ -532,6 +591,39 @@ type ACMEIssuerDNS01ProviderCloudDNS struct {
 	// If left empty cert-manager will automatically choose a zone.
 	// +optional
 	HostedZoneName string `json:"hostedZoneName,omitempty"`
+
+	// FederatedCredentials authenticates with Google Cloud DNS using
+	// Workload Identity Federation, by exchanging a bound ServiceAccount
+	// token for short-lived credentials. The `audience` of the federated
+	// credentials must be set to the full resource name of the workload
+	// identity pool provider.
+	// Cannot be set when serviceAccountSecretRef is set.
+	// +optional
+	FederatedCredentials *ACMEIssuerDNS01FederatedCredentials `json:"federatedCredentials,omitempty"`
+}
+
+// ACMEIssuerDNS01FederatedCredentials configures a DNS01 provider to obtain
+// short-lived credentials from its cloud provider by exchanging a bound
+// ServiceAccount token using OIDC federation. The credentials are refreshed
+// automatically before they expire.
+type ACMEIssuerDNS01FederatedCredentials struct {
+	// A reference to a service account that will be used to request bound
+	// tokens (also known as "projected tokens") to exchange. The service
+	// account must be in the namespace of the Issuer, or in the cluster
+	// resource namespace for a ClusterIssuer. To use this field, you must
+	// configure an RBAC rule to let cert-manager request a token.
+	// A new token is requested each time new credentials are obtained.
+	// If its audiences are unset, they default to `sts.amazonaws.com` for
+	// Route53, `api://AzureADTokenExchange` for AzureDNS, and the `audience`
+	// prefixed with `https:` for CloudDNS.
+	ServiceAccountRef ServiceAccountRef `json:"serviceAccountRef"`
+
+	// Audience is the audience of the token exchange. It is required by and
+	// only supported by the CloudDNS provider, where it is the full resource
+	// name of the workload identity pool provider, for example
+	// `//iam.googleapis.com/projects/<number>/locations/global/workloadIdentityPools/<pool>/providers/<provider>`.
+	// +optional
+	Audience string `json:"audience,omitempty"`
 }
 
 // ACMEIssuerDNS01ProviderCloudflare is a structure containing the DNS
@@ -624,6 +716,14 @@ type ACMEIssuerDNS01ProviderRoute53 struct {
 	//
 	// +optional
 	Region string `json:"region,omitempty"`
+
+	// FederatedCredentials authenticates with Route53 using
+	// AssumeRoleWithWebIdentity, by exchanging a bound ServiceAccount
+	// token for short-lived credentials of the role given by `role`, which
+	// must be set.
+	// Cannot be set when access keys or `auth` are set.
+	// +optional
+	FederatedCredentials *ACMEIssuerDNS01FederatedCredentials `json:"federatedCredentials,omitempty"`
 }
 
 // Route53Auth is configuration used to authenticate with a Route53.
@@ -649,9 +749,10 @@ type ServiceAccountRef struct {
 	Name string `json:"name"`
 
 	// TokenAudiences is an optional list of audiences to include in the
-	// token passed to AWS. The default token consisting of the issuer's namespace
-	// and name is always included.
-	// If unset the audience defaults to `sts.amazonaws.com`.
+	// token passed to the cloud provider. The default token consisting of the
+	// issuer's namespace and name is always included.
+	// If unset the audience defaults to `sts.amazonaws.com`, unless the
+	// referencing field documents another default.
 	// +optional
 	TokenAudiences []string `json:"audiences,omitempty"`
 }
@@ -696,6 +797,14 @@ type ACMEIssuerDNS01ProviderAzureDNS struct {
 	// If set, ClientID, ClientSecret and TenantID must not be set.
 	// +optional
 	ManagedIdentity *AzureManagedIdentity `json:"managedIdentity,omitempty"`
+
+	// Auth: Azure Workload Identity Federation:
+	// Authenticates with Azure DNS by exchanging a bound ServiceAccount
+	// token for short-lived credentials of the application given by ClientID
+	// and TenantID, which must be set.
+	// If set, ClientSecret and ManagedIdentity must not be set.
+	// +optional
+	FederatedCredentials *ACMEIssuerDNS01FederatedCredentials `json:"federatedCredentials,omitempty"`
 }
 
 // AzureManagedIdentity contains the configuration for Azure Workload Identity or Azure Managed Service Identity
@@ -92,9 +92,38 @@ const (
 	// Annotation key for the 'group' of the Issuer resource.
 	IssuerGroupAnnotationKey = "cert-manager.io/issuer-group"
 
+	// Annotation key for the revision of the Certificate resource which the
+	// certificate stored in the Secret resource was issued for. It is only set
+	// on the Secrets of Certificates which set the RotationSignalAnnotation.
+	// The key is shared with CertificateRequestRevisionAnnotationKey on
+	// purpose, as the Secret records the revision of the CertificateRequest
+	// it was issued from.
+	SecretCertificateRevisionAnnotationKey = CertificateRequestRevisionAnnotationKey
+
+	// Annotation key set to "true" on the Secret of a Certificate whose
+	// expired certificate has been replaced with a temporary self-signed
+	// certificate, following the Certificate's expiredCertificatePolicy.
+	FallbackCertificateAnnotationKey = "cert-manager.io/fallback-certificate"
+
+	// Annotation key set on an Issuer or ClusterIssuer resource to give it a
+	// stable alias. An issuerRef whose name does not match any Issuer (or
+	// ClusterIssuer) will be resolved to the single Issuer (or ClusterIssuer)
+	// of that kind carrying this annotation with a value equal to the name.
+	// Aliases of Issuers are scoped to the Issuer's namespace.
+	IssuerAliasAnnotationKey = "cert-manager.io/issuer-alias"
+
 	// Annotation key for the name of the certificate that a resource is related to.
 	CertificateNameKey = "cert-manager.io/certificate-name"
 
+	// Annotation key set on a Secret to allow Certificates in other namespaces
+	// to write to it as one of their additional Secrets. The value is a comma
+	// separated list of `<namespace>/<name>` Certificate references.
+	AllowedCertificatesAnnotationKey = "cert-manager.io/allowed-certificates"
+
+	// Label key set on a Namespace to allow Certificates in that namespace to
+	// use deterministic private keys derived from a seed Secret.
+	AllowDeterministicPrivateKeysLabelKey = "cert-manager.io/allow-deterministic-private-keys"
+
 	// Annotation key used to denote whether a Secret is named on a Certificate
 	// as a 'next private key' Secret resource.
 	IsNextPrivateKeySecretLabelKey = "cert-manager.io/next-private-key"
@@ -152,6 +181,15 @@ const (
 	// IngressSecretTemplate can be used to set the secretTemplate field in the generated Certificate.
 	// The value is a JSON representation of secretTemplate and must not have any unknown fields.
 	IngressSecretTemplate = "cert-manager.io/secret-template"
+
+	// GatewayCertificateModeAnnotationKey can be set on a Gateway to choose how
+	// its listeners are grouped into Certificates.
+	// If set to `Consolidated`, one Certificate is created per secretName,
+	// containing the hostnames of every listener which references that Secret.
+	// If set to `PerListener`, one Certificate is created per listener, and
+	// listeners must not share a secretName.
+	// If unset, `Consolidated` is used.
+	GatewayCertificateModeAnnotationKey = "cert-manager.io/gateway-certificate-mode"
 )
 
 // Annotation names for CertificateRequests
@@ -165,6 +203,11 @@ const (
 
 	// Annotation to declare the CertificateRequest "revision", belonging to a Certificate Resource
 	CertificateRequestRevisionAnnotationKey = "cert-manager.io/certificate-revision"
+
+	// Annotation added to the canary CertificateRequests of a Certificate
+	// which sets spec.canaryIssuerRef, recording the generation of the
+	// Certificate the canary was requested for.
+	CertificateRequestCanaryGenerationAnnotationKey = "cert-manager.io/canary-generation"
 )
 
 const (
@@ -174,6 +217,37 @@ const (
 	// stored in the target Secret resource whilst the real Issuer is processing
 	// the certificate request.
 	IssueTemporaryCertificateAnnotation = "cert-manager.io/issue-temporary-certificate"
+
+	// AdoptExistingSecretAnnotation is an annotation that can be added to
+	// Certificate resources.
+	// If it is set to "true" and the target Secret already contains a
+	// certificate which matches the Certificate's spec and has not expired,
+	// the Secret is adopted without re-issuing: the Certificate is marked as
+	// Ready and is renewed based on the existing certificate's expiry.
+	AdoptExistingSecretAnnotation = "cert-manager.io/adopt-existing-secret"
+
+	// OCSPStapleAnnotation is an annotation that can be added to Certificate
+	// resources.
+	// If it is set to "true", the OCSP response for the issued certificate is
+	// fetched from the OCSP responder named in the certificate and stored in
+	// the target Secret resource, where it is refreshed before it goes stale.
+	OCSPStapleAnnotation = "cert-manager.io/ocsp-staple"
+
+	// RotationSignalAnnotation is an annotation that can be added to
+	// Certificate resources.
+	// If it is set to "true", the target Secret resource is annotated with the
+	// SecretCertificateRevisionAnnotationKey, which changes each time a new
+	// certificate is stored, so that consumers watching the Secret can detect
+	// the rotation and reload the certificate.
+	RotationSignalAnnotation = "cert-manager.io/rotation-signal"
+
+	// SharedSecretOwnershipAnnotation is an annotation that can be added to
+	// Certificate resources whose target Secret is also written by another
+	// controller.
+	// If it is set to "true", cert-manager only checks the values of the keys,
+	// labels and annotations it writes to the Secret, rather than which fields
+	// it manages, and never deletes the Secret to recreate it.
+	SharedSecretOwnershipAnnotation = "cert-manager.io/shared-secret-ownership"
 )
 
 // Common/known resource kinds.
@@ -299,6 +373,11 @@ const (
 	KeystorePassword = "keystorePassword"
 )
 
+// OCSPStapleSecretKey is the name of the data entry in the Secret resource
+// used to store the DER encoded OCSP response of a Certificate which has the
+// OCSPStapleAnnotation set.
+const OCSPStapleSecretKey = "tls.ocsp"
+
 // DefaultKeyUsages contains the default list of key usages
 func DefaultKeyUsages() []KeyUsage {
 	// The serverAuth EKU is required as of Mac OS Catalina: https://support.apple.com/en-us/HT210176
@@ -91,6 +91,69 @@ type IssuerList struct {
 // configuration required for the issuer.
 type IssuerSpec struct {
 	IssuerConfig `json:",inline"`
+
+	// DefaultSecretTemplate defines labels and annotations to be copied to the
+	// Secret of every Certificate issued by this issuer. These are merged with
+	// the Certificate's own `secretTemplate`, whose values take precedence for
+	// keys that are set in both.
+	// +optional
+	DefaultSecretTemplate *CertificateSecretTemplate `json:"defaultSecretTemplate,omitempty"`
+
+	// TerminalErrors lists matchers for errors returned by this issuer which
+	// will never succeed on retry, such as policy violations reported by the
+	// signing CA. If an error returned while signing a CertificateRequest
+	// matches any of these, the CertificateRequest is marked as Failed
+	// instead of the request being retried.
+	// +optional
+	// +listType=atomic
+	TerminalErrors []TerminalErrorMatcher `json:"terminalErrors,omitempty"`
+
+	// UsagePolicy restricts the key usages and extended key usages that may be
+	// requested from this issuer. Requests asking for a usage which is not
+	// permitted by the policy are denied, or marked as Failed without being
+	// signed if they have already been approved.
+	// +optional
+	UsagePolicy *IssuerUsagePolicy `json:"usagePolicy,omitempty"`
+
+	// DefaultExpiredCertificatePolicy is the `expiredCertificatePolicy` of
+	// Certificates issued by this issuer which do not set one.
+	// If unset, defaults to `Keep`.
+	// +optional
+	DefaultExpiredCertificatePolicy ExpiredCertificatePolicy `json:"defaultExpiredCertificatePolicy,omitempty"`
+}
+
+// IssuerUsagePolicy restricts the usages of the certificates signed by an
+// issuer. The usages of a request include the default usages when none are
+// requested, and `cert sign` when a CA certificate is requested.
+type IssuerUsagePolicy struct {
+	// AllowedUsages is the list of usages that may be requested. If set,
+	// requests for any other usage are rejected. If empty, all usages not
+	// listed in `forbiddenUsages` are allowed.
+	// +optional
+	// +listType=atomic
+	AllowedUsages []KeyUsage `json:"allowedUsages,omitempty"`
+
+	// ForbiddenUsages is the list of usages that may never be requested, such
+	// as `cert sign` or `code signing`. A usage may not be both allowed and
+	// forbidden.
+	// +optional
+	// +listType=atomic
+	ForbiddenUsages []KeyUsage `json:"forbiddenUsages,omitempty"`
+}
+
+// TerminalErrorMatcher matches the message of an error returned by an issuer.
+// Error codes returned by a CA are matched as part of the error message.
+// Exactly one of `contains` or `regex` must be set.
+type TerminalErrorMatcher struct {
+	// Contains matches errors whose message contains this string.
+	// +optional
+	Contains string `json:"contains,omitempty"`
+
+	// Regex matches errors whose message matches this regular expression,
+	// using the RE2 syntax accepted by Go. The expression is not anchored, so
+	// use `^` and `$` to match the whole message.
+	// +optional
+	Regex string `json:"regex,omitempty"`
 }
 
 // The configuration for the issuer.
@@ -121,6 +184,11 @@ type IssuerConfig struct {
 	// or Venafi Cloud policy zone.
 	// +optional
 	Venafi *VenafiIssuer `json:"venafi,omitempty"`
+
+	// GoogleCAS configures this issuer to sign certificates using a Google
+	// Cloud Certificate Authority Service (CAS) CA pool.
+	// +optional
+	GoogleCAS *GoogleCASIssuer `json:"googleCAS,omitempty"`
 }
 
 // Configures an issuer to sign certificates using a Venafi TPP
@@ -141,6 +209,13 @@ type VenafiIssuer struct {
 	// Only one of TPP or Cloud may be specified.
 	// +optional
 	Cloud *VenafiCloud `json:"cloud,omitempty"`
+
+	// HTTPHeaders are additional HTTP headers which are set on all requests
+	// made to the Venafi platform, for example to authenticate with an egress
+	// gateway. Headers which are managed by the Venafi client, such as
+	// Content-Type, User-Agent and Authorization, may not be set.
+	// +optional
+	HTTPHeaders map[string]string `json:"httpHeaders,omitempty"`
 }
 
 // VenafiTPP defines connection configuration details for a Venafi TPP instance
@@ -168,6 +243,33 @@ type VenafiTPP struct {
 	// the cert-manager controller container is used to validate the TLS connection.
 	// +optional
 	CABundleSecretRef *cmmeta.SecretKeySelector `json:"caBundleSecretRef,omitempty"`
+
+	// Approval configures cert-manager to integrate with a TPP change-approval
+	// workflow. If set, a WorkToDo custom field is set on each enrollment and
+	// certificates which are awaiting approval in TPP are reported as such,
+	// rather than as a failed or generically pending request.
+	// +optional
+	Approval *VenafiTPPApproval `json:"approval,omitempty"`
+}
+
+// VenafiTPPApproval configures how enrollments interact with a TPP
+// change-approval workflow.
+type VenafiTPPApproval struct {
+	// WorkToDoField is the name of the TPP custom field which is set on each
+	// enrollment to signal that the request should enter the approval workflow.
+	WorkToDoField string `json:"workToDoField"`
+
+	// WorkToDoValue is the value which the WorkToDo custom field is set to.
+	// Defaults to "true".
+	// +optional
+	WorkToDoValue string `json:"workToDoValue,omitempty"`
+
+	// AwaitingApprovalStatuses is a list of TPP certificate statuses which
+	// indicate that the certificate is awaiting approval. Statuses are matched
+	// case-insensitively as substrings of the status reported by TPP.
+	// Defaults to ["approval"].
+	// +optional
+	AwaitingApprovalStatuses []string `json:"awaitingApprovalStatuses,omitempty"`
 }
 
 // VenafiCloud defines connection configuration details for Venafi Cloud
@@ -181,6 +283,65 @@ type VenafiCloud struct {
 	APITokenSecretRef cmmeta.SecretKeySelector `json:"apiTokenSecretRef"`
 }
 
+// Configures an issuer to sign certificates using a Google Cloud Certificate
+// Authority Service (CAS) CA pool.
+// At most one of `serviceAccountSecretRef` or `workloadIdentity` may be set.
+// If neither is set, ambient credentials are used, if they are enabled for
+// the issuer.
+type GoogleCASIssuer struct {
+	// Project is the ID of the Google Cloud project containing the CA pool.
+	Project string `json:"project"`
+
+	// Location is the Google Cloud location of the CA pool, for example
+	// `us-central1`.
+	Location string `json:"location"`
+
+	// CAPool is the ID of the CA pool to request certificates from.
+	CAPool string `json:"caPool"`
+
+	// CertificateAuthority is the ID of the certificate authority in the CA
+	// pool which should sign certificates. If not set, CAS chooses a
+	// certificate authority from the CA pool.
+	// +optional
+	CertificateAuthority string `json:"certificateAuthority,omitempty"`
+
+	// CertificateTemplate is the resource name of a CAS certificate template
+	// to apply to issued certificates, of the form
+	// `projects/*/locations/*/certificateTemplates/*`.
+	// +optional
+	CertificateTemplate string `json:"certificateTemplate,omitempty"`
+
+	// ServiceAccountSecretRef references a key of a Secret containing the JSON
+	// key of a Google Cloud service account to authenticate with.
+	// +optional
+	ServiceAccountSecretRef *cmmeta.SecretKeySelector `json:"serviceAccountSecretRef,omitempty"`
+
+	// WorkloadIdentity authenticates with Google Cloud using workload identity
+	// federation, by exchanging a token for a Kubernetes ServiceAccount.
+	// +optional
+	WorkloadIdentity *GoogleCASWorkloadIdentity `json:"workloadIdentity,omitempty"`
+}
+
+// GoogleCASWorkloadIdentity configures authentication with Google Cloud using
+// workload identity federation.
+type GoogleCASWorkloadIdentity struct {
+	// Audience is the audience of the workload identity pool provider, of the
+	// form `//iam.googleapis.com/projects/PROJECT_NUMBER/locations/global/workloadIdentityPools/POOL_ID/providers/PROVIDER_ID`.
+	// The Kubernetes ServiceAccount token is requested with this audience.
+	Audience string `json:"audience"`
+
+	// ServiceAccountName is the name of the Kubernetes ServiceAccount to
+	// request a token for. The ServiceAccount must be in the same namespace as
+	// the Issuer, or in the cluster resource namespace for a ClusterIssuer.
+	ServiceAccountName string `json:"serviceAccountName"`
+
+	// ServiceAccountEmail is the email address of a Google Cloud service
+	// account to impersonate. If not set, the federated identity is used
+	// directly.
+	// +optional
+	ServiceAccountEmail string `json:"serviceAccountEmail,omitempty"`
+}
+
 // Configures an issuer to 'self sign' certificates using the
 // private key used to create the CertificateRequest object.
 type SelfSignedIssuer struct {
@@ -189,6 +350,34 @@ type SelfSignedIssuer struct {
 	// If not set certificate will be issued without CDP. Values are strings.
 	// +optional
 	CRLDistributionPoints []string `json:"crlDistributionPoints,omitempty"`
+
+	// SubjectKeyIdentifierMethod selects how the subject key identifier of
+	// the certificates signed by this issuer is derived from their public key.
+	// `SHA1` uses the 160-bit SHA-1 hash of the public key (RFC 5280 section
+	// 4.2.1.2 method 1), and `TruncatedSHA1` uses a 4-bit type field followed
+	// by the least significant 60 bits of that hash (method 2).
+	// If not set, only CA certificates are given a subject key identifier,
+	// derived using method 1.
+	// +optional
+	SubjectKeyIdentifierMethod SubjectKeyIdentifierMethod `json:"subjectKeyIdentifierMethod,omitempty"`
+
+	// AuthorityCertificate is a PEM encoded certificate of an external CA which
+	// cross-signs the certificates signed by this issuer. Its subject key
+	// identifier is used as the authority key identifier of the signed
+	// certificates, rather than deriving it from their own public key, so that
+	// they chain to the external CA once cross-signed. The certificate must
+	// have a subject key identifier.
+	// +optional
+	AuthorityCertificate []byte `json:"authorityCertificate,omitempty"`
+
+	// NotAfterBoundary rounds the expiry time of the certificates signed by
+	// this issuer down to a boundary in UTC, `Hour` or `Day`. The start of
+	// their validity period is moved back by the same amount, so that they
+	// keep approximately the requested duration. Certificates whose expiry
+	// time would be rounded down to before the start of their validity period
+	// are signed unaligned.
+	// +optional
+	NotAfterBoundary NotAfterBoundary `json:"notAfterBoundary,omitempty"`
 }
 
 // Configures an issuer to sign certificates using a HashiCorp Vault
@@ -236,6 +425,31 @@ type VaultIssuer struct {
 	// Vault server requires mTLS.
 	// +optional
 	ClientKeySecretRef *cmmeta.SecretKeySelector `json:"clientKeySecretRef,omitempty"`
+
+	// ClientAuthOnly requires the certificates signed by this issuer to be
+	// valid only for client authentication, regardless of the usages
+	// requested. Vault determines the usages of the certificates it signs
+	// from the PKI role, so requests are failed if Vault signs a certificate
+	// with any other extended key usage.
+	// +optional
+	ClientAuthOnly *ClientAuthOnlyPolicy `json:"clientAuthOnly,omitempty"`
+
+	// NotAfterBoundary rounds the expiry time of the certificates signed by
+	// this issuer down to a boundary in UTC, `Hour` or `Day`, by requesting
+	// that expiry time from Vault rather than a TTL. Vault does not allow the
+	// start of their validity period to be moved back, so their duration is
+	// shortened by up to an hour or a day. Certificates whose expiry time
+	// would be rounded down to the current time or before are signed with
+	// the requested TTL.
+	// +optional
+	NotAfterBoundary NotAfterBoundary `json:"notAfterBoundary,omitempty"`
+
+	// HTTPHeaders are additional HTTP headers which are set on all requests
+	// made to the Vault server, for example to authenticate with an egress
+	// gateway. Headers which are managed by the Vault client, such as
+	// Content-Type, User-Agent and Authorization, may not be set.
+	// +optional
+	HTTPHeaders map[string]string `json:"httpHeaders,omitempty"`
 }
 
 // VaultAuth is configuration used to authenticate with a Vault server. The
@@ -370,6 +584,122 @@ type CAIssuer struct {
 	// As an example, such a URL might be "http://ca.domain.com/ca.crt".
 	// +optional
 	IssuingCertificateURLs []string `json:"issuingCertificateURLs,omitempty"`
+
+	// RequesterDurationPolicies maps the identity of the requester of a
+	// CertificateRequest, as recorded in its `spec.username` and
+	// `spec.groups` fields, to the maximum duration of the certificates this
+	// issuer signs for them. The first policy which matches the requester is
+	// applied, and certificates requested with a longer duration are clamped
+	// to the policy's maxDuration. If no policy matches, the requested
+	// duration is used.
+	// For example, matching the group `system:serviceaccounts` allows service
+	// accounts to be limited to short-lived certificates.
+	// +optional
+	// +listType=atomic
+	RequesterDurationPolicies []RequesterDurationPolicy `json:"requesterDurationPolicies,omitempty"`
+
+	// SubjectKeyIdentifierMethod selects how the subject key identifier of
+	// the certificates signed by this issuer is derived from their public key.
+	// `SHA1` uses the 160-bit SHA-1 hash of the public key (RFC 5280 section
+	// 4.2.1.2 method 1), and `TruncatedSHA1` uses a 4-bit type field followed
+	// by the least significant 60 bits of that hash (method 2).
+	// If not set, only CA certificates are given a subject key identifier,
+	// derived using method 1.
+	// +optional
+	SubjectKeyIdentifierMethod SubjectKeyIdentifierMethod `json:"subjectKeyIdentifierMethod,omitempty"`
+
+	// ClientAuthOnly forces the certificates signed by this issuer to be
+	// valid only for client authentication, regardless of the usages
+	// requested.
+	// +optional
+	ClientAuthOnly *ClientAuthOnlyPolicy `json:"clientAuthOnly,omitempty"`
+
+	// NotAfterBoundary rounds the expiry time of the certificates signed by
+	// this issuer down to a boundary in UTC, `Hour` or `Day`. The start of
+	// their validity period is moved back by the same amount, so that they
+	// keep approximately the requested duration. Certificates whose expiry
+	// time would be rounded down to before the start of their validity period
+	// are signed unaligned.
+	// +optional
+	NotAfterBoundary NotAfterBoundary `json:"notAfterBoundary,omitempty"`
+
+	// RootCASecretRef references a PEM encoded bundle of CA certificates,
+	// stored in a Secret in the same namespace as the signing CA Secret, which
+	// is used to complete the chain from the signing CA up to a self-signed
+	// root. Use this when the signing CA Secret only contains an intermediate
+	// CA and its private key. The chain from the signing CA to the root must
+	// build, or the issuer will not become ready. Issued certificates then
+	// contain the chain up to, but excluding, the root, and the root is stored
+	// as the `ca.crt` of the issued certificate.
+	// If the key is not set, `ca.crt` is used.
+	// +optional
+	RootCASecretRef *cmmeta.SecretKeySelector `json:"rootCASecretRef,omitempty"`
+}
+
+// RequesterDurationPolicy limits the duration of certificates signed for
+// requesters matching its usernames or groups.
+type RequesterDurationPolicy struct {
+	// Usernames matches requesters whose username is equal to one of the
+	// given values.
+	// +optional
+	// +listType=atomic
+	Usernames []string `json:"usernames,omitempty"`
+
+	// Groups matches requesters which are a member of at least one of the
+	// given groups.
+	// +optional
+	// +listType=atomic
+	Groups []string `json:"groups,omitempty"`
+
+	// MaxDuration is the maximum duration of certificates signed for a
+	// matching requester. Requests for a longer duration are clamped to this
+	// value. Must be at least 1h.
+	MaxDuration metav1.Duration `json:"maxDuration"`
+}
+
+// SubjectKeyIdentifierMethod is a method of deriving a certificate's subject
+// key identifier from its public key, as described in RFC 5280 section
+// 4.2.1.2.
+// +kubebuilder:validation:Enum=SHA1;TruncatedSHA1
+type SubjectKeyIdentifierMethod string
+
+const (
+	// SubjectKeyIdentifierMethodSHA1 derives the subject key identifier from
+	// the 160-bit SHA-1 hash of the public key (method 1).
+	SubjectKeyIdentifierMethodSHA1 SubjectKeyIdentifierMethod = "SHA1"
+
+	// SubjectKeyIdentifierMethodTruncatedSHA1 derives the subject key
+	// identifier from a 4-bit type field with the value 0100 followed by the
+	// least significant 60 bits of the SHA-1 hash of the public key (method 2).
+	SubjectKeyIdentifierMethodTruncatedSHA1 SubjectKeyIdentifierMethod = "TruncatedSHA1"
+)
+
+// NotAfterBoundary is a boundary in UTC which the expiry time of certificates
+// is rounded down to.
+// +kubebuilder:validation:Enum=Hour;Day
+type NotAfterBoundary string
+
+const (
+	// NotAfterBoundaryHour rounds the expiry time down to the start of the
+	// hour.
+	NotAfterBoundaryHour NotAfterBoundary = "Hour"
+
+	// NotAfterBoundaryDay rounds the expiry time down to midnight UTC.
+	NotAfterBoundaryDay NotAfterBoundary = "Day"
+)
+
+// ClientAuthOnlyPolicy restricts the certificates signed by an issuer to
+// client authentication. Their extended key usages are set to only
+// `client auth`, and any other requested extended key usages such as
+// `server auth` are removed.
+type ClientAuthOnlyPolicy struct {
+	// Namespaces limits the policy to CertificateRequests in the given
+	// namespaces. If empty, the policy applies to all certificates signed by
+	// the issuer. Kubernetes CertificateSigningRequests, which are not
+	// namespaced, are always subject to the policy.
+	// +optional
+	// +listType=atomic
+	Namespaces []string `json:"namespaces,omitempty"`
 }
 
 // IssuerStatus contains status information about an Issuer
@@ -81,6 +81,63 @@ type ControllerConfiguration struct {
 	// automatically removed when the certificate resource is deleted.
 	EnableCertificateOwnerRef *bool `json:"enableCertificateOwnerRef,omitempty"`
 
+	// How the controller handles an existing Secret named by a Certificate's
+	// `spec.secretName` which is not of type `kubernetes.io/tls`.
+	// `Preserve` keeps the existing Secret type and writes the certificate data
+	// to it, `Fail` refuses to write to the Secret and fails the issuance with a
+	// condition naming the type mismatch, and `Recreate` deletes the existing
+	// Secret and creates a new one of type `kubernetes.io/tls`.
+	// Defaults to `Preserve`.
+	SecretTypeMismatchPolicy string `json:"secretTypeMismatchPolicy,omitempty"`
+
+	// How the controller handles a certificate returned by an issuer whose
+	// common name, subject alternative names or key usages do not match the
+	// CertificateRequest.
+	// `None` does not verify the returned certificate, `Warn` records a warning
+	// event on the CertificateRequest but stores the certificate, and `Strict`
+	// rejects the certificate and marks the CertificateRequest as failed.
+	// Defaults to `None`.
+	IssuedCertificateVerificationPolicy string `json:"issuedCertificateVerificationPolicy,omitempty"`
+
+	// How the controller handles Certificates in the same namespace which
+	// share a `spec.secretName`. The `SecretOwnershipConflict` condition is
+	// set on all of them in either case.
+	// `Owner` only issues the Certificate which owns the Secret, that is the
+	// Certificate named by the Secret's annotation or else the oldest
+	// Certificate, and `Refuse` does not issue any of the Certificates until
+	// the conflict is resolved.
+	// Defaults to `Owner`.
+	SecretOwnershipConflictPolicy string `json:"secretOwnershipConflictPolicy,omitempty"`
+
+	// What happens to the Secret of a Certificate which does not set
+	// `spec.secretDeletionPolicy` when the Certificate is deleted.
+	// `Retain` leaves the Secret in place, unless it is garbage collected
+	// because the Certificate owns it, and `Delete` deletes the Secret using a
+	// finalizer on the Certificate, regardless of enableCertificateOwnerRef.
+	// Defaults to `Retain`.
+	SecretDeletionPolicy string `json:"secretDeletionPolicy,omitempty"`
+
+	// The minimum size, in bits, of an RSA key which is not considered
+	// deprecated. Certificates issued for a smaller RSA key are reported with
+	// a warning Event and the `certmanager_deprecated_crypto_total` metric,
+	// but are not blocked. Set to 0 to not report RSA keys.
+	// Defaults to 2048.
+	DeprecatedCryptoMinimumRSAKeySize *int32 `json:"deprecatedCryptoMinimumRSAKeySize,omitempty"`
+
+	// The minimum size, in bits, of an ECDSA key which is not considered
+	// deprecated. Certificates issued for a key on a smaller curve are
+	// reported, but are not blocked. Set to 0 to not report ECDSA keys.
+	// Defaults to 256.
+	DeprecatedCryptoMinimumECDSAKeySize *int32 `json:"deprecatedCryptoMinimumECDSAKeySize,omitempty"`
+
+	// The minimum size, in bits, of the hash used by the signature algorithm
+	// of an issued certificate which is not considered deprecated, for
+	// example 256 to report certificates signed using SHA-1 or MD5.
+	// Certificates with a weaker signature are reported, but are not blocked.
+	// Set to 0 to not report signature algorithms.
+	// Defaults to 256.
+	DeprecatedCryptoMinimumSignatureHashSize *int32 `json:"deprecatedCryptoMinimumSignatureHashSize,omitempty"`
+
 	// Whether gateway API integration is enabled within cert-manager. The
 	// ExperimentalGatewayAPISupport feature gate must also be enabled (default
 	// as of 1.15).
@@ -97,9 +154,122 @@ type ControllerConfiguration struct {
 	// The number of concurrent workers for each controller.
 	NumberOfConcurrentWorkers *int32 `json:"numberOfConcurrentWorkers,omitempty"`
 
+	// The rate, in items per second, at which each controller processes the
+	// backlog of items queued when its informers first sync, for example
+	// after a restart or a restore of many Certificates. Once the initial
+	// backlog has been processed, items are processed as soon as they are
+	// queued. Set to 0 to not throttle the initial sync.
+	InitialSyncItemsPerSecond *float32 `json:"initialSyncItemsPerSecond,omitempty"`
+
+	// The maximum number of issuances which can be in flight at once across
+	// the certificaterequests and orders controllers, regardless of the number
+	// of workers of each controller. The issuance of a CertificateRequest, or
+	// of an Order not created for a CertificateRequest, is in flight from the
+	// time it starts until the resource reaches a final state or is deleted.
+	// Issuances beyond the limit are retried once an issuance in flight has
+	// completed. This bounds the load on the controller and the issuers during
+	// mass renewals.
+	// Set to 0 to not limit the number of concurrent issuances.
+	// Defaults to 0.
+	MaxConcurrentIssuances *int32 `json:"maxConcurrentIssuances,omitempty"`
+
 	// The maximum number of challenges that can be scheduled as 'processing' at once.
 	MaxConcurrentChallenges *int32 `json:"maxConcurrentChallenges,omitempty"`
 
+	// The maximum amount of time an accepted ACME challenge's authorization may
+	// remain in the 'processing' state on the ACME server. Once exceeded, the
+	// authorization is deactivated and the challenge is marked as errored so
+	// that a fresh authorization is requested with a new order.
+	// This should be a valid duration string, for example 10m or 1h.
+	// Defaults to 10m.
+	ChallengeProcessingTimeout *sharedv1alpha1.Duration `json:"challengeProcessingTimeout,omitempty"`
+
+	// The maximum number of ACME orders per hour which the orders controller
+	// creates for the Orders of a single namespace. This stops a single
+	// namespace from using up the rate limits of an ACME account which is
+	// shared by many namespaces. Orders exceeding the limit wait until they
+	// are allowed. Set to 0 to not limit the rate of ACME orders.
+	// Defaults to 0.
+	NamespaceACMEOrdersPerHour *int32 `json:"namespaceACMEOrdersPerHour,omitempty"`
+
+	// The maximum number of ACME orders which the orders controller may create
+	// for the Orders of a single namespace in a burst, before the rate set by
+	// namespaceACMEOrdersPerHour applies. If 0, the burst is equal to
+	// namespaceACMEOrdersPerHour.
+	// Defaults to 0.
+	NamespaceACMEOrderBurst *int32 `json:"namespaceACMEOrderBurst,omitempty"`
+
+	// The window before the expiry of a valid ACME authorization in which the
+	// orders controller refreshes the authorization when it is reused by a new
+	// Order. The authorization is deactivated and a new ACME order is created,
+	// so that it is validated again before the Order is finalized rather than
+	// expiring mid-renewal. Must not be longer than 7 days. Set to 0 to not
+	// refresh authorizations.
+	// Defaults to 0s.
+	ACMEAuthorizationRefreshWindow *sharedv1alpha1.Duration `json:"acmeAuthorizationRefreshWindow,omitempty"`
+
+	// The maximum number of Certificate Secret writes per second made by the
+	// certificates controller. Limiting this smooths the load on the
+	// Kubernetes apiserver when many certificates are renewed at once.
+	// Set to 0 to disable rate limiting of Secret writes.
+	// Defaults to 0.
+	SecretWritesPerSecond *float32 `json:"secretWritesPerSecond,omitempty"`
+
+	// The maximum number of Certificate Secret writes made by the certificates
+	// controller which can be in flight at once.
+	// Set to 0 to not limit the number of concurrent Secret writes.
+	// Defaults to 0.
+	MaxConcurrentSecretWrites *int32 `json:"maxConcurrentSecretWrites,omitempty"`
+
+	// The number of times the certificates controller retries deleting a
+	// Certificate Secret of the wrong type, when the `Recreate`
+	// secretTypeMismatchPolicy is used and the Secret was replaced since it
+	// was observed. The Secret is re-read from the apiserver each time. Once
+	// exhausted, the deletion is retried on the next sync of the Certificate.
+	// Set to 0 to not retry conflicting Secret deletions.
+	// Defaults to 5.
+	SecretRecreateConflictRetries *int32 `json:"secretRecreateConflictRetries,omitempty"`
+
+	// RenewalsPaused stops the certificates controller from triggering the
+	// renewal of Certificates, for example during a maintenance window.
+	// Certificates which are about to expire are still renewed. Other reasons
+	// for issuing a Certificate, such as a change to its spec, are unaffected.
+	// This can be changed at runtime by reloading the configuration.
+	// Defaults to false.
+	RenewalsPaused *bool `json:"renewalsPaused,omitempty"`
+
+	// The interval after which the OCSP response stored in the Secret of a
+	// Certificate which opts in to OCSP stapling is refreshed. The response
+	// is refreshed sooner if half of its validity period has passed. If 0,
+	// the response is only refreshed once half of its validity has passed.
+	// Must be 0 or at least 1m. Defaults to 12h.
+	OCSPStapleRefreshInterval *sharedv1alpha1.Duration `json:"ocspStapleRefreshInterval,omitempty"`
+
+	// CertificateRequestApprovalRules restricts the CertificateRequests which
+	// are approved by the certificaterequests-approver controller to those
+	// matching at least one of the rules. CertificateRequests which do not
+	// match any rule are left for another approver.
+	// If empty, all CertificateRequests are approved.
+	CertificateRequestApprovalRules []CertificateRequestApprovalRule `json:"certificateRequestApprovalRules,omitempty"`
+
+	// The rate, in Events per second, at which the controllers may record
+	// Events about a single object once the burst has been used up. Events
+	// exceeding the rate are dropped rather than sent to the Kubernetes
+	// apiserver.
+	// Defaults to 0.0033 (one Event every 5 minutes).
+	EventRecorderQPS *float32 `json:"eventRecorderQPS,omitempty"`
+
+	// The maximum number of Events the controllers may record about a single
+	// object in a burst, before the rate set by eventRecorderQPS applies.
+	// Defaults to 25.
+	EventRecorderBurst *int32 `json:"eventRecorderBurst,omitempty"`
+
+	// The window within which similar Events recorded about the same object
+	// are deduplicated and aggregated into a single Event, rather than each
+	// being sent to the Kubernetes apiserver.
+	// Must be at least 1s. Defaults to 10m.
+	EventRecorderDeduplicationWindow *sharedv1alpha1.Duration `json:"eventRecorderDeduplicationWindow,omitempty"`
+
 	// The host and port that the metrics endpoint should listen on.
 	MetricsListenAddress string `json:"metricsListenAddress,omitempty"`
 
@@ -137,6 +307,27 @@ type ControllerConfiguration struct {
 	ACMEDNS01Config ACMEDNS01Config `json:"acmeDNS01Config,omitempty"`
 }
 
+// CertificateRequestApprovalRule matches CertificateRequests by the issuer
+// they reference and their namespace. Fields which are not set match any
+// CertificateRequest.
+type CertificateRequestApprovalRule struct {
+	// IssuerName matches CertificateRequests referencing an issuer with this
+	// name.
+	IssuerName string `json:"issuerName,omitempty"`
+
+	// IssuerKind matches CertificateRequests referencing an issuer of this
+	// kind. An empty kind on a CertificateRequest is treated as `Issuer`.
+	IssuerKind string `json:"issuerKind,omitempty"`
+
+	// IssuerGroup matches CertificateRequests referencing an issuer in this
+	// API group. An empty group on a CertificateRequest is treated as
+	// `cert-manager.io`.
+	IssuerGroup string `json:"issuerGroup,omitempty"`
+
+	// Namespaces matches CertificateRequests in one of the given namespaces.
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
 type LeaderElectionConfig struct {
 	sharedv1alpha1.LeaderElectionConfig `json:",inline"`
 
@@ -162,6 +353,52 @@ type IngressShimConfig struct {
 	// The annotation consumed by the ingress-shim controller to indicate an ingress
 	// is requesting a certificate
 	DefaultAutoCertificateAnnotations []string `json:"defaultAutoCertificateAnnotations,omitempty"`
+
+	// DefaultIssuerRules select the issuer to use when the TLS is requested
+	// but the issuer is not specified on the ingress resource, based on the
+	// DNS names of the Certificate. The rule with the most specific pattern
+	// matching all of the DNS names is used. If no rule matches, the default
+	// issuer is used.
+	DefaultIssuerRules []DefaultIssuerRule `json:"defaultIssuerRules,omitempty"`
+	// AllowedIngressClasses is the list of IngressClasses for which the
+	// ingress-shim creates Certificates. If it is non-empty, Ingresses of any
+	// other class, or without a class, are ignored.
+	AllowedIngressClasses []string `json:"allowedIngressClasses,omitempty"`
+
+	// DeniedIngressClasses is the list of IngressClasses for which the
+	// ingress-shim never creates Certificates. It takes precedence over
+	// AllowedIngressClasses.
+	DeniedIngressClasses []string `json:"deniedIngressClasses,omitempty"`
+
+	// AllowedGatewayClasses is the list of GatewayClasses for which the
+	// gateway-shim creates Certificates. If it is non-empty, Gateways of any
+	// other class are ignored.
+	AllowedGatewayClasses []string `json:"allowedGatewayClasses,omitempty"`
+
+	// DeniedGatewayClasses is the list of GatewayClasses for which the
+	// gateway-shim never creates Certificates. It takes precedence over
+	// AllowedGatewayClasses.
+	DeniedGatewayClasses []string `json:"deniedGatewayClasses,omitempty"`
+}
+
+// DefaultIssuerRule selects the issuer used by ingress-shim for the
+// Certificates whose DNS names all match a pattern.
+type DefaultIssuerRule struct {
+	// DNSNamePattern is a glob pattern matched against each of the DNS names
+	// of a Certificate. `*` matches any characters within a single label,
+	// and a leftmost `**` label matches one or more labels, for example
+	// `**.internal.example.com`.
+	DNSNamePattern string `json:"dnsNamePattern"`
+
+	// Name of the Issuer to use for Certificates matching the pattern.
+	IssuerName string `json:"issuerName"`
+
+	// Kind of the Issuer to use for Certificates matching the pattern. An
+	// empty kind is treated as `Issuer`.
+	IssuerKind string `json:"issuerKind,omitempty"`
+
+	// Group of the Issuer to use for Certificates matching the pattern.
+	IssuerGroup string `json:"issuerGroup,omitempty"`
 }
 
 type ACMEHTTP01Config struct {
@@ -220,4 +457,31 @@ type ACMEDNS01Config struct {
 	// token is served at the challenge URL. This should be a valid duration
 	// string, for example 180s or 1h
 	CheckRetryPeriod *sharedv1alpha1.Duration `json:"checkRetryPeriod,omitempty"`
+
+	// PreValidationURL is the URL of an optional HTTP endpoint which is called
+	// after a DNS01 challenge record has been presented and has passed the
+	// propagation check, and before the ACME server is asked to validate the
+	// challenge. The endpoint should confirm that the record exists, for
+	// example using the DNS provider's authoritative API. The challenge is
+	// POSTed as JSON and the endpoint must respond with a 2xx status code once
+	// the record exists. Pre-validation is disabled if empty.
+	PreValidationURL string `json:"preValidationURL,omitempty"`
+
+	// PreValidationCAFile is the path to a file containing PEM encoded CA
+	// certificates used to verify the pre-validation endpoint's serving
+	// certificate. The system trust store is used if empty.
+	PreValidationCAFile string `json:"preValidationCAFile,omitempty"`
+
+	// PreValidationTimeout is the maximum amount of time to wait for a
+	// response from the pre-validation endpoint. This should be a valid
+	// duration string, for example 10s.
+	PreValidationTimeout *sharedv1alpha1.Duration `json:"preValidationTimeout,omitempty"`
+
+	// CleanUpParallelism is the maximum number of DNS01 challenge records
+	// which are cleaned up at once. Records are cleaned up in the background,
+	// so that cleaning up the records of a certificate with many identifiers
+	// does not hold up the other challenges. If 0, records are cleaned up by
+	// the challenge controller's workers.
+	// Defaults to 0.
+	CleanUpParallelism *int32 `json:"cleanUpParallelism,omitempty"`
 }
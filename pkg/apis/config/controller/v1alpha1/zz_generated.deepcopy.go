@@ -44,6 +44,16 @@ func (in *ACMEDNS01Config) DeepCopyInto(out *ACMEDNS01Config) {
 		*out = new(sharedv1alpha1.Duration)
 		**out = **in
 	}
+	if in.PreValidationTimeout != nil {
+		in, out := &in.PreValidationTimeout, &out.PreValidationTimeout
+		*out = new(sharedv1alpha1.Duration)
+		**out = **in
+	}
+	if in.CleanUpParallelism != nil {
+		in, out := &in.CleanUpParallelism, &out.CleanUpParallelism
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -83,6 +93,27 @@ func (in *ACMEHTTP01Config) DeepCopy() *ACMEHTTP01Config {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRequestApprovalRule) DeepCopyInto(out *CertificateRequestApprovalRule) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRequestApprovalRule.
+func (in *CertificateRequestApprovalRule) DeepCopy() *CertificateRequestApprovalRule {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRequestApprovalRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 	*out = *in
@@ -118,6 +149,21 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DeprecatedCryptoMinimumRSAKeySize != nil {
+		in, out := &in.DeprecatedCryptoMinimumRSAKeySize, &out.DeprecatedCryptoMinimumRSAKeySize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeprecatedCryptoMinimumECDSAKeySize != nil {
+		in, out := &in.DeprecatedCryptoMinimumECDSAKeySize, &out.DeprecatedCryptoMinimumECDSAKeySize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DeprecatedCryptoMinimumSignatureHashSize != nil {
+		in, out := &in.DeprecatedCryptoMinimumSignatureHashSize, &out.DeprecatedCryptoMinimumSignatureHashSize
+		*out = new(int32)
+		**out = **in
+	}
 	if in.EnableGatewayAPI != nil {
 		in, out := &in.EnableGatewayAPI, &out.EnableGatewayAPI
 		*out = new(bool)
@@ -133,11 +179,88 @@ func (in *ControllerConfiguration) DeepCopyInto(out *ControllerConfiguration) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.InitialSyncItemsPerSecond != nil {
+		in, out := &in.InitialSyncItemsPerSecond, &out.InitialSyncItemsPerSecond
+		*out = new(float32)
+		**out = **in
+	}
+	if in.MaxConcurrentIssuances != nil {
+		in, out := &in.MaxConcurrentIssuances, &out.MaxConcurrentIssuances
+		*out = new(int32)
+		**out = **in
+	}
 	if in.MaxConcurrentChallenges != nil {
 		in, out := &in.MaxConcurrentChallenges, &out.MaxConcurrentChallenges
 		*out = new(int32)
 		**out = **in
 	}
+	if in.ChallengeProcessingTimeout != nil {
+		in, out := &in.ChallengeProcessingTimeout, &out.ChallengeProcessingTimeout
+		*out = new(sharedv1alpha1.Duration)
+		**out = **in
+	}
+	if in.NamespaceACMEOrdersPerHour != nil {
+		in, out := &in.NamespaceACMEOrdersPerHour, &out.NamespaceACMEOrdersPerHour
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NamespaceACMEOrderBurst != nil {
+		in, out := &in.NamespaceACMEOrderBurst, &out.NamespaceACMEOrderBurst
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ACMEAuthorizationRefreshWindow != nil {
+		in, out := &in.ACMEAuthorizationRefreshWindow, &out.ACMEAuthorizationRefreshWindow
+		*out = new(sharedv1alpha1.Duration)
+		**out = **in
+	}
+	if in.SecretWritesPerSecond != nil {
+		in, out := &in.SecretWritesPerSecond, &out.SecretWritesPerSecond
+		*out = new(float32)
+		**out = **in
+	}
+	if in.MaxConcurrentSecretWrites != nil {
+		in, out := &in.MaxConcurrentSecretWrites, &out.MaxConcurrentSecretWrites
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SecretRecreateConflictRetries != nil {
+		in, out := &in.SecretRecreateConflictRetries, &out.SecretRecreateConflictRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RenewalsPaused != nil {
+		in, out := &in.RenewalsPaused, &out.RenewalsPaused
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OCSPStapleRefreshInterval != nil {
+		in, out := &in.OCSPStapleRefreshInterval, &out.OCSPStapleRefreshInterval
+		*out = new(sharedv1alpha1.Duration)
+		**out = **in
+	}
+	if in.CertificateRequestApprovalRules != nil {
+		in, out := &in.CertificateRequestApprovalRules, &out.CertificateRequestApprovalRules
+		*out = make([]CertificateRequestApprovalRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EventRecorderQPS != nil {
+		in, out := &in.EventRecorderQPS, &out.EventRecorderQPS
+		*out = new(float32)
+		**out = **in
+	}
+	if in.EventRecorderBurst != nil {
+		in, out := &in.EventRecorderBurst, &out.EventRecorderBurst
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EventRecorderDeduplicationWindow != nil {
+		in, out := &in.EventRecorderDeduplicationWindow, &out.EventRecorderDeduplicationWindow
+		*out = new(sharedv1alpha1.Duration)
+		**out = **in
+	}
 	in.MetricsTLSConfig.DeepCopyInto(&out.MetricsTLSConfig)
 	if in.EnablePprof != nil {
 		in, out := &in.EnablePprof, &out.EnablePprof
@@ -176,6 +299,22 @@ func (in *ControllerConfiguration) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultIssuerRule) DeepCopyInto(out *DefaultIssuerRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultIssuerRule.
+func (in *DefaultIssuerRule) DeepCopy() *DefaultIssuerRule {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultIssuerRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressShimConfig) DeepCopyInto(out *IngressShimConfig) {
 	*out = *in
@@ -184,6 +323,31 @@ func (in *IngressShimConfig) DeepCopyInto(out *IngressShimConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DefaultIssuerRules != nil {
+		in, out := &in.DefaultIssuerRules, &out.DefaultIssuerRules
+		*out = make([]DefaultIssuerRule, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedIngressClasses != nil {
+		in, out := &in.AllowedIngressClasses, &out.AllowedIngressClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedIngressClasses != nil {
+		in, out := &in.DeniedIngressClasses, &out.DeniedIngressClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedGatewayClasses != nil {
+		in, out := &in.AllowedGatewayClasses, &out.AllowedGatewayClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeniedGatewayClasses != nil {
+		in, out := &in.DeniedGatewayClasses, &out.DeniedGatewayClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
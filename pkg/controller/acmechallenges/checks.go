@@ -16,4 +16,41 @@ limitations under the License.
 
 package acmechallenges
 
-// no checks for the acme orders controller yet
+import (
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/cert-manager/cert-manager/pkg/acme"
+	"github.com/cert-manager/cert-manager/pkg/api/util"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+// challengesForSecret returns the challenges being processed whose DNS01
+// solver reads its credentials from the given Secret.
+func (c *controller) challengesForSecret(secret *corev1.Secret) ([]*cmacme.Challenge, error) {
+	challenges, err := c.challengeLister.List(labels.NewSelector())
+	if err != nil {
+		return nil, fmt.Errorf("error listing challenges: %s", err.Error())
+	}
+
+	var affected []*cmacme.Challenge
+	for _, ch := range challenges {
+		if !ch.Status.Processing || acme.IsFinalState(ch.Status.State) {
+			continue
+		}
+		if ch.Spec.Type != cmacme.ACMEChallengeTypeDNS01 || ch.Spec.Solver.DNS01 == nil {
+			continue
+		}
+		if c.issuerOptions.ResourceNamespaceRef(ch.Spec.IssuerRef, ch.Namespace) != secret.Namespace {
+			continue
+		}
+		if slices.Contains(util.DNS01SolverSecretNames(ch.Spec.Solver.DNS01), secret.Name) {
+			affected = append(affected, ch)
+		}
+	}
+
+	return affected, nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmechallenges
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func Test_challengesForSecret(t *testing.T) {
+	const clusterResourceNamespace = "cert-manager"
+
+	cloudflareSolver := gen.SetChallengeSolver(cmacme.ACMEChallengeSolver{
+		DNS01: &cmacme.ACMEChallengeSolverDNS01{
+			Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{
+				APIToken: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "cloudflare"},
+					Key:                  "api-token",
+				},
+			},
+		},
+	})
+	processingDNS01 := func(name string, mods ...gen.ChallengeModifier) *cmacme.Challenge {
+		return gen.Challenge(name, append([]gen.ChallengeModifier{
+			gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+			gen.SetChallengeProcessing(true),
+			gen.SetChallengeState(cmacme.Pending),
+			cloudflareSolver,
+		}, mods...)...)
+	}
+	secret := func(namespace, name string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+
+	challenges := []runtime.Object{
+		processingDNS01("issuer"),
+		processingDNS01("cluster-issuer", gen.SetChallengeIssuer(cmmeta.ObjectReference{
+			Name: "letsencrypt",
+			Kind: cmapi.ClusterIssuerKind,
+		})),
+		processingDNS01("not-processing", gen.SetChallengeProcessing(false)),
+		processingDNS01("valid", gen.SetChallengeState(cmacme.Valid)),
+		gen.Challenge("http01",
+			gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+			gen.SetChallengeProcessing(true),
+			gen.SetChallengeSolver(cmacme.ACMEChallengeSolver{
+				HTTP01: &cmacme.ACMEChallengeSolverHTTP01{},
+			}),
+		),
+	}
+
+	tests := map[string]struct {
+		secret        *corev1.Secret
+		expChallenges []string
+	}{
+		"secret referenced by the solver of an issuer": {
+			secret:        secret(gen.DefaultTestNamespace, "cloudflare"),
+			expChallenges: []string{"issuer"},
+		},
+		"secret referenced by the solver of a cluster issuer": {
+			secret:        secret(clusterResourceNamespace, "cloudflare"),
+			expChallenges: []string{"cluster-issuer"},
+		},
+		"secret which is not referenced": {
+			secret: secret(gen.DefaultTestNamespace, "other"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: challenges,
+			}
+			builder.Init()
+			defer builder.Stop()
+			builder.Context.IssuerOptions.ClusterResourceNamespace = clusterResourceNamespace
+
+			c := &controller{}
+			_, _, err := c.Register(builder.Context)
+			require.NoError(t, err)
+			builder.Start()
+
+			affected, err := c.challengesForSecret(test.secret)
+			require.NoError(t, err)
+
+			var names []string
+			for _, ch := range affected {
+				names = append(names, ch.Name)
+			}
+			assert.ElementsMatch(t, test.expChallenges, names)
+		})
+	}
+}
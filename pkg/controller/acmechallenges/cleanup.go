@@ -0,0 +1,154 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmechallenges
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+// maxCleanUpAttempts is the number of consecutive times cleaning up a deleted
+// challenge is attempted before its finalizer is removed regardless, so that
+// a solver which keeps failing does not block the deletion of the challenge
+// forever.
+const maxCleanUpAttempts = 5
+
+// cleaner cleans up the records presented for challenges. DNS01 challenges
+// are cleaned up in the background, with at most parallelism clean ups
+// running at once, so that cleaning up the records of a certificate with many
+// identifiers does not hold up the controller's workers. If parallelism is 0,
+// all challenges are cleaned up by the calling worker.
+//
+// Clean ups are tracked in memory. If the controller restarts while a clean
+// up is running, the challenge is still presented, or still has its
+// finalizer, so its clean up is attempted again.
+type cleaner struct {
+	slots   chan struct{}
+	enqueue func(types.NamespacedName)
+
+	lock     sync.Mutex
+	running  map[types.UID]*cleanUpResult
+	failures map[types.UID]int
+}
+
+type cleanUpResult struct {
+	done bool
+	err  error
+}
+
+func newCleaner(parallelism int, enqueue func(types.NamespacedName)) *cleaner {
+	c := &cleaner{
+		enqueue:  enqueue,
+		running:  make(map[types.UID]*cleanUpResult),
+		failures: make(map[types.UID]int),
+	}
+	if parallelism > 0 {
+		c.slots = make(chan struct{}, parallelism)
+	}
+	return c
+}
+
+// cleanUp cleans up the records presented for the given challenge using the
+// given solver. It returns false if the clean up is running in the
+// background, in which case the challenge is queued again once it is done and
+// the outcome is returned by the next call.
+func (c *cleaner) cleanUp(ctx context.Context, s solver, ch *cmacme.Challenge) (bool, error) {
+	if c.slots == nil || ch.Spec.Type != cmacme.ACMEChallengeTypeDNS01 {
+		err := s.CleanUp(ctx, ch)
+
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		return true, c.record(ch.UID, err)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if result, ok := c.running[ch.UID]; ok {
+		if !result.done {
+			return false, nil
+		}
+		delete(c.running, ch.UID)
+		return true, c.record(ch.UID, result.err)
+	}
+
+	result := &cleanUpResult{}
+	c.running[ch.UID] = result
+
+	ch = ch.DeepCopy()
+	go func() {
+		select {
+		case c.slots <- struct{}{}:
+		case <-ctx.Done():
+			// The controller is stopping before the clean up could start,
+			// so it is started again when the challenge is next synced.
+			c.lock.Lock()
+			if c.running[ch.UID] == result {
+				delete(c.running, ch.UID)
+			}
+			c.lock.Unlock()
+			return
+		}
+		err := s.CleanUp(ctx, ch)
+		<-c.slots
+
+		c.lock.Lock()
+		result.done, result.err = true, err
+		c.lock.Unlock()
+
+		c.enqueue(types.NamespacedName{
+			Namespace: ch.Namespace,
+			Name:      ch.Name,
+		})
+	}()
+
+	return false, nil
+}
+
+// record counts the consecutive failed clean ups of the challenge with the
+// given UID, and returns err. The lock must be held.
+func (c *cleaner) record(uid types.UID, err error) error {
+	if err != nil {
+		c.failures[uid]++
+	} else {
+		delete(c.failures, uid)
+	}
+	return err
+}
+
+// failedAttempts returns the number of consecutive times cleaning up the
+// challenge with the given UID has failed.
+func (c *cleaner) failedAttempts(uid types.UID) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.failures[uid]
+}
+
+// forget stops tracking the challenge with the given UID, discarding the
+// outcome of any clean up running in the background.
+func (c *cleaner) forget(uid types.UID) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.running, uid)
+	delete(c.failures, uid)
+}
@@ -0,0 +1,162 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmechallenges
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func Test_cleanerSynchronous(t *testing.T) {
+	simulatedErr := errors.New("simulated-cleanup-error")
+	s := &fakeSolver{
+		fakeCleanUp: func(context.Context, *cmacme.Challenge) error {
+			return simulatedErr
+		},
+	}
+	c := newCleaner(0, func(types.NamespacedName) {
+		t.Error("unexpected call to enqueue")
+	})
+
+	ch := gen.Challenge("test", gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01))
+	ch.UID = "test"
+
+	for i := 1; i <= 2; i++ {
+		done, err := c.cleanUp(context.Background(), s, ch)
+		assert.True(t, done)
+		assert.Equal(t, simulatedErr, err)
+		assert.Equal(t, i, c.failedAttempts(ch.UID))
+	}
+
+	c.forget(ch.UID)
+	assert.Equal(t, 0, c.failedAttempts(ch.UID))
+}
+
+func Test_cleanerParallel(t *testing.T) {
+	const parallelism = 2
+
+	var running, maxRunning atomic.Int32
+	release := make(chan struct{})
+	s := &fakeSolver{
+		fakeCleanUp: func(context.Context, *cmacme.Challenge) error {
+			n := running.Add(1)
+			for {
+				m := maxRunning.Load()
+				if n <= m || maxRunning.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			<-release
+			running.Add(-1)
+			return nil
+		},
+	}
+	enqueued := make(chan types.NamespacedName, 5)
+	c := newCleaner(parallelism, func(key types.NamespacedName) {
+		enqueued <- key
+	})
+
+	var challenges []*cmacme.Challenge
+	for i := 0; i < 5; i++ {
+		ch := gen.Challenge(fmt.Sprintf("test-%d", i), gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01))
+		ch.UID = types.UID(ch.Name)
+		challenges = append(challenges, ch)
+
+		done, err := c.cleanUp(context.Background(), s, ch)
+		assert.False(t, done)
+		assert.NoError(t, err)
+	}
+
+	// Clean ups which are still running are not started again.
+	done, err := c.cleanUp(context.Background(), s, challenges[0])
+	assert.False(t, done)
+	assert.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		return running.Load() == parallelism
+	}, time.Second, time.Millisecond)
+	close(release)
+
+	for range challenges {
+		select {
+		case <-enqueued:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for clean ups to finish")
+		}
+	}
+	assert.Equal(t, int32(parallelism), maxRunning.Load())
+
+	for _, ch := range challenges {
+		done, err := c.cleanUp(context.Background(), s, ch)
+		assert.True(t, done)
+		assert.NoError(t, err)
+	}
+}
+
+func Test_cleanerStopped(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	s := &fakeSolver{
+		fakeCleanUp: func(context.Context, *cmacme.Challenge) error {
+			close(started)
+			<-release
+			return nil
+		},
+	}
+	c := newCleaner(1, func(types.NamespacedName) {})
+
+	running := gen.Challenge("running", gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01))
+	running.UID = "running"
+	done, err := c.cleanUp(context.Background(), s, running)
+	assert.False(t, done)
+	assert.NoError(t, err)
+	<-started
+
+	// A clean up waiting for a free slot is dropped once the context is
+	// cancelled.
+	ctx, cancel := context.WithCancel(context.Background())
+	waiting := gen.Challenge("waiting", gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01))
+	waiting.UID = "waiting"
+	done, err = c.cleanUp(ctx, s, waiting)
+	assert.False(t, done)
+	assert.NoError(t, err)
+	cancel()
+
+	assert.Eventually(t, func() bool {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+		_, ok := c.running[waiting.UID]
+		return !ok
+	}, 5*time.Second, time.Millisecond)
+
+	// The outcome of a clean up of a deleted challenge is discarded.
+	c.forget(running.UID)
+	c.lock.Lock()
+	assert.Empty(t, c.running)
+	c.lock.Unlock()
+	close(release)
+}
@@ -31,6 +31,7 @@ import (
 
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmacmelisters "github.com/cert-manager/cert-manager/pkg/client/listers/acme/v1"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
@@ -39,6 +40,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/http"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 )
 
 type controller struct {
@@ -74,10 +76,36 @@ type controller struct {
 	// logger to be used by this controller
 	log logr.Logger
 
+	// issuerOptions is used to find the namespace of the Secrets read by
+	// DNS01 solvers.
+	issuerOptions controllerpkg.IssuerOptions
+
 	dns01Nameservers []string
 
 	DNS01CheckRetryPeriod time.Duration
 
+	// dns01PreValidator, if set, confirms that a DNS01 challenge record exists
+	// before the ACME server is asked to validate the challenge.
+	dns01PreValidator preValidator
+
+	// challengeProcessingTimeout is the maximum amount of time an accepted
+	// challenge may remain 'processing' before its authorization is
+	// deactivated. Zero disables the timeout.
+	challengeProcessingTimeout time.Duration
+	processing                 *stateTracker
+
+	// validated tracks when challenges were first observed to be valid, so
+	// that removing their DNS01 record can be delayed by the solver's
+	// cleanupDelay.
+	validated *stateTracker
+
+	// cleaner cleans up the records presented for challenges, running DNS01
+	// clean ups in parallel if configured to.
+	cleaner *cleaner
+
+	// metrics is used to record the challenges which failed to be cleaned up.
+	metrics *metrics.Metrics
+
 	// objectUpdater implements the updateObject function which is used to save
 	// changes to the Challenge.Status and Challenge.Finalizers
 	objectUpdater
@@ -138,9 +166,22 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 	if _, err := challengeInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue}); err != nil {
 		return nil, nil, fmt.Errorf("error setting up event handler: %v", err)
 	}
+	// stop tracking the clean ups of deleted challenges, whose outcome would
+	// otherwise never be collected
+	if _, err := challengeInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: c.challengeDeleted,
+	}); err != nil {
+		return nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+	// requeue the challenges whose DNS01 solver credentials change, so that
+	// rotated credentials are used without waiting for the challenge to be
+	// retried
+	if _, err := secretInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.secretEvent}); err != nil {
+		return nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
 
 	c.helper = issuer.NewHelper(c.issuerLister, c.clusterIssuerLister)
-	c.scheduler = scheduler.New(logf.NewContext(ctx.RootContext, c.log), c.challengeLister, ctx.SchedulerOptions.MaxConcurrentChallenges)
+	c.scheduler = scheduler.New(logf.NewContext(ctx.RootContext, c.log), c.challengeLister, ctx.SchedulerOptions.CurrentMaxConcurrentChallenges)
 	c.recorder = ctx.Recorder
 	c.accountRegistry = ctx.ACMEOptions.AccountRegistry
 
@@ -153,10 +194,22 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 	if err != nil {
 		return nil, nil, err
 	}
+	c.dns01PreValidator, err = newDNS01PreValidator(ctx.ACMEOptions.DNS01PreValidationURL, ctx.ACMEOptions.DNS01PreValidationCAFile, ctx.ACMEOptions.DNS01PreValidationTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	// read options from context
+	c.issuerOptions = ctx.IssuerOptions
 	c.dns01Nameservers = ctx.ACMEOptions.DNS01Nameservers
 	c.DNS01CheckRetryPeriod = ctx.ACMEOptions.DNS01CheckRetryPeriod
+	c.challengeProcessingTimeout = ctx.ACMEOptions.ChallengeProcessingTimeout
+	c.processing = newStateTracker(ctx.Clock)
+	c.validated = newStateTracker(ctx.Clock)
+	c.cleaner = newCleaner(ctx.ACMEOptions.DNS01CleanUpParallelism, func(key types.NamespacedName) {
+		c.queue.Add(key)
+	})
+	c.metrics = ctx.Metrics
 
 	// Construct an objectUpdater which is used to save changes to the Challenge
 	// object, either using Update or using Patch + Server Side Apply.
@@ -165,6 +218,40 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 	return c.queue, mustSync, nil
 }
 
+func (c *controller) challengeDeleted(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	ch, ok := obj.(*cmacme.Challenge)
+	if !ok {
+		c.log.WithName("challengeDeleted").Error(nil, "object is not a challenge", "object", obj)
+		return
+	}
+	c.cleaner.forget(ch.UID)
+}
+
+func (c *controller) secretEvent(obj interface{}) {
+	log := c.log.WithName("secretEvent")
+	secret, ok := controllerpkg.ToSecret(obj)
+	if !ok {
+		log.Error(nil, "object is not a secret", "object", obj)
+		return
+	}
+
+	log = logf.WithResource(log, secret)
+	challenges, err := c.challengesForSecret(secret)
+	if err != nil {
+		log.Error(err, "error looking up challenges observing secret")
+		return
+	}
+	for _, ch := range challenges {
+		c.queue.Add(types.NamespacedName{
+			Name:      ch.Name,
+			Namespace: ch.Namespace,
+		})
+	}
+}
+
 // MaxChallengesPerSchedule is the maximum number of challenges that can be
 // scheduled with a single call to the scheduler.
 // This provides a very crude rate limit on how many challenges we will schedule
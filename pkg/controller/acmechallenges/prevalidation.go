@@ -0,0 +1,129 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmechallenges
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+// maxPreValidationResponseSize is the maximum number of bytes of a failed
+// pre-validation response body included in the returned error.
+const maxPreValidationResponseSize = 1024
+
+// preValidator confirms that a presented challenge can be validated before
+// the ACME server is asked to validate it.
+type preValidator interface {
+	PreValidate(ctx context.Context, ch *cmacme.Challenge) error
+}
+
+// preValidationRequest is the body POSTed to the DNS01 pre-validation
+// endpoint. The endpoint should confirm that a TXT record containing Key
+// exists for the `_acme-challenge` label of DNSName, following any CNAME
+// the record has been delegated to.
+type preValidationRequest struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	DNSName   string `json:"dnsName"`
+	Key       string `json:"key"`
+}
+
+// httpPreValidator implements preValidator by calling an HTTP endpoint.
+type httpPreValidator struct {
+	url    string
+	client *http.Client
+}
+
+var _ preValidator = &httpPreValidator{}
+
+// newDNS01PreValidator returns a preValidator which calls the given URL, or
+// nil if the URL is empty. If caFile is set, the endpoint's serving
+// certificate is verified using the CA certificates it contains instead of
+// the system trust store.
+func newDNS01PreValidator(url, caFile string, timeout time.Duration) (preValidator, error) {
+	if url == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{}
+	if caFile != "" {
+		caBundle, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read DNS01 pre-validation CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if ok := pool.AppendCertsFromPEM(caBundle); !ok {
+			return nil, fmt.Errorf("DNS01 pre-validation CA file %q contains no valid certificates", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &httpPreValidator{
+		url: url,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   timeout,
+		},
+	}, nil
+}
+
+// PreValidate returns an error unless the endpoint responds with a 2xx status
+// code, confirming that the challenge record exists.
+func (p *httpPreValidator) PreValidate(ctx context.Context, ch *cmacme.Challenge) error {
+	body, err := json.Marshal(preValidationRequest{
+		Namespace: ch.Namespace,
+		Name:      ch.Name,
+		DNSName:   ch.Spec.DNSName,
+		Key:       ch.Spec.Key,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call pre-validation endpoint: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxPreValidationResponseSize))
+		return fmt.Errorf("pre-validation endpoint returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	return nil
+}
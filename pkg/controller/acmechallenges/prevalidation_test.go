@@ -0,0 +1,95 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmechallenges
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestHTTPPreValidator(t *testing.T) {
+	ch := gen.Challenge("testchal",
+		gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+		gen.SetChallengeDNSName("example.com"),
+		gen.SetChallengeKey("token"),
+	)
+
+	var got preValidationRequest
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		if r.URL.Path == "/missing" {
+			http.Error(w, "record not found", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600))
+
+	t.Run("no validator is returned if the URL is empty", func(t *testing.T) {
+		v, err := newDNS01PreValidator("", caFile, time.Second)
+		require.NoError(t, err)
+		assert.Nil(t, v)
+	})
+
+	t.Run("an invalid CA file returns an error", func(t *testing.T) {
+		invalidCAFile := filepath.Join(t.TempDir(), "invalid.crt")
+		require.NoError(t, os.WriteFile(invalidCAFile, []byte("not a certificate"), 0600))
+
+		_, err := newDNS01PreValidator(server.URL, invalidCAFile, time.Second)
+		assert.EqualError(t, err, `DNS01 pre-validation CA file "`+invalidCAFile+`" contains no valid certificates`)
+	})
+
+	t.Run("the endpoint is not trusted without the CA file", func(t *testing.T) {
+		v, err := newDNS01PreValidator(server.URL, "", time.Second)
+		require.NoError(t, err)
+		assert.ErrorContains(t, v.PreValidate(context.Background(), ch), "failed to call pre-validation endpoint")
+	})
+
+	t.Run("a 2xx response confirms the record exists", func(t *testing.T) {
+		v, err := newDNS01PreValidator(server.URL+"/found", caFile, time.Second)
+		require.NoError(t, err)
+		assert.NoError(t, v.PreValidate(context.Background(), ch))
+		assert.Equal(t, preValidationRequest{
+			Namespace: gen.DefaultTestNamespace,
+			Name:      "testchal",
+			DNSName:   "example.com",
+			Key:       "token",
+		}, got)
+	})
+
+	t.Run("a non-2xx response returns an error", func(t *testing.T) {
+		v, err := newDNS01PreValidator(server.URL+"/missing", caFile, time.Second)
+		require.NoError(t, err)
+		assert.EqualError(t, v.PreValidate(context.Background(), ch), "pre-validation endpoint returned status 404: record not found")
+	})
+}
@@ -35,11 +35,11 @@ import (
 type Scheduler struct {
 	log                     logr.Logger
 	challengeLister         cmacmelisters.ChallengeLister
-	maxConcurrentChallenges int
+	maxConcurrentChallenges func() int
 }
 
 // New will construct a new instance of a scheduler
-func New(ctx context.Context, l cmacmelisters.ChallengeLister, maxConcurrentChallenges int) *Scheduler {
+func New(ctx context.Context, l cmacmelisters.ChallengeLister, maxConcurrentChallenges func() int) *Scheduler {
 	log := logs.FromContext(ctx, "challenge-scheduler")
 	return &Scheduler{log: log, challengeLister: l, maxConcurrentChallenges: maxConcurrentChallenges}
 }
@@ -65,7 +65,7 @@ func (s *Scheduler) scheduleN(n int, allChallenges []*cmacme.Challenge) []*cmacm
 	candidates, inProgressChallengeCount := s.determineChallengeCandidates(allChallenges)
 
 	numberToSelect := n
-	remainingNumberAllowedChallenges := s.maxConcurrentChallenges - inProgressChallengeCount
+	remainingNumberAllowedChallenges := s.maxConcurrentChallenges() - inProgressChallengeCount
 	if remainingNumberAllowedChallenges < 0 {
 		remainingNumberAllowedChallenges = 0
 	}
@@ -101,8 +101,9 @@ func (s *Scheduler) determineChallengeCandidates(allChallenges []*cmacme.Challen
 	// Ensure we only run a max of MaxConcurrentChallenges at a time
 	// We perform this check here to avoid extra processing if we've already
 	// hit the maximum number of challenges.
-	if inProgressChallengeCount >= s.maxConcurrentChallenges {
-		s.log.V(logs.DebugLevel).Info("hit maximum concurrent challenge limit. refusing to schedule more challenges.", "in_progress", len(inProgress), "max_concurrent", s.maxConcurrentChallenges)
+	maxConcurrentChallenges := s.maxConcurrentChallenges()
+	if inProgressChallengeCount >= maxConcurrentChallenges {
+		s.log.V(logs.DebugLevel).Info("hit maximum concurrent challenge limit. refusing to schedule more challenges.", "in_progress", len(inProgress), "max_concurrent", maxConcurrentChallenges)
 		return []*cmacme.Challenge{}, inProgressChallengeCount
 	}
 
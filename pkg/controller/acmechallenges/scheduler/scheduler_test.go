@@ -316,7 +316,7 @@ func TestScheduleN(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			s := New(context.Background(), challengesInformer.Lister(), maxConcurrentChallenges)
+			s := New(context.Background(), challengesInformer.Lister(), func() int { return maxConcurrentChallenges })
 
 			if test.expected == nil {
 				test.expected = []*cmacme.Challenge{}
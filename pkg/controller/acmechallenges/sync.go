@@ -38,15 +38,20 @@ import (
 )
 
 const (
-	reasonDomainVerified = "DomainVerified"
-	reasonCleanUpError   = "CleanUpError"
-	reasonPresentError   = "PresentError"
-	reasonPresented      = "Presented"
-	reasonFailed         = "Failed"
-
-	// How long to wait for an authorization response from the ACME server in acceptChallenge()
+	reasonDomainVerified    = "DomainVerified"
+	reasonCleanUpError      = "CleanUpError"
+	reasonPresentError      = "PresentError"
+	reasonPresented         = "Presented"
+	reasonFailed            = "Failed"
+	reasonProcessingTimeout = "ProcessingTimeout"
+
+	// How long to wait for an authorization response from the ACME server in waitAuthorization()
 	// before giving up
 	authorizationTimeout = 20 * time.Second
+
+	// How long to wait before checking an HTTP01 challenge again when the
+	// ACME server is still processing it
+	http01ProcessingRetryPeriod = 10 * time.Second
 )
 
 // solver solves ACME challenges by presenting the given token and key in an
@@ -80,6 +85,7 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 	}()
 
 	if !ch.DeletionTimestamp.IsZero() {
+		c.processing.forget(ch.UID)
 		return c.handleFinalizer(ctx, ch)
 	}
 
@@ -117,24 +123,35 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 	// if a challenge is in a final state, we bail out early as there is nothing
 	// left for us to do here.
 	if acme.IsFinalState(ch.Status.State) {
+		c.processing.forget(ch.UID)
+
 		if ch.Status.Presented {
+			if c.delayCleanUp(ctx, ch) {
+				return nil
+			}
+
 			solver, err := c.solverFor(ch.Spec.Type)
 			if err != nil {
 				log.Error(err, "error getting solver for challenge")
 				return err
 			}
 
-			err = solver.CleanUp(ctx, ch)
+			done, err := c.cleaner.cleanUp(ctx, solver, ch)
+			if !done {
+				return nil
+			}
 			if err != nil {
 				c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonCleanUpError, "Error cleaning up challenge: %v", err)
 				ch.Status.Reason = err.Error()
 				log.Error(err, "error cleaning up challenge")
+				c.recordCleanUpError(ch)
 				return err
 			}
 
 			ch.Status.Presented = false
 		}
 
+		c.validated.forget(ch.UID)
 		ch.Status.Processing = false
 
 		return nil
@@ -169,6 +186,8 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 	}
 
 	if !ch.Status.Presented {
+		ch.Status.Solver = solverStatusFor(ch.Spec.Solver)
+
 		err := solver.Present(ctx, genericIssuer, ch)
 		if err != nil {
 			c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonPresentError, "Error presenting challenge: %v", err)
@@ -193,6 +212,21 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 		return nil
 	}
 
+	if ch.Spec.Type == cmacme.ACMEChallengeTypeDNS01 && c.dns01PreValidator != nil {
+		err = c.dns01PreValidator.PreValidate(ctx, ch)
+		if err != nil {
+			log.Error(err, "pre-validation failed")
+			ch.Status.Reason = fmt.Sprintf("Waiting for %s challenge pre-validation: %s", ch.Spec.Type, err)
+
+			c.queue.AddAfter(types.NamespacedName{
+				Namespace: ch.Namespace,
+				Name:      ch.Name,
+			}, c.DNS01CheckRetryPeriod)
+
+			return nil
+		}
+	}
+
 	err = c.acceptChallenge(ctx, cl, ch)
 	if err != nil {
 		return err
@@ -240,7 +274,9 @@ func handleError(ctx context.Context, ch *cmacme.Challenge, err error) error {
 }
 
 // handleFinalizer will attempt to 'finalize' the Challenge resource by calling
-// CleanUp if the resource is in a 'processing' state.
+// CleanUp if the resource is in a 'processing' state. If CleanUp fails, the
+// finalizer is kept so that the clean up is retried, until it has failed
+// maxCleanUpAttempts times in a row.
 func (c *controller) handleFinalizer(ctx context.Context, ch *cmacme.Challenge) (err error) {
 	log := logf.FromContext(ctx, "finalizer")
 	if len(ch.Finalizers) == 0 {
@@ -250,8 +286,18 @@ func (c *controller) handleFinalizer(ctx context.Context, ch *cmacme.Challenge)
 		log.V(logf.DebugLevel).Info("waiting to run challenge finalization...")
 		return nil
 	}
+	if ch.Status.Processing && c.delayCleanUp(ctx, ch) {
+		return nil
+	}
 
+	removeFinalizer := true
 	defer func() {
+		if !removeFinalizer {
+			return
+		}
+		c.validated.forget(ch.UID)
+		c.cleaner.forget(ch.UID)
+
 		// call Update to remove the metadata.finalizers entry
 		ch.Finalizers = slices.DeleteFunc(ch.Finalizers, func(finalizer string) bool {
 			return finalizer == cmacme.ACMELegacyFinalizer || finalizer == cmacme.ACMEDomainQualifiedFinalizer
@@ -268,17 +314,61 @@ func (c *controller) handleFinalizer(ctx context.Context, ch *cmacme.Challenge)
 		return nil
 	}
 
-	err = solver.CleanUp(ctx, ch)
+	done, err := c.cleaner.cleanUp(ctx, solver, ch)
+	if !done {
+		removeFinalizer = false
+		return nil
+	}
 	if err != nil {
 		c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonCleanUpError, "Error cleaning up challenge: %v", err)
 		ch.Status.Reason = err.Error()
 		log.Error(err, "error cleaning up challenge")
+		c.recordCleanUpError(ch)
+
+		if c.cleaner.failedAttempts(ch.UID) < maxCleanUpAttempts {
+			removeFinalizer = false
+			return err
+		}
+
+		c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonCleanUpError, "Giving up cleaning up challenge after %d attempts, the challenge record may need to be removed manually", maxCleanUpAttempts)
 		return nil
 	}
 
 	return nil
 }
 
+// recordCleanUpError increments the metric counting the challenges which
+// failed to be cleaned up.
+func (c *controller) recordCleanUpError(ch *cmacme.Challenge) {
+	if c.metrics != nil {
+		c.metrics.IncrementACMEChallengeCleanUpErrorCount(string(ch.Spec.Type))
+	}
+}
+
+// delayCleanUp returns true and requeues the challenge if cleaning up the
+// record of a valid DNS01 challenge should be delayed, as configured by the
+// solver's cleanupDelay. Challenges which are not valid are always cleaned up
+// immediately.
+func (c *controller) delayCleanUp(ctx context.Context, ch *cmacme.Challenge) bool {
+	if ch.Status.State != cmacme.Valid || ch.Spec.Type != cmacme.ACMEChallengeTypeDNS01 ||
+		ch.Spec.Solver.DNS01 == nil || ch.Spec.Solver.DNS01.CleanupDelay == nil {
+		return false
+	}
+
+	remaining := ch.Spec.Solver.DNS01.CleanupDelay.Duration - c.validated.observedFor(ch.UID)
+	if remaining <= 0 {
+		return false
+	}
+
+	logf.FromContext(ctx).V(logf.DebugLevel).Info("delaying clean up of the challenge record", "remaining", remaining)
+	c.queue.AddAfter(types.NamespacedName{
+		Namespace: ch.Namespace,
+		Name:      ch.Name,
+	}, remaining)
+
+	return true
+}
+
 // syncChallengeStatus will communicate with the ACME server to retrieve the current
 // state of the Challenge. It will then update the Challenge's status block with the new
 // state of the Challenge.
@@ -366,6 +456,17 @@ func (c *controller) acceptChallenge(ctx context.Context, cl acmecl.Interface, c
 		return handleError(ctx, ch, err)
 	}
 
+	return c.waitAuthorization(ctx, cl, ch)
+}
+
+// waitAuthorization waits for the authorization of an accepted challenge to
+// reach a 'final' state, and updates the challenge's status to reflect it.
+// If the challenge processing timeout is enabled and the ACME server is still
+// processing the challenge when the wait times out, the challenge is left
+// 'processing' and checked again later.
+func (c *controller) waitAuthorization(ctx context.Context, cl acmecl.Interface, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx, "waitAuthorization")
+
 	log.V(logf.DebugLevel).Info("waiting for authorization for domain")
 	// The underlying ACME implementation from golang.org/x/crypto of WaitAuthorization retries on
 	// response parsing errors.  In the event that an ACME server is not returning expected JSON
@@ -377,6 +478,10 @@ func (c *controller) acceptChallenge(ctx context.Context, cl acmecl.Interface, c
 	defer cancelAuthorization()
 	authorization, err := cl.WaitAuthorization(ctxTimeout, ch.Spec.AuthorizationURL)
 	if err != nil {
+		if c.challengeProcessingTimeout > 0 && ch.Status.State == cmacme.Processing && errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			return c.handleProcessingChallenge(ctx, cl, ch)
+		}
+
 		log.Error(err, "error waiting for authorization")
 		return c.handleAuthorizationError(ctxTimeout, ch, err)
 	}
@@ -388,6 +493,51 @@ func (c *controller) acceptChallenge(ctx context.Context, cl acmecl.Interface, c
 	return nil
 }
 
+// handleProcessingChallenge is called when the ACME server is still
+// processing an accepted challenge. The challenge is checked again after the
+// retry period, unless it has been processing for longer than the challenge
+// processing timeout. In that case its authorization is deactivated and the
+// challenge is marked as errored, so that the Order fails and a fresh
+// authorization is requested with a new Order rather than polling forever.
+func (c *controller) handleProcessingChallenge(ctx context.Context, cl acmecl.Interface, ch *cmacme.Challenge) error {
+	log := logf.FromContext(ctx, "handleProcessingChallenge")
+
+	processingFor := c.processing.observedFor(ch.UID)
+	if processingFor < c.challengeProcessingTimeout {
+		log.V(logf.DebugLevel).Info("ACME server is still processing the challenge, checking again later", "processing_for", processingFor)
+		ch.Status.Reason = "Waiting for the ACME server to finish processing the challenge"
+
+		c.queue.AddAfter(types.NamespacedName{
+			Namespace: ch.Namespace,
+			Name:      ch.Name,
+		}, c.processingRetryPeriod(ch))
+
+		return nil
+	}
+
+	log.V(logf.InfoLevel).Info("deactivating authorization which has been processing for longer than the timeout", "processing_for", processingFor, "timeout", c.challengeProcessingTimeout)
+	if err := cl.RevokeAuthorization(ctx, ch.Spec.AuthorizationURL); err != nil {
+		log.Error(err, "error deactivating authorization")
+		return fmt.Errorf("error deactivating authorization that has been processing for %s: %w", processingFor.Round(time.Second), err)
+	}
+
+	c.processing.forget(ch.UID)
+	ch.Status.State = cmacme.Errored
+	ch.Status.Reason = fmt.Sprintf("Authorization was still being processed by the ACME server after %s and has been deactivated", c.challengeProcessingTimeout)
+	c.recorder.Eventf(ch, corev1.EventTypeWarning, reasonProcessingTimeout, "Deactivated authorization which was still being processed by the ACME server after %s", c.challengeProcessingTimeout)
+
+	return nil
+}
+
+// processingRetryPeriod returns how long to wait before checking a challenge
+// that the ACME server is still processing again.
+func (c *controller) processingRetryPeriod(ch *cmacme.Challenge) time.Duration {
+	if ch.Spec.Type == cmacme.ACMEChallengeTypeDNS01 {
+		return c.DNS01CheckRetryPeriod
+	}
+	return http01ProcessingRetryPeriod
+}
+
 func (c *controller) handleAuthorizationError(ctx context.Context, ch *cmacme.Challenge, err error) error {
 	authErr, ok := err.(*acmeapi.AuthorizationError)
 	if !ok {
@@ -418,3 +568,51 @@ func (c *controller) solverFor(challengeType cmacme.ACMEChallengeType) (solver,
 	}
 	return nil, fmt.Errorf("no solver for %q implemented", challengeType)
 }
+
+// solverStatusFor returns the details identifying the given solver
+// configuration, so that it is visible on the Challenge which of the issuer's
+// solvers was selected for it.
+func solverStatusFor(solver cmacme.ACMEChallengeSolver) *cmacme.ChallengeSolverStatus {
+	return &cmacme.ChallengeSolverStatus{
+		Type:     solverType(solver),
+		Selector: solver.Selector.DeepCopy(),
+	}
+}
+
+// solverType returns the path of the configured solver within the given
+// solver configuration, e.g. "http01.ingress" or "dns01.route53".
+func solverType(solver cmacme.ACMEChallengeSolver) string {
+	switch {
+	case solver.HTTP01 != nil:
+		switch {
+		case solver.HTTP01.Ingress != nil:
+			return "http01.ingress"
+		case solver.HTTP01.GatewayHTTPRoute != nil:
+			return "http01.gatewayHTTPRoute"
+		}
+		return "http01"
+	case solver.DNS01 != nil:
+		switch p := solver.DNS01; {
+		case p.Akamai != nil:
+			return "dns01.akamai"
+		case p.CloudDNS != nil:
+			return "dns01.cloudDNS"
+		case p.Cloudflare != nil:
+			return "dns01.cloudflare"
+		case p.Route53 != nil:
+			return "dns01.route53"
+		case p.AzureDNS != nil:
+			return "dns01.azureDNS"
+		case p.DigitalOcean != nil:
+			return "dns01.digitalocean"
+		case p.AcmeDNS != nil:
+			return "dns01.acmeDNS"
+		case p.RFC2136 != nil:
+			return "dns01.rfc2136"
+		case p.Webhook != nil:
+			return "dns01.webhook"
+		}
+		return "dns01"
+	}
+	return ""
+}
@@ -21,12 +21,14 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	acmeapi "golang.org/x/crypto/acme"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	coretesting "k8s.io/client-go/testing"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	fakeclock "k8s.io/utils/clock/testing"
 
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	accountstest "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
@@ -65,13 +67,33 @@ type fakeSolver struct {
 	fakeCleanUp func(ctx context.Context, ch *cmacme.Challenge) error
 }
 
+type fakePreValidator func(ctx context.Context, ch *cmacme.Challenge) error
+
+func (f fakePreValidator) PreValidate(ctx context.Context, ch *cmacme.Challenge) error {
+	return f(ctx, ch)
+}
+
 type testT struct {
-	challenge  *cmacme.Challenge
-	builder    *testpkg.Builder
-	httpSolver *fakeSolver
-	dnsSolver  *fakeSolver
-	expectErr  bool
-	acmeClient *acmecl.FakeACME
+	challenge         *cmacme.Challenge
+	builder           *testpkg.Builder
+	httpSolver        *fakeSolver
+	dnsSolver         *fakeSolver
+	dns01PreValidator preValidator
+	expectErr         bool
+	acmeClient        *acmecl.FakeACME
+
+	// challengeProcessingTimeout is set on the controller, and processingFor
+	// is how long the challenge has already been observed to be processing.
+	challengeProcessingTimeout time.Duration
+	processingFor              time.Duration
+
+	// validatedFor is how long the challenge has already been observed to be
+	// valid.
+	validatedFor time.Duration
+
+	// failedCleanUps is the number of times cleaning up the challenge has
+	// already failed.
+	failedCleanUps int
 }
 
 func testSyncHappyPathWithFinalizer(t *testing.T, finalizer string, activeFinalizer string) {
@@ -93,6 +115,39 @@ func testSyncHappyPathWithFinalizer(t *testing.T, finalizer string, activeFinali
 	deletedChallenge := gen.ChallengeFrom(baseChallenge,
 		gen.SetChallengeDeletionTimestamp(metav1.Now()))
 
+	dns01CleanupDelayChallenge := gen.ChallengeFrom(baseChallenge,
+		gen.SetChallengeProcessing(true),
+		gen.SetChallengeURL("testurl"),
+		gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+		gen.SetChallengePresented(true),
+		gen.SetChallengeSolver(cmacme.ACMEChallengeSolver{
+			DNS01: &cmacme.ACMEChallengeSolverDNS01{
+				CleanupDelay: &metav1.Duration{Duration: 5 * time.Minute},
+			},
+		}),
+	)
+
+	dns01PendingChallenge := gen.ChallengeFrom(baseChallenge,
+		gen.SetChallengeProcessing(true),
+		gen.SetChallengeURL("testurl"),
+		gen.SetChallengeDNSName("example.com"),
+		gen.SetChallengeState(cmacme.Pending),
+		gen.SetChallengeType(cmacme.ACMEChallengeTypeDNS01),
+		gen.SetChallengePresented(true),
+		gen.SetChallengeSolver(cmacme.ACMEChallengeSolver{
+			DNS01: &cmacme.ACMEChallengeSolverDNS01{},
+		}),
+	)
+
+	http01IngressSolverWithSelector := cmacme.ACMEChallengeSolver{
+		Selector: &cmacme.CertificateDNSNameSelector{
+			DNSZones: []string{"example.com"},
+		},
+		HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+			Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+		},
+	}
+
 	simulatedCleanupError := errors.New("simulated-cleanup-error")
 	tests := map[string]testT{
 		"cleanup if the challenge is deleted and remove the finalizer": {
@@ -127,7 +182,45 @@ func testSyncHappyPathWithFinalizer(t *testing.T, finalizer string, activeFinali
 				},
 			},
 		},
-		"if the challenge is deleted and the cleanup fails, set the reason (and remove the finalizer, which is a bug)": {
+		"if the challenge is deleted and the cleanup fails, set the reason and keep the finalizer so that the cleanup is retried": {
+			challenge: gen.ChallengeFrom(deletedChallenge,
+				gen.SetChallengeProcessing(true),
+				gen.SetChallengeURL("testurl"),
+				gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+			),
+			httpSolver: &fakeSolver{
+				fakeCleanUp: func(context.Context, *cmacme.Challenge) error {
+					return simulatedCleanupError
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.ChallengeFrom(deletedChallenge,
+						gen.SetChallengeProcessing(true),
+						gen.SetChallengeURL("testurl"),
+						gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+					),
+					testIssuerHTTP01Enabled,
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(
+						coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+							"status",
+							gen.DefaultTestNamespace,
+							gen.ChallengeFrom(deletedChallenge,
+								gen.SetChallengeProcessing(true),
+								gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+								gen.SetChallengeURL("testurl"),
+								gen.SetChallengeReason(simulatedCleanupError.Error()),
+							))),
+				},
+				ExpectedEvents: []string{
+					fmt.Sprintf("Warning CleanUpError Error cleaning up challenge: %s", simulatedCleanupError),
+				},
+			},
+			expectErr: true,
+		},
+		"if the challenge is deleted and the cleanup has failed too many times, set the reason and remove the finalizer": {
 			challenge: gen.ChallengeFrom(deletedChallenge,
 				gen.SetChallengeProcessing(true),
 				gen.SetChallengeURL("testurl"),
@@ -138,6 +231,7 @@ func testSyncHappyPathWithFinalizer(t *testing.T, finalizer string, activeFinali
 					return simulatedCleanupError
 				},
 			},
+			failedCleanUps: maxCleanUpAttempts - 1,
 			builder: &testpkg.Builder{
 				CertManagerObjects: []runtime.Object{
 					gen.ChallengeFrom(deletedChallenge,
@@ -171,6 +265,7 @@ func testSyncHappyPathWithFinalizer(t *testing.T, finalizer string, activeFinali
 				},
 				ExpectedEvents: []string{
 					fmt.Sprintf("Warning CleanUpError Error cleaning up challenge: %s", simulatedCleanupError),
+					fmt.Sprintf("Warning CleanUpError Giving up cleaning up challenge after %d attempts, the challenge record may need to be removed manually", maxCleanUpAttempts),
 				},
 			},
 		},
@@ -299,6 +394,7 @@ func testSyncHappyPathWithFinalizer(t *testing.T, finalizer string, activeFinali
 				gen.SetChallengeURL("testurl"),
 				gen.SetChallengeState(cmacme.Pending),
 				gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+				gen.SetChallengeSolver(http01IngressSolverWithSelector),
 			),
 			httpSolver: &fakeSolver{
 				fakePresent: func(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
@@ -314,6 +410,7 @@ func testSyncHappyPathWithFinalizer(t *testing.T, finalizer string, activeFinali
 					gen.SetChallengeURL("testurl"),
 					gen.SetChallengeState(cmacme.Pending),
 					gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+					gen.SetChallengeSolver(http01IngressSolverWithSelector),
 				), testIssuerHTTP01Enabled},
 				ExpectedActions: []testpkg.Action{
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
@@ -325,6 +422,13 @@ func testSyncHappyPathWithFinalizer(t *testing.T, finalizer string, activeFinali
 							gen.SetChallengeState(cmacme.Pending),
 							gen.SetChallengePresented(true),
 							gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+							gen.SetChallengeSolver(http01IngressSolverWithSelector),
+							gen.SetChallengeSolverStatus(&cmacme.ChallengeSolverStatus{
+								Type: "http01.ingress",
+								Selector: &cmacme.CertificateDNSNameSelector{
+									DNSZones: []string{"example.com"},
+								},
+							}),
 							gen.SetChallengeReason("Waiting for HTTP-01 challenge propagation: some error"),
 						))),
 				},
@@ -390,6 +494,152 @@ func testSyncHappyPathWithFinalizer(t *testing.T, finalizer string, activeFinali
 				},
 			},
 		},
+		"check again later if the ACME server is still processing the challenge": {
+			challenge: gen.ChallengeFrom(baseChallenge,
+				gen.SetChallengeProcessing(true),
+				gen.SetChallengeURL("testurl"),
+				gen.SetChallengeState(cmacme.Processing),
+				gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+				gen.SetChallengePresented(true),
+			),
+			httpSolver: &fakeSolver{
+				fakeCheck: func(context.Context, v1.GenericIssuer, *cmacme.Challenge) error {
+					return nil
+				},
+			},
+			builder: &testpkg.Builder{
+				Clock: fakeclock.NewFakeClock(time.Now()),
+				CertManagerObjects: []runtime.Object{gen.ChallengeFrom(baseChallenge,
+					gen.SetChallengeProcessing(true),
+					gen.SetChallengeURL("testurl"),
+					gen.SetChallengeState(cmacme.Processing),
+					gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+					gen.SetChallengePresented(true),
+				), testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.ChallengeFrom(baseChallenge,
+							gen.SetChallengeProcessing(true),
+							gen.SetChallengeURL("testurl"),
+							gen.SetChallengeState(cmacme.Processing),
+							gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+							gen.SetChallengePresented(true),
+							gen.SetChallengeReason("Waiting for the ACME server to finish processing the challenge"),
+						))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeAccept: func(context.Context, *acmeapi.Challenge) (*acmeapi.Challenge, error) {
+					return &acmeapi.Challenge{Status: acmeapi.StatusProcessing}, nil
+				},
+				FakeWaitAuthorization: func(context.Context, string) (*acmeapi.Authorization, error) {
+					return nil, context.DeadlineExceeded
+				},
+			},
+			challengeProcessingTimeout: time.Hour,
+			processingFor:              time.Minute,
+		},
+		"deactivate the authorization if the ACME server has been processing the challenge for longer than the timeout": {
+			challenge: gen.ChallengeFrom(baseChallenge,
+				gen.SetChallengeProcessing(true),
+				gen.SetChallengeURL("testurl"),
+				gen.SetChallengeState(cmacme.Processing),
+				gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+				gen.SetChallengePresented(true),
+			),
+			httpSolver: &fakeSolver{
+				fakeCheck: func(context.Context, v1.GenericIssuer, *cmacme.Challenge) error {
+					return nil
+				},
+			},
+			builder: &testpkg.Builder{
+				Clock: fakeclock.NewFakeClock(time.Now()),
+				CertManagerObjects: []runtime.Object{gen.ChallengeFrom(baseChallenge,
+					gen.SetChallengeProcessing(true),
+					gen.SetChallengeURL("testurl"),
+					gen.SetChallengeState(cmacme.Processing),
+					gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+					gen.SetChallengePresented(true),
+				), testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.ChallengeFrom(baseChallenge,
+							gen.SetChallengeProcessing(true),
+							gen.SetChallengeURL("testurl"),
+							gen.SetChallengeState(cmacme.Errored),
+							gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+							gen.SetChallengePresented(true),
+							gen.SetChallengeReason("Authorization was still being processed by the ACME server after 1h0m0s and has been deactivated"),
+						))),
+				},
+				ExpectedEvents: []string{
+					"Warning ProcessingTimeout Deactivated authorization which was still being processed by the ACME server after 1h0m0s",
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeAccept: func(context.Context, *acmeapi.Challenge) (*acmeapi.Challenge, error) {
+					return &acmeapi.Challenge{Status: acmeapi.StatusProcessing}, nil
+				},
+				FakeWaitAuthorization: func(context.Context, string) (*acmeapi.Authorization, error) {
+					return nil, context.DeadlineExceeded
+				},
+				FakeRevokeAuthorization: func(context.Context, string) error {
+					return nil
+				},
+			},
+			challengeProcessingTimeout: time.Hour,
+			processingFor:              2 * time.Hour,
+		},
+		"treat the authorization wait timing out as an error if the challenge processing timeout is disabled": {
+			challenge: gen.ChallengeFrom(baseChallenge,
+				gen.SetChallengeProcessing(true),
+				gen.SetChallengeURL("testurl"),
+				gen.SetChallengeState(cmacme.Processing),
+				gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+				gen.SetChallengePresented(true),
+			),
+			httpSolver: &fakeSolver{
+				fakeCheck: func(context.Context, v1.GenericIssuer, *cmacme.Challenge) error {
+					return nil
+				},
+			},
+			builder: &testpkg.Builder{
+				Clock: fakeclock.NewFakeClock(time.Now()),
+				CertManagerObjects: []runtime.Object{gen.ChallengeFrom(baseChallenge,
+					gen.SetChallengeProcessing(true),
+					gen.SetChallengeURL("testurl"),
+					gen.SetChallengeState(cmacme.Processing),
+					gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+					gen.SetChallengePresented(true),
+				), testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.ChallengeFrom(baseChallenge,
+							gen.SetChallengeProcessing(true),
+							gen.SetChallengeURL("testurl"),
+							gen.SetChallengeState(cmacme.Errored),
+							gen.SetChallengeType(cmacme.ACMEChallengeTypeHTTP01),
+							gen.SetChallengePresented(true),
+							gen.SetChallengeReason("unexpected non-ACME API error: context deadline exceeded"),
+						))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeAccept: func(context.Context, *acmeapi.Challenge) (*acmeapi.Challenge, error) {
+					return &acmeapi.Challenge{Status: acmeapi.StatusProcessing}, nil
+				},
+				FakeWaitAuthorization: func(context.Context, string) (*acmeapi.Authorization, error) {
+					return nil, context.DeadlineExceeded
+				},
+			},
+			expectErr: true,
+		},
 		"mark certificate as failed if accepting the authorization fails": {
 			challenge: gen.ChallengeFrom(baseChallenge,
 				gen.SetChallengeProcessing(true),
@@ -582,6 +832,156 @@ func testSyncHappyPathWithFinalizer(t *testing.T, finalizer string, activeFinali
 				},
 			},
 		},
+		"wait to accept a DNS01 challenge until pre-validation succeeds": {
+			challenge: gen.ChallengeFrom(dns01PendingChallenge),
+			dnsSolver: &fakeSolver{
+				fakeCheck: func(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+					return nil
+				},
+			},
+			dns01PreValidator: fakePreValidator(func(context.Context, *cmacme.Challenge) error {
+				return errors.New("record not found")
+			}),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.ChallengeFrom(dns01PendingChallenge), testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.ChallengeFrom(dns01PendingChallenge,
+							gen.SetChallengeReason("Waiting for DNS-01 challenge pre-validation: record not found"),
+						))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeAccept: func(context.Context, *acmeapi.Challenge) (*acmeapi.Challenge, error) {
+					return nil, errors.New("unexpected call to Accept")
+				},
+			},
+		},
+		"accept a DNS01 challenge once pre-validation succeeds": {
+			challenge: gen.ChallengeFrom(dns01PendingChallenge),
+			dnsSolver: &fakeSolver{
+				fakeCheck: func(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
+					return nil
+				},
+			},
+			dns01PreValidator: fakePreValidator(func(context.Context, *cmacme.Challenge) error {
+				return nil
+			}),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.ChallengeFrom(dns01PendingChallenge), testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.ChallengeFrom(dns01PendingChallenge,
+							gen.SetChallengeState(cmacme.Valid),
+							gen.SetChallengeReason("Successfully authorized domain"),
+						))),
+				},
+				ExpectedEvents: []string{
+					`Normal DomainVerified Domain "example.com" verified with "DNS-01" validation`,
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeAccept: func(context.Context, *acmeapi.Challenge) (*acmeapi.Challenge, error) {
+					return &acmeapi.Challenge{Status: acmeapi.StatusPending}, nil
+				},
+				FakeWaitAuthorization: func(context.Context, string) (*acmeapi.Authorization, error) {
+					return &acmeapi.Authorization{Status: acmeapi.StatusValid}, nil
+				},
+			},
+		},
+		"delay cleaning up a valid DNS01 challenge until the cleanup delay has passed": {
+			challenge: gen.ChallengeFrom(dns01CleanupDelayChallenge,
+				gen.SetChallengeState(cmacme.Valid),
+			),
+			dnsSolver: &fakeSolver{
+				fakeCleanUp: func(context.Context, *cmacme.Challenge) error {
+					return errors.New("unexpected call to CleanUp")
+				},
+			},
+			builder: &testpkg.Builder{
+				Clock: fakeclock.NewFakeClock(time.Now()),
+				CertManagerObjects: []runtime.Object{gen.ChallengeFrom(dns01CleanupDelayChallenge,
+					gen.SetChallengeState(cmacme.Valid),
+				), testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{},
+			},
+			validatedFor: time.Minute,
+		},
+		"clean up a valid DNS01 challenge once the cleanup delay has passed": {
+			challenge: gen.ChallengeFrom(dns01CleanupDelayChallenge,
+				gen.SetChallengeState(cmacme.Valid),
+			),
+			dnsSolver: &fakeSolver{
+				fakeCleanUp: func(context.Context, *cmacme.Challenge) error {
+					return nil
+				},
+			},
+			builder: &testpkg.Builder{
+				Clock: fakeclock.NewFakeClock(time.Now()),
+				CertManagerObjects: []runtime.Object{gen.ChallengeFrom(dns01CleanupDelayChallenge,
+					gen.SetChallengeState(cmacme.Valid),
+				), testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.ChallengeFrom(dns01CleanupDelayChallenge,
+							gen.SetChallengeProcessing(false),
+							gen.SetChallengeState(cmacme.Valid),
+							gen.SetChallengePresented(false),
+						))),
+				},
+			},
+			validatedFor: 10 * time.Minute,
+		},
+		"clean up a failed DNS01 challenge immediately regardless of the cleanup delay": {
+			challenge: gen.ChallengeFrom(dns01CleanupDelayChallenge,
+				gen.SetChallengeState(cmacme.Invalid),
+			),
+			dnsSolver: &fakeSolver{
+				fakeCleanUp: func(context.Context, *cmacme.Challenge) error {
+					return nil
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{gen.ChallengeFrom(dns01CleanupDelayChallenge,
+					gen.SetChallengeState(cmacme.Invalid),
+				), testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("challenges"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.ChallengeFrom(dns01CleanupDelayChallenge,
+							gen.SetChallengeProcessing(false),
+							gen.SetChallengeState(cmacme.Invalid),
+							gen.SetChallengePresented(false),
+						))),
+				},
+			},
+		},
+		"delay removing the finalizer of a deleted valid DNS01 challenge until the cleanup delay has passed": {
+			challenge: gen.ChallengeFrom(dns01CleanupDelayChallenge,
+				gen.SetChallengeState(cmacme.Valid),
+				gen.SetChallengeDeletionTimestamp(metav1.Now()),
+			),
+			dnsSolver: &fakeSolver{
+				fakeCleanUp: func(context.Context, *cmacme.Challenge) error {
+					return errors.New("unexpected call to CleanUp")
+				},
+			},
+			builder: &testpkg.Builder{
+				Clock: fakeclock.NewFakeClock(time.Now()),
+				CertManagerObjects: []runtime.Object{gen.ChallengeFrom(dns01CleanupDelayChallenge,
+					gen.SetChallengeState(cmacme.Valid),
+				), testIssuerHTTP01Enabled},
+				ExpectedActions: []testpkg.Action{},
+			},
+			validatedFor: time.Minute,
+		},
 	}
 
 	for name, test := range tests {
@@ -631,6 +1031,17 @@ func runTest(t *testing.T, test testT) {
 	}
 	c.httpSolver = test.httpSolver
 	c.dnsSolver = test.dnsSolver
+	c.dns01PreValidator = test.dns01PreValidator
+	c.challengeProcessingTimeout = test.challengeProcessingTimeout
+	if test.processingFor > 0 {
+		c.processing.observedFor(test.challenge.UID)
+		test.builder.Clock.Step(test.processingFor)
+	}
+	if test.validatedFor > 0 {
+		c.validated.observedFor(test.challenge.UID)
+		test.builder.Clock.Step(test.validatedFor)
+	}
+	c.cleaner.failures[test.challenge.UID] = test.failedCleanUps
 	test.builder.Start()
 
 	err := c.Sync(context.Background(), test.challenge)
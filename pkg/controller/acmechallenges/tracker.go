@@ -0,0 +1,69 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmechallenges
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+)
+
+// stateTracker records when each challenge was first observed to be in a
+// particular state, such as 'processing' on the ACME server after being
+// accepted.
+// The ACME API does not expose when a challenge entered a state, and this is
+// tracked in memory so a restart of the controller restarts any timers based
+// on it, which errs on the side of waiting longer.
+type stateTracker struct {
+	clock clock.Clock
+
+	lock  sync.Mutex
+	since map[types.UID]time.Time
+}
+
+func newStateTracker(clock clock.Clock) *stateTracker {
+	return &stateTracker{
+		clock: clock,
+		since: make(map[types.UID]time.Time),
+	}
+}
+
+// observedFor returns how long the challenge with the given UID has been
+// observed to be in the tracked state, starting the timer if this is the
+// first time.
+func (p *stateTracker) observedFor(uid types.UID) time.Duration {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	since, ok := p.since[uid]
+	if !ok {
+		since = p.clock.Now()
+		p.since[uid] = since
+	}
+
+	return p.clock.Since(since)
+}
+
+// forget stops tracking the challenge with the given UID.
+func (p *stateTracker) forget(uid types.UID) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	delete(p.since, uid)
+}
@@ -27,6 +27,7 @@ import (
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmacmelisters "github.com/cert-manager/cert-manager/pkg/client/listers/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
 )
 
 func handleGenericIssuerFunc(
@@ -73,7 +74,8 @@ func ordersForGenericIssuer(iss cmapi.GenericIssuer, orderLister cmacmelisters.O
 				continue
 			}
 		}
-		if o.Spec.IssuerRef.Name != iss.GetObjectMeta().Name {
+		// Orders may reference the issuer either by its name or by its alias.
+		if !issuer.IssuerRefNameMatches(iss, o.Spec.IssuerRef.Name) {
 			continue
 		}
 		affected = append(affected, o)
@@ -19,6 +19,7 @@ package acmeorders
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
@@ -37,6 +38,7 @@ import (
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 	"github.com/cert-manager/cert-manager/pkg/scheduler"
 )
 
@@ -64,6 +66,26 @@ type controller struct {
 	// fieldManager is the manager name used for the Apply operations on Secrets.
 	fieldManager string
 
+	// orderLimiter limits the rate at which ACME orders are created for the
+	// Orders of each namespace.
+	orderLimiter *namespaceOrderLimiter
+
+	// metrics is used to record the Orders delayed by orderLimiter.
+	metrics *metrics.Metrics
+
+	// issuanceLimiter limits the number of issuances in flight at once
+	// across the certificaterequests and orders controllers. The issuance of
+	// an Order is in flight until it reaches a final state or is deleted.
+	issuanceLimiter *controllerpkg.IssuanceLimiter
+
+	// authorizationRefreshWindow is the window before the expiry of a valid
+	// authorization in which it is refreshed when reused by an Order.
+	authorizationRefreshWindow time.Duration
+
+	// refreshedOrders records the Orders for which an authorization has
+	// already been refreshed.
+	refreshedOrders *refreshedOrders
+
 	// maintain a reference to the workqueue for this controller
 	// so the handleOwnedResource method can enqueue resources
 	queue workqueue.TypedRateLimitingInterface[types.NamespacedName]
@@ -157,6 +179,12 @@ func NewController(
 		cmClient:            ctx.CMClient,
 		accountRegistry:     ctx.AccountRegistry,
 		fieldManager:        ctx.FieldManager,
+		orderLimiter:        newNamespaceOrderLimiter(ctx.ACMEOptions.NamespaceOrdersPerHour, ctx.ACMEOptions.NamespaceOrderBurst),
+		metrics:             ctx.Metrics,
+		issuanceLimiter:     ctx.IssuanceLimiter,
+
+		authorizationRefreshWindow: ctx.ACMEOptions.AuthorizationRefreshWindow,
+		refreshedOrders:            newRefreshedOrders(),
 	}, queue, mustSync, nil
 
 }
@@ -169,6 +197,8 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	if err != nil {
 		if k8sErrors.IsNotFound(err) {
 			log.Error(err, "order in work queue no longer exists")
+			c.refreshedOrders.forget(key)
+			c.issuanceLimiter.Finish(controllerpkg.IssuanceKey{Kind: cmacme.OrderKind, NamespacedName: key})
 			return nil
 		}
 
@@ -0,0 +1,104 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmeorders
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// namespaceOrderLimiter limits the rate at which ACME orders are created for
+// the Orders of each namespace, so that a single namespace cannot use up the
+// rate limits of an ACME account shared with other namespaces. A nil
+// namespaceOrderLimiter does not limit the creation of orders.
+type namespaceOrderLimiter struct {
+	limit rate.Limit
+	burst int
+
+	lock     sync.Mutex
+	limiters map[string]*rate.Limiter
+	// lastEviction is the last time the limiters of idle namespaces were
+	// evicted.
+	lastEviction time.Time
+}
+
+// limiterEvictionInterval is the minimum interval between two evictions of
+// the limiters of idle namespaces.
+const limiterEvictionInterval = 10 * time.Minute
+
+// newNamespaceOrderLimiter returns a limiter allowing ordersPerHour ACME
+// orders per hour in each namespace, with the given burst. If burst is 0, it
+// is equal to ordersPerHour. If ordersPerHour is 0, nil is returned.
+func newNamespaceOrderLimiter(ordersPerHour, burst int) *namespaceOrderLimiter {
+	if ordersPerHour <= 0 {
+		return nil
+	}
+	if burst <= 0 {
+		burst = ordersPerHour
+	}
+
+	return &namespaceOrderLimiter{
+		limit:    rate.Limit(float64(ordersPerHour) / time.Hour.Seconds()),
+		burst:    burst,
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// allow reports whether an ACME order may be created for an Order in the
+// given namespace at the given time. If it may, a token is taken from the
+// namespace's bucket and 0 is returned. Otherwise no token is taken and the
+// time to wait until a token becomes available is returned.
+func (l *namespaceOrderLimiter) allow(namespace string, now time.Time) time.Duration {
+	if l == nil {
+		return 0
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if now.Sub(l.lastEviction) >= limiterEvictionInterval {
+		l.evictIdle(now)
+		l.lastEviction = now
+	}
+
+	limiter, ok := l.limiters[namespace]
+	if !ok {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+		l.limiters[namespace] = limiter
+	}
+
+	if limiter.AllowN(now, 1) {
+		return 0
+	}
+
+	missing := 1 - limiter.TokensAt(now)
+	return time.Duration(math.Ceil(missing / float64(l.limit) * float64(time.Second)))
+}
+
+// evictIdle removes the limiters whose bucket is full at the given time. They
+// behave the same as the new limiter which is created for the namespace when
+// it next creates an order. The lock must be held by the caller.
+func (l *namespaceOrderLimiter) evictIdle(now time.Time) {
+	for namespace, limiter := range l.limiters {
+		if limiter.TokensAt(now) >= float64(l.burst) {
+			delete(l.limiters, namespace)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmeorders
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNamespaceOrderLimiter(t *testing.T) {
+	now := time.Now()
+
+	// attempt is a single attempt to create an order in a namespace, made
+	// after advancing the time by the given duration.
+	type attempt struct {
+		advance   time.Duration
+		namespace string
+		expDelay  time.Duration
+	}
+
+	tests := map[string]struct {
+		ordersPerHour int
+		burst         int
+		attempts      []attempt
+	}{
+		"a zero rate does not limit orders": {
+			ordersPerHour: 0,
+			attempts: []attempt{
+				{namespace: "a"},
+				{namespace: "a"},
+				{namespace: "a"},
+			},
+		},
+		"the burst defaults to the hourly rate": {
+			ordersPerHour: 2,
+			attempts: []attempt{
+				{namespace: "a"},
+				{namespace: "a"},
+				{namespace: "a", expDelay: 30 * time.Minute},
+				{advance: 30 * time.Minute, namespace: "a"},
+			},
+		},
+		"orders exceeding the burst wait for the rate": {
+			ordersPerHour: 6,
+			burst:         1,
+			attempts: []attempt{
+				{namespace: "a"},
+				{namespace: "a", expDelay: 10 * time.Minute},
+				{advance: 5 * time.Minute, namespace: "a", expDelay: 5 * time.Minute},
+				{advance: 5 * time.Minute, namespace: "a"},
+			},
+		},
+		"namespaces are limited independently": {
+			ordersPerHour: 1,
+			attempts: []attempt{
+				{namespace: "a"},
+				{namespace: "a", expDelay: time.Hour},
+				{namespace: "b"},
+				{namespace: "b", expDelay: time.Hour},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			l := newNamespaceOrderLimiter(test.ordersPerHour, test.burst)
+
+			at := now
+			for i, a := range test.attempts {
+				at = at.Add(a.advance)
+				if delay := l.allow(a.namespace, at); delay != a.expDelay {
+					t.Errorf("attempt %d: unexpected delay, exp=%s got=%s", i, a.expDelay, delay)
+				}
+			}
+		})
+	}
+}
+
+func TestNamespaceOrderLimiterEvictsIdleNamespaces(t *testing.T) {
+	now := time.Now()
+	l := newNamespaceOrderLimiter(6, 1)
+
+	l.allow("a", now)
+	l.allow("b", now.Add(5*time.Minute))
+	if len(l.limiters) != 2 {
+		t.Fatalf("expected 2 limiters, got %d", len(l.limiters))
+	}
+
+	// The bucket of "a" is full again after 10 minutes, while the bucket of
+	// "b" is not, so only "a" is evicted.
+	l.allow("c", now.Add(limiterEvictionInterval))
+	if _, ok := l.limiters["a"]; ok {
+		t.Errorf("expected the limiter of the idle namespace to be evicted")
+	}
+	if _, ok := l.limiters["b"]; !ok {
+		t.Errorf("expected the limiter of the active namespace to be kept")
+	}
+
+	// An evicted namespace is limited the same as before being evicted.
+	if delay := l.allow("a", now.Add(limiterEvictionInterval)); delay != 0 {
+		t.Errorf("unexpected delay for an evicted namespace: %s", delay)
+	}
+	if delay := l.allow("a", now.Add(limiterEvictionInterval)); delay != 10*time.Minute {
+		t.Errorf("unexpected delay, exp=%s got=%s", 10*time.Minute, delay)
+	}
+}
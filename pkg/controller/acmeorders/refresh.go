@@ -0,0 +1,62 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acmeorders
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// refreshedOrders records the Orders for which an authorization has been
+// refreshed. The authorizations of an Order are refreshed at most once, so
+// that an ACME server which keeps returning authorizations close to their
+// expiry does not cause a new ACME order to be created over and over.
+type refreshedOrders struct {
+	lock   sync.Mutex
+	orders sets.Set[types.NamespacedName]
+}
+
+func newRefreshedOrders() *refreshedOrders {
+	return &refreshedOrders{
+		orders: sets.New[types.NamespacedName](),
+	}
+}
+
+// markRefreshed records that an authorization of the given Order is being
+// refreshed. It returns false if an authorization of the Order has already
+// been refreshed.
+func (r *refreshedOrders) markRefreshed(key types.NamespacedName) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.orders.Has(key) {
+		return false
+	}
+	r.orders.Insert(key)
+	return true
+}
+
+// forget removes the given Order, once it has reached a final state or has
+// been deleted.
+func (r *refreshedOrders) forget(key types.NamespacedName) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.orders.Delete(key)
+}
@@ -42,13 +42,17 @@ import (
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
 
 const (
-	reasonSolver  = "Solver"
-	reasonCreated = "Created"
+	reasonSolver      = "Solver"
+	reasonCreated     = "Created"
+	reasonRateLimited = "RateLimited"
+
+	reasonAuthorizationRefreshed = "AuthorizationRefreshed"
 )
 
 var (
@@ -88,12 +92,33 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 		return err
 	}
 
+	// Orders which have failed or been completed have no issuance in flight.
+	// The issuance of an Order created for a CertificateRequest is finished
+	// by the certificaterequests controller instead.
+	issuanceKey := issuanceKeyForOrder(o)
+	defer func() {
+		if orderIsFinal(o) && issuanceKey.Kind == cmacme.OrderKind {
+			c.issuanceLimiter.Finish(issuanceKey)
+		}
+	}()
+	if !orderIsFinal(o) && !c.issuanceLimiter.Start(issuanceKey) {
+		dbg.Info("Delaying the Order as the maximum number of issuances are in flight")
+		c.scheduledWorkQueue.Add(orderKey(o), controllerpkg.IssuanceLimiterRequeuePeriod)
+		return nil
+	}
+
 	switch {
 	case acme.IsFailureState(o.Status.State):
 		log.V(logf.DebugLevel).Info("Doing nothing as Order is in a failed state")
+		c.refreshedOrders.forget(orderKey(o))
 		// if the Order is failed there's nothing left for us to do, return nil
 		return nil
 	case o.Status.URL == "":
+		if delay := c.orderLimiter.allow(o.Namespace, c.clock.Now()); delay > 0 {
+			log.V(logf.DebugLevel).Info("Delaying the creation of the ACME order as the namespace has exceeded its ACME order rate limit", "delay", delay)
+			c.rateLimitOrder(o, delay)
+			return nil
+		}
 		log.V(logf.DebugLevel).Info("Creating new ACME order as status.url is not set")
 		return c.createOrder(ctx, cl, o)
 	case o.Status.FinalizeURL == "":
@@ -119,6 +144,7 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 		log.V(logf.DebugLevel).Info("Order has already been completed, cleaning up any owned Challenge resources")
 		// if the Order is valid and the certificate data has been set, clean
 		// up any owned Challenge resources and do nothing
+		c.refreshedOrders.forget(orderKey(o))
 		return c.deleteAllChallenges(ctx, o)
 	}
 
@@ -297,12 +323,55 @@ func (c *controller) createOrder(ctx context.Context, cl acmecl.Interface, o *cm
 
 	o.Status.URL = acmeOrder.URI
 	o.Status.FinalizeURL = acmeOrder.FinalizeURL
+	o.Status.Reason = ""
 	o.Status.Authorizations = constructAuthorizations(acmeOrder)
 	c.setOrderState(&o.Status, acmeOrder.Status)
 
 	return nil
 }
 
+// issuanceKeyForOrder returns the key which identifies the issuance of the
+// given Order in the IssuanceLimiter. An Order created for a
+// CertificateRequest is part of the issuance of that CertificateRequest, so
+// it does not take up another issuance in flight.
+func issuanceKeyForOrder(o *cmacme.Order) controllerpkg.IssuanceKey {
+	if ref := metav1.GetControllerOf(o); ref != nil && ref.Kind == cmapi.CertificateRequestKind {
+		return controllerpkg.IssuanceKey{
+			Kind:           cmapi.CertificateRequestKind,
+			NamespacedName: types.NamespacedName{Namespace: o.Namespace, Name: ref.Name},
+		}
+	}
+	return controllerpkg.IssuanceKey{Kind: cmacme.OrderKind, NamespacedName: orderKey(o)}
+}
+
+// orderIsFinal returns true if the given Order has failed, or has been
+// completed and its certificate has been retrieved.
+func orderIsFinal(o *cmacme.Order) bool {
+	return acme.IsFailureState(o.Status.State) || (o.Status.State == cmacme.Valid && len(o.Status.Certificate) > 0)
+}
+
+// rateLimitOrder records that the creation of the ACME order for the given
+// Order has been delayed by the rate limit of its namespace, and re-queues
+// the Order once the limit allows another order to be created.
+// The status reason does not contain the delay, so that it is only updated
+// once while the Order waits.
+func (c *controller) rateLimitOrder(o *cmacme.Order, delay time.Duration) {
+	reason := fmt.Sprintf("Waiting for the ACME order rate limit of namespace %q", o.Namespace)
+	if o.Status.Reason != reason {
+		c.recorder.Eventf(o, corev1.EventTypeNormal, reasonRateLimited, "%s, the order will be created in %s", reason, delay.Round(time.Second))
+	}
+	o.Status.Reason = reason
+
+	if c.metrics != nil {
+		c.metrics.IncrementACMEOrderRateLimitedCount(o.Namespace)
+	}
+
+	c.scheduledWorkQueue.Add(types.NamespacedName{
+		Name:      o.Name,
+		Namespace: o.Namespace,
+	}, delay)
+}
+
 func (c *controller) updateOrderStatus(ctx context.Context, cl acmecl.Interface, o *cmacme.Order) (*acmeapi.Order, error) {
 	acmeOrder, err := getACMEOrder(ctx, cl, o)
 	if err != nil {
@@ -386,6 +455,10 @@ func (c *controller) fetchMetadataForAuthorizations(ctx context.Context, o *cmac
 			return err
 		}
 
+		if c.shouldRefreshAuthorization(o, acmeAuthz) {
+			return c.refreshAuthorization(ctx, cl, o, authz.URL, acmeAuthz)
+		}
+
 		authz.InitialState = cmacme.State(acmeAuthz.Status)
 		authz.Identifier = acmeAuthz.Identifier.Value
 		authz.Wildcard = &acmeAuthz.Wildcard
@@ -400,6 +473,51 @@ func (c *controller) fetchMetadataForAuthorizations(ctx context.Context, o *cmac
 	return nil
 }
 
+// shouldRefreshAuthorization returns true if the given authorization of the
+// Order is valid but expires within the authorization refresh window, and no
+// authorization of the Order has been refreshed yet. Only authorizations which
+// are reused by an Order are refreshed, so the authorizations of identifiers
+// which are no longer requested are left to expire.
+func (c *controller) shouldRefreshAuthorization(o *cmacme.Order, acmeAuthz *acmeapi.Authorization) bool {
+	if c.authorizationRefreshWindow <= 0 || acmeAuthz.Status != acmeapi.StatusValid || acmeAuthz.Expires.IsZero() {
+		return false
+	}
+	return acmeAuthz.Expires.Sub(c.clock.Now()) < c.authorizationRefreshWindow && c.refreshedOrders.markRefreshed(orderKey(o))
+}
+
+// refreshAuthorization deactivates the given authorization, which is about to
+// expire, and resets the status of the Order so that a new ACME order is
+// created. The ACME server then returns a fresh authorization which is
+// validated again, rather than the authorization expiring before the Order
+// is finalized.
+func (c *controller) refreshAuthorization(ctx context.Context, cl acmecl.Interface, o *cmacme.Order, url string, acmeAuthz *acmeapi.Authorization) error {
+	log := logf.FromContext(ctx)
+
+	expiresIn := acmeAuthz.Expires.Sub(c.clock.Now()).Round(time.Second)
+	log.V(logf.InfoLevel).Info("deactivating authorization which expires within the refresh window", "identifier", acmeAuthz.Identifier.Value, "expires_in", expiresIn, "refresh_window", c.authorizationRefreshWindow)
+	if err := cl.RevokeAuthorization(ctx, url); err != nil {
+		// Allow the authorization to be refreshed when the Order is retried.
+		c.refreshedOrders.forget(orderKey(o))
+		return fmt.Errorf("error deactivating authorization for %q which expires in %s: %w", acmeAuthz.Identifier.Value, expiresIn, err)
+	}
+
+	o.Status.URL = ""
+	o.Status.FinalizeURL = ""
+	o.Status.Authorizations = nil
+	o.Status.State = ""
+	o.Status.Reason = fmt.Sprintf("Authorization for %q was about to expire in %s and has been deactivated, a new ACME order will be created", acmeAuthz.Identifier.Value, expiresIn)
+	c.recorder.Eventf(o, corev1.EventTypeNormal, reasonAuthorizationRefreshed, "Deactivated the authorization for %q which expires in %s, a new ACME order will be created to validate it again", acmeAuthz.Identifier.Value, expiresIn)
+
+	return nil
+}
+
+func orderKey(o *cmacme.Order) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: o.Namespace,
+		Name:      o.Name,
+	}
+}
+
 func (c *controller) anyRequiredChallengesDoNotExist(requiredChallenges []*cmacme.Challenge) (bool, error) {
 	for _, ch := range requiredChallenges {
 		_, err := c.challengeLister.Challenges(ch.Namespace).Get(ch.Name)
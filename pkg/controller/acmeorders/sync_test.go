@@ -29,12 +29,15 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 
 	"github.com/cert-manager/cert-manager/internal/pem"
 	accountstest "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
 	schedulertest "github.com/cert-manager/cert-manager/pkg/scheduler/test"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -90,6 +93,24 @@ func TestSync(t *testing.T) {
 		}),
 	)
 
+	// exhaustedOrderLimiter allows a single order per hour, which has already
+	// been created for the namespace of testOrder.
+	exhaustedOrderLimiter := newNamespaceOrderLimiter(1, 1)
+	exhaustedOrderLimiter.allow(testOrder.Namespace, nowTime)
+
+	// testOrderUnpopulated is an Order whose authorization has not been
+	// fetched from the ACME server yet.
+	testOrderUnpopulated := gen.OrderFrom(testOrder, gen.SetOrderStatus(cmacme.OrderStatus{
+		State:       cmacme.Pending,
+		URL:         "http://testurl.com/abcde",
+		FinalizeURL: "http://testurl.com/abcde/finalize",
+		Authorizations: []cmacme.ACMEAuthorization{
+			{
+				URL: "http://authzurl",
+			},
+		},
+	}))
+
 	testOrderIP := gen.Order("testorder", gen.SetOrderIssuer(cmmeta.ObjectReference{Name: testIssuerHTTP01.Name}), gen.SetOrderIPAddresses("10.0.0.1"))
 
 	pendingStatus := cmacme.OrderStatus{
@@ -318,6 +339,15 @@ Dfvp7OOGAN6dEOM4+qR9sdjoSYKEBpsr6GtPAQw4dy753ec5
 		},
 	}
 
+	testACMEAuthorizationValidExpiring := &acmeapi.Authorization{
+		URI:     "http://authzurl",
+		Status:  acmeapi.StatusValid,
+		Expires: nowTime.Add(time.Hour),
+		Identifier: acmeapi.AuthzID{
+			Value: "test.com",
+		},
+	}
+
 	testACMEOrderPending := &acmeapi.Order{
 		URI: testOrderPending.Status.URL,
 		Identifiers: []acmeapi.AuthzID{
@@ -381,6 +411,47 @@ Dfvp7OOGAN6dEOM4+qR9sdjoSYKEBpsr6GtPAQw4dy753ec5
 				},
 			},
 		},
+		"wait to create a new order with the acme server if the namespace has exceeded its order rate limit": {
+			order:        testOrder,
+			orderLimiter: exhaustedOrderLimiter,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrder},
+				ExpectedEvents: []string{
+					`Normal RateLimited Waiting for the ACME order rate limit of namespace "default-unit-test-ns", the order will be created in 1h0m0s`,
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrder.Namespace,
+						gen.OrderFrom(testOrder, gen.SetOrderStatus(cmacme.OrderStatus{
+							Reason: `Waiting for the ACME order rate limit of namespace "default-unit-test-ns"`,
+						})))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeAuthorizeOrder: func(ctx context.Context, id []acmeapi.AuthzID, opt ...acmeapi.OrderOption) (*acmeapi.Order, error) {
+					return nil, errors.New("unexpected call to AuthorizeOrder")
+				},
+			},
+			shouldSchedule: true,
+		},
+		"do not update the status of an order which is already waiting for the namespace order rate limit": {
+			order: gen.OrderFrom(testOrder, gen.SetOrderStatus(cmacme.OrderStatus{
+				Reason: `Waiting for the ACME order rate limit of namespace "default-unit-test-ns"`,
+			})),
+			orderLimiter: exhaustedOrderLimiter,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, gen.OrderFrom(testOrder, gen.SetOrderStatus(cmacme.OrderStatus{
+					Reason: `Waiting for the ACME order rate limit of namespace "default-unit-test-ns"`,
+				}))},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeAuthorizeOrder: func(ctx context.Context, id []acmeapi.AuthzID, opt ...acmeapi.OrderOption) (*acmeapi.Order, error) {
+					return nil, errors.New("unexpected call to AuthorizeOrder")
+				},
+			},
+			shouldSchedule: true,
+		},
 		"create a new order with the acme server with an IP address": {
 			order: testOrderIP,
 			builder: &testpkg.Builder{
@@ -420,6 +491,85 @@ Dfvp7OOGAN6dEOM4+qR9sdjoSYKEBpsr6GtPAQw4dy753ec5
 				},
 			},
 		},
+		"refresh a reused valid authorization which expires within the refresh window": {
+			order:                      testOrderUnpopulated,
+			authorizationRefreshWindow: 24 * time.Hour,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrderUnpopulated},
+				ExpectedEvents: []string{
+					`Normal AuthorizationRefreshed Deactivated the authorization for "test.com" which expires in 1h0m0s, a new ACME order will be created to validate it again`,
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrder.Namespace,
+						gen.OrderFrom(testOrder, gen.SetOrderStatus(cmacme.OrderStatus{
+							Reason: `Authorization for "test.com" was about to expire in 1h0m0s and has been deactivated, a new ACME order will be created`,
+						})))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeGetAuthorization: func(ctx context.Context, url string) (*acmeapi.Authorization, error) {
+					return testACMEAuthorizationValidExpiring, nil
+				},
+				FakeRevokeAuthorization: func(ctx context.Context, url string) error {
+					if url != "http://authzurl" {
+						return fmt.Errorf("Invalid URL: expected http://authzurl got %q", url)
+					}
+					return nil
+				},
+			},
+		},
+		"return an error if a reused valid authorization which expires within the refresh window cannot be deactivated": {
+			order:                      testOrderUnpopulated,
+			authorizationRefreshWindow: 24 * time.Hour,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrderUnpopulated},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeGetAuthorization: func(ctx context.Context, url string) (*acmeapi.Authorization, error) {
+					return testACMEAuthorizationValidExpiring, nil
+				},
+				FakeRevokeAuthorization: func(ctx context.Context, url string) error {
+					return errors.New("some error")
+				},
+			},
+			expectErr: true,
+		},
+		"do not refresh a reused valid authorization which expires after the refresh window": {
+			order:                      testOrderUnpopulated,
+			authorizationRefreshWindow: 30 * time.Minute,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrderUnpopulated},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrder.Namespace,
+						gen.OrderFrom(testOrderUnpopulated, gen.SetOrderStatus(cmacme.OrderStatus{
+							State:       cmacme.Pending,
+							URL:         "http://testurl.com/abcde",
+							FinalizeURL: "http://testurl.com/abcde/finalize",
+							Authorizations: []cmacme.ACMEAuthorization{
+								{
+									URL:          "http://authzurl",
+									Identifier:   "test.com",
+									InitialState: cmacme.Valid,
+									Wildcard:     ptr.To(false),
+									Challenges:   []cmacme.ACMEChallenge{},
+								},
+							},
+						})))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeGetAuthorization: func(ctx context.Context, url string) (*acmeapi.Authorization, error) {
+					return testACMEAuthorizationValidExpiring, nil
+				},
+				FakeRevokeAuthorization: func(ctx context.Context, url string) error {
+					return errors.New("unexpected call to RevokeAuthorization")
+				},
+			},
+		},
 		"create a challenge resource for the test.com dnsName on the order": {
 			order: testOrderPending,
 			builder: &testpkg.Builder{
@@ -466,7 +616,7 @@ Dfvp7OOGAN6dEOM4+qR9sdjoSYKEBpsr6GtPAQw4dy753ec5
 					// the 'unsupported challenge type' text is not printed here as the code that 'selects'
 					// a solver to use for a challenge filters out unsupported challenge types earlier
 					// in its selection routine.
-					`Warning Solver Failed to determine a valid solver configuration for the set of domains on the Order: no configured challenge solvers can be used for this challenge`,
+					`Warning Solver Failed to determine a valid solver configuration for the set of domains on the Order: no configured challenge solvers can be used for this challenge: solvers[0] (http01, selector {dnsNames: [test.com]}): the ACME server did not offer a challenge of this type`,
 				},
 			},
 		},
@@ -909,11 +1059,13 @@ Dfvp7OOGAN6dEOM4+qR9sdjoSYKEBpsr6GtPAQw4dy753ec5
 }
 
 type testT struct {
-	order          *cmacme.Order
-	builder        *testpkg.Builder
-	acmeClient     acmecl.Interface
-	shouldSchedule bool
-	expectErr      bool
+	order                      *cmacme.Order
+	builder                    *testpkg.Builder
+	acmeClient                 acmecl.Interface
+	orderLimiter               *namespaceOrderLimiter
+	authorizationRefreshWindow time.Duration
+	shouldSchedule             bool
+	expectErr                  bool
 }
 
 func runTest(t *testing.T, test testT) {
@@ -940,6 +1092,8 @@ func runTest(t *testing.T, test testT) {
 		},
 	}
 	cw.scheduledWorkQueue = &fakeScheduler
+	cw.orderLimiter = test.orderLimiter
+	cw.authorizationRefreshWindow = test.authorizationRefreshWindow
 
 	test.builder.Start()
 
@@ -956,3 +1110,39 @@ func runTest(t *testing.T, test testT) {
 
 	test.builder.CheckAndFinish(err)
 }
+
+func TestIssuanceKeyForOrder(t *testing.T) {
+	cr := gen.CertificateRequest("test-cr", gen.SetCertificateRequestNamespace("default-unit-test-ns"))
+	cr.UID = "cr-uid"
+
+	tests := map[string]struct {
+		order  *cmacme.Order
+		expKey controllerpkg.IssuanceKey
+	}{
+		"an Order created for a CertificateRequest uses the key of the CertificateRequest": {
+			order: gen.Order("test-order",
+				gen.SetOrderNamespace("default-unit-test-ns"),
+				gen.SetOrderOwnerReference(*metav1.NewControllerRef(cr, cmapi.SchemeGroupVersion.WithKind(cmapi.CertificateRequestKind))),
+			),
+			expKey: controllerpkg.IssuanceKey{
+				Kind:           cmapi.CertificateRequestKind,
+				NamespacedName: types.NamespacedName{Namespace: "default-unit-test-ns", Name: "test-cr"},
+			},
+		},
+		"an Order without a CertificateRequest uses its own key": {
+			order: gen.Order("test-order", gen.SetOrderNamespace("default-unit-test-ns")),
+			expKey: controllerpkg.IssuanceKey{
+				Kind:           cmacme.OrderKind,
+				NamespacedName: types.NamespacedName{Namespace: "default-unit-test-ns", Name: "test-order"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if key := issuanceKeyForOrder(test.order); key != test.expKey {
+				t.Errorf("unexpected key, exp=%v got=%v", test.expKey, key)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ package acmeorders
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -121,11 +122,16 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 		return nil
 	}
 
+	// rejectedSolvers records why each solver which could not be used was
+	// rejected, so that a mismatch can be diagnosed if no solver is selected.
+	var rejectedSolvers []string
+
 	// 2. filter solvers to only those that matchLabels
-	for _, cfg := range solvers {
+	for i, cfg := range solvers {
 		acmech := challengeForSolver(&cfg) // #nosec G601 -- False positive. See https://github.com/golang/go/discussions/56010
 		if acmech == nil {
 			dbg.Info("cannot use solver as the ACME authorization does not allow solvers of this type")
+			rejectedSolvers = append(rejectedSolvers, fmt.Sprintf("%s: the ACME server did not offer a challenge of this type", describeSolver(i, &cfg))) // #nosec G601 -- False positive. See https://github.com/golang/go/discussions/56010
 			continue
 		}
 
@@ -146,6 +152,8 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 
 		if !labelsMatch || !dnsNamesMatch || !dnsZonesMatch {
 			dbg.Info("not selecting solver", "labels_match", labelsMatch, "dnsnames_match", dnsNamesMatch, "dnszones_match", dnsZonesMatch)
+			rejectedSolvers = append(rejectedSolvers, fmt.Sprintf("%s: selector does not match %q (matchLabels: %t, dnsNames: %t, dnsZones: %t)",
+				describeSolver(i, &cfg), domainToFind, labelsMatch, dnsNamesMatch, dnsZonesMatch)) // #nosec G601 -- False positive. See https://github.com/golang/go/discussions/56010
 			continue
 		}
 
@@ -256,7 +264,10 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 	}
 
 	if selectedSolver == nil || selectedChallenge == nil {
-		return nil, fmt.Errorf("no configured challenge solvers can be used for this challenge")
+		if len(solvers) == 0 {
+			return nil, fmt.Errorf("no configured challenge solvers can be used for this challenge: the issuer has no solvers configured")
+		}
+		return nil, fmt.Errorf("no configured challenge solvers can be used for this challenge: %s", strings.Join(rejectedSolvers, "; "))
 	}
 
 	// It should never be possible for this case to be hit as earlier in this
@@ -287,6 +298,35 @@ func partialChallengeSpecForAuthorization(ctx context.Context, issuer cmapi.Gene
 	}, nil
 }
 
+// describeSolver returns a short description of the solver at index i of the
+// issuer's solvers, including its type and selector.
+func describeSolver(i int, solver *cmacme.ACMEChallengeSolver) string {
+	solverType := "unknown"
+	switch {
+	case solver.HTTP01 != nil:
+		solverType = "http01"
+	case solver.DNS01 != nil:
+		solverType = "dns01"
+	}
+
+	sel := solver.Selector
+	if sel == nil {
+		return fmt.Sprintf("solvers[%d] (%s, no selector)", i, solverType)
+	}
+
+	var fields []string
+	if len(sel.MatchLabels) > 0 {
+		fields = append(fields, fmt.Sprintf("matchLabels: %v", sel.MatchLabels))
+	}
+	if len(sel.DNSNames) > 0 {
+		fields = append(fields, fmt.Sprintf("dnsNames: %v", sel.DNSNames))
+	}
+	if len(sel.DNSZones) > 0 {
+		fields = append(fields, fmt.Sprintf("dnsZones: %v", sel.DNSZones))
+	}
+	return fmt.Sprintf("solvers[%d] (%s, selector {%s})", i, solverType, strings.Join(fields, ", "))
+}
+
 func challengeType(t string) (cmacme.ACMEChallengeType, error) {
 	switch t {
 	case "http-01":
@@ -1253,6 +1253,75 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 	}
 }
 
+func TestChallengeSpecForAuthorizationNoMatchingSolver(t *testing.T) {
+	tests := map[string]struct {
+		solvers     []cmacme.ACMEChallengeSolver
+		expectedErr string
+	}{
+		"no solvers configured": {
+			expectedErr: "no configured challenge solvers can be used for this challenge: the issuer has no solvers configured",
+		},
+		"each evaluated solver and why it was rejected is listed": {
+			solvers: []cmacme.ACMEChallengeSolver{
+				{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						Cloudflare: &cmacme.ACMEIssuerDNS01ProviderCloudflare{},
+					},
+				},
+				{
+					Selector: &cmacme.CertificateDNSNameSelector{
+						DNSNames: []string{"example.com"},
+						DNSZones: []string{"example.org"},
+					},
+					HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+						Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+					},
+				},
+				{
+					Selector: &cmacme.CertificateDNSNameSelector{
+						MatchLabels: map[string]string{"team": "payments"},
+					},
+					HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+						Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+					},
+				},
+			},
+			expectedErr: "no configured challenge solvers can be used for this challenge: " +
+				"solvers[0] (dns01, no selector): the ACME server did not offer a challenge of this type; " +
+				`solvers[1] (http01, selector {dnsNames: [example.com], dnsZones: [example.org]}): selector does not match "www.example.net" (matchLabels: true, dnsNames: false, dnsZones: false); ` +
+				`solvers[2] (http01, selector {matchLabels: map[team:payments]}): selector does not match "www.example.net" (matchLabels: false, dnsNames: true, dnsZones: true)`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			issuer := &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{Solvers: test.solvers},
+					},
+				},
+			}
+			order := &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					DNSNames: []string{"www.example.net"},
+				},
+			}
+			authz := cmacme.ACMEAuthorization{
+				Identifier: "www.example.net",
+				Challenges: []cmacme.ACMEChallenge{{Type: "http-01", Token: "http-01-token"}},
+			}
+
+			_, err := partialChallengeSpecForAuthorization(context.Background(), issuer, order, authz)
+			if err == nil {
+				t.Fatalf("expected to get an error, but got none")
+			}
+			if err.Error() != test.expectedErr {
+				t.Errorf("unexpected error:\nexp: %s\ngot: %s", test.expectedErr, err)
+			}
+		})
+	}
+}
+
 func Test_ensureKeysForChallenges(t *testing.T) {
 	basicACMEClient := &acmecl.FakeACME{
 		FakeHTTP01ChallengeResponse: func(token string) (string, error) {
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"time"
+
+	"k8s.io/client-go/util/flowcontrol"
 )
 
 // Builder is used to build controllers that implement the queuingController
@@ -78,5 +80,10 @@ func (b *Builder) Complete() (Interface, error) {
 		return nil, fmt.Errorf("error registering controller: %v", err)
 	}
 
-	return NewController(b.name, controllerctx.Metrics, b.impl.ProcessItem, mustSync, b.runDurationFuncs, queue), nil
+	ctrl := newController(b.name, controllerctx.Metrics, b.impl.ProcessItem, mustSync, b.runDurationFuncs, queue)
+	if controllerctx.InitialSyncItemsPerSecond > 0 {
+		ctrl.initialSyncLimiter = flowcontrol.NewTokenBucketRateLimiter(controllerctx.InitialSyncItemsPerSecond, 1)
+	}
+
+	return ctrl, nil
 }
@@ -0,0 +1,69 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shimhelper
+
+import (
+	"slices"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+)
+
+// ingressLikeClass returns the IngressClass or GatewayClass of the given
+// Ingress-like object, or an empty string if it does not have a class. The
+// class of an Ingress is read from spec.ingressClassName, falling back to the
+// deprecated kubernetes.io/ingress.class annotation.
+func ingressLikeClass(ingLike metav1.Object) string {
+	switch o := ingLike.(type) {
+	case *networkingv1.Ingress:
+		if o.Spec.IngressClassName != nil {
+			return *o.Spec.IngressClassName
+		}
+		return o.Annotations[cmapi.IngressClassAnnotationKey]
+	case *gwapi.Gateway:
+		return string(o.Spec.GatewayClassName)
+	}
+	return ""
+}
+
+// isClassAllowed returns whether the certificate-shim may create Certificates
+// for the given Ingress-like object, based on the allowed and denied classes
+// configured for its kind. A denied class is never allowed. If any classes
+// are allowed, objects of any other class, or without a class, are not
+// allowed.
+func isClassAllowed(defaults controller.IngressShimOptions, ingLike metav1.Object) bool {
+	var allowed, denied []string
+	switch ingLike.(type) {
+	case *networkingv1.Ingress:
+		allowed, denied = defaults.AllowedIngressClasses, defaults.DeniedIngressClasses
+	case *gwapi.Gateway:
+		allowed, denied = defaults.AllowedGatewayClasses, defaults.DeniedGatewayClasses
+	}
+
+	class := ingressLikeClass(ingLike)
+	if class != "" && slices.Contains(denied, class) {
+		return false
+	}
+	if len(allowed) > 0 {
+		return class != "" && slices.Contains(allowed, class)
+	}
+	return true
+}
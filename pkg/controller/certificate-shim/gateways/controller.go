@@ -32,6 +32,7 @@ import (
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	shimhelper "github.com/cert-manager/cert-manager/pkg/controller/certificate-shim"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 )
 
 const (
@@ -40,6 +41,7 @@ const (
 
 type controller struct {
 	gatewayLister gwlisters.GatewayLister
+	metrics       *metrics.Metrics
 	sync          shimhelper.SyncFn
 
 	// For testing purposes.
@@ -48,8 +50,10 @@ type controller struct {
 
 func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
 	c.gatewayLister = ctx.GWShared.Gateway().V1().Gateways().Lister()
+	c.metrics = ctx.Metrics
+
 	log := logf.FromContext(ctx.RootContext, ControllerName)
-	c.sync = shimhelper.SyncFnFor(ctx.Recorder, log, ctx.CMClient, ctx.SharedInformerFactory.Certmanager().V1().Certificates().Lister(), ctx.IngressShimOptions, ctx.FieldManager)
+	c.sync = shimhelper.SyncFnFor(ctx.Recorder, log, ctx.CMClient, ctx.SharedInformerFactory.Certmanager().V1().Certificates().Lister(), ctx.KubeSharedInformerFactory.Secrets().Lister(), ctx.Metrics, ctx.IngressShimOptions, ctx.FieldManager)
 
 	// We don't need to requeue Gateways on "Deleted" events, since our Sync
 	// function does nothing when the Gateway lister returns "not found". But we
@@ -79,6 +83,7 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 	mustSync := []cache.InformerSynced{
 		ctx.GWShared.Gateway().V1().Gateways().Informer().HasSynced,
 		ctx.SharedInformerFactory.Certmanager().V1().Certificates().Informer().HasSynced,
+		ctx.KubeSharedInformerFactory.Secrets().Informer().HasSynced,
 	}
 
 	return c.queue, mustSync, nil
@@ -92,6 +97,7 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	if err != nil {
 		if k8sErrors.IsNotFound(err) {
 			runtime.HandleError(fmt.Errorf("Gateway '%s' in work queue no longer exists", key))
+			c.metrics.RemoveShimSANDrift(namespace, "", "Gateway", name)
 			return nil
 		}
 
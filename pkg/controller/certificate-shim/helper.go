@@ -203,6 +203,7 @@ func translateAnnotations(crt *cmapi.Certificate, ingLikeAnnotations map[string]
 		algorithm := cmapi.PrivateKeyAlgorithm(privateKeyAlgorithm)
 		switch algorithm {
 		case cmapi.RSAKeyAlgorithm,
+			cmapi.RSAPSSKeyAlgorithm,
 			cmapi.ECDSAKeyAlgorithm,
 			cmapi.Ed25519KeyAlgorithm:
 			// ok
@@ -244,7 +245,7 @@ func translateAnnotations(crt *cmapi.Certificate, ingLikeAnnotations map[string]
 		}
 
 		switch algorithm {
-		case cmapi.RSAKeyAlgorithm:
+		case cmapi.RSAKeyAlgorithm, cmapi.RSAPSSKeyAlgorithm:
 			if size < pki.MinRSAKeySize || size > pki.MaxRSAKeySize {
 				return fmt.Errorf("%w %q: invalid private key size for RSA algorithm %q", errInvalidIngressAnnotation, cmapi.PrivateKeySizeAnnotationKey, privateKeySize)
 			}
@@ -32,6 +32,7 @@ import (
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	shimhelper "github.com/cert-manager/cert-manager/pkg/controller/certificate-shim"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 )
 
 const (
@@ -40,6 +41,7 @@ const (
 
 type controller struct {
 	ingressLister networkingv1listers.IngressLister
+	metrics       *metrics.Metrics
 	sync          shimhelper.SyncFn
 }
 
@@ -49,8 +51,10 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 	ingressInformer := ctx.KubeSharedInformerFactory.Ingresses()
 	c.ingressLister = ingressInformer.Lister()
 
+	c.metrics = ctx.Metrics
+
 	log := logf.FromContext(ctx.RootContext, ControllerName)
-	c.sync = shimhelper.SyncFnFor(ctx.Recorder, log, ctx.CMClient, cmShared.Certmanager().V1().Certificates().Lister(), ctx.IngressShimOptions, ctx.FieldManager)
+	c.sync = shimhelper.SyncFnFor(ctx.Recorder, log, ctx.CMClient, cmShared.Certmanager().V1().Certificates().Lister(), ctx.KubeSharedInformerFactory.Secrets().Lister(), ctx.Metrics, ctx.IngressShimOptions, ctx.FieldManager)
 
 	queue := workqueue.NewTypedRateLimitingQueueWithConfig(
 		controllerpkg.DefaultItemBasedRateLimiter(),
@@ -62,6 +66,7 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 	mustSync := []cache.InformerSynced{
 		ingressInformer.Informer().HasSynced,
 		cmShared.Certmanager().V1().Certificates().Informer().HasSynced,
+		ctx.KubeSharedInformerFactory.Secrets().Informer().HasSynced,
 	}
 
 	// We still requeue on "Deleted" for consistency with the rest of the
@@ -102,6 +107,7 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	if err != nil {
 		if k8sErrors.IsNotFound(err) {
 			runtime.HandleError(fmt.Errorf("ingress '%s' in work queue no longer exists", key))
+			c.metrics.RemoveShimSANDrift(namespace, "", "Ingress", name)
 			return nil
 		}
 
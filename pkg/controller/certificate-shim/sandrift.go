@@ -0,0 +1,92 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shimhelper
+
+import (
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	gwapi "sigs.k8s.io/gateway-api/apis/v1"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+	"github.com/cert-manager/cert-manager/pkg/util"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// sourceKind returns the kind of the given Ingress-like object, as used in
+// the labels of the SAN drift metric.
+func sourceKind(ingLike metav1.Object) string {
+	switch ingLike.(type) {
+	case *networkingv1.Ingress:
+		return ingressV1GVK.Kind
+	case *gwapi.Gateway:
+		return gatewayGVK.Kind
+	}
+	return ""
+}
+
+// updateSANDriftMetrics updates the SAN drift metric of each of the given
+// Certificates managed for the Ingress-like object. The Certificates are
+// expected to have the spec derived from the current state of the object.
+func updateSANDriftMetrics(log logr.Logger, m *metrics.Metrics, secretLister internalinformers.SecretLister, ingLike metav1.Object, crts []*cmapi.Certificate) {
+	for _, crt := range crts {
+		drifted := secretSANsDrifted(log, secretLister, crt)
+		if drifted {
+			logf.WithRelatedResource(log, crt).V(logf.DebugLevel).Info("SANs of the issued certificate differ from the hosts of the object, waiting for the Certificate to be re-issued")
+		}
+		m.UpdateShimSANDrift(crt.Namespace, crt.Name, sourceKind(ingLike), ingLike.GetName(), drifted)
+	}
+}
+
+// secretSANsDrifted returns true if the Secret of the Certificate contains a
+// certificate whose DNS names or IP addresses differ from the Certificate
+// spec. A Secret which does not exist yet, or does not contain a valid
+// certificate, is not considered to have drifted since there is nothing to
+// compare; the Certificate will be issued by the certificates controllers.
+func secretSANsDrifted(log logr.Logger, secretLister internalinformers.SecretLister, crt *cmapi.Certificate) bool {
+	secret, err := secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		return false
+	}
+	if err != nil {
+		log.V(logf.DebugLevel).Info("failed to get Secret to check for SAN drift", "secret", crt.Spec.SecretName, "error", err.Error())
+		return false
+	}
+
+	certBytes := secret.Data[corev1.TLSCertKey]
+	if len(certBytes) == 0 {
+		return false
+	}
+	cert, err := pki.DecodeX509CertificateBytes(certBytes)
+	if err != nil {
+		return false
+	}
+
+	ipAddresses, err := pki.IPAddressesFromStrings(crt.Spec.IPAddresses)
+	if err != nil {
+		return false
+	}
+
+	return !sets.New(cert.DNSNames...).Equal(sets.New(crt.Spec.DNSNames...)) ||
+		!util.EqualIPsUnsorted(cert.IPAddresses, ipAddresses)
+}
@@ -0,0 +1,115 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shimhelper
+
+import (
+	"crypto/x509"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientcorev1 "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcorelisters "github.com/cert-manager/cert-manager/test/unit/listers"
+)
+
+func Test_secretSANsDrifted(t *testing.T) {
+	key, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"example.com", "www.example.com"},
+		IPAddresses:  []net.IP{net.ParseIP("10.0.0.1")},
+	}
+	certPEM, _, err := pki.SignCertificate(template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		secretData  map[string][]byte
+		dnsNames    []string
+		ipAddresses []string
+		expDrifted  bool
+	}{
+		"SANs matching the Certificate in a different order have not drifted": {
+			secretData:  map[string][]byte{corev1.TLSCertKey: certPEM},
+			dnsNames:    []string{"www.example.com", "example.com"},
+			ipAddresses: []string{"10.0.0.1"},
+		},
+		"an added host has drifted": {
+			secretData:  map[string][]byte{corev1.TLSCertKey: certPEM},
+			dnsNames:    []string{"example.com", "www.example.com", "new.example.com"},
+			ipAddresses: []string{"10.0.0.1"},
+			expDrifted:  true,
+		},
+		"a removed IP address has drifted": {
+			secretData: map[string][]byte{corev1.TLSCertKey: certPEM},
+			dnsNames:   []string{"example.com", "www.example.com"},
+			expDrifted: true,
+		},
+		"a Secret which does not exist yet has not drifted": {
+			dnsNames: []string{"example.com"},
+		},
+		"a Secret without a certificate has not drifted": {
+			secretData: map[string][]byte{},
+			dnsNames:   []string{"example.com"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			secretLister := testcorelisters.NewFakeSecretLister(testcorelisters.SetFakeSecretListerSecret(func(namespace string) clientcorev1.SecretNamespaceLister {
+				return &testcorelisters.FakeSecretNamespaceLister{
+					GetFn: func(name string) (*corev1.Secret, error) {
+						if test.secretData == nil {
+							return nil, apierrors.NewNotFound(corev1.Resource("secrets"), name)
+						}
+						return &corev1.Secret{
+							ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+							Data:       test.secretData,
+						}, nil
+					},
+				}
+			}))
+
+			crt := &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "example-com-tls"},
+				Spec: cmapi.CertificateSpec{
+					SecretName:  "example-com-tls",
+					DNSNames:    test.dnsNames,
+					IPAddresses: test.ipAddresses,
+				},
+			}
+
+			if drifted := secretSANsDrifted(logr.Discard(), secretLister, crt); drifted != test.expDrifted {
+				t.Errorf("expected drifted=%t, got %t", test.expDrifted, drifted)
+			}
+		})
+	}
+}
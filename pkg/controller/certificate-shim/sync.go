@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -33,12 +34,14 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/tools/record"
 	gwapi "sigs.k8s.io/gateway-api/apis/v1"
 
 	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -46,6 +49,7 @@ import (
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
 
@@ -58,6 +62,13 @@ const (
 
 const applysetLabel = "applyset.kubernetes.io/part-of"
 
+// The values accepted by the cert-manager.io/gateway-certificate-mode
+// annotation.
+const (
+	gatewayCertificateModeConsolidated = "Consolidated"
+	gatewayCertificateModePerListener  = "PerListener"
+)
+
 var ingressV1GVK = networkingv1.SchemeGroupVersion.WithKind("Ingress")
 var gatewayGVK = gwapi.SchemeGroupVersion.WithKind("Gateway")
 
@@ -77,6 +88,8 @@ func SyncFnFor(
 	log logr.Logger,
 	cmClient clientset.Interface,
 	cmLister cmlisters.CertificateLister,
+	secretLister internalinformers.SecretLister,
+	m *metrics.Metrics,
 	defaults controller.IngressShimOptions,
 	fieldManager string,
 ) SyncFn {
@@ -101,6 +114,14 @@ func SyncFnFor(
 		if !hasShimAnnotation(ingLike, autoAnnotations) {
 			log.V(logf.DebugLevel).Info("not syncing ingress resource",
 				"reason", fmt.Sprintf("it does not contain a %q or %q annotation", cmapi.IngressIssuerNameAnnotationKey, cmapi.IngressClusterIssuerNameAnnotationKey))
+			m.RemoveShimSANDrift(ingLike.GetNamespace(), "", sourceKind(ingLike), ingLike.GetName())
+			return nil
+		}
+
+		if !isClassAllowed(defaults, ingLike) {
+			log.V(logf.DebugLevel).Info("not syncing ingress resource",
+				"reason", fmt.Sprintf("its class %q is not allowed by the controller configuration", ingressLikeClass(ingLike)))
+			m.RemoveShimSANDrift(ingLike.GetNamespace(), "", sourceKind(ingLike), ingLike.GetName())
 			return nil
 		}
 
@@ -109,7 +130,10 @@ func SyncFnFor(
 			return nil
 		}
 
-		issuerName, issuerKind, issuerGroup, err := issuerForIngressLike(defaults, ingLike)
+		// The issuer is determined for each Certificate, since the default
+		// issuer may depend on its DNS names. Determining it here only checks
+		// the annotations, so that bad annotations are reported once.
+		_, _, _, err := issuerForIngressLike(defaults, ingLike, nil)
 		if err != nil {
 			log.Error(err, "failed to determine issuer to be used for ingress resource")
 			rec.Eventf(ingLikeObj, corev1.EventTypeWarning, reasonBadConfig, "Could not determine issuer for ingress due to bad annotations: %s",
@@ -123,7 +147,7 @@ func SyncFnFor(
 			return nil
 		}
 
-		newCrts, updateCrts, err := buildCertificates(rec, log, cmLister, ingLike, issuerName, issuerKind, issuerGroup)
+		newCrts, updateCrts, err := buildCertificates(rec, log, cmLister, ingLike, defaults)
 		if err != nil {
 			return err
 		}
@@ -176,8 +200,11 @@ func SyncFnFor(
 				return err
 			}
 			rec.Eventf(ingLikeObj, corev1.EventTypeNormal, reasonDeleteCertificate, "Successfully deleted unrequired Certificate %q", certName)
+			m.RemoveShimSANDrift(ingLike.GetNamespace(), certName, sourceKind(ingLike), ingLike.GetName())
 		}
 
+		updateSANDriftMetrics(log, m, secretLister, ingLike, managedCertificates(certs, unrequiredCertNames, newCrts, updateCrts, ingLike))
+
 		return nil
 	}
 }
@@ -187,7 +214,7 @@ func validateIngressLike(ingLike metav1.Object) field.ErrorList {
 	case *networkingv1.Ingress:
 		return checkForDuplicateSecretNames(field.NewPath("spec", "tls"), o.Spec.TLS)
 	case *gwapi.Gateway:
-		return nil
+		return validateGatewayCertificateMode(o)
 	default:
 		panic(fmt.Errorf("programmer mistake: validateIngressLike can't handle %T, expected Ingress or Gateway", ingLike))
 	}
@@ -234,6 +261,70 @@ func checkForDuplicateSecretNames(path *field.Path, tlsBlocks []networkingv1.Ing
 	return errs
 }
 
+// validateGatewayCertificateMode checks the value of the
+// cert-manager.io/gateway-certificate-mode annotation. In the PerListener mode,
+// no two listeners may reference the same Secret since each of them gets its
+// own Certificate, and two Certificates can't share a secretName.
+func validateGatewayCertificateMode(gw *gwapi.Gateway) field.ErrorList {
+	mode, ok := gw.GetAnnotations()[cmapi.GatewayCertificateModeAnnotationKey]
+	if !ok {
+		return nil
+	}
+
+	if mode == gatewayCertificateModeConsolidated {
+		return nil
+	}
+	if mode != gatewayCertificateModePerListener {
+		return field.ErrorList{field.NotSupported(
+			field.NewPath("metadata", "annotations").Key(cmapi.GatewayCertificateModeAnnotationKey),
+			mode, []string{gatewayCertificateModeConsolidated, gatewayCertificateModePerListener})}
+	}
+
+	var errs field.ErrorList
+	type listenerRef struct {
+		index int
+		path  *field.Path
+	}
+	secretPaths := make(map[string]listenerRef)
+	for i, l := range gw.Spec.Listeners {
+		if l.TLS == nil || !gatewayListenerRequestsCertificate(l) {
+			continue
+		}
+		for j, certRef := range l.TLS.CertificateRefs {
+			path := field.NewPath("spec", "listeners").Index(i).Child("tls", "certificateRefs").Index(j).Child("name")
+			first, already := secretPaths[string(certRef.Name)]
+			if !already {
+				secretPaths[string(certRef.Name)] = listenerRef{index: i, path: path}
+				continue
+			}
+			if first.index == i {
+				continue
+			}
+			errs = append(errs, field.Invalid(first.path, string(certRef.Name),
+				fmt.Sprintf("this secret name must only appear in a single listener when using the %s certificate mode but is also used in %s",
+					gatewayCertificateModePerListener, path)))
+		}
+	}
+
+	return errs
+}
+
+// gatewayListenerRequestsCertificate returns true if a Certificate should be
+// issued for the given listener.
+func gatewayListenerRequestsCertificate(l gwapi.Listener) bool {
+	// TLS is only supported for a limited set of protocol types: https://gateway-api.sigs.k8s.io/guides/tls/#listeners-and-tls
+	if l.Protocol != gwapi.HTTPSProtocolType && l.Protocol != gwapi.TLSProtocolType {
+		return false
+	}
+
+	// We never issue certificates for TLS Passthrough mode
+	if l.TLS != nil && l.TLS.Mode != nil && *l.TLS.Mode == gwapi.TLSModePassthrough {
+		return false
+	}
+
+	return true
+}
+
 func validateIngressTLSBlock(path *field.Path, tlsBlock networkingv1.IngressTLS) field.ErrorList {
 	var errs field.ErrorList
 
@@ -298,7 +389,7 @@ func buildCertificates(
 	log logr.Logger,
 	cmLister cmlisters.CertificateLister,
 	ingLike metav1.Object,
-	issuerName, issuerKind, issuerGroup string,
+	defaults controller.IngressShimOptions,
 ) (newCrts, updateCrts []*cmapi.Certificate, _ error) {
 	tlsHosts := make(map[corev1.ObjectReference][]string)
 	switch ingLike := ingLike.(type) {
@@ -317,13 +408,7 @@ func buildCertificates(
 		}
 	case *gwapi.Gateway:
 		for i, l := range ingLike.Spec.Listeners {
-			// TLS is only supported for a limited set of protocol types: https://gateway-api.sigs.k8s.io/guides/tls/#listeners-and-tls
-			if l.Protocol != gwapi.HTTPSProtocolType && l.Protocol != gwapi.TLSProtocolType {
-				continue
-			}
-
-			// We never issue certificates for TLS Passthrough mode
-			if l.TLS != nil && l.TLS.Mode != nil && *l.TLS.Mode == gwapi.TLSModePassthrough {
+			if !gatewayListenerRequestsCertificate(l) {
 				continue
 			}
 
@@ -342,6 +427,12 @@ func buildCertificates(
 				} else {
 					secretRef.Namespace = ingLike.GetNamespace()
 				}
+				// Listeners sharing a secretName are consolidated into a
+				// single Certificate, so the same hostname may be seen more
+				// than once, e.g. on listeners for different ports.
+				if slices.Contains(tlsHosts[secretRef], string(*l.Hostname)) {
+					continue
+				}
 				// Gateway API hostname explicitly disallows IP addresses, so this
 				// should be OK.
 				tlsHosts[secretRef] = append(tlsHosts[secretRef], string(*l.Hostname))
@@ -376,6 +467,11 @@ func buildCertificates(
 			}
 		}
 
+		issuerName, issuerKind, issuerGroup, err := issuerForIngressLike(defaults, ingLike, dnsNames)
+		if err != nil {
+			return nil, nil, err
+		}
+
 		labels := ingLike.GetLabels()
 
 		// Remove applyset labels, as they cause certificates to be
@@ -463,6 +559,28 @@ func buildCertificates(
 	return newCrts, updateCrts, nil
 }
 
+// managedCertificates returns the Certificates controlled by the Ingress-like
+// object which are still required, using the created or updated version of
+// a Certificate where there is one since the lister may not have observed it
+// yet.
+func managedCertificates(certs []*cmapi.Certificate, unrequiredCertNames []string, newCrts, updateCrts []*cmapi.Certificate, ingLike metav1.Object) []*cmapi.Certificate {
+	byKey := make(map[types.NamespacedName]*cmapi.Certificate)
+	for _, crt := range certs {
+		if metav1.IsControlledBy(crt, ingLike) && !slices.Contains(unrequiredCertNames, crt.Name) {
+			byKey[types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name}] = crt
+		}
+	}
+	for _, crt := range slices.Concat(newCrts, updateCrts) {
+		byKey[types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name}] = crt
+	}
+
+	managed := make([]*cmapi.Certificate, 0, len(byKey))
+	for _, crt := range byKey {
+		managed = append(managed, crt)
+	}
+	return managed
+}
+
 func findCertificatesToBeRemoved(certs []*cmapi.Certificate, ingLike metav1.Object) []string {
 	var toBeRemoved []string
 	for _, crt := range certs {
@@ -713,21 +831,29 @@ func isDeletedInForeground(ingLike metav1.Object) bool {
 }
 
 // issuerForIngressLike determines the Issuer that should be specified on a
-// Certificate created for the given ingress-like resource. If one is not set,
-// the default issuer given to the controller is used. We look up the following
-// Ingress annotations:
+// Certificate with the given DNS names created for the given ingress-like
+// resource. If one is not set, the default issuer rule with the most specific
+// pattern matching all of the DNS names is used, or otherwise the default
+// issuer given to the controller. We look up the following Ingress
+// annotations:
 //
 //	cert-manager.io/cluster-issuer
 //	cert-manager.io/issuer
 //	cert-manager.io/issuer-kind
 //	cert-manager.io/issuer-group
-func issuerForIngressLike(defaults controller.IngressShimOptions, ingLike metav1.Object) (name, kind, group string, err error) {
+func issuerForIngressLike(defaults controller.IngressShimOptions, ingLike metav1.Object, dnsNames []string) (name, kind, group string, err error) {
 	var errs []string
 
 	name = defaults.DefaultIssuerName
 	kind = defaults.DefaultIssuerKind
 	group = defaults.DefaultIssuerGroup
 
+	if rule := defaultIssuerRuleFor(defaults.DefaultIssuerRules, dnsNames); rule != nil {
+		name = rule.IssuerName
+		kind = rule.IssuerKind
+		group = rule.IssuerGroup
+	}
+
 	annotations := ingLike.GetAnnotations()
 
 	if annotations == nil {
@@ -784,3 +910,32 @@ func issuerForIngressLike(defaults controller.IngressShimOptions, ingLike metav1
 
 	return name, kind, group, nil
 }
+
+// defaultIssuerRuleFor returns the rule with the most specific pattern which
+// matches all of the given DNS names, or nil if no rule matches. Of rules
+// with equally specific patterns, the first is returned.
+func defaultIssuerRuleFor(rules []controller.DefaultIssuerRule, dnsNames []string) *controller.DefaultIssuerRule {
+	if len(dnsNames) == 0 {
+		return nil
+	}
+
+	var match *controller.DefaultIssuerRule
+	for i, rule := range rules {
+		if match != nil && !rule.DNSNamePattern.MoreSpecificThan(match.DNSNamePattern) {
+			continue
+		}
+
+		matchesAll := true
+		for _, dnsName := range dnsNames {
+			if !rule.DNSNamePattern.Match(dnsName) {
+				matchesAll = false
+				break
+			}
+		}
+		if matchesAll {
+			match = &rules[i]
+		}
+	}
+
+	return match
+}
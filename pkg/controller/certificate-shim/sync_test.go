@@ -32,6 +32,7 @@ import (
 	"k8s.io/utils/ptr"
 	gwapi "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/cert-manager/cert-manager/internal/dnsglob"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -86,6 +87,8 @@ func TestSync(t *testing.T) {
 		DefaultIssuerName   string
 		DefaultIssuerKind   string
 		DefaultIssuerGroup  string
+		AllowedClasses      []string
+		DeniedClasses       []string
 		Err                 bool
 		ExpectedCreate      []*cmapi.Certificate
 		ExpectedUpdate      []*cmapi.Certificate
@@ -1826,6 +1829,238 @@ func TestSync(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:   "create a Certificate for an ingress whose class is allowed",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey: "issuer-name",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					IngressClassName: ptr.To("public"),
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com"},
+							SecretName: "example-com-tls",
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			AllowedClasses:      []string{"public"},
+			ExpectedEvents:      []string{`Normal CreateCertificate Successfully created Certificate "example-com-tls"`},
+			ExpectedCreate: []*cmapi.Certificate{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildIngressOwnerReferences("ingress-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"example.com"},
+						SecretName: "example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name: "issuer-name",
+							Kind: "ClusterIssuer",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+			},
+		},
+		{
+			Name:   "create a Certificate for an ingress whose class is allowed by the deprecated ingress class annotation",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey: "issuer-name",
+						cmapi.IngressClassAnnotationKey:             "public",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com"},
+							SecretName: "example-com-tls",
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			AllowedClasses:      []string{"public"},
+			ExpectedEvents:      []string{`Normal CreateCertificate Successfully created Certificate "example-com-tls"`},
+			ExpectedCreate: []*cmapi.Certificate{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildIngressOwnerReferences("ingress-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"example.com"},
+						SecretName: "example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name: "issuer-name",
+							Kind: "ClusterIssuer",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+			},
+		},
+		{
+			Name:   "do nothing for an ingress whose class is not allowed",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey: "issuer-name",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					IngressClassName: ptr.To("internal"),
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com"},
+							SecretName: "example-com-tls",
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			AllowedClasses:      []string{"public"},
+		},
+		{
+			Name:   "do nothing for an ingress without a class if classes are allowed",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey: "issuer-name",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com"},
+							SecretName: "example-com-tls",
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			AllowedClasses:      []string{"public"},
+		},
+		{
+			Name:   "do nothing for an ingress whose class is denied",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey: "issuer-name",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					IngressClassName: ptr.To("internal"),
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com"},
+							SecretName: "example-com-tls",
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			DeniedClasses:       []string{"internal"},
+		},
+		{
+			Name:   "do nothing for an ingress whose class is both allowed and denied",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey: "issuer-name",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					IngressClassName: ptr.To("public"),
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com"},
+							SecretName: "example-com-tls",
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			AllowedClasses:      []string{"public"},
+			DeniedClasses:       []string{"public"},
+		},
+		{
+			Name:   "create a Certificate for an ingress whose class is not denied",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &networkingv1.Ingress{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "ingress-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey: "issuer-name",
+					},
+					UID: types.UID("ingress-name"),
+				},
+				Spec: networkingv1.IngressSpec{
+					IngressClassName: ptr.To("public"),
+					TLS: []networkingv1.IngressTLS{
+						{
+							Hosts:      []string{"example.com"},
+							SecretName: "example-com-tls",
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			DeniedClasses:       []string{"internal"},
+			ExpectedEvents:      []string{`Normal CreateCertificate Successfully created Certificate "example-com-tls"`},
+			ExpectedCreate: []*cmapi.Certificate{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildIngressOwnerReferences("ingress-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"example.com"},
+						SecretName: "example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name: "issuer-name",
+							Kind: "ClusterIssuer",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+			},
+		},
 	}
 
 	testGatewayShim := []testT{
@@ -3099,7 +3334,7 @@ func TestSync(t *testing.T) {
 			},
 		},
 		{
-			Name:         "if a Gateway contains two listeners with different Secret names, it should create two Certificates",
+			Name:         "if a Gateway in the Consolidated mode contains listeners sharing a secretName, it should create a single Certificate with the union of their hostnames",
 			Issuer:       acmeIssuer,
 			IssuerLister: []runtime.Object{acmeIssuer},
 			IngressLike: &gwapi.Gateway{
@@ -3107,16 +3342,17 @@ func TestSync(t *testing.T) {
 					Name:      "gateway-name",
 					Namespace: gen.DefaultTestNamespace,
 					Annotations: map[string]string{
-						cmapi.IngressIssuerNameAnnotationKey: "issuer-name",
-						cmapi.IssuerKindAnnotationKey:        "Issuer",
-						cmapi.IssuerGroupAnnotationKey:       "cert-manager.io",
+						cmapi.IngressIssuerNameAnnotationKey:      "issuer-name",
+						cmapi.IssuerKindAnnotationKey:             "Issuer",
+						cmapi.IssuerGroupAnnotationKey:            "cert-manager.io",
+						cmapi.GatewayCertificateModeAnnotationKey: "Consolidated",
 					},
 					UID: types.UID("gateway-name"),
 				},
 				Spec: gwapi.GatewaySpec{
 					GatewayClassName: "test-gateway",
 					Listeners: []gwapi.Listener{{
-						Hostname: ptrHostname("foo.example.com"),
+						Hostname: ptrHostname("example.com"),
 						Port:     443,
 						Protocol: gwapi.HTTPSProtocolType,
 						TLS: &gwapi.GatewayTLSConfig{
@@ -3125,12 +3361,26 @@ func TestSync(t *testing.T) {
 								{
 									Group: func() *gwapi.Group { g := gwapi.Group("core"); return &g }(),
 									Kind:  func() *gwapi.Kind { k := gwapi.Kind("Secret"); return &k }(),
-									Name:  "foo-example-com-tls",
+									Name:  "example-com-tls",
 								},
 							},
 						},
 					}, {
-						Hostname: ptrHostname("bar.example.com"),
+						Hostname: ptrHostname("example.com"),
+						Port:     8443,
+						Protocol: gwapi.HTTPSProtocolType,
+						TLS: &gwapi.GatewayTLSConfig{
+							Mode: ptrMode(gwapi.TLSModeTerminate),
+							CertificateRefs: []gwapi.SecretObjectReference{
+								{
+									Group: func() *gwapi.Group { g := gwapi.Group("core"); return &g }(),
+									Kind:  func() *gwapi.Kind { k := gwapi.Kind("Secret"); return &k }(),
+									Name:  "example-com-tls",
+								},
+							},
+						},
+					}, {
+						Hostname: ptrHostname("www.example.com"),
 						Port:     443,
 						Protocol: gwapi.HTTPSProtocolType,
 						TLS: &gwapi.GatewayTLSConfig{
@@ -3139,7 +3389,7 @@ func TestSync(t *testing.T) {
 								{
 									Group: func() *gwapi.Group { g := gwapi.Group("core"); return &g }(),
 									Kind:  func() *gwapi.Kind { k := gwapi.Kind("Secret"); return &k }(),
-									Name:  "bar-example-com-tls",
+									Name:  "example-com-tls",
 								},
 							},
 						},
@@ -3147,19 +3397,18 @@ func TestSync(t *testing.T) {
 				},
 			},
 			ExpectedEvents: []string{
-				`Normal CreateCertificate Successfully created Certificate "foo-example-com-tls"`,
-				`Normal CreateCertificate Successfully created Certificate "bar-example-com-tls"`,
+				`Normal CreateCertificate Successfully created Certificate "example-com-tls"`,
 			},
 			ExpectedCreate: []*cmapi.Certificate{
 				{
 					ObjectMeta: metav1.ObjectMeta{
-						Name:            "foo-example-com-tls",
+						Name:            "example-com-tls",
 						Namespace:       gen.DefaultTestNamespace,
 						OwnerReferences: buildGatewayOwnerReferences("gateway-name"),
 					},
 					Spec: cmapi.CertificateSpec{
-						DNSNames:   []string{"foo.example.com"},
-						SecretName: "foo-example-com-tls",
+						DNSNames:   []string{"example.com", "www.example.com"},
+						SecretName: "example-com-tls",
 						IssuerRef: cmmeta.ObjectReference{
 							Name:  "issuer-name",
 							Kind:  "Issuer",
@@ -3168,17 +3417,271 @@ func TestSync(t *testing.T) {
 						Usages: cmapi.DefaultKeyUsages(),
 					},
 				},
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:            "bar-example-com-tls",
-						Namespace:       gen.DefaultTestNamespace,
-						OwnerReferences: buildGatewayOwnerReferences("gateway-name"),
-					},
-					Spec: cmapi.CertificateSpec{
-						DNSNames:   []string{"bar.example.com"},
-						SecretName: "bar-example-com-tls",
-						IssuerRef: cmmeta.ObjectReference{
-							Name:  "issuer-name",
+			},
+		},
+		{
+			Name:         "if a Gateway in the PerListener mode contains listeners with different Secret names, it should create one Certificate per listener",
+			Issuer:       acmeIssuer,
+			IssuerLister: []runtime.Object{acmeIssuer},
+			IngressLike: &gwapi.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gateway-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressIssuerNameAnnotationKey:      "issuer-name",
+						cmapi.IssuerKindAnnotationKey:             "Issuer",
+						cmapi.IssuerGroupAnnotationKey:            "cert-manager.io",
+						cmapi.GatewayCertificateModeAnnotationKey: "PerListener",
+					},
+					UID: types.UID("gateway-name"),
+				},
+				Spec: gwapi.GatewaySpec{
+					GatewayClassName: "test-gateway",
+					Listeners: []gwapi.Listener{{
+						Hostname: ptrHostname("example.com"),
+						Port:     443,
+						Protocol: gwapi.HTTPSProtocolType,
+						TLS: &gwapi.GatewayTLSConfig{
+							Mode: ptrMode(gwapi.TLSModeTerminate),
+							CertificateRefs: []gwapi.SecretObjectReference{
+								{
+									Group: func() *gwapi.Group { g := gwapi.Group("core"); return &g }(),
+									Kind:  func() *gwapi.Kind { k := gwapi.Kind("Secret"); return &k }(),
+									Name:  "example-com-tls",
+								},
+							},
+						},
+					}, {
+						Hostname: ptrHostname("www.example.com"),
+						Port:     443,
+						Protocol: gwapi.HTTPSProtocolType,
+						TLS: &gwapi.GatewayTLSConfig{
+							Mode: ptrMode(gwapi.TLSModeTerminate),
+							CertificateRefs: []gwapi.SecretObjectReference{
+								{
+									Group: func() *gwapi.Group { g := gwapi.Group("core"); return &g }(),
+									Kind:  func() *gwapi.Kind { k := gwapi.Kind("Secret"); return &k }(),
+									Name:  "www-example-com-tls",
+								},
+							},
+						},
+					}},
+				},
+			},
+			ExpectedEvents: []string{
+				`Normal CreateCertificate Successfully created Certificate "example-com-tls"`,
+				`Normal CreateCertificate Successfully created Certificate "www-example-com-tls"`,
+			},
+			ExpectedCreate: []*cmapi.Certificate{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildGatewayOwnerReferences("gateway-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"example.com"},
+						SecretName: "example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name:  "issuer-name",
+							Kind:  "Issuer",
+							Group: "cert-manager.io",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "www-example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildGatewayOwnerReferences("gateway-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"www.example.com"},
+						SecretName: "www-example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name:  "issuer-name",
+							Kind:  "Issuer",
+							Group: "cert-manager.io",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+			},
+		},
+		{
+			Name:         "if a Gateway in the PerListener mode contains listeners sharing a secretName, an error should be logged and no action taken",
+			Issuer:       acmeIssuer,
+			IssuerLister: []runtime.Object{acmeIssuer},
+			IngressLike: &gwapi.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gateway-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressIssuerNameAnnotationKey:      "issuer-name",
+						cmapi.IssuerKindAnnotationKey:             "Issuer",
+						cmapi.IssuerGroupAnnotationKey:            "cert-manager.io",
+						cmapi.GatewayCertificateModeAnnotationKey: "PerListener",
+					},
+					UID: types.UID("gateway-name"),
+				},
+				Spec: gwapi.GatewaySpec{
+					GatewayClassName: "test-gateway",
+					Listeners: []gwapi.Listener{{
+						Hostname: ptrHostname("example.com"),
+						Port:     443,
+						Protocol: gwapi.HTTPSProtocolType,
+						TLS: &gwapi.GatewayTLSConfig{
+							Mode: ptrMode(gwapi.TLSModeTerminate),
+							CertificateRefs: []gwapi.SecretObjectReference{
+								{
+									Group: func() *gwapi.Group { g := gwapi.Group("core"); return &g }(),
+									Kind:  func() *gwapi.Kind { k := gwapi.Kind("Secret"); return &k }(),
+									Name:  "example-com-tls",
+								},
+							},
+						},
+					}, {
+						Hostname: ptrHostname("www.example.com"),
+						Port:     443,
+						Protocol: gwapi.HTTPSProtocolType,
+						TLS: &gwapi.GatewayTLSConfig{
+							Mode: ptrMode(gwapi.TLSModeTerminate),
+							CertificateRefs: []gwapi.SecretObjectReference{
+								{
+									Group: func() *gwapi.Group { g := gwapi.Group("core"); return &g }(),
+									Kind:  func() *gwapi.Kind { k := gwapi.Kind("Secret"); return &k }(),
+									Name:  "example-com-tls",
+								},
+							},
+						},
+					}},
+				},
+			},
+			ExpectedEvents: []string{
+				`Warning BadConfig spec.listeners[0].tls.certificateRefs[0].name: Invalid value: "example-com-tls": this secret name must only appear in a single listener when using the PerListener certificate mode but is also used in spec.listeners[1].tls.certificateRefs[0].name`,
+			},
+		},
+		{
+			Name:         "if a Gateway has an unknown certificate mode, an error should be logged and no action taken",
+			Issuer:       acmeIssuer,
+			IssuerLister: []runtime.Object{acmeIssuer},
+			IngressLike: &gwapi.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gateway-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressIssuerNameAnnotationKey:      "issuer-name",
+						cmapi.IssuerKindAnnotationKey:             "Issuer",
+						cmapi.IssuerGroupAnnotationKey:            "cert-manager.io",
+						cmapi.GatewayCertificateModeAnnotationKey: "PerHostname",
+					},
+					UID: types.UID("gateway-name"),
+				},
+				Spec: gwapi.GatewaySpec{
+					GatewayClassName: "test-gateway",
+					Listeners: []gwapi.Listener{{
+						Hostname: ptrHostname("example.com"),
+						Port:     443,
+						Protocol: gwapi.HTTPSProtocolType,
+						TLS: &gwapi.GatewayTLSConfig{
+							Mode: ptrMode(gwapi.TLSModeTerminate),
+							CertificateRefs: []gwapi.SecretObjectReference{
+								{
+									Group: func() *gwapi.Group { g := gwapi.Group("core"); return &g }(),
+									Kind:  func() *gwapi.Kind { k := gwapi.Kind("Secret"); return &k }(),
+									Name:  "example-com-tls",
+								},
+							},
+						},
+					}},
+				},
+			},
+			ExpectedEvents: []string{
+				`Warning BadConfig metadata.annotations[cert-manager.io/gateway-certificate-mode]: Unsupported value: "PerHostname": supported values: "Consolidated", "PerListener"`,
+			},
+		},
+		{
+			Name:         "if a Gateway contains two listeners with different Secret names, it should create two Certificates",
+			Issuer:       acmeIssuer,
+			IssuerLister: []runtime.Object{acmeIssuer},
+			IngressLike: &gwapi.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gateway-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressIssuerNameAnnotationKey: "issuer-name",
+						cmapi.IssuerKindAnnotationKey:        "Issuer",
+						cmapi.IssuerGroupAnnotationKey:       "cert-manager.io",
+					},
+					UID: types.UID("gateway-name"),
+				},
+				Spec: gwapi.GatewaySpec{
+					GatewayClassName: "test-gateway",
+					Listeners: []gwapi.Listener{{
+						Hostname: ptrHostname("foo.example.com"),
+						Port:     443,
+						Protocol: gwapi.HTTPSProtocolType,
+						TLS: &gwapi.GatewayTLSConfig{
+							Mode: ptrMode(gwapi.TLSModeTerminate),
+							CertificateRefs: []gwapi.SecretObjectReference{
+								{
+									Group: func() *gwapi.Group { g := gwapi.Group("core"); return &g }(),
+									Kind:  func() *gwapi.Kind { k := gwapi.Kind("Secret"); return &k }(),
+									Name:  "foo-example-com-tls",
+								},
+							},
+						},
+					}, {
+						Hostname: ptrHostname("bar.example.com"),
+						Port:     443,
+						Protocol: gwapi.HTTPSProtocolType,
+						TLS: &gwapi.GatewayTLSConfig{
+							Mode: ptrMode(gwapi.TLSModeTerminate),
+							CertificateRefs: []gwapi.SecretObjectReference{
+								{
+									Group: func() *gwapi.Group { g := gwapi.Group("core"); return &g }(),
+									Kind:  func() *gwapi.Kind { k := gwapi.Kind("Secret"); return &k }(),
+									Name:  "bar-example-com-tls",
+								},
+							},
+						},
+					}},
+				},
+			},
+			ExpectedEvents: []string{
+				`Normal CreateCertificate Successfully created Certificate "foo-example-com-tls"`,
+				`Normal CreateCertificate Successfully created Certificate "bar-example-com-tls"`,
+			},
+			ExpectedCreate: []*cmapi.Certificate{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "foo-example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildGatewayOwnerReferences("gateway-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"foo.example.com"},
+						SecretName: "foo-example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name:  "issuer-name",
+							Kind:  "Issuer",
+							Group: "cert-manager.io",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "bar-example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildGatewayOwnerReferences("gateway-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"bar.example.com"},
+						SecretName: "bar-example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name:  "issuer-name",
 							Kind:  "Issuer",
 							Group: "cert-manager.io",
 						},
@@ -3361,6 +3864,133 @@ func TestSync(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:   "create a Certificate for a Gateway whose class is allowed",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &gwapi.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gateway-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey: "issuer-name",
+					},
+					UID: types.UID("gateway-name"),
+				},
+				Spec: gwapi.GatewaySpec{
+					GatewayClassName: "public",
+					Listeners: []gwapi.Listener{
+						{
+							Hostname: ptrHostname("example.com"),
+							Port:     443,
+							Protocol: gwapi.HTTPSProtocolType,
+							TLS: &gwapi.GatewayTLSConfig{
+								Mode: ptrMode(gwapi.TLSModeTerminate),
+								CertificateRefs: []gwapi.SecretObjectReference{
+									{
+										Group: func() *gwapi.Group { g := gwapi.Group("core"); return &g }(),
+										Kind:  func() *gwapi.Kind { k := gwapi.Kind("Secret"); return &k }(),
+										Name:  "example-com-tls",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			AllowedClasses:      []string{"public"},
+			ExpectedEvents:      []string{`Normal CreateCertificate Successfully created Certificate "example-com-tls"`},
+			ExpectedCreate: []*cmapi.Certificate{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "example-com-tls",
+						Namespace:       gen.DefaultTestNamespace,
+						OwnerReferences: buildGatewayOwnerReferences("gateway-name"),
+					},
+					Spec: cmapi.CertificateSpec{
+						DNSNames:   []string{"example.com"},
+						SecretName: "example-com-tls",
+						IssuerRef: cmmeta.ObjectReference{
+							Name: "issuer-name",
+							Kind: "ClusterIssuer",
+						},
+						Usages: cmapi.DefaultKeyUsages(),
+					},
+				},
+			},
+		},
+		{
+			Name:   "do nothing for a Gateway whose class is not allowed",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &gwapi.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gateway-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey: "issuer-name",
+					},
+					UID: types.UID("gateway-name"),
+				},
+				Spec: gwapi.GatewaySpec{
+					GatewayClassName: "internal",
+					Listeners: []gwapi.Listener{
+						{
+							Hostname: ptrHostname("example.com"),
+							Port:     443,
+							Protocol: gwapi.HTTPSProtocolType,
+							TLS: &gwapi.GatewayTLSConfig{
+								Mode: ptrMode(gwapi.TLSModeTerminate),
+								CertificateRefs: []gwapi.SecretObjectReference{
+									{
+										Group: func() *gwapi.Group { g := gwapi.Group("core"); return &g }(),
+										Kind:  func() *gwapi.Kind { k := gwapi.Kind("Secret"); return &k }(),
+										Name:  "example-com-tls",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			AllowedClasses:      []string{"public"},
+		},
+		{
+			Name:   "do nothing for a Gateway whose class is denied",
+			Issuer: acmeClusterIssuer,
+			IngressLike: &gwapi.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gateway-name",
+					Namespace: gen.DefaultTestNamespace,
+					Annotations: map[string]string{
+						cmapi.IngressClusterIssuerNameAnnotationKey: "issuer-name",
+					},
+					UID: types.UID("gateway-name"),
+				},
+				Spec: gwapi.GatewaySpec{
+					GatewayClassName: "internal",
+					Listeners: []gwapi.Listener{
+						{
+							Hostname: ptrHostname("example.com"),
+							Port:     443,
+							Protocol: gwapi.HTTPSProtocolType,
+							TLS: &gwapi.GatewayTLSConfig{
+								Mode: ptrMode(gwapi.TLSModeTerminate),
+								CertificateRefs: []gwapi.SecretObjectReference{
+									{
+										Group: func() *gwapi.Group { g := gwapi.Group("core"); return &g }(),
+										Kind:  func() *gwapi.Kind { k := gwapi.Kind("Secret"); return &k }(),
+										Name:  "example-com-tls",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			ClusterIssuerLister: []runtime.Object{acmeClusterIssuer},
+			DeniedClasses:       []string{"internal"},
+		},
 	}
 
 	testFn := func(test testT) func(t *testing.T) {
@@ -3413,11 +4043,15 @@ func TestSync(t *testing.T) {
 			}
 			b.Init()
 			defer b.Stop()
-			sync := SyncFnFor(b.Recorder, logr.Discard(), b.CMClient, b.SharedInformerFactory.Certmanager().V1().Certificates().Lister(), controllerpkg.IngressShimOptions{
+			sync := SyncFnFor(b.Recorder, logr.Discard(), b.CMClient, b.SharedInformerFactory.Certmanager().V1().Certificates().Lister(), b.KubeSharedInformerFactory.Secrets().Lister(), b.Metrics, controllerpkg.IngressShimOptions{
 				DefaultIssuerName:                 test.DefaultIssuerName,
 				DefaultIssuerKind:                 test.DefaultIssuerKind,
 				DefaultIssuerGroup:                test.DefaultIssuerGroup,
 				DefaultAutoCertificateAnnotations: []string{"kubernetes.io/tls-acme"},
+				AllowedIngressClasses:             test.AllowedClasses,
+				DeniedIngressClasses:              test.DeniedClasses,
+				AllowedGatewayClasses:             test.AllowedClasses,
+				DeniedGatewayClasses:              test.DeniedClasses,
 			}, "cert-manager-test")
 			b.Start()
 
@@ -3451,11 +4085,18 @@ func TestSync(t *testing.T) {
 }
 
 func TestIssuerForIngress(t *testing.T) {
+	defaultIssuerRules := []controllerpkg.DefaultIssuerRule{
+		{DNSNamePattern: mustParseDNSGlob(t, "**.internal.example.com"), IssuerName: "internal-ca", IssuerKind: "ClusterIssuer"},
+		{DNSNamePattern: mustParseDNSGlob(t, "api-*.internal.example.com"), IssuerName: "api-ca"},
+	}
+
 	type testT struct {
 		Ingress       *networkingv1.Ingress
 		DefaultName   string
 		DefaultKind   string
 		DefaultGroup  string
+		DefaultRules  []controllerpkg.DefaultIssuerRule
+		DNSNames      []string
 		ExpectedName  string
 		ExpectedKind  string
 		ExpectedGroup string
@@ -3508,14 +4149,60 @@ func TestIssuerForIngress(t *testing.T) {
 			}),
 			ExpectedError: errors.New(`both "cert-manager.io/issuer" and "cert-manager.io/cluster-issuer" may not be set, both "cert-manager.io/cluster-issuer" and "cert-manager.io/issuer-group" may not be set`),
 		},
+		{
+			Ingress: buildIngress("name", "namespace", map[string]string{
+				"kubernetes.io/tls-acme": "true",
+			}),
+			DefaultName:   "letsencrypt",
+			DefaultKind:   "ClusterIssuer",
+			DefaultRules:  defaultIssuerRules,
+			DNSNames:      []string{"a.internal.example.com", "b.c.internal.example.com"},
+			ExpectedName:  "internal-ca",
+			ExpectedKind:  "ClusterIssuer",
+			ExpectedGroup: "",
+		},
+		{
+			Ingress: buildIngress("name", "namespace", map[string]string{
+				"kubernetes.io/tls-acme": "true",
+			}),
+			DefaultName:  "letsencrypt",
+			DefaultKind:  "ClusterIssuer",
+			DefaultRules: defaultIssuerRules,
+			DNSNames:     []string{"api-1.internal.example.com"},
+			ExpectedName: "api-ca",
+			ExpectedKind: "",
+		},
+		{
+			Ingress: buildIngress("name", "namespace", map[string]string{
+				"kubernetes.io/tls-acme": "true",
+			}),
+			DefaultName:  "letsencrypt",
+			DefaultKind:  "ClusterIssuer",
+			DefaultRules: defaultIssuerRules,
+			DNSNames:     []string{"a.internal.example.com", "www.example.com"},
+			ExpectedName: "letsencrypt",
+			ExpectedKind: "ClusterIssuer",
+		},
+		{
+			Ingress: buildIngress("name", "namespace", map[string]string{
+				cmapi.IngressIssuerNameAnnotationKey: "issuer",
+			}),
+			DefaultName:  "letsencrypt",
+			DefaultKind:  "ClusterIssuer",
+			DefaultRules: defaultIssuerRules,
+			DNSNames:     []string{"a.internal.example.com"},
+			ExpectedName: "issuer",
+			ExpectedKind: "Issuer",
+		},
 	}
 	for _, test := range tests {
 		defaults := controllerpkg.IngressShimOptions{
 			DefaultIssuerKind:  test.DefaultKind,
 			DefaultIssuerName:  test.DefaultName,
 			DefaultIssuerGroup: test.DefaultGroup,
+			DefaultIssuerRules: test.DefaultRules,
 		}
-		name, kind, group, err := issuerForIngressLike(defaults, test.Ingress)
+		name, kind, group, err := issuerForIngressLike(defaults, test.Ingress, test.DNSNames)
 		if err != nil {
 			if test.ExpectedError == nil || err.Error() != test.ExpectedError.Error() {
 				t.Errorf("unexpected error, exp=%v got=%s", test.ExpectedError, err)
@@ -3975,3 +4662,48 @@ func Test_isDeletedInForeground(t *testing.T) {
 		}
 	})
 }
+
+func TestDefaultIssuerRuleFor(t *testing.T) {
+	rules := []controllerpkg.DefaultIssuerRule{
+		{DNSNamePattern: mustParseDNSGlob(t, "a*.example.com"), IssuerName: "first"},
+		{DNSNamePattern: mustParseDNSGlob(t, "*b.example.com"), IssuerName: "second"},
+	}
+
+	tests := map[string]struct {
+		dnsNames     []string
+		expectedName string
+	}{
+		"no DNS names match no rule": {
+			dnsNames: nil,
+		},
+		"the first of equally specific rules is used": {
+			dnsNames:     []string{"ab.example.com"},
+			expectedName: "first",
+		},
+		"a rule must match all of the DNS names": {
+			dnsNames:     []string{"ab.example.com", "xb.example.com"},
+			expectedName: "second",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotName string
+			if rule := defaultIssuerRuleFor(rules, test.dnsNames); rule != nil {
+				gotName = rule.IssuerName
+			}
+			if gotName != test.expectedName {
+				t.Errorf("unexpected rule, exp=%q got=%q", test.expectedName, gotName)
+			}
+		})
+	}
+}
+
+func mustParseDNSGlob(t *testing.T, pattern string) *dnsglob.Pattern {
+	t.Helper()
+	p, err := dnsglob.Parse(pattern)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
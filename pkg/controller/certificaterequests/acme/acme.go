@@ -204,9 +204,12 @@ func (a *ACME) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuer cm
 
 	if order.Status.State != cmacme.Valid {
 		// We update here to just pending while we wait for the order to be resolved.
-		a.reporter.Pending(cr, nil, "OrderPending",
-			fmt.Sprintf("Waiting on certificate issuance from order %s/%s: %q",
-				expectedOrder.Namespace, order.Name, order.Status.State))
+		message := fmt.Sprintf("Waiting on certificate issuance from order %s/%s: %q",
+			expectedOrder.Namespace, order.Name, order.Status.State)
+		if order.Status.Reason != "" {
+			message = fmt.Sprintf("%s: %s", message, order.Status.Reason)
+		}
+		a.reporter.Pending(cr, nil, "OrderPending", message)
 
 		log.V(logf.DebugLevel).Info("acme Order resource is not in a ready state, waiting...")
 
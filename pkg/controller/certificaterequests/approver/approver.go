@@ -30,6 +30,7 @@ import (
 	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
@@ -39,19 +40,32 @@ const (
 
 // Controller is a CertificateRequest controller which manages the "Approved"
 // condition. In the absence of any automated policy engine, this controller
-// will _always_ set the "Approved" condition to True. All CertificateRequest
-// signing controllers should wait until the "Approved" condition is set to
-// True before processing.
+// will _always_ set the "Approved" condition to True, unless approval rules
+// are configured, in which case only the CertificateRequests matching one of
+// the rules are approved. CertificateRequests for usages which the usage
+// policy of the referenced issuer does not permit are denied instead. All
+// CertificateRequest signing controllers should wait until the "Approved"
+// condition is set to True before processing.
 type Controller struct {
 	// logger to be used by this controller
 	log logr.Logger
 
 	certificateRequestLister cmlisters.CertificateRequestLister
 	cmClient                 cmclient.Interface
-	fieldManager             string
+
+	// helper is used to read the issuers referenced by CertificateRequests,
+	// to check their usage policy.
+	helper issuer.Helper
+
+	fieldManager string
 
 	recorder record.EventRecorder
 
+	// approvalRules restricts the approved CertificateRequests to those
+	// matching at least one rule. If empty, all CertificateRequests are
+	// approved.
+	approvalRules []controllerpkg.CertificateRequestApprovalRule
+
 	queue workqueue.TypedRateLimitingInterface[types.NamespacedName]
 }
 
@@ -81,10 +95,24 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 		return nil, nil, fmt.Errorf("error setting up event handler: %v", err)
 	}
 
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+	mustSync = append(mustSync, issuerInformer.Informer().HasSynced)
+
+	// if we are running in non-namespaced mode (i.e. --namespace=""), we also
+	// obtain a lister for clusterissuers.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		clusterIssuerLister = clusterIssuerInformer.Lister()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+	}
+
 	c.certificateRequestLister = certificateRequestInformer.Lister()
+	c.helper = issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister)
 	c.cmClient = ctx.CMClient
 	c.fieldManager = ctx.FieldManager
 	c.recorder = ctx.Recorder
+	c.approvalRules = ctx.CertificateRequestApprovalRules
 
 	c.log.V(logf.DebugLevel).Info("certificate request approver controller registered")
 
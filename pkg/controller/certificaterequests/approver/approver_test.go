@@ -22,12 +22,14 @@ import (
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
 )
 
@@ -45,6 +47,13 @@ func TestProcessItem(t *testing.T) {
 		// if not set, the 'key' will be passed to ProcessItem instead.
 		request *cmapi.CertificateRequest
 
+		// approvalRules are the approval rules configured on the controller.
+		approvalRules []controllerpkg.CertificateRequestApprovalRule
+
+		// issuers are the issuers which exist when the CertificateRequest is
+		// synced.
+		issuers []runtime.Object
+
 		// expectedEvent, if set, is an 'event string' that is expected to be fired.
 		expectedEvent string
 
@@ -171,6 +180,108 @@ func TestProcessItem(t *testing.T) {
 			},
 			expectedEvent: "Normal cert-manager.io Certificate request has been approved by cert-manager.io",
 		},
+		"approve CertificateRequest matching an approval rule": {
+			request: &cmapi.CertificateRequest{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+				Spec: cmapi.CertificateRequestSpec{
+					IssuerRef: cmmeta.ObjectReference{Name: "ca-issuer"},
+				},
+			},
+			approvalRules: []controllerpkg.CertificateRequestApprovalRule{
+				{IssuerName: "other-issuer"},
+				{IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "cert-manager.io", Namespaces: []string{"otherns", "testns"}},
+			},
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionApproved,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            ApprovedMessage,
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: "Normal cert-manager.io Certificate request has been approved by cert-manager.io",
+		},
+		"deny CertificateRequest for usages forbidden by the usage policy of the issuer": {
+			request: &cmapi.CertificateRequest{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+				Spec: cmapi.CertificateRequestSpec{
+					IssuerRef: cmmeta.ObjectReference{Name: "ca-issuer"},
+					Usages:    []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageCodeSigning},
+				},
+			},
+			issuers: []runtime.Object{
+				&cmapi.Issuer{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "ca-issuer"},
+					Spec: cmapi.IssuerSpec{
+						UsagePolicy: &cmapi.IssuerUsagePolicy{
+							ForbiddenUsages: []cmapi.KeyUsage{cmapi.UsageCertSign, cmapi.UsageCodeSigning},
+						},
+					},
+				},
+			},
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionDenied,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "ForbiddenUsages",
+					Message:            "Requested usages [code signing] are not permitted by the usage policy of the referenced issuer",
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: "Warning ForbiddenUsages Requested usages [code signing] are not permitted by the usage policy of the referenced issuer",
+		},
+		"approve CertificateRequest for usages permitted by the usage policy of the issuer": {
+			request: &cmapi.CertificateRequest{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+				Spec: cmapi.CertificateRequestSpec{
+					IssuerRef: cmmeta.ObjectReference{Name: "ca-issuer"},
+					Usages:    []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageServerAuth},
+				},
+			},
+			issuers: []runtime.Object{
+				&cmapi.Issuer{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "ca-issuer"},
+					Spec: cmapi.IssuerSpec{
+						UsagePolicy: &cmapi.IssuerUsagePolicy{
+							ForbiddenUsages: []cmapi.KeyUsage{cmapi.UsageCertSign, cmapi.UsageCodeSigning},
+						},
+					},
+				},
+			},
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionApproved,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            ApprovedMessage,
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: "Normal cert-manager.io Certificate request has been approved by cert-manager.io",
+		},
+		"do nothing if CertificateRequest issuer does not match an approval rule": {
+			request: &cmapi.CertificateRequest{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+				Spec: cmapi.CertificateRequestSpec{
+					IssuerRef: cmmeta.ObjectReference{Name: "ca-issuer", Kind: "ClusterIssuer"},
+				},
+			},
+			approvalRules: []controllerpkg.CertificateRequestApprovalRule{
+				{IssuerName: "ca-issuer", IssuerKind: "Issuer"},
+			},
+		},
+		"do nothing if CertificateRequest namespace does not match an approval rule": {
+			request: &cmapi.CertificateRequest{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+				Spec: cmapi.CertificateRequestSpec{
+					IssuerRef: cmmeta.ObjectReference{Name: "ca-issuer"},
+				},
+			},
+			approvalRules: []controllerpkg.CertificateRequestApprovalRule{
+				{Namespaces: []string{"otherns"}},
+			},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -182,7 +293,9 @@ func TestProcessItem(t *testing.T) {
 			if test.request != nil {
 				builder.CertManagerObjects = append(builder.CertManagerObjects, test.request)
 			}
+			builder.CertManagerObjects = append(builder.CertManagerObjects, test.issuers...)
 			builder.Init()
+			builder.Context.CertificateRequestApprovalRules = test.approvalRules
 
 			c := new(Controller)
 			_, _, err := c.Register(builder.Context)
@@ -18,6 +18,8 @@ package approver
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,19 +27,26 @@ import (
 	internalcertificaterequests "github.com/cert-manager/cert-manager/internal/controller/certificaterequests"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
 
 const (
 	ApprovedMessage = "Certificate request has been approved by cert-manager.io"
+
+	// reasonForbiddenUsages is the reason of the "Denied" condition set on
+	// CertificateRequests for usages forbidden by the issuer's usage policy.
+	reasonForbiddenUsages = "ForbiddenUsages"
 )
 
 // Sync will set the "Approved" condition to True on synced
-// CertificateRequests. If the "Denied", "Approved" or "Ready" condition
-// already exists, exit early.
+// CertificateRequests, or the "Denied" condition to True if the usage policy
+// of the referenced issuer does not permit the requested usages. If the
+// "Denied", "Approved" or "Ready" condition already exists, exit early.
 func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (err error) {
 	log := logf.FromContext(ctx, "approver")
 
@@ -55,6 +64,35 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		return nil
 	}
 
+	// If approval rules are configured, leave CertificateRequests which do
+	// not match any of them to be approved or denied by another approver.
+	if !c.matchesApprovalRules(cr) {
+		log.V(logf.DebugLevel).Info("certificate request does not match any approval rule, not approving")
+		return nil
+	}
+
+	// Deny requests for usages which the issuer will never sign, rather than
+	// approving them.
+	if violations := c.usagesViolatingIssuerPolicy(cr); len(violations) > 0 {
+		message := fmt.Sprintf("Requested usages %v are not permitted by the usage policy of the referenced issuer", violations)
+		cr = cr.DeepCopy()
+		apiutil.SetCertificateRequestCondition(cr,
+			cmapi.CertificateRequestConditionDenied,
+			cmmeta.ConditionTrue,
+			reasonForbiddenUsages,
+			message,
+		)
+
+		if err := c.updateStatusOrApply(ctx, cr); err != nil {
+			return err
+		}
+		c.recorder.Event(cr, corev1.EventTypeWarning, reasonForbiddenUsages, message)
+
+		log.V(logf.DebugLevel).Info("denied certificate request")
+
+		return nil
+	}
+
 	// Update the CertificateRequest approved condition to true.
 	cr = cr.DeepCopy()
 	apiutil.SetCertificateRequestCondition(cr,
@@ -75,6 +113,59 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 	return nil
 }
 
+// usagesViolatingIssuerPolicy returns the usages requested by the
+// CertificateRequest which are not permitted by the usage policy of the
+// referenced cert-manager issuer. Requests for issuers which cannot be read
+// are approved as usual, as their policy is still enforced when signing.
+func (c *Controller) usagesViolatingIssuerPolicy(cr *cmapi.CertificateRequest) []cmapi.KeyUsage {
+	if group := cr.Spec.IssuerRef.Group; group != "" && group != certmanager.GroupName {
+		return nil
+	}
+
+	issuerObj, err := c.helper.GetGenericIssuer(cr.Spec.IssuerRef, cr.Namespace)
+	if err != nil {
+		return nil
+	}
+
+	return internalcertificaterequests.UsagesViolatingIssuerPolicy(cr, issuerObj)
+}
+
+// matchesApprovalRules returns true if no approval rules are configured, or
+// if the CertificateRequest matches at least one of them.
+func (c *Controller) matchesApprovalRules(cr *cmapi.CertificateRequest) bool {
+	if len(c.approvalRules) == 0 {
+		return true
+	}
+	for _, rule := range c.approvalRules {
+		if approvalRuleMatches(rule, cr) {
+			return true
+		}
+	}
+	return false
+}
+
+// approvalRuleMatches returns true if the CertificateRequest matches all of
+// the fields set on the rule.
+func approvalRuleMatches(rule controllerpkg.CertificateRequestApprovalRule, cr *cmapi.CertificateRequest) bool {
+	issuerKind := cr.Spec.IssuerRef.Kind
+	if issuerKind == "" {
+		issuerKind = cmapi.IssuerKind
+	}
+	issuerGroup := cr.Spec.IssuerRef.Group
+	if issuerGroup == "" {
+		issuerGroup = certmanager.GroupName
+	}
+
+	switch {
+	case rule.IssuerName != "" && rule.IssuerName != cr.Spec.IssuerRef.Name,
+		rule.IssuerKind != "" && rule.IssuerKind != issuerKind,
+		rule.IssuerGroup != "" && rule.IssuerGroup != issuerGroup,
+		len(rule.Namespaces) > 0 && !slices.Contains(rule.Namespaces, cr.Namespace):
+		return false
+	}
+	return true
+}
+
 func (c *Controller) updateStatusOrApply(ctx context.Context, cr *cmapi.CertificateRequest) error {
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
 		return internalcertificaterequests.ApplyStatus(ctx, c.cmClient, c.fieldManager, cr)
@@ -19,7 +19,9 @@ package ca
 import (
 	"context"
 	"crypto"
+	"crypto/rsa"
 	"crypto/x509"
+	"errors"
 	"fmt"
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
@@ -31,9 +33,9 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
 	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
 	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	issuerca "github.com/cert-manager/cert-manager/pkg/issuer/ca"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
-	"github.com/cert-manager/cert-manager/pkg/util/kube"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
@@ -78,8 +80,9 @@ func (c *CA) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj c
 	secretName := issuerObj.GetSpec().CA.SecretName
 	resourceNamespace := c.issuerOptions.ResourceNamespace(issuerObj)
 
-	// get a copy of the CA certificate named on the Issuer
-	caCerts, caKey, err := kube.SecretTLSKeyPairAndCA(ctx, c.secretsLister, resourceNamespace, issuerObj.GetSpec().CA.SecretName)
+	// get a copy of the CA certificate named on the Issuer, completed with the
+	// chain up to the root CA if one is referenced
+	caCerts, caKey, err := issuerca.SigningKeyPairAndChain(ctx, c.secretsLister, resourceNamespace, issuerObj.GetSpec().CA)
 	if k8sErrors.IsNotFound(err) {
 		message := fmt.Sprintf("Referenced secret %s/%s not found", resourceNamespace, secretName)
 
@@ -117,6 +120,36 @@ func (c *CA) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj c
 	template.OCSPServer = issuerObj.GetSpec().CA.OCSPServers
 	template.IssuingCertificateURL = issuerObj.GetSpec().CA.IssuingCertificateURLs
 
+	if method := issuerObj.GetSpec().CA.SubjectKeyIdentifierMethod; method != "" {
+		template.SubjectKeyId, err = pki.SubjectKeyIdentifier(template.PublicKey, method)
+		if err != nil {
+			message := "Error computing subject key identifier"
+			c.reporter.Failed(cr, err, "SigningError", message)
+			log.Error(err, message)
+			return nil, nil
+		}
+	}
+
+	// Clamp the certificate duration to the policy matching the requester, if any.
+	if maxDuration, ok := apiutil.RequesterMaxDuration(issuerObj.GetSpec().CA.RequesterDurationPolicies, cr.Spec.Username, cr.Spec.Groups); ok {
+		apiutil.ClampCertificateTemplateDuration(template, maxDuration)
+	}
+
+	apiutil.AlignCertificateTemplateNotAfter(template, issuerObj.GetSpec().CA.NotAfterBoundary)
+
+	if apiutil.ClientAuthOnlyApplies(issuerObj.GetSpec().CA.ClientAuthOnly, cr.Namespace) {
+		apiutil.RestrictCertificateTemplateToClientAuth(template)
+	}
+
+	// RSASSA-PSS signatures can only be made by a CA with an RSA key.
+	if _, isRSA := caKey.Public().(*rsa.PublicKey); pki.IsRSAPSSSignatureAlgorithm(template.SignatureAlgorithm) && !isRSA {
+		err := errors.New("the CA's private key is not an RSA key")
+		message := "RSASSA-PSS signatures are not supported by this CA issuer"
+		c.reporter.Failed(cr, err, "SigningError", message)
+		log.Error(err, message)
+		return nil, nil
+	}
+
 	bundle, err := c.signingFn(caCerts, caKey, template)
 	if err != nil {
 		message := "Error signing certificate"
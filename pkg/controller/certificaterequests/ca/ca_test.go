@@ -357,6 +357,42 @@ func TestSign(t *testing.T) {
 				},
 			},
 		},
+		"a CertificateRequest requesting an RSASSA-PSS signature from a CA without an RSA key should set condition to failed": {
+			certificateRequest: baseCR.DeepCopy(),
+			templateGenerator: func(cr *cmapi.CertificateRequest) (*x509.Certificate, error) {
+				template, err := pki.CertificateTemplateFromCertificateRequest(cr)
+				if err != nil {
+					return nil, err
+				}
+
+				template.SignatureAlgorithm = x509.SHA256WithRSAPSS
+				return template, nil
+			},
+			builder: &testpkg.Builder{
+				KubeObjects:        []runtime.Object{rsaCASecret},
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), baseIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning SigningError RSASSA-PSS signatures are not supported by this CA issuer: the CA's private key is not an RSA key",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR.DeepCopy(),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "RSASSA-PSS signatures are not supported by this CA issuer: the CA's private key is not an RSA key",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+		},
 		"a successful signing should set condition to Ready": {
 			certificateRequest: baseCR.DeepCopy(),
 			templateGenerator: func(cr *cmapi.CertificateRequest) (*x509.Certificate, error) {
@@ -591,6 +627,97 @@ func TestCA_Sign(t *testing.T) {
 				assert.Equal(t, []string{"http://www.example.com/crl/test.crl"}, gotCA.CRLDistributionPoints)
 			},
 		},
+		"when the Issuer has subjectKeyIdentifierMethod set, the signed certificate should have a subject key identifier derived using that method": {
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, rootCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName:                 "secret-1",
+				SubjectKeyIdentifierMethod: cmapi.SubjectKeyIdentifierMethodTruncatedSHA1,
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestCSR(testCSR),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+			),
+			assertSignedCert: func(t *testing.T, got *x509.Certificate) {
+				expected, err := pki.SubjectKeyIdentifier(testpk.Public(), cmapi.SubjectKeyIdentifierMethodTruncatedSHA1)
+				require.NoError(t, err)
+				assert.Equal(t, expected, got.SubjectKeyId)
+				assert.Len(t, got.SubjectKeyId, 8)
+				assert.Equal(t, byte(0x40), got.SubjectKeyId[0]&0xf0)
+			},
+		},
+		"when the Issuer has a notAfterBoundary set, the notAfter of the signed certificate should be rounded down to it": {
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, rootCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName:       "secret-1",
+				NotAfterBoundary: cmapi.NotAfterBoundaryDay,
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestCSR(testCSR),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+				gen.SetCertificateRequestDuration(&metav1.Duration{
+					Duration: 30 * 24 * time.Hour,
+				}),
+			),
+			assertSignedCert: func(t *testing.T, got *x509.Certificate) {
+				assert.Equal(t, got.NotAfter.UTC().Truncate(24*time.Hour), got.NotAfter, "expected notAfter to be rounded down to midnight UTC")
+				// The notBefore is moved back so that the requested
+				// duration is kept.
+				assert.Equal(t, 30*24*time.Hour, got.NotAfter.Sub(got.NotBefore))
+			},
+		},
+		"when the Issuer has a clientAuthOnly policy for the namespace, server auth should be removed from the signed certificate": {
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, rootCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName: "secret-1",
+				ClientAuthOnly: &cmapi.ClientAuthOnlyPolicy{
+					Namespaces: []string{"zero-trust"},
+				},
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestNamespace("zero-trust"),
+				gen.SetCertificateRequestCSR(testCSR),
+				gen.SetCertificateRequestKeyUsages(cmapi.UsageDigitalSignature, cmapi.UsageServerAuth, cmapi.UsageClientAuth),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+			),
+			assertSignedCert: func(t *testing.T, got *x509.Certificate) {
+				assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, got.ExtKeyUsage)
+				assert.Equal(t, x509.KeyUsageDigitalSignature, got.KeyUsage)
+			},
+		},
+		"when the Issuer has a clientAuthOnly policy for another namespace, the requested usages should be kept": {
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, rootCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName: "secret-1",
+				ClientAuthOnly: &cmapi.ClientAuthOnlyPolicy{
+					Namespaces: []string{"zero-trust"},
+				},
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestNamespace("default"),
+				gen.SetCertificateRequestCSR(testCSR),
+				gen.SetCertificateRequestKeyUsages(cmapi.UsageDigitalSignature, cmapi.UsageServerAuth, cmapi.UsageClientAuth),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+			),
+			assertSignedCert: func(t *testing.T, got *x509.Certificate) {
+				assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}, got.ExtKeyUsage)
+			},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
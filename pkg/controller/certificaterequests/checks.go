@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
@@ -68,7 +69,8 @@ func (c *Controller) certificatesRequestsForGenericIssuer(iss cmapi.GenericIssue
 				continue
 			}
 		}
-		if crt.Spec.IssuerRef.Name != iss.GetObjectMeta().Name {
+		// Requests may reference the issuer either by its name or by its alias.
+		if !issuer.IssuerRefNameMatches(iss, crt.Spec.IssuerRef.Name) {
 			continue
 		}
 		affected = append(affected, crt)
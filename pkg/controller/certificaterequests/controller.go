@@ -36,6 +36,8 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
 // Issuer implements the functionality to sign a certificate request for a
@@ -99,6 +101,24 @@ type Controller struct {
 	clock clock.Clock
 
 	reporter *util.Reporter
+
+	// issuedCertificateVerificationPolicy controls how a certificate returned
+	// by the issuer which does not match the request is handled
+	issuedCertificateVerificationPolicy controllerpkg.IssuedCertificateVerificationPolicy
+
+	// issuanceLimiter limits the number of issuances in flight at once
+	// across the certificaterequests and orders controllers. The issuance of
+	// a CertificateRequest is in flight from the time it is first signed
+	// until it reaches a final state or is deleted.
+	issuanceLimiter *controllerpkg.IssuanceLimiter
+
+	// deprecatedCryptoPolicy defines the cryptography used by issued
+	// certificates which is reported as deprecated
+	deprecatedCryptoPolicy pki.DeprecatedCryptoPolicy
+
+	// metrics is used to count issued certificates using deprecated
+	// cryptography
+	metrics *metrics.Metrics
 }
 
 // New will construct a new certificaterequest controller using the given
@@ -194,6 +214,10 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLi
 	c.reporter = util.NewReporter(c.clock, c.recorder)
 	c.cmClient = ctx.CMClient
 	c.fieldManager = ctx.FieldManager
+	c.issuedCertificateVerificationPolicy = ctx.CertificateOptions.IssuedCertificateVerificationPolicy
+	c.issuanceLimiter = ctx.IssuanceLimiter
+	c.deprecatedCryptoPolicy = ctx.CertificateOptions.DeprecatedCryptoPolicy
+	c.metrics = ctx.Metrics
 
 	// Construct the issuer implementation with the built component context.
 	c.issuer = c.issuerConstructor(ctx)
@@ -216,6 +240,7 @@ func (c *Controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	if err != nil {
 		if k8sErrors.IsNotFound(err) {
 			dbg.Info(fmt.Sprintf("certificate request in work queue no longer exists: %s", err))
+			c.issuanceLimiter.Finish(controllerpkg.IssuanceKey{Kind: v1.CertificateRequestKind, NamespacedName: key})
 			return nil
 		}
 
@@ -0,0 +1,136 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlecas
+
+import (
+	"context"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
+	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	googlecasclient "github.com/cert-manager/cert-manager/pkg/issuer/googlecas/client"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	CRControllerName = "certificaterequests-issuer-googlecas"
+)
+
+type GoogleCAS struct {
+	issuerOptions controllerpkg.IssuerOptions
+	secretsLister internalinformers.SecretLister
+	reporter      *crutil.Reporter
+
+	clientBuilder googlecasclient.ClientBuilder
+
+	// For testing purposes.
+	createTokenFn func(ns string) googlecasclient.CreateToken
+}
+
+func init() {
+	// create certificate request controller for google cas issuer
+	controllerpkg.Register(CRControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, CRControllerName).
+			For(certificaterequests.New(apiutil.IssuerGoogleCAS, NewGoogleCAS)).
+			Complete()
+	})
+}
+
+func NewGoogleCAS(ctx *controllerpkg.Context) certificaterequests.Issuer {
+	return &GoogleCAS{
+		issuerOptions: ctx.IssuerOptions,
+		secretsLister: ctx.KubeSharedInformerFactory.Secrets().Lister(),
+		reporter:      crutil.NewReporter(ctx.Clock, ctx.Recorder),
+		clientBuilder: googlecasclient.New,
+		createTokenFn: func(ns string) googlecasclient.CreateToken {
+			return ctx.Client.CoreV1().ServiceAccounts(ns).CreateToken
+		},
+	}
+}
+
+func (g *GoogleCAS) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuerpkg.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+	log = logf.WithRelatedResource(log, issuerObj)
+
+	client, err := g.clientBuilder(ctx, g.issuerOptions.ResourceNamespace(issuerObj), g.createTokenFn, g.secretsLister, issuerObj, g.issuerOptions.CanUseAmbientCredentials(issuerObj))
+	if k8sErrors.IsNotFound(err) {
+		message := "Required secret resource not found"
+
+		g.reporter.Pending(cr, err, "SecretMissing", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	if err != nil {
+		message := "Failed to initialise Google CAS client for signing"
+
+		g.reporter.Pending(cr, err, "GoogleCASInitError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	// The certificate ID is derived from the CertificateRequest UID, so that a
+	// retried request returns the certificate which was already issued for it
+	// rather than issuing another.
+	certificateID := "cert-manager-" + string(cr.UID)
+	duration := apiutil.DefaultCertDuration(cr.Spec.Duration)
+
+	certPEM, err := client.Sign(ctx, certificateID, cr.Spec.Request, duration)
+	if googlecasclient.IsInvalidRequest(err) {
+		message := "Google CAS rejected the certificate request"
+
+		g.reporter.Failed(cr, err, "SigningError", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	if err != nil {
+		message := "Failed to request certificate from Google CAS"
+
+		g.reporter.Pending(cr, err, "SigningError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	log.V(logf.DebugLevel).Info("certificate issued")
+
+	bundle, err := utilpki.ParseSingleCertificateChainPEM(certPEM)
+	if err != nil {
+		message := "Failed to parse returned certificate bundle"
+
+		g.reporter.Failed(cr, err, "ParseError", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	return &issuerpkg.IssueResponse{
+		Certificate: bundle.ChainPEM,
+		CA:          bundle.CAPEM,
+	}, nil
+}
@@ -0,0 +1,276 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlecas
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer/googlecas/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/googlecas/client/fake"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+var (
+	fixedClockStart = time.Now()
+	fixedClock      = fakeclock.NewFakeClock(fixedClockStart)
+)
+
+func TestSign(t *testing.T) {
+	metaFixedClockStart := metav1.NewTime(fixedClockStart)
+
+	pk, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrPEM, err := gen.CSRWithSigner(pk, gen.SetCSRCommonName("test-common-name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	leafPEM, intermediatePEM, rootPEM := testcrypto.MustCreateCertificateChain(t)
+
+	issuer := gen.Issuer("test-issuer",
+		gen.SetIssuerGoogleCAS(cmapi.GoogleCASIssuer{
+			Project:  "my-project",
+			Location: "us-central1",
+			CAPool:   "my-pool",
+		}),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}),
+	)
+
+	baseCR := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestCSR(csrPEM),
+		gen.SetCertificateRequestDuration(&metav1.Duration{Duration: time.Hour}),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+			Group: certmanager.GroupName,
+			Name:  issuer.Name,
+			Kind:  issuer.Kind,
+		}),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionApproved,
+			Status:             cmmeta.ConditionTrue,
+			Reason:             "cert-manager.io",
+			Message:            "Certificate request has been approved by cert-manager.io",
+			LastTransitionTime: &metaFixedClockStart,
+		}),
+	)
+	baseCR.UID = types.UID("2e8a2fd6-2a8e-4a6b-a1e4-3f1f6ac8e8f0")
+
+	tests := map[string]testT{
+		"if the credentials secret does not exist then set pending and return nil": {
+			certificateRequest: baseCR.DeepCopy(),
+			clientBuilderErr:   apierrors.NewNotFound(corev1.Resource("secrets"), "cas-credentials"),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					`Normal SecretMissing Required secret resource not found: secrets "cas-credentials" not found`,
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            `Required secret resource not found: secrets "cas-credentials" not found`,
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"if Google CAS rejects the request then fail and return nil": {
+			certificateRequest: baseCR.DeepCopy(),
+			fakeClient: &fake.GoogleCAS{
+				SignFn: func(context.Context, string, []byte, time.Duration) ([]byte, error) {
+					return nil, &googleapi.Error{Code: 400, Message: "CSR does not satisfy the issuance policy"}
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning SigningError Google CAS rejected the certificate request: googleapi: Error 400: CSR does not satisfy the issuance policy",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "Google CAS rejected the certificate request: googleapi: Error 400: CSR does not satisfy the issuance policy",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+		},
+		"if Google CAS returns a transient error then set pending and return error": {
+			certificateRequest: baseCR.DeepCopy(),
+			fakeClient: &fake.GoogleCAS{
+				SignFn: func(context.Context, string, []byte, time.Duration) ([]byte, error) {
+					return nil, errors.New("connection reset")
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal SigningError Failed to request certificate from Google CAS: connection reset",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "Failed to request certificate from Google CAS: connection reset",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+			expectedErr: true,
+		},
+		"if Google CAS returns a certificate then store it with the chain and root CA": {
+			certificateRequest: baseCR.DeepCopy(),
+			fakeClient: &fake.GoogleCAS{
+				SignFn: func(_ context.Context, certificateID string, csr []byte, duration time.Duration) ([]byte, error) {
+					if certificateID != "cert-manager-2e8a2fd6-2a8e-4a6b-a1e4-3f1f6ac8e8f0" {
+						return nil, errors.New("unexpected certificate ID " + certificateID)
+					}
+					if duration != time.Hour {
+						return nil, errors.New("unexpected duration " + duration.String())
+					}
+					return append(append(append([]byte{}, leafPEM...), intermediatePEM...), rootPEM...), nil
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(append(append([]byte{}, leafPEM...), intermediatePEM...)),
+							gen.SetCertificateRequestCA(rootPEM),
+						),
+					)),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.builder.Clock = fixedClock
+			runTest(t, test)
+		})
+	}
+}
+
+type testT struct {
+	builder            *controllertest.Builder
+	certificateRequest *cmapi.CertificateRequest
+
+	fakeClient       *fake.GoogleCAS
+	clientBuilderErr error
+
+	expectedErr bool
+}
+
+func runTest(t *testing.T, test testT) {
+	test.builder.T = t
+	test.builder.InitWithRESTConfig()
+	defer test.builder.Stop()
+
+	g := NewGoogleCAS(test.builder.Context).(*GoogleCAS)
+	g.clientBuilder = func(context.Context, string, func(string) client.CreateToken, internalinformers.SecretLister, cmapi.GenericIssuer, bool) (client.Interface, error) {
+		if test.clientBuilderErr != nil {
+			return nil, test.clientBuilderErr
+		}
+		return test.fakeClient, nil
+	}
+
+	controller := certificaterequests.New(
+		apiutil.IssuerGoogleCAS,
+		func(*controllerpkg.Context) certificaterequests.Issuer { return g },
+	)
+	if _, _, err := controller.Register(test.builder.Context); err != nil {
+		t.Fatal(err)
+	}
+	test.builder.Start()
+
+	err := controller.Sync(context.Background(), test.certificateRequest)
+	if err != nil && !test.expectedErr {
+		t.Errorf("expected to not get an error, but got: %v", err)
+	}
+	if err == nil && test.expectedErr {
+		t.Errorf("expected to get an error but did not get one")
+	}
+
+	test.builder.CheckAndFinish(err)
+}
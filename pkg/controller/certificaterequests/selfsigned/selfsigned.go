@@ -160,6 +160,30 @@ func (s *SelfSigned) Sign(ctx context.Context, cr *cmapi.CertificateRequest, iss
 	}
 
 	template.CRLDistributionPoints = issuerObj.GetSpec().SelfSigned.CRLDistributionPoints
+	apiutil.AlignCertificateTemplateNotAfter(template, issuerObj.GetSpec().SelfSigned.NotAfterBoundary)
+
+	if method := issuerObj.GetSpec().SelfSigned.SubjectKeyIdentifierMethod; method != "" {
+		template.SubjectKeyId, err = pki.SubjectKeyIdentifier(template.PublicKey, method)
+		if err != nil {
+			message := "Error computing subject key identifier"
+			s.reporter.Failed(cr, err, "ErrorGenerating", message)
+			log.Error(err, message)
+			return nil, nil
+		}
+	}
+
+	// As the certificate is self-signed, x509.CreateCertificate uses the
+	// authority key identifier of the template rather than the subject key
+	// identifier of a parent.
+	if authorityCertificate := issuerObj.GetSpec().SelfSigned.AuthorityCertificate; len(authorityCertificate) > 0 {
+		template.AuthorityKeyId, err = pki.AuthorityKeyIdentifier(authorityCertificate)
+		if err != nil {
+			message := "Error reading authority key identifier from the authority certificate"
+			s.reporter.Failed(cr, err, "ErrorGenerating", message)
+			log.Error(err, message)
+			return nil, nil
+		}
+	}
 
 	if template.Subject.String() == "" {
 		// RFC 5280 (https://tools.ietf.org/html/rfc5280#section-4.1.2.4) says that:
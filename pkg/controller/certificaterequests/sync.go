@@ -18,14 +18,17 @@ package certificaterequests
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/kr/pretty"
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	internalcertificaterequests "github.com/cert-manager/cert-manager/internal/controller/certificaterequests"
@@ -34,6 +37,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -56,6 +60,15 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		}
 	}()
 
+	// The issuance of the CertificateRequest is no longer in flight once it
+	// has reached a final state.
+	issuanceKey := issuanceKeyForCertificateRequest(cr)
+	defer func() {
+		if certificateRequestIsFinal(crCopy) {
+			c.issuanceLimiter.Finish(issuanceKey)
+		}
+	}()
+
 	// If CertificateRequest has been denied, mark the CertificateRequest as
 	// Ready=RequestDenied if not already.
 	if apiutil.CertificateRequestIsDenied(cr) {
@@ -135,11 +148,34 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		return nil
 	}
 
+	// Requests for usages which the issuer's usage policy does not permit are
+	// denied by the cert-manager approver. A request which has been approved
+	// regardless, e.g. by another approver, can no longer be denied, so fail
+	// it rather than calling the issuer.
+	if violations := internalcertificaterequests.UsagesViolatingIssuerPolicy(crCopy, issuerObj); len(violations) > 0 {
+		c.reporter.Failed(crCopy, fmt.Errorf("usages %v are not permitted by the issuer's usage policy", violations),
+			"ForbiddenUsages", "Requested usages are forbidden")
+		return nil
+	}
+
+	if !c.issuanceLimiter.Start(issuanceKey) {
+		dbg.Info("delaying the issuance as the maximum number of issuances are in flight")
+		c.queue.AddAfter(issuanceKey.NamespacedName, controllerpkg.IssuanceLimiterRequeuePeriod)
+		return nil
+	}
+
 	dbg.Info("invoking sign function as existing certificate does not exist")
 
 	// Attempt to call the Sign function on our issuer
 	resp, err := c.issuer.Sign(ctx, crCopy, issuerObj)
 	if err != nil {
+		// Errors which the issuer has been configured to treat as terminal
+		// will not succeed on retry, so fail the request rather than
+		// returning the error to be requeued.
+		if apiutil.MatchesTerminalError(issuerObj.GetSpec().TerminalErrors, err) {
+			c.reporter.Failed(crCopy, err, "TerminalError", "Issuer returned an error configured as terminal")
+			return nil
+		}
 		log.Error(err, "error issuing certificate request")
 		return err
 	}
@@ -156,18 +192,93 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 	crCopy.Status.CA = resp.CA
 
 	// invalid cert
-	_, err = pki.DecodeX509CertificateBytes(crCopy.Status.Certificate)
+	cert, err := pki.DecodeX509CertificateBytes(crCopy.Status.Certificate)
 	if err != nil {
 		c.reporter.Failed(crCopy, err, "DecodeError", "Failed to decode returned certificate")
 		return nil
 	}
 
+	if !c.verifyIssuedCertificate(crCopy, issuerObj, cert) {
+		return nil
+	}
+
+	c.reportDeprecatedCrypto(crCopy, cert)
+
 	// Set condition to Ready.
 	c.reporter.Ready(crCopy)
 
 	return nil
 }
 
+// verifyIssuedCertificate checks that the certificate returned by the issuer
+// matches the CertificateRequest according to the configured
+// IssuedCertificateVerificationPolicy. It returns false if the certificate has
+// been rejected, in which case the CertificateRequest has been marked as
+// failed and the returned certificate removed from its status.
+func (c *Controller) verifyIssuedCertificate(cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer, cert *x509.Certificate) bool {
+	policy := c.issuedCertificateVerificationPolicy
+	if policy != controllerpkg.IssuedCertificateVerificationPolicyWarn && policy != controllerpkg.IssuedCertificateVerificationPolicyStrict {
+		return true
+	}
+
+	violations, err := issuedCertificateViolations(cr, issuerObj, cert)
+	if err == nil && len(violations) == 0 {
+		return true
+	}
+	if err == nil {
+		err = fmt.Errorf("mismatched fields: %s", strings.Join(violations, ", "))
+	}
+
+	if policy == controllerpkg.IssuedCertificateVerificationPolicyWarn {
+		c.recorder.Eventf(cr, corev1.EventTypeWarning, "IssuedCertificateMismatch", "Issued certificate does not match the request: %v", err)
+		return true
+	}
+
+	cr.Status.Certificate = nil
+	cr.Status.CA = nil
+	c.reporter.Failed(cr, err, "IssuedCertificateMismatch", "Issued certificate does not match the request")
+	return false
+}
+
+// reportDeprecatedCrypto records a warning Event and increments the
+// deprecated crypto metric if the certificate returned by the issuer uses a
+// key or signature algorithm below the thresholds of the deprecated crypto
+// policy. The certificate is not rejected.
+func (c *Controller) reportDeprecatedCrypto(cr *cmapi.CertificateRequest, cert *x509.Certificate) {
+	deprecated := c.deprecatedCryptoPolicy.DeprecatedCryptoInCertificate(cert)
+	if len(deprecated) == 0 {
+		return
+	}
+
+	descriptions := make([]string, len(deprecated))
+	for i, d := range deprecated {
+		descriptions[i] = d.String()
+		c.metrics.IncrementDeprecatedCrypto(d.Algorithm, d.Size)
+	}
+	c.recorder.Eventf(cr, corev1.EventTypeWarning, "DeprecatedCrypto", "Issued certificate uses deprecated cryptography: %s", strings.Join(descriptions, ", "))
+}
+
+// issuedCertificateViolations returns the fields of the certificate which do
+// not match the CertificateRequest. The extended key usages are checked
+// against those the issuer signs for, which are only client authentication
+// if its ClientAuthOnly policy applies to the request.
+func issuedCertificateViolations(cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer, cert *x509.Certificate) ([]string, error) {
+	req, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	keyUsage, extKeyUsage, err := pki.KeyUsagesForCertificateOrCertificateRequest(cr.Spec.Usages, cr.Spec.IsCA)
+	if err != nil {
+		return nil, err
+	}
+	if apiutil.ClientAuthOnlyApplies(apiutil.ClientAuthOnlyPolicyForIssuer(issuerObj), cr.Namespace) {
+		extKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	}
+
+	return pki.IssuedCertificateMatchesRequest(cert, req, keyUsage, extKeyUsage), nil
+}
+
 func (c *Controller) updateCertificateRequestStatusAndAnnotations(ctx context.Context, oldCR, newCR *cmapi.CertificateRequest) error {
 	log := logf.FromContext(ctx, "updateStatus")
 
@@ -203,3 +314,26 @@ func (c *Controller) updateStatusOrApply(ctx context.Context, cr *cmapi.Certific
 		return err
 	}
 }
+
+// issuanceKeyForCertificateRequest returns the key which identifies the
+// issuance of the given CertificateRequest in the IssuanceLimiter.
+func issuanceKeyForCertificateRequest(cr *cmapi.CertificateRequest) controllerpkg.IssuanceKey {
+	return controllerpkg.IssuanceKey{
+		Kind:           cmapi.CertificateRequestKind,
+		NamespacedName: types.NamespacedName{Namespace: cr.Namespace, Name: cr.Name},
+	}
+}
+
+// certificateRequestIsFinal returns true if the given CertificateRequest has
+// been denied, is invalid, or has failed or been issued, in which case it
+// will not be signed.
+func certificateRequestIsFinal(cr *cmapi.CertificateRequest) bool {
+	if apiutil.CertificateRequestIsDenied(cr) || apiutil.CertificateRequestHasInvalidRequest(cr) {
+		return true
+	}
+	switch apiutil.CertificateRequestReadyReason(cr) {
+	case cmapi.CertificateRequestReasonFailed, cmapi.CertificateRequestReasonIssued:
+		return true
+	}
+	return false
+}
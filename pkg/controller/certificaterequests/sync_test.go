@@ -29,6 +29,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 
@@ -62,7 +63,7 @@ func generateCSR(t *testing.T, secretKey crypto.Signer) []byte {
 	return csr
 }
 
-func generateSelfSignedCert(t *testing.T, cr *cmapi.CertificateRequest, key crypto.Signer, notBefore, notAfter time.Time) []byte {
+func generateSelfSignedCert(t *testing.T, cr *cmapi.CertificateRequest, key crypto.Signer, notBefore, notAfter time.Time, mods ...func(*x509.Certificate)) []byte {
 	t.Helper()
 	template, err := pki.CertificateTemplateFromCertificateRequest(cr)
 	if err != nil {
@@ -72,6 +73,9 @@ func generateSelfSignedCert(t *testing.T, cr *cmapi.CertificateRequest, key cryp
 
 	template.NotAfter = notAfter
 	template.NotBefore = notBefore
+	for _, mod := range mods {
+		mod(template)
+	}
 
 	derBytes, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
 	if err != nil {
@@ -158,7 +162,102 @@ func TestSync(t *testing.T) {
 	certECPEM := generateSelfSignedCert(t, baseCREC, skEC, fixedClockStart, fixedClockStart.Add(time.Hour*12))
 	certECPEMExpired := generateSelfSignedCert(t, baseCREC, skEC, fixedClockStart.Add(-time.Hour*13), fixedClockStart.Add(-time.Hour*12))
 
+	// A certificate as returned by a misbehaving issuer, whose common name
+	// and DNS names do not match the request.
+	csrMismatchedPEM, err := gen.CSRWithSigner(skRSA,
+		gen.SetCSRCommonName("mismatched"),
+		gen.SetCSRDNSNames("mismatched.example.com"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certMismatchedPEM := generateSelfSignedCert(t, gen.CertificateRequestFrom(baseCR,
+		gen.SetCertificateRequestCSR(csrMismatchedPEM),
+	), skRSA, fixedClockStart, fixedClockStart.Add(time.Hour*12))
+
+	// A request for server and client authentication to a CA issuer whose
+	// ClientAuthOnly policy restricts the certificates it signs to client
+	// authentication.
+	clientAuthOnlyIssuer := gen.Issuer("test-ca-issuer",
+		gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "test-ca-secret", ClientAuthOnly: &cmapi.ClientAuthOnlyPolicy{}}),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}),
+	)
+	clientAuthOnlyCR := gen.CertificateRequestFrom(baseCR,
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+			Kind: clientAuthOnlyIssuer.Kind,
+			Name: clientAuthOnlyIssuer.Name,
+		}),
+		gen.SetCertificateRequestKeyUsages(cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment, cmapi.UsageServerAuth, cmapi.UsageClientAuth),
+	)
+	certClientAuthOnlyPEM := generateSelfSignedCert(t, clientAuthOnlyCR, skRSA, fixedClockStart, fixedClockStart.Add(time.Hour*12),
+		util.RestrictCertificateTemplateToClientAuth)
+
+	// newIssuanceLimiter returns an IssuanceLimiter allowing a single issuance
+	// in flight, with the issuances of the given keys in flight.
+	newIssuanceLimiter := func(inFlight ...types.NamespacedName) *controller.IssuanceLimiter {
+		l := controller.NewIssuanceLimiter(1, nil)
+		for _, key := range inFlight {
+			l.Start(controller.IssuanceKey{Kind: cmapi.CertificateRequestKind, NamespacedName: key})
+		}
+		return l
+	}
+	baseCRKey := types.NamespacedName{Namespace: baseCR.Namespace, Name: baseCR.Name}
+	otherCRKey := controller.IssuanceKey{Kind: cmapi.CertificateRequestKind, NamespacedName: types.NamespacedName{Namespace: baseCR.Namespace, Name: "other"}}
+
 	tests := map[string]testT{
+		"should keep the issuance of a pending request in flight": {
+			certificateRequest: baseCR.DeepCopy(),
+			issuanceLimiter:    newIssuanceLimiter(),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					return nil, nil
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseIssuer, baseCR},
+				ExpectedEvents:     []string{},
+				ExpectedActions:    []testpkg.Action{},
+			},
+			checkIssuanceLimiter: func(t *testing.T, l *controller.IssuanceLimiter) {
+				if l.Start(otherCRKey) {
+					t.Error("expected the issuance of the pending request to be in flight")
+				}
+			},
+		},
+		"should not sign the request if the maximum number of issuances are in flight": {
+			certificateRequest: baseCR.DeepCopy(),
+			issuanceLimiter:    newIssuanceLimiter(otherCRKey.NamespacedName),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseIssuer, baseCR},
+				ExpectedEvents:     []string{},
+				ExpectedActions:    []testpkg.Action{},
+			},
+		},
+		"should finish the issuance of a failed request": {
+			certificateRequest: gen.CertificateRequestFrom(baseCR,
+				gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+					Type:               cmapi.CertificateRequestConditionReady,
+					Status:             cmmeta.ConditionFalse,
+					Reason:             cmapi.CertificateRequestReasonFailed,
+					Message:            "Failed",
+					LastTransitionTime: &nowMetaTime,
+				}),
+			),
+			issuanceLimiter: newIssuanceLimiter(baseCRKey),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseIssuer, baseCR},
+				ExpectedEvents:     []string{},
+				ExpectedActions:    []testpkg.Action{},
+			},
+			checkIssuanceLimiter: func(t *testing.T, l *controller.IssuanceLimiter) {
+				if !l.Start(otherCRKey) {
+					t.Error("expected the issuance of the failed request to be finished")
+				}
+			},
+		},
 		"should return nil (no action) if group name if not 'cert-manager.io' or ''": {
 			certificateRequest: gen.CertificateRequestFrom(baseCR,
 				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
@@ -556,6 +655,164 @@ func TestSync(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		"if calling sign errors with an error not configured as terminal, we should not update condition and return error to retry": {
+			certificateRequest: gen.CertificateRequestFrom(baseCR),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					return nil, errors.New("connection refused")
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseCR,
+					gen.IssuerFrom(baseIssuer,
+						gen.SetIssuerTerminalErrors(cmapi.TerminalErrorMatcher{Contains: "policy violation"}),
+					),
+				},
+				ExpectedEvents:  []string{},
+				ExpectedActions: []testpkg.Action{},
+			},
+			expectedErr: true,
+		},
+		"if calling sign errors with an error configured as terminal, we should set condition Failed and not retry": {
+			certificateRequest: gen.CertificateRequestFrom(baseCR),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					return nil, errors.New("error code 4001: policy violation")
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseCR,
+					gen.IssuerFrom(baseIssuer,
+						gen.SetIssuerTerminalErrors(
+							cmapi.TerminalErrorMatcher{Contains: "key too small"},
+							cmapi.TerminalErrorMatcher{Regex: `^error code 400[0-9]:`},
+						),
+					),
+				},
+				ExpectedEvents: []string{
+					"Warning TerminalError Issuer returned an error configured as terminal: error code 4001: policy violation",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "Failed",
+								Message:            "Issuer returned an error configured as terminal: error code 4001: policy violation",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateRequestFailureTime(nowMetaTime),
+						),
+					)),
+				},
+			},
+		},
+		"if the approved request has usages forbidden by the issuer's usage policy, we should set condition Failed without calling sign": {
+			certificateRequest: gen.CertificateRequestFrom(baseCR,
+				gen.SetCertificateRequestKeyUsages(cmapi.UsageDigitalSignature, cmapi.UsageCodeSigning),
+			),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					return nil, errors.New("unexpected sign call")
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseCR,
+					gen.IssuerFrom(baseIssuer,
+						gen.SetIssuerUsagePolicy(cmapi.IssuerUsagePolicy{
+							ForbiddenUsages: []cmapi.KeyUsage{cmapi.UsageCertSign, cmapi.UsageCodeSigning},
+						}),
+					),
+				},
+				ExpectedEvents: []string{
+					"Warning ForbiddenUsages Requested usages are forbidden: usages [code signing] are not permitted by the issuer's usage policy",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestKeyUsages(cmapi.UsageDigitalSignature, cmapi.UsageCodeSigning),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "Failed",
+								Message:            "Requested usages are forbidden: usages [code signing] are not permitted by the issuer's usage policy",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateRequestFailureTime(nowMetaTime),
+						),
+					)),
+				},
+			},
+		},
+		"if the approved request is for a CA and cert sign is forbidden by the issuer's usage policy, we should set condition Failed": {
+			certificateRequest: gen.CertificateRequestFrom(baseCR,
+				gen.SetCertificateRequestIsCA(true),
+			),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					return nil, errors.New("unexpected sign call")
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseCR,
+					gen.IssuerFrom(baseIssuer,
+						gen.SetIssuerUsagePolicy(cmapi.IssuerUsagePolicy{
+							ForbiddenUsages: []cmapi.KeyUsage{cmapi.UsageCertSign},
+						}),
+					),
+				},
+				ExpectedEvents: []string{
+					"Warning ForbiddenUsages Requested usages are forbidden: usages [cert sign] are not permitted by the issuer's usage policy",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestIsCA(true),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "Failed",
+								Message:            "Requested usages are forbidden: usages [cert sign] are not permitted by the issuer's usage policy",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateRequestFailureTime(nowMetaTime),
+						),
+					)),
+				},
+			},
+		},
+		"if the request only has usages allowed by the issuer's usage policy, we should call sign": {
+			certificateRequest: gen.CertificateRequestFrom(baseCR,
+				gen.SetCertificateRequestKeyUsages(cmapi.UsageSigning, cmapi.UsageKeyEncipherment),
+			),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					return nil, nil
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseCR,
+					gen.IssuerFrom(baseIssuer,
+						gen.SetIssuerUsagePolicy(cmapi.IssuerUsagePolicy{
+							AllowedUsages:   []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment, cmapi.UsageServerAuth},
+							ForbiddenUsages: []cmapi.KeyUsage{cmapi.UsageCertSign},
+						}),
+					),
+				},
+				ExpectedEvents:  []string{},
+				ExpectedActions: []testpkg.Action{},
+			},
+		},
 		"if calling sign returns nil, nil then we should return nil with no-op since the underlying issuer has probably set the condition to failed": {
 			certificateRequest: gen.CertificateRequestFrom(baseCR),
 			issuerImpl: &fake.Issuer{
@@ -640,6 +897,211 @@ func TestSync(t *testing.T) {
 				},
 			},
 		},
+		"if the verification policy is Strict and sign returns a certificate which does not match the request then set condition Failed": {
+			certificateRequest: baseCR.DeepCopy(),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						Certificate: certMismatchedPEM,
+					}, nil
+				},
+			},
+			builder: &testpkg.Builder{
+				Context: &controller.Context{
+					ContextOptions: controller.ContextOptions{
+						CertificateOptions: controller.CertificateOptions{
+							IssuedCertificateVerificationPolicy: controller.IssuedCertificateVerificationPolicyStrict,
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{baseIssuer, baseCR.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning IssuedCertificateMismatch Issued certificate does not match the request: mismatched fields: commonName, dnsNames",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "Failed",
+								Message:            "Issued certificate does not match the request: mismatched fields: commonName, dnsNames",
+								LastTransitionTime: &nowMetaTime,
+							}),
+							gen.SetCertificateRequestFailureTime(nowMetaTime),
+						),
+					)),
+				},
+			},
+		},
+		"if the verification policy is Warn and sign returns a certificate which does not match the request then fire an event and set condition Ready": {
+			certificateRequest: baseCR.DeepCopy(),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						Certificate: certMismatchedPEM,
+					}, nil
+				},
+			},
+			builder: &testpkg.Builder{
+				Context: &controller.Context{
+					ContextOptions: controller.ContextOptions{
+						CertificateOptions: controller.CertificateOptions{
+							IssuedCertificateVerificationPolicy: controller.IssuedCertificateVerificationPolicyWarn,
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{baseIssuer, baseCR.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning IssuedCertificateMismatch Issued certificate does not match the request: mismatched fields: commonName, dnsNames",
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestCertificate(certMismatchedPEM),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             "Issued",
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"if sign returns a certificate using deprecated cryptography then fire an event and set condition Ready": {
+			certificateRequest: baseCR.DeepCopy(),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						Certificate: certRSAPEM,
+					}, nil
+				},
+			},
+			builder: &testpkg.Builder{
+				Context: &controller.Context{
+					ContextOptions: controller.ContextOptions{
+						CertificateOptions: controller.CertificateOptions{
+							DeprecatedCryptoPolicy: pki.DeprecatedCryptoPolicy{
+								MinimumRSAKeySize: 4096,
+							},
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{baseIssuer, baseCR.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning DeprecatedCrypto Issued certificate uses deprecated cryptography: RSA (2048 bits)",
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestCertificate(certRSAPEM),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             "Issued",
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"if the verification policy is Strict and sign returns a certificate which matches the request then set condition Ready": {
+			certificateRequest: baseCR.DeepCopy(),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						Certificate: certRSAPEM,
+					}, nil
+				},
+			},
+			builder: &testpkg.Builder{
+				Context: &controller.Context{
+					ContextOptions: controller.ContextOptions{
+						CertificateOptions: controller.CertificateOptions{
+							IssuedCertificateVerificationPolicy: controller.IssuedCertificateVerificationPolicyStrict,
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{baseIssuer, baseCR.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestCertificate(certRSAPEM),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             "Issued",
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"if the verification policy is Strict and the issuer's ClientAuthOnly policy removed the server auth usage then set condition Ready": {
+			certificateRequest: clientAuthOnlyCR.DeepCopy(),
+			issuerType:         util.IssuerCA,
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						Certificate: certClientAuthOnlyPEM,
+					}, nil
+				},
+			},
+			builder: &testpkg.Builder{
+				Context: &controller.Context{
+					ContextOptions: controller.ContextOptions{
+						CertificateOptions: controller.CertificateOptions{
+							IssuedCertificateVerificationPolicy: controller.IssuedCertificateVerificationPolicyStrict,
+						},
+					},
+				},
+				CertManagerObjects: []runtime.Object{clientAuthOnlyIssuer, clientAuthOnlyCR.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(clientAuthOnlyCR,
+							gen.SetCertificateRequestCertificate(certClientAuthOnlyPEM),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             "Issued",
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+		},
 		"if calling sign returns a response with an expired RSA certificate then set condition Ready": {
 			certificateRequest: baseCR.DeepCopy(),
 			issuerImpl: &fake.Issuer{
@@ -754,7 +1216,16 @@ type testT struct {
 	issuerImpl         Issuer
 	certificateRequest *cmapi.CertificateRequest
 	helper             *issuerfake.Helper
+	issuanceLimiter    *controller.IssuanceLimiter
 	expectedErr        bool
+
+	// issuerType is the type of issuer handled by the controller. Defaults
+	// to SelfSigned.
+	issuerType string
+
+	// checkIssuanceLimiter is called with the issuanceLimiter once the
+	// request has been synced, if set.
+	checkIssuanceLimiter func(*testing.T, *controller.IssuanceLimiter)
 }
 
 func runTest(t *testing.T, test testT) {
@@ -772,7 +1243,11 @@ func runTest(t *testing.T, test testT) {
 		}
 	}
 
-	c := New(util.IssuerSelfSigned, func(*controller.Context) Issuer { return test.issuerImpl })
+	if test.issuerType == "" {
+		test.issuerType = util.IssuerSelfSigned
+	}
+
+	c := New(test.issuerType, func(*controller.Context) Issuer { return test.issuerImpl })
 	if _, _, err := c.Register(test.builder.Context); err != nil {
 		t.Fatal(err)
 	}
@@ -780,6 +1255,9 @@ func runTest(t *testing.T, test testT) {
 	if test.helper != nil {
 		c.helper = test.helper
 	}
+	if test.issuanceLimiter != nil {
+		c.issuanceLimiter = test.issuanceLimiter
+	}
 
 	test.builder.Start()
 
@@ -791,4 +1269,8 @@ func runTest(t *testing.T, test testT) {
 		t.Errorf("expected to get an error but did not get one")
 	}
 	test.builder.CheckAndFinish(err)
+
+	if test.checkIssuanceLimiter != nil {
+		test.checkIssuanceLimiter(t, test.issuanceLimiter)
+	}
 }
@@ -111,6 +111,17 @@ func (v *Vault) Sign(ctx context.Context, cr *v1.CertificateRequest, issuerObj v
 		return nil, nil
 	}
 
+	if apiutil.ClientAuthOnlyApplies(issuerObj.GetSpec().Vault.ClientAuthOnly, cr.Namespace) {
+		if err := vaultinternal.VerifyClientAuthOnly(certPem); err != nil {
+			message := "Vault signed a certificate which is not restricted to client authentication, as required by the issuer"
+
+			v.reporter.Failed(cr, err, "SigningError", message)
+			log.Error(err, message)
+
+			return nil, nil
+		}
+	}
+
 	log.V(logf.DebugLevel).Info("certificate issued")
 
 	return &issuer.IssueResponse{
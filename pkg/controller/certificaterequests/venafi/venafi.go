@@ -131,6 +131,14 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 
 				return nil, nil
 
+			case venaficlient.ErrPolicyViolation:
+				message := "Refusing to request the certificate from Venafi"
+
+				v.reporter.Failed(cr, err, "PolicyViolation", message)
+				log.Error(err, message)
+
+				return nil, nil
+
 			default:
 				message := "Failed to request venafi certificate"
 
@@ -151,6 +159,13 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 	certPem, err := client.RetrieveCertificate(pickupID, cr.Spec.Request, duration, customFields)
 	if err != nil {
 		switch err.(type) {
+		case venaficlient.ErrAwaitingApproval:
+			message := "Venafi certificate is awaiting approval, the request will be retried"
+
+			v.reporter.Pending(cr, err, "AwaitingApproval", message)
+			log.Info(message, "status", err.(venaficlient.ErrAwaitingApproval).Status)
+			return nil, err
+
 		case endpoint.ErrCertificatePending, endpoint.ErrRetrieveCertificateTimeout:
 			message := "Venafi certificate still in a pending state, the request will be retried"
 
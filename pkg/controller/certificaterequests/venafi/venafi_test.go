@@ -232,6 +232,17 @@ func TestSign(t *testing.T) {
 			}
 		},
 	}
+	clientReturnsAwaitingApproval := &internalvenafifake.Venafi{
+		RequestCertificateFn: func(csrPEM []byte, duration time.Duration, customFields []api.CustomField) (string, error) {
+			return "test", nil
+		},
+		RetrieveCertificateFn: func(string, []byte, time.Duration, []api.CustomField) ([]byte, error) {
+			return nil, client.ErrAwaitingApproval{
+				CertificateID: "test-cert-id",
+				Status:        "Pending Approval",
+			}
+		},
+	}
 	clientReturnsGenericError := &internalvenafifake.Venafi{
 		RequestCertificateFn: func(csrPEM []byte, duration time.Duration, customFields []api.CustomField) (string, error) {
 			return "", errors.New("this is an error")
@@ -264,6 +275,12 @@ func TestSign(t *testing.T) {
 		},
 	}
 
+	clientReturnsPolicyViolation := &internalvenafifake.Venafi{
+		RequestCertificateFn: func([]byte, time.Duration, []api.CustomField) (string, error) {
+			return "", client.ErrPolicyViolation{Err: errors.New("DNS SANs [forbidden.example.com] do not match regular expressions: [.*\\.example\\.org]")}
+		},
+	}
+
 	tests := map[string]testT{
 		"a CertificateRequest without an approved condition should do nothing": {
 			certificateRequest: baseCRNotApproved.DeepCopy(),
@@ -479,6 +496,52 @@ func TestSign(t *testing.T) {
 			fakeClient:       clientReturnsPending,
 			expectedErr:      true,
 		},
+		"tpp: if sign returns awaiting approval error then set pending and return err": {
+			certificateRequest: tppCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				KubeObjects:        []runtime.Object{tppSecret},
+				CertManagerObjects: []runtime.Object{tppCR.DeepCopy(), tppIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal IssuancePending Venafi certificate is requested",
+					"Normal AwaitingApproval Venafi certificate is awaiting approval, the request will be retried: certificate \"test-cert-id\" is awaiting approval in Venafi TPP, status: \"Pending Approval\"",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(tppCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "Venafi certificate is requested",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.AddCertificateRequestAnnotations(map[string]string{cmapi.VenafiPickupIDAnnotationKey: "test"}),
+						),
+					)),
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(tppCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "Venafi certificate is awaiting approval, the request will be retried: certificate \"test-cert-id\" is awaiting approval in Venafi TPP, status: \"Pending Approval\"",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.AddCertificateRequestAnnotations(map[string]string{cmapi.VenafiPickupIDAnnotationKey: "test"}),
+						),
+					)),
+				},
+			},
+			fakeSecretLister: failGetSecretLister,
+			fakeClient:       clientReturnsAwaitingApproval,
+			expectedErr:      true,
+		},
 		"cloud: if sign returns pending error then set pending and return err": {
 			certificateRequest: cloudCR.DeepCopy(),
 			builder: &controllertest.Builder{
@@ -556,6 +619,35 @@ func TestSign(t *testing.T) {
 			expectedErr:        true,
 			skipSecondSignCall: false,
 		},
+		"tpp: if the request violates the zone policy then set failed without requesting the certificate": {
+			certificateRequest: tppCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				KubeObjects:        []runtime.Object{tppSecret},
+				CertManagerObjects: []runtime.Object{tppCR.DeepCopy(), tppIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning PolicyViolation Refusing to request the certificate from Venafi: certificate request violates the policy of the Venafi zone: DNS SANs [forbidden.example.com] do not match regular expressions: [.*\\.example\\.org]",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(tppCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "Refusing to request the certificate from Venafi: certificate request violates the policy of the Venafi zone: DNS SANs [forbidden.example.com] do not match regular expressions: [.*\\.example\\.org]",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+			fakeSecretLister: failGetSecretLister,
+			fakeClient:       clientReturnsPolicyViolation,
+		},
 		"cloud: if sign returns generic error then set pending and return error": {
 			certificateRequest: cloudCR.DeepCopy(),
 			builder: &controllertest.Builder{
@@ -0,0 +1,298 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package canary
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/kr/pretty"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+// relaxedCertificateRequestMatcher matches the created CertificateRequest,
+// ignoring the CSR which is signed by a newly generated private key.
+func relaxedCertificateRequestMatcher(l coretesting.Action, r coretesting.Action) error {
+	objL := l.(coretesting.CreateAction).GetObject().(*cmapi.CertificateRequest).DeepCopy()
+	objR := r.(coretesting.CreateAction).GetObject().(*cmapi.CertificateRequest).DeepCopy()
+	objL.Spec.Request = nil
+	objR.Spec.Request = nil
+	if !reflect.DeepEqual(objL, objR) {
+		return fmt.Errorf("unexpected difference between actions: %s", pretty.Diff(objL, objR))
+	}
+	return nil
+}
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now()
+	metaNow := metav1.NewTime(now)
+
+	canaryIssuer := cmmeta.ObjectReference{Name: "staging", Kind: "ClusterIssuer"}
+	baseCrt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateUID("uid-1"),
+		gen.SetCertificateGeneration(2),
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateKeyAlgorithm(cmapi.ECDSAKeyAlgorithm),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "production", Kind: "ClusterIssuer"}),
+		gen.SetCertificateCanaryIssuer(canaryIssuer),
+	)
+
+	ownerRef := metav1.NewControllerRef(baseCrt, certificateGvk)
+	ownerRef.Controller = ptr.To(false)
+	canaryRequest := func(generation string, mods ...gen.CertificateRequestModifier) *cmapi.CertificateRequest {
+		return gen.CertificateRequest("test-canary-"+generation, append([]gen.CertificateRequestModifier{
+			gen.SetCertificateRequestNamespace("testns"),
+			gen.SetCertificateRequestIssuer(canaryIssuer),
+			gen.SetCertificateRequestAnnotations(map[string]string{
+				cmapi.CertificateNameKey:                              "test",
+				cmapi.CertificateRequestCanaryGenerationAnnotationKey: generation,
+			}),
+			gen.AddCertificateRequestOwnerReferences(*ownerRef),
+		}, mods...)...)
+	}
+	canaryCondition := func(status cmmeta.ConditionStatus, reason, message string) cmapi.CertificateCondition {
+		return cmapi.CertificateCondition{
+			Type:               cmapi.CertificateConditionCanaryIssued,
+			Status:             status,
+			Reason:             reason,
+			Message:            message,
+			LastTransitionTime: &metaNow,
+			ObservedGeneration: 2,
+		}
+	}
+	updateStatus := func(crt *cmapi.Certificate) testpkg.Action {
+		return testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+			cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns", crt))
+	}
+	deleteRequest := func(name string) testpkg.Action {
+		return testpkg.NewAction(coretesting.NewDeleteAction(
+			cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", name))
+	}
+
+	tests := map[string]struct {
+		// key that should be passed to ProcessItem.
+		// if not set, the 'namespace/name' of the 'Certificate' field will be used.
+		// if neither is set, the key will be ""
+		key types.NamespacedName
+
+		// Certificate to be synced for the test.
+		// if not set, the 'key' will be passed to ProcessItem instead.
+		certificate *cmapi.Certificate
+
+		// Request, if set, will exist in the apiserver before the test is run.
+		requests []runtime.Object
+
+		expectedEvents  []string
+		expectedActions []testpkg.Action
+
+		// err is the expected error text returned by the controller, if any.
+		err string
+	}{
+		"do nothing if an empty 'key' is used": {},
+		"do nothing if a key references a Certificate that does not exist": {
+			key: types.NamespacedName{
+				Namespace: "namespace",
+				Name:      "name",
+			},
+		},
+		"do nothing if the Certificate does not set a canaryIssuerRef": {
+			certificate: gen.CertificateFrom(baseCrt, func(crt *cmapi.Certificate) {
+				crt.Spec.CanaryIssuerRef = nil
+			}),
+		},
+		"ignore CertificateRequests which are not canaries of the Certificate": {
+			certificate: gen.CertificateFrom(baseCrt, func(crt *cmapi.Certificate) {
+				crt.Spec.CanaryIssuerRef = nil
+			}),
+			requests: []runtime.Object{
+				gen.CertificateRequest("test-1",
+					gen.SetCertificateRequestNamespace("testns"),
+					gen.AddCertificateRequestOwnerReferences(*metav1.NewControllerRef(baseCrt, certificateGvk)),
+				),
+			},
+		},
+		"delete canary CertificateRequests and the CanaryIssued condition if the canaryIssuerRef is removed": {
+			certificate: gen.CertificateFrom(baseCrt,
+				func(crt *cmapi.Certificate) {
+					crt.Spec.CanaryIssuerRef = nil
+				},
+				gen.SetCertificateStatusCondition(canaryCondition(cmmeta.ConditionTrue, reasonIssued, `The canary certificate was issued by ClusterIssuer "staging"`)),
+			),
+			requests: []runtime.Object{canaryRequest("2")},
+			expectedActions: []testpkg.Action{
+				deleteRequest("test-canary-2"),
+				updateStatus(gen.CertificateFrom(baseCrt, func(crt *cmapi.Certificate) {
+					crt.Spec.CanaryIssuerRef = nil
+				})),
+			},
+		},
+		"create a canary CertificateRequest if none exists for the current generation": {
+			certificate: baseCrt,
+			requests:    []runtime.Object{canaryRequest("1")},
+			expectedEvents: []string{
+				`Normal CanaryRequested Created canary CertificateRequest "test-canary-2" for ClusterIssuer "staging"`,
+			},
+			expectedActions: []testpkg.Action{
+				deleteRequest("test-canary-1"),
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
+					canaryRequest("2")), relaxedCertificateRequestMatcher),
+				updateStatus(gen.CertificateFrom(baseCrt,
+					gen.SetCertificateStatusCondition(canaryCondition(cmmeta.ConditionFalse, reasonPending, `Waiting for the canary CertificateRequest "test-canary-2" to be issued by ClusterIssuer "staging"`)),
+				)),
+			},
+		},
+		"do nothing if the canary CertificateRequest is still pending": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateStatusCondition(canaryCondition(cmmeta.ConditionFalse, reasonPending, `Waiting for the canary CertificateRequest "test-canary-2" to be issued by ClusterIssuer "staging"`)),
+			),
+			requests: []runtime.Object{canaryRequest("2")},
+		},
+		"set the CanaryIssued condition to True once the canary certificate is issued": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateStatusCondition(canaryCondition(cmmeta.ConditionFalse, reasonPending, `Waiting for the canary CertificateRequest "test-canary-2" to be issued by ClusterIssuer "staging"`)),
+			),
+			requests: []runtime.Object{canaryRequest("2",
+				gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+					Type:   cmapi.CertificateRequestConditionReady,
+					Status: cmmeta.ConditionTrue,
+					Reason: cmapi.CertificateRequestReasonIssued,
+				}),
+			)},
+			expectedEvents: []string{
+				`Normal CanaryIssued The canary certificate was issued by ClusterIssuer "staging"`,
+			},
+			expectedActions: []testpkg.Action{
+				updateStatus(gen.CertificateFrom(baseCrt,
+					gen.SetCertificateStatusCondition(canaryCondition(cmmeta.ConditionTrue, reasonIssued, `The canary certificate was issued by ClusterIssuer "staging"`)),
+				)),
+			},
+		},
+		"set the CanaryIssued condition to False if the canary CertificateRequest failed": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateStatusCondition(canaryCondition(cmmeta.ConditionFalse, reasonPending, `Waiting for the canary CertificateRequest "test-canary-2" to be issued by ClusterIssuer "staging"`)),
+			),
+			requests: []runtime.Object{canaryRequest("2",
+				gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+					Type:    cmapi.CertificateRequestConditionReady,
+					Status:  cmmeta.ConditionFalse,
+					Reason:  cmapi.CertificateRequestReasonFailed,
+					Message: "the ACME server is unavailable",
+				}),
+			)},
+			expectedEvents: []string{
+				`Warning CanaryFailed The canary certificate could not be issued by ClusterIssuer "staging": the ACME server is unavailable`,
+			},
+			expectedActions: []testpkg.Action{
+				updateStatus(gen.CertificateFrom(baseCrt,
+					gen.SetCertificateStatusCondition(canaryCondition(cmmeta.ConditionFalse, reasonFailed, `The canary certificate could not be issued by ClusterIssuer "staging": the ACME server is unavailable`)),
+				)),
+			},
+		},
+		"set the CanaryIssued condition to False if the canary CertificateRequest was denied": {
+			certificate: baseCrt,
+			requests: []runtime.Object{canaryRequest("2",
+				gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+					Type:    cmapi.CertificateRequestConditionDenied,
+					Status:  cmmeta.ConditionTrue,
+					Reason:  "Policy",
+					Message: "not allowed",
+				}),
+			)},
+			expectedEvents: []string{
+				`Warning CanaryFailed The canary CertificateRequest "test-canary-2" was denied: not allowed`,
+			},
+			expectedActions: []testpkg.Action{
+				updateStatus(gen.CertificateFrom(baseCrt,
+					gen.SetCertificateStatusCondition(canaryCondition(cmmeta.ConditionFalse, reasonFailed, `The canary CertificateRequest "test-canary-2" was denied: not allowed`)),
+				)),
+			},
+		},
+		"do nothing once the canary certificate was issued for the current generation": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateStatusCondition(canaryCondition(cmmeta.ConditionTrue, reasonIssued, `The canary certificate was issued by ClusterIssuer "staging"`)),
+			),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Create and initialise a new unit test builder
+			builder := &testpkg.Builder{
+				T:               t,
+				Clock:           fakeclock.NewFakeClock(now),
+				ExpectedEvents:  test.expectedEvents,
+				ExpectedActions: test.expectedActions,
+			}
+			if test.certificate != nil {
+				builder.CertManagerObjects = append(builder.CertManagerObjects, test.certificate)
+			}
+			builder.CertManagerObjects = append(builder.CertManagerObjects, test.requests...)
+			builder.Init()
+
+			// Register informers used by the controller using the registration wrapper
+			w := &controllerWrapper{}
+			_, _, err := w.Register(builder.Context)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// Start the informers and begin processing updates
+			builder.Start()
+			defer builder.Stop()
+
+			key := test.key
+			if key == (types.NamespacedName{}) && test.certificate != nil {
+				key = types.NamespacedName{
+					Name:      test.certificate.Name,
+					Namespace: test.certificate.Namespace,
+				}
+			}
+
+			// Call ProcessItem
+			err = w.controller.ProcessItem(context.Background(), key)
+			switch {
+			case err != nil:
+				if test.err != err.Error() {
+					t.Errorf("error text did not match, got=%s, exp=%s", err.Error(), test.err)
+				}
+			default:
+				if test.err != "" {
+					t.Errorf("got no error but expected: %s", test.err)
+				}
+			}
+
+			if err := builder.AllEventsCalled(); err != nil {
+				builder.T.Error(err)
+			}
+			if err := builder.AllActionsExecuted(); err != nil {
+				builder.T.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package canary
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
+)
+
+const (
+	// reasonPending is the reason of the CanaryIssued condition while the
+	// canary CertificateRequest has not completed.
+	reasonPending = "Pending"
+
+	// reasonIssued is the reason of the CanaryIssued condition once the
+	// canary certificate has been issued.
+	reasonIssued = "Issued"
+
+	// reasonFailed is the reason of the CanaryIssued condition if the canary
+	// CertificateRequest failed or was denied.
+	reasonFailed = "Failed"
+
+	// reasonRequested is used for the event when a canary CertificateRequest
+	// is created.
+	reasonRequested = "CanaryRequested"
+)
+
+var certificateGvk = cmapi.SchemeGroupVersion.WithKind("Certificate")
+
+// sync ensures that a canary CertificateRequest exists for the current
+// generation of a Certificate which sets spec.canaryIssuerRef, and reflects
+// its outcome in the CanaryIssued condition. Canary CertificateRequests
+// requested for previous generations are deleted.
+func (c *controller) sync(ctx context.Context, crt *cmapi.Certificate) error {
+	log := logf.FromContext(ctx)
+
+	// If the Certificate object is being deleted, we don't want to create any
+	// new CertificateRequests objects
+	if crt.DeletionTimestamp != nil {
+		return nil
+	}
+
+	requests, err := certificates.ListCertificateRequestsMatchingPredicates(c.certificateRequestLister.CertificateRequests(crt.Namespace), labels.Everything(), isCanaryRequestFor(crt))
+	if err != nil {
+		return err
+	}
+
+	oldCrt := crt
+	crt = crt.DeepCopy()
+
+	if crt.Spec.CanaryIssuerRef == nil {
+		if err := c.deleteRequests(ctx, requests...); err != nil {
+			return err
+		}
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionCanaryIssued)
+		return c.updateStatusIfChanged(ctx, oldCrt, crt)
+	}
+
+	generation := strconv.FormatInt(crt.Generation, 10)
+	var current *cmapi.CertificateRequest
+	for _, req := range requests {
+		if req.Annotations[cmapi.CertificateRequestCanaryGenerationAnnotationKey] == generation {
+			current = req
+			continue
+		}
+		log.V(logf.DebugLevel).Info("Deleting canary CertificateRequest requested for a previous generation of the Certificate", "request", req.Name)
+		if err := c.deleteRequests(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	// Once the canary issuance has succeeded for this generation, the canary
+	// CertificateRequest is no longer needed for the Certificate to be issued.
+	if apiutil.CertificateHasConditionWithObservedGeneration(crt, cmapi.CertificateCondition{
+		Type:               cmapi.CertificateConditionCanaryIssued,
+		Status:             cmmeta.ConditionTrue,
+		ObservedGeneration: crt.Generation,
+	}) {
+		return nil
+	}
+
+	issuer := describeIssuerRef(*crt.Spec.CanaryIssuerRef)
+	if current == nil {
+		req, err := c.createCanaryRequest(ctx, crt)
+		if err != nil {
+			return err
+		}
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonRequested, "Created canary CertificateRequest %q for %s", req.Name, issuer)
+		apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionCanaryIssued, cmmeta.ConditionFalse, reasonPending,
+			fmt.Sprintf("Waiting for the canary CertificateRequest %q to be issued by %s", req.Name, issuer))
+		return c.updateStatusIfChanged(ctx, oldCrt, crt)
+	}
+
+	status, reason, message := canaryOutcome(current, issuer)
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionCanaryIssued, status, reason, message)
+	if reason != reasonPending && !apiequality.Semantic.DeepEqual(oldCrt.Status, crt.Status) {
+		eventType := corev1.EventTypeNormal
+		if status != cmmeta.ConditionTrue {
+			eventType = corev1.EventTypeWarning
+		}
+		c.recorder.Event(crt, eventType, "Canary"+reason, message)
+	}
+	return c.updateStatusIfChanged(ctx, oldCrt, crt)
+}
+
+// canaryOutcome returns the status, reason and message of the CanaryIssued
+// condition for the given canary CertificateRequest.
+func canaryOutcome(req *cmapi.CertificateRequest, issuer string) (cmmeta.ConditionStatus, string, string) {
+	if apiutil.CertificateRequestIsDenied(req) {
+		cond := apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionDenied)
+		return cmmeta.ConditionFalse, reasonFailed, fmt.Sprintf("The canary CertificateRequest %q was denied: %s", req.Name, cond.Message)
+	}
+
+	ready := apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionReady)
+	switch {
+	case ready != nil && ready.Status == cmmeta.ConditionTrue:
+		return cmmeta.ConditionTrue, reasonIssued, fmt.Sprintf("The canary certificate was issued by %s", issuer)
+	case ready != nil && ready.Status == cmmeta.ConditionFalse && ready.Reason == cmapi.CertificateRequestReasonFailed:
+		return cmmeta.ConditionFalse, reasonFailed, fmt.Sprintf("The canary certificate could not be issued by %s: %s", issuer, ready.Message)
+	default:
+		return cmmeta.ConditionFalse, reasonPending, fmt.Sprintf("Waiting for the canary CertificateRequest %q to be issued by %s", req.Name, issuer)
+	}
+}
+
+// createCanaryRequest creates a CertificateRequest for the given Certificate
+// against its canary issuer. The request uses a private key which is
+// generated for it and then discarded, since the canary certificate is never
+// stored.
+func (c *controller) createCanaryRequest(ctx context.Context, crt *cmapi.Certificate) (*cmapi.CertificateRequest, error) {
+	log := logf.FromContext(ctx)
+
+	pk, err := pki.GeneratePrivateKeyForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+
+	x509CSR, err := pki.GenerateCSR(
+		crt,
+		pki.WithUseLiteralSubject(utilfeature.DefaultMutableFeatureGate.Enabled(feature.LiteralCertificateSubject)),
+		pki.WithEncodeBasicConstraintsInRequest(utilfeature.DefaultMutableFeatureGate.Enabled(feature.UseCertificateRequestBasicConstraints)),
+		pki.WithNameConstraints(utilfeature.DefaultMutableFeatureGate.Enabled(feature.NameConstraints)),
+		pki.WithOtherNames(utilfeature.DefaultMutableFeatureGate.Enabled(feature.OtherNames)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	csrDER, err := pki.EncodeCSR(x509CSR, pk)
+	if err != nil {
+		return nil, err
+	}
+
+	csrPEM := bytes.NewBuffer([]byte{})
+	if err := pem.Encode(csrPEM, &pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER}); err != nil {
+		return nil, err
+	}
+
+	// The owner reference is not a controller reference, so that the
+	// controllers which manage the Certificate's own CertificateRequests
+	// ignore the canary.
+	ownerRef := metav1.NewControllerRef(crt, certificateGvk)
+	ownerRef.Controller = ptr.To(false)
+
+	generation := strconv.FormatInt(crt.Generation, 10)
+	req := &cmapi.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: crt.Namespace,
+			Name:      apiutil.DNSSafeShortenTo52Characters(crt.Name) + "-canary-" + generation,
+			Annotations: map[string]string{
+				cmapi.CertificateNameKey:                              crt.Name,
+				cmapi.CertificateRequestCanaryGenerationAnnotationKey: generation,
+			},
+			Labels:          crt.Labels,
+			OwnerReferences: []metav1.OwnerReference{*ownerRef},
+		},
+		Spec: cmapi.CertificateRequestSpec{
+			Duration:  crt.Spec.Duration,
+			IssuerRef: *crt.Spec.CanaryIssuerRef,
+			Request:   csrPEM.Bytes(),
+			IsCA:      crt.Spec.IsCA,
+			Usages:    crt.Spec.Usages,
+		},
+	}
+
+	created, err := c.client.CertmanagerV1().CertificateRequests(req.Namespace).Create(ctx, req, metav1.CreateOptions{FieldManager: c.fieldManager})
+	if apierrors.IsAlreadyExists(err) {
+		// The informer has not yet observed the request.
+		log.V(logf.DebugLevel).Info("canary CertificateRequest already exists", "request", req.Name)
+		return req, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	log.V(logf.InfoLevel).Info("Created canary CertificateRequest", "request", created.Name)
+	return created, nil
+}
+
+func (c *controller) deleteRequests(ctx context.Context, reqs ...*cmapi.CertificateRequest) error {
+	for _, req := range reqs {
+		err := c.client.CertmanagerV1().CertificateRequests(req.Namespace).Delete(ctx, req.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateStatusIfChanged will update the CanaryIssued condition of the
+// Certificate if it has changed. If the ServerSideApply feature is enabled,
+// the condition will instead get applied using the relevant Patch API call.
+func (c *controller) updateStatusIfChanged(ctx context.Context, oldCrt, crt *cmapi.Certificate) error {
+	if apiequality.Semantic.DeepEqual(oldCrt.Status, crt.Status) {
+		return nil
+	}
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		var conditions []cmapi.CertificateCondition
+		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionCanaryIssued); cond != nil {
+			conditions = []cmapi.CertificateCondition{*cond}
+		}
+		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
+			Status:     cmapi.CertificateStatus{Conditions: conditions},
+		})
+	}
+
+	_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
+	return err
+}
+
+// isCanaryRequestFor returns a predicate matching the canary
+// CertificateRequests of the given Certificate.
+func isCanaryRequestFor(crt *cmapi.Certificate) predicate.Func {
+	return func(obj runtime.Object) bool {
+		req := obj.(*cmapi.CertificateRequest)
+		if _, ok := req.Annotations[cmapi.CertificateRequestCanaryGenerationAnnotationKey]; !ok {
+			return false
+		}
+		for _, ref := range req.OwnerReferences {
+			if ref.UID == crt.UID {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// canaryRequestOwnerOf returns a predicate matching the Certificate which the
+// given canary CertificateRequest was requested for.
+func canaryRequestOwnerOf(obj runtime.Object) predicate.Func {
+	return func(crtObj runtime.Object) bool {
+		return isCanaryRequestFor(crtObj.(*cmapi.Certificate))(obj)
+	}
+}
+
+// describeIssuerRef returns a human readable description of the given issuer
+// reference, for use in condition messages and events.
+func describeIssuerRef(ref cmmeta.ObjectReference) string {
+	kind := ref.Kind
+	if kind == "" {
+		kind = cmapi.IssuerKind
+	}
+	if ref.Group != "" && ref.Group != cmapi.SchemeGroupVersion.Group {
+		kind = kind + "." + ref.Group
+	}
+	return fmt.Sprintf("%s %q", kind, ref.Name)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"context"
+	"crypto"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const reasonFallbackCertificate = "FallbackCertificate"
+
+// fallbackCertificateDuration is the duration of fallback certificates. They
+// only bridge the time until the renewal of the Certificate succeeds, which is
+// retried as long as its Secret contains a fallback certificate.
+const fallbackCertificateDuration = time.Hour
+
+// expiredCertificatePolicy returns the expiredCertificatePolicy of the given
+// Certificate. If the Certificate does not set one, the
+// defaultExpiredCertificatePolicy of the issuer it references is used.
+// External issuers and issuers which cannot be found default to Keep.
+func (c *controller) expiredCertificatePolicy(crt *cmapi.Certificate) (cmapi.ExpiredCertificatePolicy, error) {
+	if crt.Spec.ExpiredCertificatePolicy != "" {
+		return crt.Spec.ExpiredCertificatePolicy, nil
+	}
+
+	if group := crt.Spec.IssuerRef.Group; group != "" && group != certmanager.GroupName {
+		return cmapi.ExpiredCertificatePolicyKeep, nil
+	}
+
+	issuerObj, err := c.issuerHelper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+	if apierrors.IsNotFound(err) {
+		return cmapi.ExpiredCertificatePolicyKeep, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if policy := issuerObj.GetSpec().DefaultExpiredCertificatePolicy; policy != "" {
+		return policy, nil
+	}
+	return cmapi.ExpiredCertificatePolicyKeep, nil
+}
+
+// ensureFallbackCertificate will replace the certificate stored in the target
+// Secret with a temporary self-signed certificate, marked as a fallback
+// certificate, if:
+// - The Certificate's expiredCertificatePolicy is Fallback
+// - The target Secret contains a certificate which has expired
+// It is called once issuing the renewal of the Certificate has failed.
+// Returns true if a fallback certificate was issued.
+func (c *controller) ensureFallbackCertificate(ctx context.Context, crt *cmapi.Certificate, pk crypto.Signer) (bool, error) {
+	policy, err := c.expiredCertificatePolicy(crt)
+	if err != nil {
+		return false, err
+	}
+	if policy != cmapi.ExpiredCertificatePolicyFallback {
+		return false, nil
+	}
+
+	// Only an expired certificate is replaced. A fallback certificate which
+	// has already been issued has not expired, so is kept.
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	x509Cert, err := utilpki.DecodeX509CertificateBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil || c.clock.Now().Before(x509Cert.NotAfter) {
+		return false, nil
+	}
+
+	crt = crt.DeepCopy()
+	crt.Spec.Duration = &metav1.Duration{Duration: fallbackCertificateDuration}
+	if crt.Spec.PrivateKey == nil {
+		crt.Spec.PrivateKey = &cmapi.CertificatePrivateKey{}
+	}
+
+	pkData, err := utilpki.EncodePrivateKey(pk, crt.Spec.PrivateKey.Encoding)
+	if err != nil {
+		return false, err
+	}
+	certData, err := c.localTemporarySigner(crt, pkData)
+	if err != nil {
+		return false, err
+	}
+	secretData := internal.SecretData{
+		Certificate:     certData,
+		PrivateKey:      pkData,
+		CertificateName: crt.Name,
+		Fallback:        true,
+	}
+	if err := c.secretsUpdateData(ctx, crt, secretData); err != nil {
+		return false, err
+	}
+
+	c.recorder.Event(crt, corev1.EventTypeWarning, reasonFallbackCertificate, "Replaced expired certificate with a temporary self-signed fallback certificate")
+
+	return true, nil
+}
@@ -0,0 +1,203 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internal
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
+	applymetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// updateAdditionalSecrets writes the given secret data to each of the
+// Certificate's additional Secrets, in the format configured for that Secret.
+// The CA data must already have been resolved according to the Certificate's
+// CACertificatePolicy.
+func (s *SecretsManager) updateAdditionalSecrets(ctx context.Context, crt *cmapi.Certificate, data SecretData) error {
+	for _, target := range crt.Spec.AdditionalSecrets {
+		if err := s.updateAdditionalSecret(ctx, crt, target, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SecretsManager) updateAdditionalSecret(ctx context.Context, crt *cmapi.Certificate, target cmapi.CertificateAdditionalSecret, data SecretData) error {
+	namespace := target.Namespace
+	if namespace == "" {
+		namespace = crt.Namespace
+	}
+
+	secretType := corev1.SecretTypeTLS
+	if target.Format == cmapi.CertificateAdditionalSecretFormatPKCS12 || target.Format == cmapi.CertificateAdditionalSecretFormatJKS {
+		secretType = corev1.SecretTypeOpaque
+	}
+
+	existingSecret, err := s.secretLister.Secrets(namespace).Get(target.Name)
+	switch {
+	case apierrors.IsNotFound(err):
+		// Never create Secrets in other namespaces, since that namespace has
+		// not opted in to receiving the Certificate.
+		if namespace != crt.Namespace {
+			return fmt.Errorf("additional secret %s/%s must already exist to be written from another namespace", namespace, target.Name)
+		}
+	case err != nil:
+		return err
+	default:
+		if namespace != crt.Namespace && !allowsCertificate(existingSecret, crt) {
+			return fmt.Errorf("additional secret %s/%s does not allow the Certificate %s/%s in its %q annotation",
+				namespace, target.Name, crt.Namespace, crt.Name, cmapi.AllowedCertificatesAnnotationKey)
+		}
+		// Type is immutable, so keep the type of the existing Secret.
+		secretType = existingSecret.Type
+	}
+
+	secretData, err := s.additionalSecretData(crt, target, data)
+	if err != nil {
+		return fmt.Errorf("failed to encode additional secret %s/%s: %w", namespace, target.Name, err)
+	}
+
+	applyOpts := metav1.ApplyOptions{FieldManager: s.fieldManager, Force: true}
+	applyCnf := applycorev1.Secret(target.Name, namespace).
+		WithLabels(map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"}).
+		WithData(secretData).WithType(secretType)
+
+	// Owner references cannot refer to resources in other namespaces, so are
+	// only set on additional Secrets in the Certificate's namespace.
+	if s.enableSecretOwnerReferences && namespace == crt.Namespace {
+		ref := *metav1.NewControllerRef(crt, certificateGvk)
+		applyCnf = applyCnf.WithOwnerReferences(&applymetav1.OwnerReferenceApplyConfiguration{
+			APIVersion: &ref.APIVersion, Kind: &ref.Kind,
+			Name: &ref.Name, UID: &ref.UID,
+			Controller: ref.Controller, BlockOwnerDeletion: ref.BlockOwnerDeletion,
+		})
+	}
+
+	release, err := s.writeLimiter.acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed waiting to apply additional secret %s/%s: %w", namespace, target.Name, err)
+	}
+	defer release()
+
+	logf.FromContext(ctx).WithName("secrets_manager").V(logf.DebugLevel).Info("applying additional secret",
+		"secret", namespace+"/"+target.Name, "format", target.Format)
+
+	if _, err := s.secretClient.Secrets(namespace).Apply(ctx, applyCnf, applyOpts); err != nil {
+		return fmt.Errorf("failed to apply additional secret %s/%s of type %q: %w", namespace, target.Name, secretType, err)
+	}
+
+	return nil
+}
+
+// additionalSecretData returns the Secret data entries for the given
+// additional Secret's format.
+func (s *SecretsManager) additionalSecretData(crt *cmapi.Certificate, target cmapi.CertificateAdditionalSecret, data SecretData) (map[string][]byte, error) {
+	switch target.Format {
+	case "", cmapi.CertificateAdditionalSecretFormatTLS:
+		secretData := map[string][]byte{
+			corev1.TLSPrivateKeyKey: data.PrivateKey,
+			corev1.TLSCertKey:       data.Certificate,
+		}
+		if len(data.CA) > 0 {
+			secretData[cmmeta.TLSCAKey] = data.CA
+		}
+		return secretData, nil
+
+	case cmapi.CertificateAdditionalSecretFormatPKCS12:
+		pw, err := s.additionalSecretPassword(crt, target)
+		if err != nil {
+			return nil, err
+		}
+		keystoreData, err := encodePKCS12Keystore(target.Profile, string(pw), data.PrivateKey, data.Certificate, data.CA)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding PKCS12 bundle: %w", err)
+		}
+		secretData := map[string][]byte{cmapi.PKCS12SecretKey: keystoreData}
+		if len(data.CA) > 0 {
+			truststoreData, err := encodePKCS12Truststore(target.Profile, string(pw), data.CA)
+			if err != nil {
+				return nil, fmt.Errorf("error encoding PKCS12 trust store bundle: %w", err)
+			}
+			secretData[cmapi.PKCS12TruststoreKey] = truststoreData
+		}
+		return secretData, nil
+
+	case cmapi.CertificateAdditionalSecretFormatJKS:
+		pw, err := s.additionalSecretPassword(crt, target)
+		if err != nil {
+			return nil, err
+		}
+		keystoreData, err := encodeJKSKeystore(pw, "certificate", data.PrivateKey, data.Certificate, data.CA)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding JKS bundle: %w", err)
+		}
+		secretData := map[string][]byte{cmapi.JKSSecretKey: keystoreData}
+		if len(data.CA) > 0 {
+			truststoreData, err := encodeJKSTruststore(pw, data.CA)
+			if err != nil {
+				return nil, fmt.Errorf("error encoding JKS trust store bundle: %w", err)
+			}
+			secretData[cmapi.JKSTruststoreKey] = truststoreData
+		}
+		return secretData, nil
+
+	default:
+		return nil, fmt.Errorf("unknown additional secret format %s", target.Format)
+	}
+}
+
+// additionalSecretPassword returns the keystore password of the given
+// additional Secret. The password Secret is always read from the
+// Certificate's namespace.
+func (s *SecretsManager) additionalSecretPassword(crt *cmapi.Certificate, target cmapi.CertificateAdditionalSecret) ([]byte, error) {
+	ref := target.PasswordSecretRef
+	if ref == nil {
+		return nil, fmt.Errorf("passwordSecretRef must be set for the %s format", target.Format)
+	}
+
+	pwSecret, err := s.secretLister.Secrets(crt.Namespace).Get(ref.Name)
+	if err != nil {
+		return nil, fmt.Errorf("fetching %s keystore password from Secret: %v", target.Format, err)
+	}
+
+	if pwSecret.Data == nil || len(pwSecret.Data[ref.Key]) == 0 {
+		return nil, fmt.Errorf("%s keystore password Secret contains no data for key %q", target.Format, ref.Key)
+	}
+
+	return pwSecret.Data[ref.Key], nil
+}
+
+// allowsCertificate returns true if the given Secret lists the Certificate in
+// its allowed certificates annotation.
+func allowsCertificate(secret *corev1.Secret, crt *cmapi.Certificate) bool {
+	want := crt.Namespace + "/" + crt.Name
+	for _, allowed := range strings.Split(secret.Annotations[cmapi.AllowedCertificatesAnnotationKey], ",") {
+		if strings.TrimSpace(allowed) == want {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,227 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internal
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	jks "github.com/pavlo-v-chernykh/keystore-go/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
+	clientcorev1 "k8s.io/client-go/listers/core/v1"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"software.sslmate.com/src/go-pkcs12"
+
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	testcoreclients "github.com/cert-manager/cert-manager/test/unit/coreclients"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	testcorelisters "github.com/cert-manager/cert-manager/test/unit/listers"
+)
+
+func Test_SecretsManager_AdditionalSecrets(t *testing.T) {
+	passwordRef := &cmmeta.SecretKeySelector{
+		LocalObjectReference: cmmeta.LocalObjectReference{Name: "keystore-password"},
+		Key:                  "password",
+	}
+	passwordSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "keystore-password"},
+		Data:       map[string][]byte{"password": []byte("changeit")},
+	}
+	allowedSecret := func(namespace, name string, secretType corev1.SecretType) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace, Name: name,
+				Annotations: map[string]string{
+					cmapi.AllowedCertificatesAnnotationKey: "other/test, " + gen.DefaultTestNamespace + "/test",
+				},
+			},
+			Type: secretType,
+		}
+	}
+
+	baseCert := gen.Certificate("test",
+		gen.SetCertificateSecretName("output"),
+		gen.SetCertificateDNSNames("example.com"),
+	)
+	bundle := testcrypto.MustCreateCryptoBundle(t, baseCert, fixedClock)
+	data := SecretData{
+		Certificate: bundle.CertBytes,
+		CA:          bundle.CertBytes,
+		PrivateKey:  bundle.PrivateKeyBytes,
+	}
+
+	tests := map[string]struct {
+		featureEnabled    bool
+		additionalSecrets []cmapi.CertificateAdditionalSecret
+		existingSecrets   []*corev1.Secret
+		// expectedSecrets is the type of each applied Secret, keyed on
+		// "namespace/name".
+		expectedSecrets map[string]corev1.SecretType
+		expectedErr     bool
+	}{
+		"if feature disabled, only the certificate's secret is written": {
+			featureEnabled: false,
+			additionalSecrets: []cmapi.CertificateAdditionalSecret{
+				{Name: "ingress-tls", Namespace: "ingress"},
+			},
+			existingSecrets: []*corev1.Secret{allowedSecret("ingress", "ingress-tls", corev1.SecretTypeTLS)},
+			expectedSecrets: map[string]corev1.SecretType{
+				gen.DefaultTestNamespace + "/output": corev1.SecretTypeTLS,
+			},
+		},
+		"each additional secret is written in its own format": {
+			featureEnabled: true,
+			additionalSecrets: []cmapi.CertificateAdditionalSecret{
+				{Name: "ingress-tls", Namespace: "ingress"},
+				{Name: "java-keystore", Namespace: "java", Format: cmapi.CertificateAdditionalSecretFormatPKCS12, PasswordSecretRef: passwordRef, Profile: cmapi.Modern2023PKCS12Profile},
+				{Name: "app-keystore", Format: cmapi.CertificateAdditionalSecretFormatJKS, PasswordSecretRef: passwordRef},
+			},
+			existingSecrets: []*corev1.Secret{
+				passwordSecret,
+				allowedSecret("ingress", "ingress-tls", corev1.SecretTypeTLS),
+				allowedSecret("java", "java-keystore", corev1.SecretTypeOpaque),
+			},
+			expectedSecrets: map[string]corev1.SecretType{
+				gen.DefaultTestNamespace + "/output":       corev1.SecretTypeTLS,
+				"ingress/ingress-tls":                      corev1.SecretTypeTLS,
+				"java/java-keystore":                       corev1.SecretTypeOpaque,
+				gen.DefaultTestNamespace + "/app-keystore": corev1.SecretTypeOpaque,
+			},
+		},
+		"if an additional secret in another namespace does not exist, error": {
+			featureEnabled: true,
+			additionalSecrets: []cmapi.CertificateAdditionalSecret{
+				{Name: "ingress-tls", Namespace: "ingress"},
+			},
+			expectedSecrets: map[string]corev1.SecretType{
+				gen.DefaultTestNamespace + "/output": corev1.SecretTypeTLS,
+			},
+			expectedErr: true,
+		},
+		"if an additional secret in another namespace does not allow the certificate, error": {
+			featureEnabled: true,
+			additionalSecrets: []cmapi.CertificateAdditionalSecret{
+				{Name: "ingress-tls", Namespace: "ingress"},
+			},
+			existingSecrets: []*corev1.Secret{{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "ingress", Name: "ingress-tls",
+					Annotations: map[string]string{cmapi.AllowedCertificatesAnnotationKey: "other/test"},
+				},
+				Type: corev1.SecretTypeTLS,
+			}},
+			expectedSecrets: map[string]corev1.SecretType{
+				gen.DefaultTestNamespace + "/output": corev1.SecretTypeTLS,
+			},
+			expectedErr: true,
+		},
+		"if the keystore password secret does not exist, error": {
+			featureEnabled: true,
+			additionalSecrets: []cmapi.CertificateAdditionalSecret{
+				{Name: "app-keystore", Format: cmapi.CertificateAdditionalSecretFormatPKCS12, PasswordSecretRef: passwordRef},
+			},
+			expectedSecrets: map[string]corev1.SecretType{
+				gen.DefaultTestNamespace + "/output": corev1.SecretTypeTLS,
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultMutableFeatureGate, feature.AdditionalCertificateSecrets, test.featureEnabled)
+
+			crt := gen.CertificateFrom(baseCert, gen.SetCertificateAdditionalSecrets(test.additionalSecrets...))
+
+			existing := make(map[string]*corev1.Secret)
+			for _, secret := range test.existingSecrets {
+				existing[secret.Namespace+"/"+secret.Name] = secret
+			}
+			secretLister := testcorelisters.NewFakeSecretLister(testcorelisters.SetFakeSecretListerSecret(func(namespace string) clientcorev1.SecretNamespaceLister {
+				return &testcorelisters.FakeSecretNamespaceLister{
+					GetFn: func(name string) (*corev1.Secret, error) {
+						if secret, ok := existing[namespace+"/"+name]; ok {
+							return secret, nil
+						}
+						return nil, apierrors.NewNotFound(corev1.Resource("secrets"), name)
+					},
+				}
+			}))
+
+			applied := make(map[string]*applycorev1.SecretApplyConfiguration)
+			secretClient := testcoreclients.NewFakeSecretsGetter(testcoreclients.SetFakeSecretsGetterApplyFn(
+				func(_ context.Context, cnf *applycorev1.SecretApplyConfiguration, _ metav1.ApplyOptions) (*corev1.Secret, error) {
+					applied[*cnf.Namespace+"/"+*cnf.Name] = cnf
+					return nil, nil
+				},
+			))
+
+			testManager := NewSecretsManager(secretClient, secretLister, SecretsManagerOptions{
+				FieldManager: "cert-manager-test",
+				WriteLimits:  SecretWriteLimits{MaxInFlight: 1},
+			})
+
+			err := testManager.UpdateData(context.Background(), crt, data)
+			if err != nil && !test.expectedErr {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("expected to get an error but did not get one")
+			}
+
+			appliedTypes := make(map[string]corev1.SecretType)
+			for key, cnf := range applied {
+				appliedTypes[key] = *cnf.Type
+			}
+			assert.Equal(t, test.expectedSecrets, appliedTypes)
+
+			if cnf, ok := applied["ingress/ingress-tls"]; ok {
+				assert.Equal(t, map[string][]byte{
+					corev1.TLSCertKey:       bundle.CertBytes,
+					corev1.TLSPrivateKeyKey: bundle.PrivateKeyBytes,
+					cmmeta.TLSCAKey:         bundle.CertBytes,
+				}, cnf.Data)
+			}
+
+			if cnf, ok := applied["java/java-keystore"]; ok {
+				assert.Len(t, cnf.Data, 2)
+				_, cert, _, err := pkcs12.DecodeChain(cnf.Data[cmapi.PKCS12SecretKey], "changeit")
+				require.NoError(t, err)
+				assert.Equal(t, bundle.Certificate.Spec.DNSNames, cert.DNSNames)
+				assert.NotEmpty(t, cnf.Data[cmapi.PKCS12TruststoreKey])
+			}
+
+			if cnf, ok := applied[gen.DefaultTestNamespace+"/app-keystore"]; ok {
+				assert.Len(t, cnf.Data, 2)
+				ks := jks.New()
+				require.NoError(t, ks.Load(bytes.NewReader(cnf.Data[cmapi.JKSSecretKey]), []byte("changeit")))
+				assert.True(t, ks.IsPrivateKeyEntry("certificate"))
+				assert.NotEmpty(t, cnf.Data[cmapi.JKSTruststoreKey])
+			}
+		})
+	}
+}
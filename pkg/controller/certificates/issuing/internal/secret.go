@@ -20,19 +20,24 @@ import (
 	"context"
 	"crypto/x509"
 	"fmt"
+	"slices"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	applymetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
 	coreclient "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
 
 	"github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -58,6 +63,29 @@ type SecretsManager struct {
 	// Secret resource will be automatically deleted.
 	// This option is disabled by default.
 	enableSecretOwnerReferences bool
+
+	// secretTypeMismatchPolicy controls what happens when the existing Secret
+	// is not of type `kubernetes.io/tls`.
+	secretTypeMismatchPolicy controllerpkg.SecretTypeMismatchPolicy
+
+	// writeLimiter limits the rate and concurrency of Secret writes.
+	writeLimiter *secretWriteLimiter
+
+	// recreateConflictRetries is the number of times deleting a Secret of the
+	// wrong type, which was replaced concurrently, is retried.
+	recreateConflictRetries int
+}
+
+// SecretTypeMismatchError is returned by UpdateData when the existing Secret
+// is not of type `kubernetes.io/tls` and the SecretTypeMismatchPolicy is
+// `Fail`.
+type SecretTypeMismatchError struct {
+	Namespace, Name string
+	Type            corev1.SecretType
+}
+
+func (e *SecretTypeMismatchError) Error() string {
+	return fmt.Sprintf("existing Secret %s/%s is of type %q, expected %q", e.Namespace, e.Name, e.Type, corev1.SecretTypeTLS)
 }
 
 // SecretData is a structure wrapping private key, Certificate and CA data
@@ -65,22 +93,56 @@ type SecretData struct {
 	PrivateKey, Certificate, CA         []byte
 	CertificateName                     string
 	IssuerName, IssuerKind, IssuerGroup string
+
+	// CertificateRevision is the revision of the Certificate which the
+	// certificate was issued for. It is only written to the Secret if set.
+	CertificateRevision string
+
+	// Fallback is true if the certificate is a temporary self-signed
+	// certificate replacing an expired certificate, in which case the Secret
+	// is annotated as holding a fallback certificate.
+	Fallback bool
+}
+
+// SecretsManagerOptions configures a SecretsManager.
+type SecretsManagerOptions struct {
+	// FieldManager is the manager name used for the Apply operations on
+	// Secrets.
+	FieldManager string
+
+	// EnableSecretOwnerReferences, if true, means that Secrets will be deleted
+	// when the corresponding Certificate is deleted.
+	EnableSecretOwnerReferences bool
+
+	// SecretTypeMismatchPolicy controls how existing Secrets which are not of
+	// type `kubernetes.io/tls` are handled. An empty policy behaves as
+	// `Preserve`.
+	SecretTypeMismatchPolicy controllerpkg.SecretTypeMismatchPolicy
+
+	// RecreateConflictRetries is the number of times deleting a Secret of the
+	// wrong type under the `Recreate` SecretTypeMismatchPolicy is retried,
+	// when it conflicts because the Secret was replaced concurrently.
+	RecreateConflictRetries int
+
+	// WriteLimits limits the rate and concurrency of the Secret writes made
+	// by the SecretsManager.
+	WriteLimits SecretWriteLimits
 }
 
-// NewSecretsManager returns a new SecretsManager. Setting
-// enableSecretOwnerReferences to true will mean that secrets will be deleted
-// when the corresponding Certificate is deleted.
+// NewSecretsManager returns a new SecretsManager configured by opts.
 func NewSecretsManager(
 	secretClient coreclient.SecretsGetter,
 	secretLister internalinformers.SecretLister,
-	fieldManager string,
-	enableSecretOwnerReferences bool,
+	opts SecretsManagerOptions,
 ) *SecretsManager {
 	return &SecretsManager{
 		secretClient:                secretClient,
 		secretLister:                secretLister,
-		fieldManager:                fieldManager,
-		enableSecretOwnerReferences: enableSecretOwnerReferences,
+		fieldManager:                opts.FieldManager,
+		enableSecretOwnerReferences: opts.EnableSecretOwnerReferences,
+		secretTypeMismatchPolicy:    opts.SecretTypeMismatchPolicy,
+		writeLimiter:                newSecretWriteLimiter(opts.WriteLimits),
+		recreateConflictRetries:     opts.RecreateConflictRetries,
 	}
 }
 
@@ -89,7 +151,23 @@ func NewSecretsManager(
 // If the Secret resource does not exist, it will be created on Apply.
 // UpdateData will also update deprecated annotations if they exist.
 func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate, data SecretData) error {
-	secret, err := s.getCertificateSecret(crt)
+	ca, err := certificates.CACertificateForPolicy(crt.Spec.CACertificatePolicy, data.Certificate, data.CA)
+	if err != nil {
+		return err
+	}
+	data.CA = ca
+
+	// The chain is truncated after the CA certificate has been derived from
+	// it, so that the `ChainRoot` policy still sees the full chain.
+	if crt.Spec.ChainTruncation != nil {
+		chain, err := utilpki.TruncateCertificateChainPEM(data.Certificate, crt.Spec.ChainTruncation)
+		if err != nil {
+			return fmt.Errorf("failed to truncate the certificate chain: %w", err)
+		}
+		data.Certificate = chain
+	}
+
+	secret, err := s.getCertificateSecret(ctx, crt)
 	if err != nil {
 		return err
 	}
@@ -119,11 +197,26 @@ func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate,
 		})
 	}
 
+	release, err := s.writeLimiter.acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed waiting to apply secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+
 	log.V(logf.DebugLevel).Info("applying secret")
 
 	_, err = s.secretClient.Secrets(secret.Namespace).Apply(ctx, applyCnf, applyOpts)
+	// Release before writing any additional Secrets, which each acquire their
+	// own write.
+	release()
 	if err != nil {
-		return fmt.Errorf("failed to apply secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		return fmt.Errorf("failed to apply secret %s/%s of type %q: %w", secret.Namespace, secret.Name, secret.Type, err)
+	}
+
+	// Write the certificate to any additional Secrets if feature enabled.
+	if utilfeature.DefaultFeatureGate.Enabled(feature.AdditionalCertificateSecrets) {
+		if err := s.updateAdditionalSecrets(ctx, crt, data); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -138,9 +231,10 @@ func (s *SecretsManager) UpdateData(ctx context.Context, crt *cmapi.Certificate,
 // It will also update depreciated issuer name and kind annotations if they
 // exist.
 func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret, data SecretData) error {
-	if err := s.setKeystores(crt, secret, data); err != nil {
-		return fmt.Errorf("failed to add keystores to Secret: %w", err)
-	}
+	// Data keys are written in order of increasing precedence, as returned by
+	// certificateSecretDataKeys, so that should two formats ever write the
+	// same key the result is deterministic. The webhook rejects Certificates
+	// which would write the same key twice.
 
 	// Add additional output formats if feature enabled.
 	if utilfeature.DefaultFeatureGate.Enabled(feature.AdditionalCertificateOutputFormats) {
@@ -149,6 +243,10 @@ func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret
 		}
 	}
 
+	if err := s.setKeystores(crt, secret, data); err != nil {
+		return fmt.Errorf("failed to add keystores to Secret: %w", err)
+	}
+
 	secret.Data[corev1.TLSPrivateKeyKey] = data.PrivateKey
 	secret.Data[corev1.TLSCertKey] = data.Certificate
 	if len(data.CA) > 0 {
@@ -202,6 +300,12 @@ func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret
 		secret.Annotations[cmapi.IssuerKindAnnotationKey] = data.IssuerKind
 		secret.Annotations[cmapi.IssuerGroupAnnotationKey] = data.IssuerGroup
 	}
+	if data.CertificateRevision != "" {
+		secret.Annotations[cmapi.SecretCertificateRevisionAnnotationKey] = data.CertificateRevision
+	}
+	if data.Fallback {
+		secret.Annotations[cmapi.FallbackCertificateAnnotationKey] = "true"
+	}
 
 	secret.Labels[cmapi.PartOfCertManagerControllerLabelKey] = "true"
 
@@ -210,21 +314,55 @@ func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret
 
 // getCertificateSecret will return a secret which is ready for fields to be
 // applied. Only the Secret Type will be persisted from the original Secret.
-func (s *SecretsManager) getCertificateSecret(crt *cmapi.Certificate) (*corev1.Secret, error) {
+// If the existing Secret is not of type `kubernetes.io/tls`, the
+// SecretTypeMismatchPolicy decides whether its type is kept, an error is
+// returned, or the Secret is deleted so that it can be recreated.
+// Deleting the Secret conflicts if it has been replaced since it was observed,
+// in which case it is re-read from the apiserver and the deletion retried
+// with backoff, rather than failing the issuance.
+func (s *SecretsManager) getCertificateSecret(ctx context.Context, crt *cmapi.Certificate) (*corev1.Secret, error) {
+	log := logf.FromContext(ctx).WithName("secrets_manager")
+
+	var secret *corev1.Secret
+	attempt := 0
+	err := retry.OnError(recreateConflictBackoff(s.recreateConflictRetries), apierrors.IsConflict, func() error {
+		// The lister is likely to still hold the Secret as it was before the
+		// conflicting update, so retries read the Secret from the apiserver.
+		var err error
+		secret, err = s.readCertificateSecret(ctx, crt, attempt > 0)
+		attempt++
+		if apierrors.IsConflict(err) {
+			log.V(logf.DebugLevel).Info("secret was replaced concurrently, retrying", "attempt", attempt, "error", err.Error())
+		}
+		return err
+	})
+	return secret, err
+}
+
+// recreateConflictBackoff returns the backoff between retries of conflicting
+// Secret deletions. Its Steps is the total number of attempts, so is never
+// less than 1.
+func recreateConflictBackoff(retries int) wait.Backoff {
+	return wait.Backoff{
+		Steps:    max(retries, 0) + 1,
+		Duration: 10 * time.Millisecond,
+		Factor:   2.0,
+		Jitter:   0.1,
+		Cap:      time.Second,
+	}
+}
+
+// readCertificateSecret implements getCertificateSecret for a single attempt.
+// If live is true, the existing Secret is read from the apiserver rather than
+// the lister.
+func (s *SecretsManager) readCertificateSecret(ctx context.Context, crt *cmapi.Certificate, live bool) (*corev1.Secret, error) {
 	// Get existing secret if it exists.
-	existingSecret, err := s.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	existingSecret, err := s.getSecret(ctx, crt.Namespace, crt.Spec.SecretName, live)
 
 	// If secret doesn't exist yet, return an empty secret that should be
 	// created.
 	if apierrors.IsNotFound(err) {
-		return &corev1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      crt.Spec.SecretName,
-				Namespace: crt.Namespace,
-			},
-			Data: make(map[string][]byte),
-			Type: corev1.SecretTypeTLS,
-		}, nil
+		return newTLSSecret(crt), nil
 	}
 
 	// Transient error.
@@ -232,6 +370,42 @@ func (s *SecretsManager) getCertificateSecret(crt *cmapi.Certificate) (*corev1.S
 		return nil, err
 	}
 
+	if existingSecret.Type != corev1.SecretTypeTLS {
+		policy := s.secretTypeMismatchPolicy
+		// A Secret shared with another controller holds data which is not
+		// ours to delete, so it is never recreated.
+		if policy == controllerpkg.SecretTypeMismatchPolicyRecreate && certificates.SharesSecret(crt) {
+			policy = controllerpkg.SecretTypeMismatchPolicyPreserve
+		}
+		switch policy {
+		case controllerpkg.SecretTypeMismatchPolicyFail:
+			return nil, &SecretTypeMismatchError{
+				Namespace: existingSecret.Namespace,
+				Name:      existingSecret.Name,
+				Type:      existingSecret.Type,
+			}
+
+		case controllerpkg.SecretTypeMismatchPolicyRecreate:
+			// Type is immutable, so the Secret must be deleted before it can be
+			// created with the correct type. The UID precondition ensures we
+			// never delete a Secret which has been replaced since it was observed.
+			release, err := s.writeLimiter.acquire(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed waiting to delete secret %s/%s: %w", existingSecret.Namespace, existingSecret.Name, err)
+			}
+			logf.FromContext(ctx).WithName("secrets_manager").Info("deleting existing Secret to recreate it with the correct type",
+				"secret", existingSecret.Namespace+"/"+existingSecret.Name, "type", existingSecret.Type)
+			err = s.secretClient.Secrets(existingSecret.Namespace).Delete(ctx, existingSecret.Name, metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{UID: &existingSecret.UID},
+			})
+			release()
+			if err != nil && !apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to delete secret %s/%s of type %q: %w", existingSecret.Namespace, existingSecret.Name, existingSecret.Type, err)
+			}
+			return newTLSSecret(crt), nil
+		}
+	}
+
 	// Only copy Secret Type to not take ownership of annotations or labels on
 	// Apply.
 	return &corev1.Secret{
@@ -247,6 +421,28 @@ func (s *SecretsManager) getCertificateSecret(crt *cmapi.Certificate) (*corev1.S
 	}, nil
 }
 
+// getSecret returns the named Secret from the lister, or from the apiserver if
+// live is true.
+func (s *SecretsManager) getSecret(ctx context.Context, namespace, name string, live bool) (*corev1.Secret, error) {
+	if live {
+		return s.secretClient.Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	}
+	return s.secretLister.Secrets(namespace).Get(name)
+}
+
+// newTLSSecret returns an empty Secret of type `kubernetes.io/tls` for the
+// given Certificate, which should be created on Apply.
+func newTLSSecret(crt *cmapi.Certificate) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      crt.Spec.SecretName,
+			Namespace: crt.Namespace,
+		},
+		Data: make(map[string][]byte),
+		Type: corev1.SecretTypeTLS,
+	}
+}
+
 // setKeystores will set extra Secret Data keys according to any Keystores
 // which have been configured.
 func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Secret, data SecretData) error {
@@ -262,6 +458,10 @@ func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Sec
 
 		switch {
 		case ref.Name != "":
+			if ref.Name == crt.Spec.SecretName && slices.Contains(certificateSecretDataKeys(crt), ref.Key) {
+				return fmt.Errorf("PKCS12 keystore password cannot be read from key %q of the Certificate's Secret, which is written by cert-manager", ref.Key)
+			}
+
 			pwSecret, err := s.secretLister.Secrets(crt.Namespace).Get(ref.Name)
 			if err != nil {
 				return fmt.Errorf("fetching PKCS12 keystore password from Secret: %v", err)
@@ -312,6 +512,10 @@ func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Sec
 
 		switch {
 		case ref.Name != "":
+			if ref.Name == crt.Spec.SecretName && slices.Contains(certificateSecretDataKeys(crt), ref.Key) {
+				return fmt.Errorf("JKS keystore password cannot be read from key %q of the Certificate's Secret, which is written by cert-manager", ref.Key)
+			}
+
 			pwSecret, err := s.secretLister.Secrets(crt.Namespace).Get(ref.Name)
 			if err != nil {
 				return fmt.Errorf("fetching JKS keystore password from Secret: %v", err)
@@ -360,6 +564,37 @@ func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Sec
 	return nil
 }
 
+// certificateSecretDataKeys returns the data keys written to the Secret of
+// the given Certificate, in order of increasing precedence: additional output
+// formats, then keystores, then the `tls.key`, `tls.crt` and `ca.crt` keys.
+func certificateSecretDataKeys(crt *cmapi.Certificate) []string {
+	var keys []string
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.AdditionalCertificateOutputFormats) {
+		for _, format := range crt.Spec.AdditionalOutputFormats {
+			switch format.Type {
+			case cmapi.CertificateOutputFormatDER:
+				keys = append(keys, cmapi.CertificateOutputFormatDERKey)
+			case cmapi.CertificateOutputFormatCombinedPEM:
+				keys = append(keys, cmapi.CertificateOutputFormatCombinedPEMKey)
+			case cmapi.CertificateOutputFormatPKCS7:
+				keys = append(keys, cmapi.CertificateOutputFormatPKCS7Key)
+			}
+		}
+	}
+
+	if crt.Spec.Keystores != nil {
+		if crt.Spec.Keystores.PKCS12 != nil && crt.Spec.Keystores.PKCS12.Create {
+			keys = append(keys, cmapi.PKCS12SecretKey, cmapi.PKCS12TruststoreKey)
+		}
+		if crt.Spec.Keystores.JKS != nil && crt.Spec.Keystores.JKS.Create {
+			keys = append(keys, cmapi.JKSSecretKey, cmapi.JKSTruststoreKey)
+		}
+	}
+
+	return append(keys, corev1.TLSPrivateKeyKey, corev1.TLSCertKey, cmmeta.TLSCAKey)
+}
+
 // setAdditionalOutputFormat will set extra Secret Data keys with additional
 // output formats according to any OutputFormats which have been configured.
 func setAdditionalOutputFormats(crt *cmapi.Certificate, secret *corev1.Secret, data SecretData) error {
@@ -371,6 +606,13 @@ func setAdditionalOutputFormats(crt *cmapi.Certificate, secret *corev1.Secret, d
 		case cmapi.CertificateOutputFormatCombinedPEM:
 			// Combine tls.key and tls.crt
 			secret.Data[cmapi.CertificateOutputFormatCombinedPEMKey] = certificates.OutputFormatCombinedPEM(data.PrivateKey, data.Certificate)
+		case cmapi.CertificateOutputFormatPKCS7:
+			// Encode the tls.crt chain as PKCS#7
+			pkcs7Data, err := certificates.OutputFormatPKCS7(data.Certificate)
+			if err != nil {
+				return fmt.Errorf("error encoding PKCS#7 certificate chain: %w", err)
+			}
+			secret.Data[cmapi.CertificateOutputFormatPKCS7Key] = pkcs7Data
 		default:
 			return fmt.Errorf("unknown additional output format %s", format.Type)
 		}
@@ -0,0 +1,83 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internal
+
+import (
+	"context"
+
+	"golang.org/x/sync/semaphore"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// SecretWriteLimits limits the Secret writes made by a SecretsManager. A zero
+// value for any field disables that limit.
+type SecretWriteLimits struct {
+	// WritesPerSecond is the maximum rate at which Secrets are written.
+	WritesPerSecond float32
+
+	// MaxInFlight is the maximum number of Secret writes in flight at once.
+	MaxInFlight int
+}
+
+// secretWriteLimiter enforces SecretWriteLimits. A nil secretWriteLimiter
+// does not limit writes.
+type secretWriteLimiter struct {
+	rateLimiter flowcontrol.RateLimiter
+	inFlight    *semaphore.Weighted
+}
+
+func newSecretWriteLimiter(limits SecretWriteLimits) *secretWriteLimiter {
+	if limits.WritesPerSecond <= 0 && limits.MaxInFlight <= 0 {
+		return nil
+	}
+
+	l := &secretWriteLimiter{}
+	if limits.WritesPerSecond > 0 {
+		// A burst of 1 spreads writes evenly rather than letting a renewal
+		// wave through all at once.
+		l.rateLimiter = flowcontrol.NewTokenBucketRateLimiter(limits.WritesPerSecond, 1)
+	}
+	if limits.MaxInFlight > 0 {
+		l.inFlight = semaphore.NewWeighted(int64(limits.MaxInFlight))
+	}
+	return l
+}
+
+// acquire blocks until a Secret write may be made, or the context is done.
+// The returned release function must be called once the write has completed.
+func (l *secretWriteLimiter) acquire(ctx context.Context) (release func(), err error) {
+	release = func() {}
+	if l == nil {
+		return release, nil
+	}
+
+	if l.inFlight != nil {
+		if err := l.inFlight.Acquire(ctx, 1); err != nil {
+			return nil, err
+		}
+		release = func() { l.inFlight.Release(1) }
+	}
+
+	if l.rateLimiter != nil {
+		if err := l.rateLimiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_secretWriteLimiter(t *testing.T) {
+	t.Run("no limits configured does not limit writes", func(t *testing.T) {
+		l := newSecretWriteLimiter(SecretWriteLimits{})
+		assert.Nil(t, l)
+
+		for range 100 {
+			release, err := l.acquire(context.Background())
+			require.NoError(t, err)
+			defer release()
+		}
+	})
+
+	t.Run("max in flight blocks writes until a write is released", func(t *testing.T) {
+		l := newSecretWriteLimiter(SecretWriteLimits{MaxInFlight: 1})
+
+		release, err := l.acquire(context.Background())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err = l.acquire(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		release()
+		release, err = l.acquire(context.Background())
+		require.NoError(t, err)
+		release()
+	})
+
+	t.Run("writes per second spreads writes out", func(t *testing.T) {
+		l := newSecretWriteLimiter(SecretWriteLimits{WritesPerSecond: 50})
+
+		start := time.Now()
+		for range 5 {
+			release, err := l.acquire(context.Background())
+			require.NoError(t, err)
+			release()
+		}
+		// The first write is immediate, each write after that waits 20ms.
+		assert.GreaterOrEqual(t, time.Since(start), 70*time.Millisecond)
+	})
+
+	t.Run("a write waiting for the rate limit releases its in flight slot when the context is done", func(t *testing.T) {
+		l := newSecretWriteLimiter(SecretWriteLimits{WritesPerSecond: 0.1, MaxInFlight: 1})
+
+		release, err := l.acquire(context.Background())
+		require.NoError(t, err)
+		release()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err = l.acquire(ctx)
+		assert.Error(t, err)
+
+		assert.True(t, l.inFlight.TryAcquire(1), "expected the in flight slot to have been released")
+	})
+}
@@ -19,6 +19,8 @@ package internal
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -27,12 +29,15 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
 	applymetav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 
+	"github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/pem"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -83,6 +88,14 @@ func Test_SecretsManager(t *testing.T) {
 		gen.SetCertificateAdditionalOutputFormats(cmapi.CertificateAdditionalOutputFormat{Type: "CombinedPEM"}),
 	)
 
+	baseCertWithAdditionalOutputFormatPKCS7 := gen.CertificateFrom(baseCertBundle.Certificate,
+		gen.SetCertificateAdditionalOutputFormats(cmapi.CertificateAdditionalOutputFormat{Type: "PKCS7"}),
+	)
+	tlsP7bContent, err := certificates.OutputFormatPKCS7(baseCertBundle.CertBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	baseCertWithAdditionalOutputFormats := gen.CertificateFrom(baseCertBundle.Certificate,
 		gen.SetCertificateAdditionalOutputFormats(
 			cmapi.CertificateAdditionalOutputFormat{Type: "DER"},
@@ -98,6 +111,35 @@ func Test_SecretsManager(t *testing.T) {
 		gen.SetCertificateKeystore(&cmapi.CertificateKeystores{PKCS12: &cmapi.PKCS12Keystore{Create: true, Password: &keystorePassword}}),
 	)
 
+	baseCertWithJKSKeystorePasswordInSecret := func(key string) *cmapi.Certificate {
+		return gen.CertificateFrom(baseCertBundle.Certificate,
+			gen.SetCertificateKeystore(&cmapi.CertificateKeystores{JKS: &cmapi.JKSKeystore{Create: true, PasswordSecretRef: cmmeta.SecretKeySelector{
+				LocalObjectReference: cmmeta.LocalObjectReference{Name: "output"}, Key: key,
+			}}}),
+		)
+	}
+	outputSecretWithPassword := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "output"},
+		Data:       map[string][]byte{"password": []byte(keystorePassword), cmapi.PKCS12SecretKey: []byte("keystore")},
+		Type:       corev1.SecretTypeTLS,
+	}
+
+	baseCertWithCACertificatePolicyChainRoot := gen.CertificateFrom(baseCertBundle.Certificate,
+		gen.SetCertificateCACertificatePolicy(cmapi.CACertificatePolicyChainRoot),
+	)
+	baseCertWithCACertificatePolicyOmit := gen.CertificateFrom(baseCertBundle.Certificate,
+		gen.SetCertificateCACertificatePolicy(cmapi.CACertificatePolicyOmit),
+	)
+	chainLeaf, chainIntermediate, chainRoot := testcrypto.MustCreateCertificateChain(t)
+	chainWithRoot := append(append(chainLeaf, chainIntermediate...), chainRoot...)
+	chainWithoutRoot := slices.Concat(chainLeaf, chainIntermediate)
+	baseCertWithChainTruncation := func(subject string) *cmapi.Certificate {
+		return gen.CertificateFrom(baseCertBundle.Certificate,
+			gen.SetCertificateCACertificatePolicy(cmapi.CACertificatePolicyChainRoot),
+			gen.SetCertificateChainTruncation(cmapi.CertificateChainTruncation{Subject: subject}),
+		)
+	}
+
 	block, _, _ := pem.SafeDecodePrivateKey(baseCertBundle.PrivateKeyBytes)
 	tlsDerContent := block.Bytes
 
@@ -166,6 +208,46 @@ func Test_SecretsManager(t *testing.T) {
 			expectedErr: false,
 		},
 
+		"if secret does not exist and a certificate revision is given, create new Secret with the revision annotation": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertBundle.Certificate,
+			existingSecret:     nil,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: []byte("test-key"),
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+				CertificateRevision: "3",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					expCnf := applycorev1.Secret("output", gen.DefaultTestNamespace).
+						WithAnnotations(
+							map[string]string{
+								cmapi.CertificateNameKey: "test", cmapi.IssuerGroupAnnotationKey: "foo.io",
+								cmapi.IssuerKindAnnotationKey: "Issuer", cmapi.IssuerNameAnnotationKey: "ca-issuer",
+								cmapi.SecretCertificateRevisionAnnotationKey: "3",
+
+								cmapi.CommonNameAnnotationKey: baseCertBundle.Cert.Subject.CommonName, cmapi.AltNamesAnnotationKey: strings.Join(baseCertBundle.Cert.DNSNames, ","),
+								cmapi.IPSANAnnotationKey:  strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
+								cmapi.URISANAnnotationKey: strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+							}).
+						WithLabels(map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"}).
+						WithData(map[string][]byte{
+							corev1.TLSCertKey:       baseCertBundle.CertBytes,
+							corev1.TLSPrivateKeyKey: []byte("test-key"),
+							cmmeta.TLSCAKey:         []byte("test-ca"),
+						}).
+						WithType(corev1.SecretTypeTLS)
+					assert.Equal(t, expCnf, gotCnf)
+
+					expOpts := metav1.ApplyOptions{FieldManager: "cert-manager-test", Force: true}
+					assert.Equal(t, expOpts, gotOpts)
+
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+
 		"if secret does not exist, create new Secret, with owner enabled": {
 			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: true},
 			certificate:        baseCertBundle.Certificate,
@@ -533,6 +615,46 @@ func Test_SecretsManager(t *testing.T) {
 			expectedErr: false,
 		},
 
+		"if secret does not exist, create new Secret with additional output format PKCS7": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertWithAdditionalOutputFormatPKCS7,
+			existingSecret:     nil,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: baseCertBundle.PrivateKeyBytes,
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					expCnf := applycorev1.Secret("output", gen.DefaultTestNamespace).
+						WithAnnotations(
+							map[string]string{
+								cmapi.CertificateNameKey: "test", cmapi.IssuerGroupAnnotationKey: "foo.io",
+								cmapi.IssuerKindAnnotationKey: "Issuer", cmapi.IssuerNameAnnotationKey: "ca-issuer",
+
+								cmapi.CommonNameAnnotationKey: baseCertBundle.Cert.Subject.CommonName,
+								cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
+								cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
+								cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+							}).
+						WithLabels(map[string]string{cmapi.PartOfCertManagerControllerLabelKey: "true"}).
+						WithData(map[string][]byte{
+							corev1.TLSCertKey:                     baseCertBundle.CertBytes,
+							corev1.TLSPrivateKeyKey:               baseCertBundle.PrivateKeyBytes,
+							cmmeta.TLSCAKey:                       []byte("test-ca"),
+							cmapi.CertificateOutputFormatPKCS7Key: tlsP7bContent,
+						}).
+						WithType(corev1.SecretTypeTLS)
+					assert.Equal(t, expCnf, gotCnf)
+
+					expOpts := metav1.ApplyOptions{FieldManager: "cert-manager-test", Force: true}
+					assert.Equal(t, expOpts, gotOpts)
+
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+
 		"if secret does not exist, create new Secret with additional output format DER and CombinedPEM": {
 			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
 			certificate:        baseCertWithAdditionalOutputFormats,
@@ -801,6 +923,154 @@ func Test_SecretsManager(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+
+		"if the JKS keystore password is read from an unwritten key of the Certificate's Secret, create the keystore": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertWithJKSKeystorePasswordInSecret("password"),
+			existingSecret:     outputSecretWithPassword,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, PrivateKey: baseCertBundle.PrivateKeyBytes,
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					assert.NotNil(t, gotCnf.Data[cmapi.JKSSecretKey])
+					assert.NotContains(t, gotCnf.Data, "password")
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+
+		"if the JKS keystore password is read from a key written to the Certificate's Secret, expect error": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertWithJKSKeystorePasswordInSecret(cmapi.JKSSecretKey),
+			existingSecret:     outputSecretWithPassword,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, PrivateKey: baseCertBundle.PrivateKeyBytes,
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					t.Error("unexpected apply call")
+					return nil, nil
+				}
+			},
+			expectedErr: true,
+		},
+
+		"if the issuer provides no CA and caCertificatePolicy is ChainRoot, store the root of the chain": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertWithCACertificatePolicyChainRoot,
+			existingSecret:     nil,
+			secretData: SecretData{
+				Certificate: chainWithRoot, PrivateKey: []byte("test-key"),
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					assert.Equal(t, map[string][]byte{
+						corev1.TLSCertKey:       chainWithRoot,
+						corev1.TLSPrivateKeyKey: []byte("test-key"),
+						cmmeta.TLSCAKey:         chainRoot,
+					}, gotCnf.Data)
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+
+		"if the issuer provides a CA and caCertificatePolicy is ChainRoot, store the issuer provided CA": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertWithCACertificatePolicyChainRoot,
+			existingSecret:     nil,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: []byte("test-key"),
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					assert.Equal(t, []byte("test-ca"), gotCnf.Data[cmmeta.TLSCAKey])
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+
+		"if chainTruncation is set to an intermediate, store the chain up to the intermediate and derive the CA from the full chain": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertWithChainTruncation("CN=intermediate"),
+			existingSecret:     nil,
+			secretData: SecretData{
+				Certificate: chainWithRoot, PrivateKey: []byte("test-key"),
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					assert.Equal(t, map[string][]byte{
+						corev1.TLSCertKey:       chainWithoutRoot,
+						corev1.TLSPrivateKeyKey: []byte("test-key"),
+						cmmeta.TLSCAKey:         chainRoot,
+					}, gotCnf.Data)
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+
+		"if chainTruncation is set to the root, store the chain including the root": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertWithChainTruncation("CN=root"),
+			existingSecret:     nil,
+			secretData: SecretData{
+				Certificate: chainWithRoot, PrivateKey: []byte("test-key"),
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					assert.Equal(t, chainWithRoot, gotCnf.Data[corev1.TLSCertKey])
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+
+		"if the chainTruncation anchor is not part of the chain, error and do not apply the Secret": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertWithChainTruncation("CN=root"),
+			existingSecret:     nil,
+			secretData: SecretData{
+				Certificate: chainWithoutRoot, PrivateKey: []byte("test-key"),
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(context.Context, *applycorev1.SecretApplyConfiguration, metav1.ApplyOptions) (*corev1.Secret, error) {
+					t.Error("unexpected apply call")
+					return nil, nil
+				}
+			},
+			expectedErr: true,
+		},
+
+		"if the issuer provides a CA and caCertificatePolicy is Omit, do not store the CA": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertWithCACertificatePolicyOmit,
+			existingSecret:     nil,
+			secretData: SecretData{
+				Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: []byte("test-key"),
+				CertificateName: "test", IssuerName: "ca-issuer", IssuerKind: "Issuer", IssuerGroup: "foo.io",
+			},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					assert.Equal(t, map[string][]byte{
+						corev1.TLSCertKey:       baseCertBundle.CertBytes,
+						corev1.TLSPrivateKeyKey: []byte("test-key"),
+					}, gotCnf.Data)
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
 	}
 
 	for name, test := range tests {
@@ -817,8 +1087,15 @@ func Test_SecretsManager(t *testing.T) {
 
 			testManager := NewSecretsManager(
 				secretClient, secretLister,
-				"cert-manager-test",
-				test.certificateOptions.EnableOwnerRef,
+				SecretsManagerOptions{
+					FieldManager:                "cert-manager-test",
+					EnableSecretOwnerReferences: test.certificateOptions.EnableOwnerRef,
+					SecretTypeMismatchPolicy:    test.certificateOptions.SecretTypeMismatchPolicy,
+					WriteLimits: SecretWriteLimits{
+						WritesPerSecond: test.certificateOptions.SecretWritesPerSecond,
+						MaxInFlight:     test.certificateOptions.MaxConcurrentSecretWrites,
+					},
+				},
 			)
 
 			err := testManager.UpdateData(context.Background(), test.certificate, test.secretData)
@@ -838,9 +1115,21 @@ func Test_getCertificateSecret(t *testing.T) {
 		Spec:       cmapi.CertificateSpec{SecretName: "test-secret"},
 	}
 
+	opaqueSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test-namespace", Name: "test-secret",
+			Annotations: map[string]string{"foo": "bar"}, Labels: map[string]string{"abc": "123"},
+		},
+		Data: map[string][]byte{"abc": []byte("123"), "hello-world": []byte("bar"), "tls.crt": []byte("cert"), "tls.key": []byte("key"), "ca.crt": []byte("ca")},
+		Type: corev1.SecretTypeOpaque,
+	}
+
 	tests := map[string]struct {
-		existingSecret *corev1.Secret
-		expSecret      *corev1.Secret
+		existingSecret           *corev1.Secret
+		secretTypeMismatchPolicy controllerpkg.SecretTypeMismatchPolicy
+		expSecret                *corev1.Secret
+		expErr                   error
+		expSecretDeleted         bool
 	}{
 		"if secret doesn't exist, expect empty secret": {
 			existingSecret: nil,
@@ -884,6 +1173,46 @@ func Test_getCertificateSecret(t *testing.T) {
 				Type: corev1.SecretTypeOpaque,
 			},
 		},
+		"if secret exists with wrong type and policy is Preserve, expect original Type set": {
+			existingSecret:           opaqueSecret,
+			secretTypeMismatchPolicy: controllerpkg.SecretTypeMismatchPolicyPreserve,
+			expSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace", Name: "test-secret",
+				},
+				Data: make(map[string][]byte),
+				Type: corev1.SecretTypeOpaque,
+			},
+		},
+		"if secret exists with wrong type and policy is Fail, expect a type mismatch error": {
+			existingSecret:           opaqueSecret,
+			secretTypeMismatchPolicy: controllerpkg.SecretTypeMismatchPolicyFail,
+			expErr: &SecretTypeMismatchError{
+				Namespace: "test-namespace", Name: "test-secret", Type: corev1.SecretTypeOpaque,
+			},
+		},
+		"if secret exists with correct type and policy is Fail, expect no error": {
+			existingSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-secret"},
+				Type:       corev1.SecretTypeTLS,
+			},
+			secretTypeMismatchPolicy: controllerpkg.SecretTypeMismatchPolicyFail,
+			expSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-secret"},
+				Data:       make(map[string][]byte),
+				Type:       corev1.SecretTypeTLS,
+			},
+		},
+		"if secret exists with wrong type and policy is Recreate, expect secret deleted and Type set to tls": {
+			existingSecret:           opaqueSecret,
+			secretTypeMismatchPolicy: controllerpkg.SecretTypeMismatchPolicyRecreate,
+			expSecret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-secret"},
+				Data:       make(map[string][]byte),
+				Type:       corev1.SecretTypeTLS,
+			},
+			expSecretDeleted: true,
+		},
 	}
 
 	for name, test := range tests {
@@ -900,18 +1229,150 @@ func Test_getCertificateSecret(t *testing.T) {
 			builder.Init()
 
 			s := SecretsManager{
-				secretClient: builder.Client.CoreV1(),
-				secretLister: builder.KubeSharedInformerFactory.Secrets().Lister(),
-				fieldManager: "cert-manager-test",
+				secretClient:             builder.Client.CoreV1(),
+				secretLister:             builder.KubeSharedInformerFactory.Secrets().Lister(),
+				fieldManager:             "cert-manager-test",
+				secretTypeMismatchPolicy: test.secretTypeMismatchPolicy,
 			}
 
 			builder.Start()
 			defer builder.Stop()
 
-			gotSecret, err := s.getCertificateSecret(crt)
-			assert.NoError(t, err)
+			gotSecret, err := s.getCertificateSecret(context.Background(), crt)
+			assert.Equal(t, test.expErr, err, "unexpected returned error")
 
 			assert.Equal(t, test.expSecret, gotSecret, "unexpected returned secret")
+
+			_, err = builder.Client.CoreV1().Secrets("test-namespace").Get(context.Background(), "test-secret", metav1.GetOptions{})
+			assert.Equal(t, test.expSecretDeleted || test.existingSecret == nil, apierrors.IsNotFound(err), "unexpected existence of secret")
+		})
+	}
+}
+
+func Test_SecretsManager_recreateConflictRetries(t *testing.T) {
+	crt := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-certificate"},
+		Spec:       cmapi.CertificateSpec{SecretName: "test-secret"},
+	}
+	secretsGVR := corev1.SchemeGroupVersion.WithResource("secrets")
+
+	tests := map[string]struct {
+		replacements            int
+		recreateConflictRetries int
+		expDeletes              int
+		expErr                  bool
+	}{
+		"a deletion of a Secret which was not replaced is not retried": {
+			replacements:            0,
+			recreateConflictRetries: 5,
+			expDeletes:              1,
+		},
+		"a deletion of a replaced Secret is retried until it succeeds": {
+			replacements:            2,
+			recreateConflictRetries: 5,
+			expDeletes:              3,
+		},
+		"a deletion of a replaced Secret is not retried if retries are disabled": {
+			replacements:            1,
+			recreateConflictRetries: 0,
+			expDeletes:              1,
+			expErr:                  true,
+		},
+		"a deletion of a Secret which keeps being replaced fails once the retries are exhausted": {
+			replacements:            10,
+			recreateConflictRetries: 2,
+			expDeletes:              3,
+			expErr:                  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T: t,
+				KubeObjects: []runtime.Object{&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-secret", UID: "test-uid-0"},
+					Type:       corev1.SecretTypeOpaque,
+				}},
+			}
+			builder.Init()
+			tracker := builder.FakeKubeClient().Tracker()
+
+			// Before each of the first deletions arrives, another client
+			// replaces the Secret with one of a new UID. The fake clientset
+			// does not enforce delete preconditions, so the UID precondition
+			// is checked here against the Secret currently stored, as the
+			// apiserver does.
+			var deletes int
+			builder.FakeKubeClient().PrependReactor("delete", "secrets", func(action coretesting.Action) (bool, runtime.Object, error) {
+				deletes++
+				if deletes <= test.replacements {
+					if err := tracker.Delete(secretsGVR, "test-namespace", "test-secret"); err != nil {
+						return true, nil, err
+					}
+					if err := tracker.Create(secretsGVR, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-secret", UID: apitypes.UID(fmt.Sprintf("test-uid-%d", deletes))},
+						Type:       corev1.SecretTypeOpaque,
+					}, "test-namespace"); err != nil {
+						return true, nil, err
+					}
+				}
+
+				obj, err := tracker.Get(secretsGVR, "test-namespace", "test-secret")
+				if err != nil {
+					return true, nil, err
+				}
+				uid := obj.(*corev1.Secret).UID
+				preconditions := action.(coretesting.DeleteActionImpl).GetDeleteOptions().Preconditions
+				if preconditions != nil && preconditions.UID != nil && *preconditions.UID != uid {
+					return true, nil, apierrors.NewConflict(corev1.Resource("secrets"), "test-secret",
+						fmt.Errorf("Precondition failed: UID in precondition: %s, UID in object meta: %s", *preconditions.UID, uid))
+				}
+				return false, nil, nil
+			})
+			builder.FakeKubeClient().PrependReactor("patch", "secrets", func(coretesting.Action) (bool, runtime.Object, error) {
+				return true, &corev1.Secret{}, nil
+			})
+
+			s := NewSecretsManager(
+				builder.Client.CoreV1(), builder.KubeSharedInformerFactory.Secrets().Lister(),
+				SecretsManagerOptions{
+					FieldManager:             "cert-manager-test",
+					SecretTypeMismatchPolicy: controllerpkg.SecretTypeMismatchPolicyRecreate,
+					RecreateConflictRetries:  test.recreateConflictRetries,
+				},
+			)
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := s.UpdateData(context.Background(), crt, SecretData{PrivateKey: []byte("test-key")})
+			if test.expErr {
+				assert.True(t, apierrors.IsConflict(err), "expected a conflict error, got: %v", err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expDeletes, deletes, "unexpected number of deletions")
+
+			// Retries must re-read the Secret from the apiserver, since the
+			// lister may not yet have observed the replaced Secret. The Secret
+			// is only applied once it has been deleted.
+			var gets, applies int
+			for _, action := range builder.FakeKubeClient().Actions() {
+				switch {
+				case action.GetResource().Resource != "secrets":
+				case action.GetVerb() == "get":
+					gets++
+				case action.GetVerb() == "patch":
+					applies++
+				}
+			}
+			assert.Equal(t, test.expDeletes-1, gets, "unexpected number of live reads")
+			if test.expErr {
+				assert.Equal(t, 0, applies, "expected the secret not to be applied")
+			} else {
+				assert.Equal(t, 1, applies, "expected the secret to be applied once")
+			}
 		})
 	}
 }
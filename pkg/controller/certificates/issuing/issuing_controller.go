@@ -19,7 +19,9 @@ package issuing
 import (
 	"context"
 	"crypto"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -45,6 +47,7 @@ import (
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	utilkube "github.com/cert-manager/cert-manager/pkg/util/kube"
@@ -55,6 +58,29 @@ import (
 
 const (
 	ControllerName = "certificates-issuing"
+
+	// reasonSecretTypeMismatch is used for the Issuing condition and event when
+	// the existing Secret is not of type `kubernetes.io/tls` and the controller
+	// is configured to refuse writing to it.
+	reasonSecretTypeMismatch = "SecretTypeMismatch"
+
+	// reasonPublicKeyMismatch is used for the Issuing condition and event when
+	// the signed certificate is not for the next private key, so it is not
+	// stored in the Secret.
+	reasonPublicKeyMismatch = "PublicKeyMismatch"
+
+	// reasonChainTooDeep is used for the Issuing condition and event when the
+	// chain returned by the issuer is deeper than the Certificate's
+	// maxChainDepth, so it is not stored in the Secret.
+	reasonChainTooDeep = "ChainTooDeep"
+
+	// reasonAdopted is used for the event when the existing certificate in
+	// the Secret is adopted without re-issuing.
+	reasonAdopted = "Adopted"
+
+	// reasonSecretUpdateLoop is used for the event when the controller backs
+	// off from re-applying the data of a Secret which keeps being reverted.
+	reasonSecretUpdateLoop = "SecretUpdateLoop"
 )
 
 type localTemporarySignerFn func(crt *cmapi.Certificate, pk []byte) ([]byte, error)
@@ -66,6 +92,7 @@ type controller struct {
 	certificateLister        cmlisters.CertificateLister
 	certificateRequestLister cmlisters.CertificateRequestLister
 	secretLister             internalinformers.SecretLister
+	issuerHelper             issuer.Helper
 	recorder                 record.EventRecorder
 	clock                    clock.Clock
 
@@ -87,6 +114,11 @@ type controller struct {
 
 	// localTemporarySigner signs a certificate that is stored temporarily
 	localTemporarySigner localTemporarySignerFn
+
+	// secretUpdateLoops detects Secrets which are being fought over with
+	// another controller, and queue is used to retry them after backing off.
+	secretUpdateLoops *secretUpdateLoopDetector
+	queue             workqueue.TypedRateLimitingInterface[types.NamespacedName]
 }
 
 func NewController(
@@ -106,6 +138,7 @@ func NewController(
 	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
 	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
 
 	if _, err := certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue}); err != nil {
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
@@ -131,23 +164,58 @@ func NewController(
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
 	}
 
+	if _, err := issuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		// Issuer reconciles on changes to the Issuer referenced by `spec.issuerRef`
+		// so that changes to its defaultSecretTemplate are applied to the Secret.
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractIssuerReference(cmapi.IssuerKind)),
+	}); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
 	mustSync := []cache.InformerSynced{
 		certificateRequestInformer.Informer().HasSynced,
 		secretsInformer.Informer().HasSynced,
 		certificateInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+	}
+
+	// If we are running in non-namespaced mode, we also
+	// register event handlers and obtain a lister for ClusterIssuers.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		if _, err := clusterIssuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+			WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+				predicate.ExtractIssuerReference(cmapi.ClusterIssuerKind)),
+		}); err != nil {
+			return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+		}
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+		clusterIssuerLister = clusterIssuerInformer.Lister()
 	}
 
 	secretsManager := internal.NewSecretsManager(
 		ctx.Client.CoreV1(), secretsInformer.Lister(),
-		ctx.FieldManager, ctx.CertificateOptions.EnableOwnerRef,
+		internal.SecretsManagerOptions{
+			FieldManager:                ctx.FieldManager,
+			EnableSecretOwnerReferences: ctx.CertificateOptions.EnableOwnerRef,
+			SecretTypeMismatchPolicy:    ctx.CertificateOptions.SecretTypeMismatchPolicy,
+			RecreateConflictRetries:     ctx.CertificateOptions.SecretRecreateConflictRetries,
+			WriteLimits: internal.SecretWriteLimits{
+				WritesPerSecond: ctx.CertificateOptions.SecretWritesPerSecond,
+				MaxInFlight:     ctx.CertificateOptions.MaxConcurrentSecretWrites,
+			},
+		},
 	)
 
 	return &controller{
 		certificateLister:        certificateInformer.Lister(),
 		certificateRequestLister: certificateRequestInformer.Lister(),
 		secretLister:             secretsInformer.Lister(),
+		issuerHelper:             issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister),
 		client:                   ctx.CMClient,
 		recorder:                 ctx.Recorder,
 		clock:                    ctx.Clock,
@@ -158,6 +226,8 @@ func NewController(
 		),
 		fieldManager:         ctx.FieldManager,
 		localTemporarySigner: pki.GenerateLocallySignedTemporaryCertificate,
+		secretUpdateLoops:    newSecretUpdateLoopDetector(),
+		queue:                queue,
 	}, queue, mustSync, nil
 }
 
@@ -174,6 +244,7 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	crt, err := c.certificateLister.Certificates(namespace).Get(name)
 	if apierrors.IsNotFound(err) {
 		log.V(logf.DebugLevel).Info("certificate not found for key", "error", err.Error())
+		c.secretUpdateLoops.forget(key)
 		return nil
 	}
 	if err != nil {
@@ -265,6 +336,24 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return nil
 	}
 
+	// If public key does not match, do nothing (requestmanager will handle this).
+	// This is checked before the CertificateRequest's final state, as the
+	// requestmanager re-generates a CertificateRequest created for a private
+	// key which has since been rotated, so its failure must not fail the
+	// issuance.
+	csr, err := utilpki.DecodeX509CertificateRequestBytes(req.Spec.Request)
+	if err != nil {
+		return err
+	}
+	publicKeyMatchesCSR, err := utilpki.PublicKeyMatchesCSR(pk.Public(), csr)
+	if err != nil {
+		return err
+	}
+	if !publicKeyMatchesCSR {
+		logf.WithResource(log, nextPrivateKeySecret).Info("next private key does not match CSR public key, waiting for requestmanager controller")
+		return nil
+	}
+
 	certIssuingCond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing)
 	crReadyCond := apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionReady)
 	if certIssuingCond == nil {
@@ -303,14 +392,14 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	// now, bump the issuance attempts and set the Issuing status condition
 	// to False.
 	if apiutil.CertificateRequestIsDenied(req) {
-		return c.failIssueCertificate(ctx, log, crt, apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionDenied))
+		return c.failIssueCertificate(ctx, log, crt, pk, apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionDenied))
 	}
 
 	// If the certificate request is invalid, set the last failure time to
 	// now, bump the issuance attempts and set the Issuing status condition
 	// to False.
 	if apiutil.CertificateRequestHasInvalidRequest(req) {
-		return c.failIssueCertificate(ctx, log, crt, apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionInvalidRequest))
+		return c.failIssueCertificate(ctx, log, crt, pk, apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionInvalidRequest))
 	}
 
 	if crReadyCond == nil {
@@ -322,21 +411,7 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	// now, bump the issuance attempts and set the Issuing status condition
 	// to False.
 	if crReadyCond.Reason == cmapi.CertificateRequestReasonFailed {
-		return c.failIssueCertificate(ctx, log, crt, apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionReady))
-	}
-
-	// If public key does not match, do nothing (requestmanager will handle this).
-	csr, err := utilpki.DecodeX509CertificateRequestBytes(req.Spec.Request)
-	if err != nil {
-		return err
-	}
-	publicKeyMatchesCSR, err := utilpki.PublicKeyMatchesCSR(pk.Public(), csr)
-	if err != nil {
-		return err
-	}
-	if !publicKeyMatchesCSR {
-		logf.WithResource(log, nextPrivateKeySecret).Info("next private key does not match CSR public key, waiting for requestmanager controller")
-		return nil
+		return c.failIssueCertificate(ctx, log, crt, pk, apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionReady))
 	}
 
 	// If the CertificateRequest is valid and ready, verify its status and issue
@@ -361,7 +436,26 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 // false, set the Certificate's last failure time and issuance attempts, and log
 // an appropriate event. The reason and message of the Issuing condition will be that of
 // the CertificateRequest condition passed.
-func (c *controller) failIssueCertificate(ctx context.Context, log logr.Logger, crt *cmapi.Certificate, condition *cmapi.CertificateRequestCondition) error {
+// If the Certificate's expired certificate is to be replaced with a fallback
+// certificate, the fallback certificate is signed for the given private key.
+func (c *controller) failIssueCertificate(ctx context.Context, log logr.Logger, crt *cmapi.Certificate, pk crypto.Signer, condition *cmapi.CertificateRequestCondition) error {
+	log.V(logf.DebugLevel).Info("CertificateRequest in failed state so retrying issuance later")
+
+	if _, err := c.ensureFallbackCertificate(ctx, crt, pk); err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("The certificate request has failed to complete and will be retried: %s",
+		condition.Message)
+
+	return c.failIssueCertificateWithReason(ctx, crt, condition.Reason, message)
+}
+
+// failIssueCertificateWithReason will mark the Issuing condition of this
+// Certificate as false with the given reason and message, set the
+// Certificate's last failure time and issuance attempts, and log an
+// appropriate event.
+func (c *controller) failIssueCertificateWithReason(ctx context.Context, crt *cmapi.Certificate, reason, message string) error {
 	nowTime := metav1.NewTime(c.clock.Now())
 	crt.Status.LastFailureTime = &nowTime
 
@@ -371,13 +465,6 @@ func (c *controller) failIssueCertificate(ctx context.Context, log logr.Logger,
 	}
 	crt.Status.FailedIssuanceAttempts = &failedIssuanceAttempts
 
-	log.V(logf.DebugLevel).Info("CertificateRequest in failed state so retrying issuance later")
-
-	var reason, message string
-	reason = condition.Reason
-	message = fmt.Sprintf("The certificate request has failed to complete and will be retried: %s",
-		condition.Message)
-
 	crt = crt.DeepCopy()
 	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuing, cmmeta.ConditionFalse, reason, message)
 
@@ -395,6 +482,29 @@ func (c *controller) failIssueCertificate(ctx context.Context, log logr.Logger,
 // Secret in the appropriate format type.
 func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt *cmapi.Certificate, req *cmapi.CertificateRequest, pk crypto.Signer) error {
 	crt = crt.DeepCopy()
+
+	// Never store a certificate which does not match the private key stored
+	// alongside it. Failing the issuance has a new CertificateRequest
+	// created for the next private key once the issuance is retried.
+	if matches, err := signedCertificateMatchesPrivateKey(req.Status.Certificate, pk); !matches {
+		message := "Refusing to store the signed certificate as its public key does not match the next private key, and will be retried"
+		if err != nil {
+			message = fmt.Sprintf("Refusing to store the signed certificate and will be retried: %s", err)
+		}
+		return c.failIssueCertificateWithReason(ctx, crt, reasonPublicKeyMismatch, message)
+	}
+
+	if crt.Spec.MaxChainDepth != nil {
+		depth, err := certificateChainDepth(req.Status.Certificate)
+		if err != nil {
+			return c.failIssueCertificateWithReason(ctx, crt, reasonChainTooDeep, fmt.Sprintf("Refusing to store the signed certificate and will be retried: %s", err))
+		}
+		if depth > int(*crt.Spec.MaxChainDepth) {
+			message := fmt.Sprintf("Refusing to store the signed certificate as the chain returned by the issuer contains %d CA certificates, which exceeds the maxChainDepth of %d", depth, *crt.Spec.MaxChainDepth)
+			return c.failIssueCertificateWithReason(ctx, crt, reasonChainTooDeep, message)
+		}
+	}
+
 	if crt.Spec.PrivateKey == nil {
 		crt.Spec.PrivateKey = &cmapi.CertificatePrivateKey{}
 	}
@@ -412,8 +522,21 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 		IssuerKind:      req.Spec.IssuerRef.Kind,
 		IssuerGroup:     req.Spec.IssuerRef.Group,
 	}
+	if internalcertificates.SignalsRotation(crt) {
+		secretData.CertificateRevision = strconv.Itoa(nextRevision)
+	}
 
-	if err := c.secretsUpdateData(ctx, crt, secretData); err != nil {
+	secretCrt, err := c.certificateWithIssuerSecretTemplate(crt)
+	if err != nil {
+		return err
+	}
+
+	if err := c.secretsUpdateData(ctx, secretCrt, secretData); err != nil {
+		var mismatchErr *internal.SecretTypeMismatchError
+		if errors.As(err, &mismatchErr) {
+			message := fmt.Sprintf("Refusing to write the issued certificate and will be retried: %s", mismatchErr)
+			return c.failIssueCertificateWithReason(ctx, crt, reasonSecretTypeMismatch, message)
+		}
 		return err
 	}
 
@@ -442,6 +565,26 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 
 }
 
+// signedCertificateMatchesPrivateKey returns true if the given PEM encoded
+// certificate was signed for the public key of the given private key.
+func signedCertificateMatchesPrivateKey(certPEM []byte, pk crypto.Signer) (bool, error) {
+	cert, err := utilpki.DecodeX509CertificateBytes(certPEM)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode the signed certificate: %w", err)
+	}
+	return utilpki.PublicKeyMatchesCertificate(pk.Public(), cert)
+}
+
+// certificateChainDepth returns the number of CA certificates which follow the
+// leaf certificate in the given PEM encoded certificate chain.
+func certificateChainDepth(chainPEM []byte) (int, error) {
+	certs, err := utilpki.DecodeX509CertificateSetBytes(chainPEM)
+	if err != nil {
+		return 0, fmt.Errorf("failed to decode the signed certificate chain: %w", err)
+	}
+	return len(certs) - 1, nil
+}
+
 // updateOrApplyStatus will update the controller status. If the
 // ServerSideApply feature is enabled, the managed fields will instead get
 // applied using the relevant Patch API call.
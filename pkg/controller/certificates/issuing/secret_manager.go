@@ -19,12 +19,17 @@ package issuing
 import (
 	"context"
 	"errors"
+	"strconv"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
@@ -73,6 +78,52 @@ func (c *controller) ensureSecretData(ctx context.Context, log logr.Logger, crt
 		IssuerName:      secret.Annotations[cmapi.IssuerNameAnnotationKey],
 		IssuerKind:      secret.Annotations[cmapi.IssuerKindAnnotationKey],
 		IssuerGroup:     secret.Annotations[cmapi.IssuerGroupAnnotationKey],
+		Fallback:        secret.Annotations[cmapi.FallbackCertificateAnnotationKey] == "true",
+	}
+
+	// Keep the revision the stored certificate was issued for, so that
+	// re-applying the Secret's metadata is not mistaken for a rotation. If the
+	// Secret has not been annotated yet, the certificate is the one issued for
+	// the Certificate's current revision.
+	if internalcertificates.SignalsRotation(crt) {
+		data.CertificateRevision = secret.Annotations[cmapi.SecretCertificateRevisionAnnotationKey]
+		if data.CertificateRevision == "" && crt.Status.Revision != nil {
+			data.CertificateRevision = strconv.Itoa(*crt.Status.Revision)
+		}
+	}
+
+	// Merge the default secret template of the Certificate's issuer so that
+	// its labels and annotations are checked and applied alongside the
+	// Certificate's own SecretTemplate.
+	crt, err = c.certificateWithIssuerSecretTemplate(crt)
+	if err != nil {
+		return err
+	}
+
+	// If the Certificate adopts its existing Secret, wait for the readiness
+	// controller to confirm that the stored certificate matches the spec and
+	// has not expired, then take over the Secret without re-issuing by
+	// annotating it as issued for this Certificate.
+	if internalcertificates.AdoptsSecret(crt, secret) {
+		if !apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
+			Type:   cmapi.CertificateConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}) {
+			log.V(logf.DebugLevel).Info("waiting for the existing certificate to be found valid before adopting the Secret")
+			return nil
+		}
+
+		data.CertificateName = crt.Name
+		data.IssuerName = crt.Spec.IssuerRef.Name
+		data.IssuerKind = crt.Spec.IssuerRef.Kind
+		data.IssuerGroup = crt.Spec.IssuerRef.Group
+		if err := c.secretsUpdateData(ctx, crt, data); err != nil {
+			return err
+		}
+
+		log.V(logf.InfoLevel).Info("adopted the existing certificate stored in the Secret")
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonAdopted, "Adopted the existing certificate in Secret %q without re-issuing", secret.Name)
+		return nil
 	}
 
 	// Check whether the Certificate's Secret has correct output format and
@@ -93,6 +144,19 @@ func (c *controller) ensureSecretData(ctx context.Context, log logr.Logger, crt
 			log.Error(errors.New(message), "failed to determine whether the SecretTemplate matches Secret")
 			return nil
 		default:
+			// If the Secret keeps being reverted, another controller is likely
+			// updating it too. Back off rather than fight over the Secret.
+			key := types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name}
+			if backoff, started := c.secretUpdateLoops.reapply(key, c.clock.Now()); backoff > 0 {
+				if started {
+					log.Info("backing off from applying Secret data which keeps being reverted", "message", message, "backoff", backoff)
+					c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonSecretUpdateLoop,
+						"The data of Secret %q was applied %d times in %s, but keeps being reverted, retrying in %s. If another controller also manages the Secret, set the %q annotation to %q",
+						secret.Name, secretUpdateLoopThreshold, secretUpdateLoopWindow, backoff.Round(time.Second), cmapi.SharedSecretOwnershipAnnotation, "true")
+				}
+				c.queue.AddAfter(key, backoff)
+				return nil
+			}
 
 			// Here the Certificate need to be re-reconciled.
 			log.Info("applying Secret data", "message", message)
@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 
@@ -58,12 +59,23 @@ func Test_ensureSecretData(t *testing.T) {
 		// secret is the optional secret to be loaded into the fake clientset.
 		secret *corev1.Secret
 
+		// issuer is the optional Issuer or ClusterIssuer to be loaded into the
+		// fake clientset.
+		issuer runtime.Object
+
 		// expectedAction is true if the test expects that the controller should
 		// reconcile the Secret.
 		expectedAction bool
 
 		// enableOwnerRef is passed to the post issuance policy checks.
 		enableOwnerRef bool
+
+		// expectedCertificateName, if set, is the certificate name expected
+		// in the reconciled Secret data.
+		expectedCertificateName string
+
+		// expectedEvents are the events expected to be recorded.
+		expectedEvents []string
 	}{
 		"if 'key' is empty, should do nothing and not error": {
 			expectedAction: false,
@@ -183,6 +195,54 @@ func Test_ensureSecretData(t *testing.T) {
 			},
 			expectedAction: true,
 		},
+		"if Certificate adopts the existing Secret but is not Ready, should wait and not reconcile Secret": {
+			cert: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace", Name: "test-name",
+					Annotations: map[string]string{cmapi.AdoptExistingSecretAnnotation: "true"},
+				},
+				Spec: cmapi.CertificateSpec{
+					SecretName: "test-secret",
+					IssuerRef:  cmmeta.ObjectReference{Name: "test-issuer"},
+				},
+				Status: cmapi.CertificateStatus{
+					Conditions: []cmapi.CertificateCondition{{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionFalse}},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-secret"},
+				Data: map[string][]byte{
+					"tls.crt": cert,
+					"tls.key": pk,
+				},
+			},
+			expectedAction: false,
+		},
+		"if Certificate adopts the existing Secret and is Ready, should annotate the Secret as issued for the Certificate": {
+			cert: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace", Name: "test-name",
+					Annotations: map[string]string{cmapi.AdoptExistingSecretAnnotation: "true"},
+				},
+				Spec: cmapi.CertificateSpec{
+					SecretName: "test-secret",
+					IssuerRef:  cmmeta.ObjectReference{Name: "test-issuer"},
+				},
+				Status: cmapi.CertificateStatus{
+					Conditions: []cmapi.CertificateCondition{{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionTrue}},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-secret"},
+				Data: map[string][]byte{
+					"tls.crt": cert,
+					"tls.key": pk,
+				},
+			},
+			expectedAction:          true,
+			expectedCertificateName: "test-name",
+			expectedEvents:          []string{`Normal Adopted Adopted the existing certificate in Secret "test-secret" without re-issuing`},
+		},
 		"if Certificate exists in a false Issuing condition, Secret exists and matches the SecretTemplate but the managed fields contains more than what is in the SecretTemplate, should reconcile Secret": {
 			cert: &cmapi.Certificate{
 				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-name"},
@@ -267,6 +327,50 @@ func Test_ensureSecretData(t *testing.T) {
 			},
 			expectedAction: true,
 		},
+		"if Certificate shares its Secret, is in a false Issuing condition, Secret exists and matches the SecretTemplate but the managed fields are managed by another manager, should do nothing": {
+			cert: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace", Name: "test-name",
+					Annotations: map[string]string{cmapi.SharedSecretOwnershipAnnotation: "true"},
+				},
+				Spec: cmapi.CertificateSpec{
+					SecretName:     "test-secret",
+					SecretTemplate: &cmapi.CertificateSecretTemplate{Annotations: map[string]string{"foo": "bar"}, Labels: map[string]string{"abc": "123"}},
+				},
+				Status: cmapi.CertificateStatus{
+					Conditions: []cmapi.CertificateCondition{{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionFalse}},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace", Name: "test-secret",
+					Annotations: map[string]string{"foo": "bar"}, Labels: map[string]string{"abc": "123"},
+					ManagedFields: []metav1.ManagedFieldsEntry{{
+						Manager: "not-cert-manager",
+						FieldsV1: &metav1.FieldsV1{
+							Raw: []byte(`{"f:metadata": {
+							"f:annotations": {
+								"f:cert-manager.io/common-name": {},
+								"f:cert-manager.io/alt-names": {},
+								"f:cert-manager.io/ip-sans": {},
+								"f:cert-manager.io/uri-sans": {},
+								"f:foo": {}
+							},
+							"f:labels": {
+								"f:controller.cert-manager.io/fao": {},
+								"f:abc": {}
+							}
+						}}`),
+						}},
+					},
+				},
+				Data: map[string][]byte{
+					"tls.crt": cert,
+					"tls.key": pk,
+				},
+			},
+			expectedAction: false,
+		},
 		"if Certificate exists in a false Issuing condition, Secret exists and matches the SecretTemplate with the correct managed fields and base labels, should do nothing": {
 			cert: &cmapi.Certificate{
 				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-name"},
@@ -310,6 +414,106 @@ func Test_ensureSecretData(t *testing.T) {
 			},
 			expectedAction: false,
 		},
+		"if Certificate exists in a false Issuing condition, Secret matches the SecretTemplate but is missing the issuer's default labels, should reconcile Secret": {
+			cert: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-name"},
+				Spec: cmapi.CertificateSpec{
+					SecretName: "test-secret",
+					IssuerRef:  cmmeta.ObjectReference{Name: "platform", Kind: cmapi.ClusterIssuerKind, Group: "cert-manager.io"},
+					SecretTemplate: &cmapi.CertificateSecretTemplate{Annotations: map[string]string{"foo": "bar"},
+						Labels: map[string]string{"abc": "123"}},
+				},
+				Status: cmapi.CertificateStatus{
+					Conditions: []cmapi.CertificateCondition{{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionFalse}},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace", Name: "test-secret",
+					Annotations: map[string]string{"foo": "bar"},
+					Labels:      map[string]string{"abc": "123", cmapi.PartOfCertManagerControllerLabelKey: "true"},
+					ManagedFields: []metav1.ManagedFieldsEntry{{
+						Manager: fieldManager,
+						FieldsV1: &metav1.FieldsV1{
+							Raw: []byte(`{"f:metadata": {
+							"f:annotations": {
+								"f:cert-manager.io/common-name": {},
+								"f:cert-manager.io/alt-names": {},
+								"f:cert-manager.io/ip-sans": {},
+								"f:cert-manager.io/uri-sans": {},
+								"f:foo": {}
+							},
+							"f:labels": {
+								"f:controller.cert-manager.io/fao": {},
+								"f:abc": {}
+							}
+						}}`),
+						}},
+					},
+				},
+				Data: map[string][]byte{
+					"tls.crt": cert,
+					"tls.key": pk,
+				},
+			},
+			issuer: &cmapi.ClusterIssuer{
+				ObjectMeta: metav1.ObjectMeta{Name: "platform"},
+				Spec: cmapi.IssuerSpec{
+					DefaultSecretTemplate: &cmapi.CertificateSecretTemplate{Labels: map[string]string{"team": "platform"}},
+				},
+			},
+			expectedAction: true,
+		},
+		"if Certificate exists in a false Issuing condition, Secret matches the SecretTemplate which overrides the issuer's default labels, should do nothing": {
+			cert: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-name"},
+				Spec: cmapi.CertificateSpec{
+					SecretName: "test-secret",
+					IssuerRef:  cmmeta.ObjectReference{Name: "platform"},
+					SecretTemplate: &cmapi.CertificateSecretTemplate{Annotations: map[string]string{"foo": "bar"},
+						Labels: map[string]string{"abc": "123"}},
+				},
+				Status: cmapi.CertificateStatus{
+					Conditions: []cmapi.CertificateCondition{{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionFalse}},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test-namespace", Name: "test-secret",
+					Annotations: map[string]string{"foo": "bar"},
+					Labels:      map[string]string{"abc": "123", cmapi.PartOfCertManagerControllerLabelKey: "true"},
+					ManagedFields: []metav1.ManagedFieldsEntry{{
+						Manager: fieldManager,
+						FieldsV1: &metav1.FieldsV1{
+							Raw: []byte(`{"f:metadata": {
+							"f:annotations": {
+								"f:cert-manager.io/common-name": {},
+								"f:cert-manager.io/alt-names": {},
+								"f:cert-manager.io/ip-sans": {},
+								"f:cert-manager.io/uri-sans": {},
+								"f:foo": {}
+							},
+							"f:labels": {
+								"f:controller.cert-manager.io/fao": {},
+								"f:abc": {}
+							}
+						}}`),
+						}},
+					},
+				},
+				Data: map[string][]byte{
+					"tls.crt": cert,
+					"tls.key": pk,
+				},
+			},
+			issuer: &cmapi.Issuer{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "platform"},
+				Spec: cmapi.IssuerSpec{
+					DefaultSecretTemplate: &cmapi.CertificateSecretTemplate{Labels: map[string]string{"abc": "456"}},
+				},
+			},
+			expectedAction: false,
+		},
 		"if Certificate exists in a false Issuing condition, Secret exists but does not match SecretTemplate, should apply the Labels and Annotations": {
 			cert: &cmapi.Certificate{
 				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-name"},
@@ -1143,10 +1347,15 @@ func Test_ensureSecretData(t *testing.T) {
 				// Ensures secret is loaded into the builder's fake clientset.
 				builder.KubeObjects = append(builder.KubeObjects, test.secret)
 			}
+			if test.issuer != nil {
+				// Ensures issuer is loaded into the builder's fake clientset.
+				builder.CertManagerObjects = append(builder.CertManagerObjects, test.issuer)
+			}
 
 			// Initialise with RESTConfig which is used to discover the User Agent.
 			builder.InitWithRESTConfig()
 			builder.EnableOwnerRef = test.enableOwnerRef
+			builder.ExpectedEvents = test.expectedEvents
 
 			// Register informers used by the controller using the registration wrapper.
 			w := &controllerWrapper{}
@@ -1154,8 +1363,10 @@ func Test_ensureSecretData(t *testing.T) {
 			assert.NoError(t, err)
 
 			var actionCalled bool
-			w.secretsUpdateData = func(_ context.Context, _ *cmapi.Certificate, _ internal.SecretData) error {
+			var updatedData internal.SecretData
+			w.secretsUpdateData = func(_ context.Context, _ *cmapi.Certificate, data internal.SecretData) error {
 				actionCalled = true
+				updatedData = data
 				return nil
 			}
 			w.postIssuancePolicyChain = policies.NewSecretPostIssuancePolicyChain(test.enableOwnerRef, fieldManager)
@@ -1179,8 +1390,15 @@ func Test_ensureSecretData(t *testing.T) {
 			if err := builder.AllActionsExecuted(); err != nil {
 				builder.T.Error(err)
 			}
+			if err := builder.AllEventsCalled(); err != nil {
+				builder.T.Error(err)
+			}
 
 			assert.Equal(t, test.expectedAction, actionCalled, "unexpected Secret reconcile called")
+			if test.expectedCertificateName != "" {
+				assert.Equal(t, test.expectedCertificateName, updatedData.CertificateName)
+				assert.Equal(t, test.cert.Spec.IssuerRef.Name, updatedData.IssuerName)
+			}
 		})
 	}
 }
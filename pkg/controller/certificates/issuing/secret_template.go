@@ -0,0 +1,85 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// certificateWithIssuerSecretTemplate returns the Certificate with its
+// SecretTemplate merged with the DefaultSecretTemplate of the issuer it
+// references. The returned Certificate is a copy if the issuer has a default
+// secret template, and must only be used to build and check the Certificate's
+// Secret.
+// External issuers and issuers which cannot be found contribute no default
+// secret template.
+func (c *controller) certificateWithIssuerSecretTemplate(crt *cmapi.Certificate) (*cmapi.Certificate, error) {
+	if group := crt.Spec.IssuerRef.Group; group != "" && group != certmanager.GroupName {
+		return crt, nil
+	}
+
+	issuerObj, err := c.issuerHelper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+	if apierrors.IsNotFound(err) {
+		return crt, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	defaults := issuerObj.GetSpec().DefaultSecretTemplate
+	if defaults == nil {
+		return crt, nil
+	}
+
+	crt = crt.DeepCopy()
+	crt.Spec.SecretTemplate = mergeSecretTemplates(defaults, crt.Spec.SecretTemplate)
+	return crt, nil
+}
+
+// mergeSecretTemplates returns a secret template containing the labels and
+// annotations of both templates. Values in tmpl take precedence over those in
+// defaults.
+func mergeSecretTemplates(defaults, tmpl *cmapi.CertificateSecretTemplate) *cmapi.CertificateSecretTemplate {
+	if defaults == nil {
+		return tmpl
+	}
+	if tmpl == nil {
+		return defaults.DeepCopy()
+	}
+
+	mergeMaps := func(defaults, values map[string]string) map[string]string {
+		if len(defaults) == 0 && len(values) == 0 {
+			return nil
+		}
+		merged := make(map[string]string, len(defaults)+len(values))
+		for k, v := range defaults {
+			merged[k] = v
+		}
+		for k, v := range values {
+			merged[k] = v
+		}
+		return merged
+	}
+
+	return &cmapi.CertificateSecretTemplate{
+		Annotations: mergeMaps(defaults.Annotations, tmpl.Annotations),
+		Labels:      mergeMaps(defaults.Labels, tmpl.Labels),
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func Test_mergeSecretTemplates(t *testing.T) {
+	tests := map[string]struct {
+		defaults, tmpl *cmapi.CertificateSecretTemplate
+		expected       *cmapi.CertificateSecretTemplate
+	}{
+		"no templates": {
+			expected: nil,
+		},
+		"only a Certificate template": {
+			tmpl: &cmapi.CertificateSecretTemplate{
+				Labels: map[string]string{"app": "foo"},
+			},
+			expected: &cmapi.CertificateSecretTemplate{
+				Labels: map[string]string{"app": "foo"},
+			},
+		},
+		"only an issuer default template": {
+			defaults: &cmapi.CertificateSecretTemplate{
+				Labels:      map[string]string{"team": "platform"},
+				Annotations: map[string]string{"owner": "platform@example.com"},
+			},
+			expected: &cmapi.CertificateSecretTemplate{
+				Labels:      map[string]string{"team": "platform"},
+				Annotations: map[string]string{"owner": "platform@example.com"},
+			},
+		},
+		"both templates are merged": {
+			defaults: &cmapi.CertificateSecretTemplate{
+				Labels:      map[string]string{"team": "platform"},
+				Annotations: map[string]string{"owner": "platform@example.com"},
+			},
+			tmpl: &cmapi.CertificateSecretTemplate{
+				Labels: map[string]string{"app": "foo"},
+			},
+			expected: &cmapi.CertificateSecretTemplate{
+				Labels:      map[string]string{"team": "platform", "app": "foo"},
+				Annotations: map[string]string{"owner": "platform@example.com"},
+			},
+		},
+		"Certificate template takes precedence on conflict": {
+			defaults: &cmapi.CertificateSecretTemplate{
+				Labels:      map[string]string{"team": "platform", "env": "prod"},
+				Annotations: map[string]string{"owner": "platform@example.com"},
+			},
+			tmpl: &cmapi.CertificateSecretTemplate{
+				Labels:      map[string]string{"team": "payments"},
+				Annotations: map[string]string{"owner": "payments@example.com"},
+			},
+			expected: &cmapi.CertificateSecretTemplate{
+				Labels:      map[string]string{"team": "payments", "env": "prod"},
+				Annotations: map[string]string{"owner": "payments@example.com"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var defaultsBefore *cmapi.CertificateSecretTemplate
+			if test.defaults != nil {
+				defaultsBefore = test.defaults.DeepCopy()
+			}
+
+			got := mergeSecretTemplates(test.defaults, test.tmpl)
+			assert.Equal(t, test.expected, got)
+
+			// The issuer's default template must never be modified.
+			assert.Equal(t, defaultsBefore, test.defaults)
+		})
+	}
+}
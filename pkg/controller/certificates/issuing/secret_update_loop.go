@@ -0,0 +1,97 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// secretUpdateLoopThreshold is the number of times the data of a
+	// Certificate's Secret may be re-applied within secretUpdateLoopWindow
+	// before the controller assumes that it is fighting over the Secret with
+	// another controller, and backs off.
+	secretUpdateLoopThreshold = 5
+
+	// secretUpdateLoopWindow is the window in which re-applies of a Secret's
+	// data are counted.
+	secretUpdateLoopWindow = 5 * time.Minute
+)
+
+// secretUpdateLoopDetector detects Certificates whose Secret data is
+// re-applied over and over, which happens when another controller keeps
+// reverting the changes made to the Secret.
+type secretUpdateLoopDetector struct {
+	lock      sync.Mutex
+	reapplies map[types.NamespacedName]*secretReapplies
+}
+
+type secretReapplies struct {
+	// times are the times of the re-applies within the window.
+	times []time.Time
+	// backingOff is true once a re-apply has been refused.
+	backingOff bool
+}
+
+func newSecretUpdateLoopDetector() *secretUpdateLoopDetector {
+	return &secretUpdateLoopDetector{
+		reapplies: make(map[types.NamespacedName]*secretReapplies),
+	}
+}
+
+// reapply records that the Secret data of the given Certificate is about to
+// be re-applied at the given time. If the Secret has already been re-applied
+// secretUpdateLoopThreshold times within secretUpdateLoopWindow, the re-apply
+// is not recorded and the time to back off for is returned instead, along
+// with whether this is the first re-apply refused since the last one allowed.
+func (d *secretUpdateLoopDetector) reapply(key types.NamespacedName, now time.Time) (time.Duration, bool) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	r, ok := d.reapplies[key]
+	if !ok {
+		r = &secretReapplies{}
+		d.reapplies[key] = r
+	}
+
+	// Forget the re-applies which happened before the window.
+	for len(r.times) > 0 && now.Sub(r.times[0]) >= secretUpdateLoopWindow {
+		r.times = r.times[1:]
+	}
+
+	if len(r.times) >= secretUpdateLoopThreshold {
+		started := !r.backingOff
+		r.backingOff = true
+		return r.times[0].Add(secretUpdateLoopWindow).Sub(now), started
+	}
+
+	r.times = append(r.times, now)
+	r.backingOff = false
+	return 0, false
+}
+
+// forget removes the re-applies recorded for the given Certificate, once it
+// has been deleted.
+func (d *secretUpdateLoopDetector) forget(key types.NamespacedName) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	delete(d.reapplies, key)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func Test_secretUpdateLoopDetector(t *testing.T) {
+	var (
+		key   = types.NamespacedName{Namespace: "test-namespace", Name: "test-certificate"}
+		other = types.NamespacedName{Namespace: "test-namespace", Name: "other-certificate"}
+		now   = time.Now()
+	)
+
+	d := newSecretUpdateLoopDetector()
+
+	// The first re-applies within the window are allowed.
+	for i := 0; i < secretUpdateLoopThreshold; i++ {
+		backoff, started := d.reapply(key, now.Add(time.Duration(i)*time.Second))
+		assert.Zero(t, backoff, "re-apply %d should be allowed", i)
+		assert.False(t, started)
+	}
+
+	// The next re-apply is refused until the first one leaves the window.
+	backoff, started := d.reapply(key, now.Add(time.Minute))
+	assert.Equal(t, secretUpdateLoopWindow-time.Minute, backoff)
+	assert.True(t, started, "first refused re-apply should start the back off")
+
+	backoff, started = d.reapply(key, now.Add(2*time.Minute))
+	assert.Equal(t, secretUpdateLoopWindow-2*time.Minute, backoff)
+	assert.False(t, started, "back off should only be started once")
+
+	// Other Certificates are not affected.
+	backoff, _ = d.reapply(other, now.Add(2*time.Minute))
+	assert.Zero(t, backoff)
+
+	// Once the first re-apply has left the window, re-applies are allowed again.
+	backoff, started = d.reapply(key, now.Add(secretUpdateLoopWindow))
+	assert.Zero(t, backoff)
+	assert.False(t, started)
+
+	// Forgetting the Certificate resets its re-applies.
+	d.forget(key)
+	backoff, _ = d.reapply(key, now.Add(secretUpdateLoopWindow+time.Second))
+	assert.Zero(t, backoff)
+}
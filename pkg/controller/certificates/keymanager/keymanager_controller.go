@@ -54,6 +54,9 @@ const (
 	reasonDecodeFailed        = "DecodeFailed"
 	reasonCannotRegenerateKey = "CannotRegenerateKey"
 	reasonDeleted             = "Deleted"
+
+	reasonDeterministicPrivateKey           = "DeterministicPrivateKey"
+	reasonDeterministicPrivateKeyNotAllowed = "DeterministicPrivateKeyNotAllowed"
 )
 
 var (
@@ -229,6 +232,11 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	return nil
 }
 
+// createNextPrivateKeyRotationPolicyNever reuses the private key stored in the
+// Certificate's Secret for the next issuance. Only the private key fields of
+// the spec are compared with the existing key, so that changes to other fields
+// such as the usages or the subject alternative names are reissued without
+// rotating the private key.
 func (c *controller) createNextPrivateKeyRotationPolicyNever(ctx context.Context, crt *cmapi.Certificate) error {
 	log := logf.FromContext(ctx)
 	s, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
@@ -266,10 +274,13 @@ func (c *controller) createNextPrivateKeyRotationPolicyNever(ctx context.Context
 }
 
 func (c *controller) createAndSetNextPrivateKey(ctx context.Context, crt *cmapi.Certificate) error {
-	pk, err := pki.GeneratePrivateKeyForCertificate(crt)
+	pk, err := c.generatePrivateKey(ctx, crt)
 	if err != nil {
 		return err
 	}
+	if pk == nil {
+		return nil
+	}
 
 	s, err := c.createNewPrivateKeySecret(ctx, crt, pk)
 	if err != nil {
@@ -281,6 +292,50 @@ func (c *controller) createAndSetNextPrivateKey(ctx context.Context, crt *cmapi.
 	return c.setNextPrivateKeySecretName(ctx, crt, &s.Name)
 }
 
+// generatePrivateKey generates a new private key for the Certificate. If the
+// Certificate's private key is derived from a seed Secret, nil is returned
+// without an error when deterministic private keys are not allowed for the
+// Certificate, after recording an event explaining why.
+func (c *controller) generatePrivateKey(ctx context.Context, crt *cmapi.Certificate) (crypto.Signer, error) {
+	if crt.Spec.PrivateKey == nil || crt.Spec.PrivateKey.SeedSecretRef == nil {
+		return pki.GeneratePrivateKeyForCertificate(crt)
+	}
+	ref := crt.Spec.PrivateKey.SeedSecretRef
+
+	if !utilfeature.DefaultFeatureGate.Enabled(feature.DeterministicPrivateKeys) {
+		c.recorder.Event(crt, corev1.EventTypeWarning, reasonDeterministicPrivateKeyNotAllowed, "Not generating a private key as spec.privateKey.seedSecretRef is set but the DeterministicPrivateKeys feature gate is disabled")
+		return nil, nil
+	}
+
+	ns, err := c.coreClient.CoreV1().Namespaces().Get(ctx, crt.Namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if ns.Labels[cmapi.AllowDeterministicPrivateKeysLabelKey] != "true" {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonDeterministicPrivateKeyNotAllowed, "Not generating a private key as spec.privateKey.seedSecretRef is set but the namespace does not have the %s=true label", cmapi.AllowDeterministicPrivateKeysLabelKey)
+		return nil, nil
+	}
+
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(ref.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get private key seed Secret %q: %w", ref.Name, err)
+	}
+	seed := secret.Data[ref.Key]
+	if len(seed) == 0 {
+		return nil, fmt.Errorf("private key seed Secret %q contains no data for key %q", ref.Name, ref.Key)
+	}
+
+	pk, err := pki.GenerateDeterministicPrivateKeyForCertificate(crt, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	logf.FromContext(ctx).V(logf.WarnLevel).Info("Derived a deterministic private key from a seed Secret. Anyone able to read the seed can recreate this private key, so this must never be used in production", "secret", ref.Name)
+	c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonDeterministicPrivateKey, "Derived private key from seed Secret %q. Anyone able to read the seed can recreate this private key, so this must never be used in production", ref.Name)
+
+	return pk, nil
+}
+
 // deleteSecretResources will delete the given secret resources
 func (c *controller) deleteSecretResources(ctx context.Context, secrets []*corev1.Secret) error {
 	log := logf.FromContext(ctx)
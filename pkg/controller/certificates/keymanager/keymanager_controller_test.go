@@ -28,12 +28,17 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	coretesting "k8s.io/client-go/testing"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
 	"k8s.io/utils/ptr"
 
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
 
 func mustGenerateRSA(t *testing.T, keySize int) []byte {
@@ -93,7 +98,57 @@ func TestProcessItem(t *testing.T) {
 			Data: data,
 		}
 	}
+	issuingCondition := []cmapi.CertificateCondition{
+		{
+			Type:   cmapi.CertificateConditionIssuing,
+			Status: cmmeta.ConditionTrue,
+		},
+	}
+	seededCertificate := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+		Spec: cmapi.CertificateSpec{
+			PrivateKey: &cmapi.CertificatePrivateKey{
+				Algorithm: cmapi.Ed25519KeyAlgorithm,
+				SeedSecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "seed"},
+					Key:                  "seed",
+				},
+			},
+		},
+		Status: cmapi.CertificateStatus{Conditions: issuingCondition},
+	}
+	seedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "seed"},
+		Data:       map[string][]byte{"seed": []byte("0123456789abcdef0123456789abcdef")},
+	}
+	getNamespaceAction := testpkg.NewAction(coretesting.NewRootGetAction(corev1.SchemeGroupVersion.WithResource("namespaces"), "testns"))
+
+	// A Certificate with rotationPolicy Never whose usages have been changed
+	// to add client auth since its Secret was issued.
+	existingPK := mustGenerateRSA(t, 2048)
+	usagesChangedCertificate := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateSecretName("test-secret"),
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateKeyUsages(cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment, cmapi.UsageServerAuth, cmapi.UsageClientAuth),
+		gen.SetCertificateKeyRotationPolicy(cmapi.RotationPolicyNever),
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+	)
+	usagesChangedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test-secret"},
+		Data: map[string][]byte{
+			corev1.TLSPrivateKeyKey: existingPK,
+			corev1.TLSCertKey: testcrypto.MustCreateCert(t, existingPK, gen.CertificateFrom(usagesChangedCertificate,
+				gen.SetCertificateKeyUsages(cmapi.UsageDigitalSignature, cmapi.UsageKeyEncipherment, cmapi.UsageServerAuth),
+			)),
+		},
+	}
+
 	tests := map[string]struct {
+		// deterministicPrivateKeys enables the DeterministicPrivateKeys
+		// feature gate for the test.
+		deterministicPrivateKeys bool
+
 		// key that should be passed to ProcessItem.
 		// if not set, the 'namespace/name' of the 'Certificate' field will be used.
 		// if neither is set, the key will be ""
@@ -195,6 +250,99 @@ func TestProcessItem(t *testing.T) {
 				), relaxedSecretMatcher),
 			},
 		},
+		"reuse the existing private key if the rotation policy is Never and only the usages have changed": {
+			certificate:    usagesChangedCertificate,
+			secrets:        []runtime.Object{usagesChangedSecret},
+			expectedEvents: []string{`Normal Reused Reusing private key stored in existing Secret resource "test-secret"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"),
+					"status",
+					"testns",
+					gen.CertificateFrom(usagesChangedCertificate,
+						gen.SetCertificateNextPrivateKeySecretName("test-notrandom"),
+					),
+				)),
+				// The private key must be copied from the existing Secret
+				// rather than generated, so the data is matched exactly.
+				testpkg.NewAction(coretesting.NewCreateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					"testns",
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:       "testns",
+							GenerateName:    "test-",
+							Labels:          map[string]string{cmapi.IsNextPrivateKeySecretLabelKey: "true", cmapi.PartOfCertManagerControllerLabelKey: "true"},
+							OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(usagesChangedCertificate, certificateGvk)},
+						},
+						Data: map[string][]byte{corev1.TLSPrivateKeyKey: existingPK},
+					},
+				)),
+			},
+		},
+		"do not create a secret if a seed is set but the DeterministicPrivateKeys feature gate is disabled": {
+			certificate: seededCertificate,
+			secrets:     []runtime.Object{seedSecret},
+			expectedEvents: []string{
+				"Warning DeterministicPrivateKeyNotAllowed Not generating a private key as spec.privateKey.seedSecretRef is set but the DeterministicPrivateKeys feature gate is disabled",
+			},
+		},
+		"do not create a secret if a seed is set but the namespace does not allow deterministic private keys": {
+			deterministicPrivateKeys: true,
+			certificate:              seededCertificate,
+			secrets: []runtime.Object{
+				seedSecret,
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "testns"}},
+			},
+			expectedEvents: []string{
+				"Warning DeterministicPrivateKeyNotAllowed Not generating a private key as spec.privateKey.seedSecretRef is set but the namespace does not have the cert-manager.io/allow-deterministic-private-keys=true label",
+			},
+			expectedActions: []testpkg.Action{getNamespaceAction},
+		},
+		"create a secret with a private key derived from the seed if the namespace allows deterministic private keys": {
+			deterministicPrivateKeys: true,
+			certificate:              seededCertificate,
+			secrets: []runtime.Object{
+				seedSecret,
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+					Name:   "testns",
+					Labels: map[string]string{cmapi.AllowDeterministicPrivateKeysLabelKey: "true"},
+				}},
+			},
+			expectedEvents: []string{
+				`Warning DeterministicPrivateKey Derived private key from seed Secret "seed". Anyone able to read the seed can recreate this private key, so this must never be used in production`,
+				`Normal Generated Stored new private key in temporary Secret resource "test-notrandom"`,
+			},
+			expectedActions: []testpkg.Action{
+				getNamespaceAction,
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"),
+					"status",
+					"testns",
+					&cmapi.Certificate{
+						ObjectMeta: seededCertificate.ObjectMeta,
+						Spec:       seededCertificate.Spec,
+						Status: cmapi.CertificateStatus{
+							NextPrivateKeySecretName: ptr.To("test-notrandom"),
+							Conditions:               issuingCondition,
+						},
+					},
+				)),
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					"testns",
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:       "testns",
+							GenerateName:    "test-",
+							Labels:          map[string]string{cmapi.IsNextPrivateKeySecretLabelKey: "true", cmapi.PartOfCertManagerControllerLabelKey: "true"},
+							OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(&cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"}}, certificateGvk)},
+						},
+						Data: map[string][]byte{"tls.key": nil},
+					},
+				), relaxedSecretMatcher),
+			},
+		},
 		"create a secret using the already allocated name if it is set": {
 			certificate: &cmapi.Certificate{
 				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
@@ -470,6 +618,8 @@ func TestProcessItem(t *testing.T) {
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultMutableFeatureGate, feature.DeterministicPrivateKeys, test.deterministicPrivateKeys)
+
 			// Create and initialise a new unit test builder
 			builder := &testpkg.Builder{
 				T:               t,
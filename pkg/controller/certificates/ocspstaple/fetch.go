@@ -0,0 +1,102 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocspstaple
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	// responderTimeout is the maximum time spent waiting for a single OCSP
+	// responder to respond.
+	responderTimeout = 10 * time.Second
+
+	// maxResponseSize is the maximum size of an OCSP response which is read
+	// from a responder.
+	maxResponseSize = 1024 * 1024
+)
+
+// fetchOCSPResponse requests the status of the given leaf certificate from
+// each of the OCSP responders named in it, in order, and returns the DER
+// encoded response and its parsed form from the first responder which returns
+// a valid response signed for the leaf and its issuer.
+func fetchOCSPResponse(ctx context.Context, client *http.Client, leaf, issuer *x509.Certificate) ([]byte, *ocsp.Response, error) {
+	if len(leaf.OCSPServer) == 0 {
+		return nil, nil, errors.New("the certificate does not name an OCSP responder")
+	}
+
+	req, err := ocsp.CreateRequest(leaf, issuer, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create OCSP request: %w", err)
+	}
+
+	var errs []error
+	for _, server := range leaf.OCSPServer {
+		der, resp, err := queryResponder(ctx, client, server, req, leaf, issuer)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", server, err))
+			continue
+		}
+		return der, resp, nil
+	}
+
+	return nil, nil, fmt.Errorf("no OCSP responder returned a valid response: %w", errors.Join(errs...))
+}
+
+// queryResponder POSTs the given OCSP request to a single responder.
+func queryResponder(ctx context.Context, client *http.Client, server string, req []byte, leaf, issuer *x509.Certificate) ([]byte, *ocsp.Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, responderTimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, server, bytes.NewReader(req))
+	if err != nil {
+		return nil, nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/ocsp-request")
+	httpReq.Header.Set("Accept", "application/ocsp-response")
+
+	httpResp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("unexpected status %d", httpResp.StatusCode)
+	}
+
+	der, err := io.ReadAll(io.LimitReader(httpResp.Body, maxResponseSize))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := ocsp.ParseResponseForCert(der, leaf, issuer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid OCSP response: %w", err)
+	}
+
+	return der, resp, nil
+}
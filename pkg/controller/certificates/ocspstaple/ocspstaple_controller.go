@@ -0,0 +1,401 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocspstaple
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/crypto/ocsp"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	applycorev1 "k8s.io/client-go/applyconfigurations/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
+)
+
+const (
+	// ControllerName is the name of the certificate OCSP stapling controller.
+	ControllerName = "certificates-ocsp-staple"
+
+	// fieldManagerSuffix is appended to the controller's field manager to
+	// form the field manager which owns the OCSP response in a Secret. A
+	// separate field manager is used so that the Apply calls made when the
+	// certificate is issued do not remove the OCSP response.
+	fieldManagerSuffix = "-ocsp-staple"
+
+	// defaultRefreshInterval is the interval after which a response without
+	// a nextUpdate time is refreshed if no refresh interval is configured.
+	defaultRefreshInterval = time.Hour
+
+	// minRefreshInterval is the minimum interval after which a response is
+	// refreshed, preventing responders which return stale responses from
+	// being queried continuously.
+	minRefreshInterval = time.Minute
+
+	// reasonFetchFailed is used for the event when the OCSP response could
+	// not be fetched from any of the certificate's responders.
+	reasonFetchFailed = "OCSPFetchFailed"
+
+	// reasonStatusNotGood is used for the event when a responder reports the
+	// certificate as revoked or unknown.
+	reasonStatusNotGood = "OCSPStatusNotGood"
+)
+
+// This controller stores the OCSP response for the certificate in a
+// Certificate's Secret, if the Certificate opts in using the
+// OCSPStapleAnnotation, and refreshes it before it goes stale.
+type controller struct {
+	certificateLister cmlisters.CertificateLister
+	secretLister      internalinformers.SecretLister
+	client            kubernetes.Interface
+	recorder          record.EventRecorder
+	clock             clock.Clock
+	queue             workqueue.TypedRateLimitingInterface[types.NamespacedName]
+
+	// httpClient is used to query OCSP responders.
+	httpClient *http.Client
+
+	// refreshInterval is the configured interval after which a stored
+	// response is refreshed.
+	refreshInterval time.Duration
+
+	// fieldManager is the string which will be used as the Field Manager on
+	// the OCSP response stored in Secrets during Apply API calls.
+	fieldManager string
+}
+
+func NewController(
+	log logr.Logger,
+	ctx *controllerpkg.Context,
+) (*controller, workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewTypedRateLimitingQueueWithConfig(
+		controllerpkg.DefaultCertificateRateLimiter(),
+		workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+			Name: ControllerName,
+		},
+	)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
+	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+
+	if _, err := certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue}); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+	// When a Secret resource changes, enqueue any Certificate resources that
+	// name it as spec.secretName, so that the OCSP response is fetched for a
+	// newly issued certificate.
+	if _, err := secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateSecretName)),
+	}); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		secretsInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		certificateLister: certificateInformer.Lister(),
+		secretLister:      secretsInformer.Lister(),
+		client:            ctx.Client,
+		recorder:          ctx.Recorder,
+		clock:             ctx.Clock,
+		queue:             queue,
+		httpClient:        &http.Client{},
+		refreshInterval:   ctx.CertificateOptions.OCSPStapleRefreshInterval,
+		fieldManager:      ctx.FieldManager + fieldManagerSuffix,
+	}, queue, mustSync, nil
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Certificate to be re-synced is pulled from the workqueue.
+// ProcessItem will fetch, refresh or remove the OCSP response stored in the
+// Certificate's Secret.
+func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name := key.Namespace, key.Name
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("certificate not found for key", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	secret, err := c.secretLister.Secrets(namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("secret not found, waiting for the certificate to be issued", "secret", crt.Spec.SecretName)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	requeueAfter, err := c.sync(ctx, crt, secret)
+	if err != nil {
+		return err
+	}
+	if requeueAfter > 0 {
+		c.queue.AddAfter(key, requeueAfter)
+	}
+
+	return nil
+}
+
+// sync ensures that the Secret contains a current OCSP response for its
+// certificate if the Certificate opts in to OCSP stapling, and that it does
+// not contain one stored by this controller otherwise. It returns the
+// duration after which the Certificate should be processed again, or 0 if it
+// need not be.
+// If no responder returns a valid response, a stored response is kept until
+// it expires and the error is returned so that the fetch is retried with
+// backoff.
+func (c *controller) sync(ctx context.Context, crt *cmapi.Certificate, secret *corev1.Secret) (time.Duration, error) {
+	log := logf.FromContext(ctx)
+	stored := secret.Data[cmapi.OCSPStapleSecretKey]
+
+	if crt.Annotations[cmapi.OCSPStapleAnnotation] != "true" {
+		if len(stored) > 0 && managesOCSPStaple(secret, c.fieldManager) {
+			log.V(logf.InfoLevel).Info("removing OCSP response from Secret as OCSP stapling is not enabled")
+			return 0, c.applyOCSPStaple(ctx, secret, nil)
+		}
+		return 0, nil
+	}
+
+	leaf, issuer, err := leafAndIssuer(secret)
+	if err != nil {
+		// The Secret will be processed again when the certificate is issued.
+		log.V(logf.DebugLevel).Info("cannot staple an OCSP response to the certificate in the Secret", "reason", err.Error())
+		return 0, nil
+	}
+
+	if len(leaf.OCSPServer) == 0 {
+		c.recorder.Event(crt, corev1.EventTypeWarning, reasonFetchFailed, "The certificate does not name an OCSP responder, the OCSP response cannot be stapled")
+		if len(stored) > 0 {
+			return 0, c.applyOCSPStaple(ctx, secret, nil)
+		}
+		return 0, nil
+	}
+
+	now := c.clock.Now()
+
+	// A response for a previously issued certificate, or one which is not
+	// signed by the issuer, fails to parse and is replaced.
+	var current *ocsp.Response
+	if len(stored) > 0 {
+		if resp, err := ocsp.ParseResponseForCert(stored, leaf, issuer); err == nil && resp.Status == ocsp.Good {
+			current = resp
+		}
+	}
+	if current != nil {
+		if refresh := c.refreshTime(current); now.Before(refresh) {
+			return refresh.Sub(now), nil
+		}
+	}
+
+	der, resp, err := fetchOCSPResponse(ctx, c.httpClient, leaf, issuer)
+	if err != nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonFetchFailed, "Failed to fetch OCSP response: %v", err)
+		if len(stored) > 0 && (current == nil || expired(current, now)) {
+			log.V(logf.InfoLevel).Info("removing stale OCSP response from Secret")
+			if err := c.applyOCSPStaple(ctx, secret, nil); err != nil {
+				return 0, err
+			}
+		}
+		return 0, err
+	}
+
+	if resp.Status != ocsp.Good {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonStatusNotGood, "OCSP responder reported the certificate status as %q, the OCSP response will not be stapled", statusString(resp.Status))
+		if len(stored) > 0 {
+			if err := c.applyOCSPStaple(ctx, secret, nil); err != nil {
+				return 0, err
+			}
+		}
+		return c.requeueAfter(resp, now), nil
+	}
+
+	if !bytes.Equal(stored, der) {
+		log.V(logf.DebugLevel).Info("storing OCSP response in Secret", "thisUpdate", resp.ThisUpdate, "nextUpdate", resp.NextUpdate)
+		if err := c.applyOCSPStaple(ctx, secret, der); err != nil {
+			return 0, err
+		}
+	}
+
+	return c.requeueAfter(resp, now), nil
+}
+
+// refreshTime returns the time at which the given response should be
+// refreshed: once half of its validity period has passed, or after the
+// refresh interval if that is sooner.
+func (c *controller) refreshTime(resp *ocsp.Response) time.Time {
+	interval := c.refreshInterval
+	if resp.NextUpdate.IsZero() {
+		// The responder indicates that newer information is always
+		// available.
+		if interval == 0 {
+			interval = defaultRefreshInterval
+		}
+		return resp.ThisUpdate.Add(interval)
+	}
+
+	refresh := resp.ThisUpdate.Add(resp.NextUpdate.Sub(resp.ThisUpdate) / 2)
+	if interval > 0 && resp.ThisUpdate.Add(interval).Before(refresh) {
+		refresh = resp.ThisUpdate.Add(interval)
+	}
+	return refresh
+}
+
+// requeueAfter returns the duration after which a freshly fetched response
+// should be refreshed.
+func (c *controller) requeueAfter(resp *ocsp.Response, now time.Time) time.Duration {
+	return max(c.refreshTime(resp).Sub(now), minRefreshInterval)
+}
+
+// applyOCSPStaple stores the given DER encoded OCSP response in the Secret,
+// or removes the response stored by this controller if der is nil.
+func (c *controller) applyOCSPStaple(ctx context.Context, secret *corev1.Secret, der []byte) error {
+	// The UID acts as a precondition, so that the Secret is not re-created
+	// with only the OCSP response if it has been deleted.
+	applyCnf := applycorev1.Secret(secret.Name, secret.Namespace).WithUID(secret.UID)
+	if der != nil {
+		applyCnf = applyCnf.WithData(map[string][]byte{cmapi.OCSPStapleSecretKey: der})
+	}
+
+	_, err := c.client.CoreV1().Secrets(secret.Namespace).Apply(ctx, applyCnf, metav1.ApplyOptions{FieldManager: c.fieldManager, Force: true})
+	return err
+}
+
+// leafAndIssuer returns the leaf certificate stored in the Secret and the
+// certificate of its issuer, which is taken from the certificate chain or,
+// if the chain only contains the leaf, from the CA certificate.
+func leafAndIssuer(secret *corev1.Secret) (*x509.Certificate, *x509.Certificate, error) {
+	certs, err := pki.DecodeX509CertificateChainBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode certificate: %w", err)
+	}
+	leaf := certs[0]
+
+	var issuer *x509.Certificate
+	if len(certs) > 1 {
+		issuer = certs[1]
+	} else if caPEM := secret.Data[cmmeta.TLSCAKey]; len(caPEM) > 0 {
+		issuer, err = pki.DecodeX509CertificateBytes(caPEM)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode CA certificate: %w", err)
+		}
+	}
+	if issuer == nil || leaf.CheckSignatureFrom(issuer) != nil {
+		return nil, nil, errors.New("the issuing CA certificate is not stored in the Secret")
+	}
+
+	return leaf, issuer, nil
+}
+
+// managesOCSPStaple returns true if the OCSP response stored in the Secret
+// is owned by the given field manager.
+func managesOCSPStaple(secret *corev1.Secret, fieldManager string) bool {
+	path := fieldpath.MakePathOrDie("data", cmapi.OCSPStapleSecretKey)
+	for _, managedField := range secret.ManagedFields {
+		if managedField.Manager != fieldManager || managedField.FieldsV1 == nil {
+			continue
+		}
+
+		var fieldset fieldpath.Set
+		if err := fieldset.FromJSON(bytes.NewReader(managedField.FieldsV1.Raw)); err != nil {
+			continue
+		}
+		if fieldset.Has(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// expired returns true if the given response is no longer valid.
+func expired(resp *ocsp.Response, now time.Time) bool {
+	return !resp.NextUpdate.IsZero() && !now.Before(resp.NextUpdate)
+}
+
+func statusString(status int) string {
+	switch status {
+	case ocsp.Good:
+		return "good"
+	case ocsp.Revoked:
+		return "revoked"
+	default:
+		return "unknown"
+	}
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync, err := NewController(log, ctx)
+	c.controller = ctrl
+
+	return queue, mustSync, err
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
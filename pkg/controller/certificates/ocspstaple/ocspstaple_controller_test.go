@@ -0,0 +1,312 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocspstaple
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	coretesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+const testFieldManager = "cert-manager" + fieldManagerSuffix
+
+func mustSignCertificate(t *testing.T, template, parent *x509.Certificate, parentKey crypto.Signer) (crypto.Signer, *x509.Certificate, []byte) {
+	key, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	require.NoError(t, err)
+
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	certPEM, cert, err := pki.SignCertificate(template, parent, key.Public(), parentKey)
+	require.NoError(t, err)
+
+	return key, cert, certPEM
+}
+
+func TestSync(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	caKey, caCert, caPEM := mustSignCertificate(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+
+	// status and fail configure the OCSP responder.
+	var (
+		status   int
+		fail     bool
+		requests int
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if fail {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		req, err := ocsp.ParseRequest(body)
+		require.NoError(t, err)
+
+		resp, err := ocsp.CreateResponse(caCert, caCert, ocsp.Response{
+			Status:       status,
+			SerialNumber: req.SerialNumber,
+			ThisUpdate:   now,
+			NextUpdate:   now.Add(24 * time.Hour),
+			RevokedAt:    now,
+		}, caKey)
+		require.NoError(t, err)
+		_, _ = w.Write(resp)
+	}))
+	defer server.Close()
+
+	leafTemplate := func(serial int64) *x509.Certificate {
+		return &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: "leaf"},
+			NotBefore:    now.Add(-time.Hour),
+			NotAfter:     now.Add(12 * time.Hour),
+			OCSPServer:   []string{server.URL},
+		}
+	}
+	_, leaf, leafPEM := mustSignCertificate(t, leafTemplate(2), caCert, caKey)
+	_, otherLeaf, _ := mustSignCertificate(t, leafTemplate(3), caCert, caKey)
+	noResponderTemplate := leafTemplate(4)
+	noResponderTemplate.OCSPServer = nil
+	_, _, noResponderPEM := mustSignCertificate(t, noResponderTemplate, caCert, caKey)
+
+	response := func(cert *x509.Certificate, thisUpdate, nextUpdate time.Time) []byte {
+		resp, err := ocsp.CreateResponse(caCert, caCert, ocsp.Response{
+			Status:       ocsp.Good,
+			SerialNumber: cert.SerialNumber,
+			ThisUpdate:   thisUpdate,
+			NextUpdate:   nextUpdate,
+		}, caKey)
+		require.NoError(t, err)
+		return resp
+	}
+	freshResponse := response(leaf, now.Add(-time.Hour), now.Add(23*time.Hour))
+	dueResponse := response(leaf, now.Add(-20*time.Hour), now.Add(4*time.Hour))
+	expiredResponse := response(leaf, now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+
+	secret := func(certPEM, staple []byte, managedFields ...metav1.ManagedFieldsEntry) *corev1.Secret {
+		s := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gen.DefaultTestNamespace, Name: "output", ManagedFields: managedFields},
+			Data: map[string][]byte{
+				corev1.TLSCertKey: certPEM,
+				cmmeta.TLSCAKey:   caPEM,
+			},
+		}
+		if staple != nil {
+			s.Data[cmapi.OCSPStapleSecretKey] = staple
+		}
+		return s
+	}
+	ownedStaple := metav1.ManagedFieldsEntry{
+		Manager:    testFieldManager,
+		Operation:  metav1.ManagedFieldsOperationApply,
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:tls.ocsp":{}}}`)},
+	}
+
+	optedOut := gen.Certificate("test",
+		gen.SetCertificateNamespace(gen.DefaultTestNamespace),
+		gen.SetCertificateSecretName("output"),
+	)
+	crt := gen.CertificateFrom(optedOut, gen.AddCertificateAnnotations(map[string]string{cmapi.OCSPStapleAnnotation: "true"}))
+
+	tests := map[string]struct {
+		crt             *cmapi.Certificate
+		secret          *corev1.Secret
+		refreshInterval time.Duration
+		status          int
+		fail            bool
+
+		// expApply is true if the staple is expected to be applied, and
+		// expStaple is true if a fetched response is expected to be stored.
+		expApply    bool
+		expStaple   bool
+		expRequests int
+		expRequeue  time.Duration
+		expErr      bool
+		expEvent    string
+	}{
+		"a Certificate which has not opted in is ignored": {
+			crt:    optedOut,
+			secret: secret(leafPEM, nil),
+		},
+		"a response stored by the controller is removed when the Certificate opts out": {
+			crt:      optedOut,
+			secret:   secret(leafPEM, freshResponse, ownedStaple),
+			expApply: true,
+		},
+		"a response stored by another field manager is not removed when the Certificate opts out": {
+			crt:    optedOut,
+			secret: secret(leafPEM, freshResponse),
+		},
+		"a certificate without an OCSP responder is not stapled": {
+			crt:      crt,
+			secret:   secret(noResponderPEM, nil),
+			expEvent: "Warning OCSPFetchFailed The certificate does not name an OCSP responder, the OCSP response cannot be stapled",
+		},
+		"the response is fetched and refreshed after half of its validity period": {
+			crt:         crt,
+			secret:      secret(leafPEM, nil),
+			status:      ocsp.Good,
+			expApply:    true,
+			expStaple:   true,
+			expRequests: 1,
+			expRequeue:  12 * time.Hour,
+		},
+		"the response is refreshed after the refresh interval if that is sooner": {
+			crt:             crt,
+			secret:          secret(leafPEM, nil),
+			refreshInterval: 6 * time.Hour,
+			status:          ocsp.Good,
+			expApply:        true,
+			expStaple:       true,
+			expRequests:     1,
+			expRequeue:      6 * time.Hour,
+		},
+		"a fresh response is not refetched": {
+			crt:        crt,
+			secret:     secret(leafPEM, freshResponse),
+			expRequeue: 11 * time.Hour,
+		},
+		"a response for a previously issued certificate is replaced": {
+			crt:         crt,
+			secret:      secret(leafPEM, response(otherLeaf, now.Add(-time.Hour), now.Add(23*time.Hour))),
+			status:      ocsp.Good,
+			expApply:    true,
+			expStaple:   true,
+			expRequests: 1,
+			expRequeue:  12 * time.Hour,
+		},
+		"a response which has not expired is kept if the responder is unreachable": {
+			crt:         crt,
+			secret:      secret(leafPEM, dueResponse),
+			fail:        true,
+			expRequests: 1,
+			expErr:      true,
+			expEvent:    "Warning OCSPFetchFailed Failed to fetch OCSP response: no OCSP responder returned a valid response: " + server.URL + ": unexpected status 503",
+		},
+		"an expired response is removed if the responder is unreachable": {
+			crt:         crt,
+			secret:      secret(leafPEM, expiredResponse),
+			fail:        true,
+			expApply:    true,
+			expRequests: 1,
+			expErr:      true,
+			expEvent:    "Warning OCSPFetchFailed Failed to fetch OCSP response: no OCSP responder returned a valid response: " + server.URL + ": unexpected status 503",
+		},
+		"a revoked certificate is not stapled": {
+			crt:         crt,
+			secret:      secret(leafPEM, dueResponse),
+			status:      ocsp.Revoked,
+			expApply:    true,
+			expRequests: 1,
+			expRequeue:  12 * time.Hour,
+			expEvent:    `Warning OCSPStatusNotGood OCSP responder reported the certificate status as "revoked", the OCSP response will not be stapled`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			status, fail, requests = test.status, test.fail, 0
+
+			var (
+				applied bool
+				staple  []byte
+			)
+			client := fake.NewSimpleClientset()
+			client.PrependReactor("patch", "secrets", func(action coretesting.Action) (bool, runtime.Object, error) {
+				patch := action.(coretesting.PatchAction)
+				assert.Equal(t, test.secret.Name, patch.GetName())
+
+				var secret corev1.Secret
+				require.NoError(t, json.Unmarshal(patch.GetPatch(), &secret))
+				assert.Equal(t, test.secret.UID, secret.UID)
+				applied, staple = true, secret.Data[cmapi.OCSPStapleSecretKey]
+				return true, &secret, nil
+			})
+			recorder := record.NewFakeRecorder(1)
+
+			c := &controller{
+				client:          client,
+				recorder:        recorder,
+				clock:           fakeclock.NewFakeClock(now),
+				httpClient:      server.Client(),
+				refreshInterval: test.refreshInterval,
+				fieldManager:    testFieldManager,
+			}
+
+			requeue, err := c.sync(context.Background(), test.crt, test.secret)
+			if test.expErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expRequeue, requeue)
+			assert.Equal(t, test.expRequests, requests)
+			assert.Equal(t, test.expApply, applied)
+			if test.expStaple {
+				resp, err := ocsp.ParseResponseForCert(staple, leaf, caCert)
+				require.NoError(t, err)
+				assert.Equal(t, ocsp.Good, resp.Status)
+				assert.Equal(t, now, resp.ThisUpdate)
+			} else {
+				assert.Empty(t, staple)
+			}
+
+			select {
+			case event := <-recorder.Events:
+				assert.Equal(t, test.expEvent, event)
+			default:
+				assert.Empty(t, test.expEvent, "expected an event")
+			}
+		})
+	}
+}
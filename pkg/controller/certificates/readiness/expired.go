@@ -0,0 +1,53 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package readiness
+
+import (
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+// updateExpiredCondition sets the Expired condition on the given Certificate
+// if the given certificate stored in its Secret has expired, or is a fallback
+// certificate which replaced an expired certificate, and removes it
+// otherwise. It returns the duration after which the certificate expires, or
+// zero if the condition is set.
+func (c *controller) updateExpiredCondition(crt *cmapi.Certificate, secret *corev1.Secret, x509cert *x509.Certificate) time.Duration {
+	if secret.Annotations[cmapi.FallbackCertificateAnnotationKey] == "true" {
+		apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionExpired, cmmeta.ConditionTrue, policies.FallbackCertificate,
+			"Certificate expired and failed to be renewed, the Secret contains a temporary self-signed fallback certificate")
+		return 0
+	}
+
+	expiresIn := x509cert.NotAfter.Sub(c.clock.Now())
+	if expiresIn > 0 {
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionExpired)
+		return expiresIn
+	}
+
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionExpired, cmmeta.ConditionTrue, policies.Expired,
+		fmt.Sprintf("Certificate expired on %s", x509cert.NotAfter.Format(time.RFC1123)))
+	return 0
+}
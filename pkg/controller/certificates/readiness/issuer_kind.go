@@ -0,0 +1,145 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package readiness
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// UnknownIssuerKindReason is the 'UnknownIssuerKind' reason of a Certificate
+// whose issuerRef refers to a kind which is not known.
+const UnknownIssuerKindReason = "NotRegistered"
+
+// issuerKindChecker determines whether the kind referenced by an issuerRef is
+// known. The kinds of cert-manager's own issuers are always known, and the
+// kinds of external issuers are looked up using discovery.
+type issuerKindChecker struct {
+	discovery discovery.DiscoveryInterface
+
+	// known caches the external kinds which have been found using discovery.
+	// Kinds which have not been found are not cached, so that an external
+	// issuer which is installed later is picked up.
+	lock  sync.RWMutex
+	known map[schema.GroupKind]bool
+}
+
+func newIssuerKindChecker(discovery discovery.DiscoveryInterface) *issuerKindChecker {
+	return &issuerKindChecker{
+		discovery: discovery,
+		known:     make(map[schema.GroupKind]bool),
+	}
+}
+
+// isKnown returns true if the given issuerRef refers to a known kind. An error
+// is returned if discovery fails.
+func (k *issuerKindChecker) isKnown(ref cmmeta.ObjectReference) (bool, error) {
+	if ref.Group == "" || ref.Group == certmanager.GroupName {
+		switch ref.Kind {
+		case "", cmapi.IssuerKind, cmapi.ClusterIssuerKind:
+			return true, nil
+		default:
+			return false, nil
+		}
+	}
+
+	groupKind := schema.GroupKind{Group: ref.Group, Kind: ref.Kind}
+
+	k.lock.RLock()
+	known := k.known[groupKind]
+	k.lock.RUnlock()
+	if known {
+		return true, nil
+	}
+
+	if k.discovery == nil {
+		return false, fmt.Errorf("discovery client not set")
+	}
+
+	groups, err := k.discovery.ServerGroups()
+	if err != nil {
+		return false, err
+	}
+	if groups == nil {
+		return false, nil
+	}
+
+	for _, apiGroup := range groups.Groups {
+		if apiGroup.Name != groupKind.Group {
+			continue
+		}
+
+		for _, version := range apiGroup.Versions {
+			apiResources, err := k.discovery.ServerResourcesForGroupVersion(version.GroupVersion)
+			if err != nil {
+				return false, err
+			}
+
+			for _, resource := range apiResources.APIResources {
+				if resource.Kind != groupKind.Kind {
+					continue
+				}
+
+				k.lock.Lock()
+				k.known[groupKind] = true
+				k.lock.Unlock()
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// updateUnknownIssuerKindCondition sets the UnknownIssuerKind condition on the
+// given Certificate if its issuerRef refers to a kind which is not known, and
+// removes it otherwise. It returns false if the kind is not known.
+// The condition is left unchanged if the kind cannot be looked up.
+func (c *controller) updateUnknownIssuerKindCondition(log logr.Logger, crt *cmapi.Certificate) bool {
+	ref := crt.Spec.IssuerRef
+
+	known, err := c.issuerKinds.isKnown(ref)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("cannot determine whether the issuer kind is known", "error", err.Error())
+		return apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionUnknownIssuerKind) == nil
+	}
+
+	if known {
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionUnknownIssuerKind)
+		return true
+	}
+
+	var message string
+	if ref.Group == "" || ref.Group == certmanager.GroupName {
+		message = fmt.Sprintf("Referenced issuer kind %q is not known: must be empty, %q or %q", ref.Kind, cmapi.IssuerKind, cmapi.ClusterIssuerKind)
+	} else {
+		message = fmt.Sprintf("Referenced issuer kind %q is not registered in the API group %q: check spec.issuerRef or install the external issuer", ref.Kind, ref.Group)
+	}
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionUnknownIssuerKind, cmmeta.ConditionTrue, UnknownIssuerKindReason, message)
+
+	return false
+}
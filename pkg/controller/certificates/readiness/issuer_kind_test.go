@@ -0,0 +1,136 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package readiness
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	discoveryfake "github.com/cert-manager/cert-manager/test/unit/discovery"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestUpdateUnknownIssuerKindCondition(t *testing.T) {
+	unknownIssuerKind := cmapi.CertificateCondition{Type: cmapi.CertificateConditionUnknownIssuerKind, Status: cmmeta.ConditionTrue}
+
+	discoveryClient := func(groupsErr error) *discoveryfake.Discovery {
+		return discoveryfake.NewDiscovery().
+			WithServerGroups(func() (*metav1.APIGroupList, error) {
+				return &metav1.APIGroupList{
+					Groups: []metav1.APIGroup{
+						{
+							Name: "example.com",
+							Versions: []metav1.GroupVersionForDiscovery{
+								{GroupVersion: "example.com/v1"},
+							},
+						},
+					},
+				}, groupsErr
+			}).
+			WithServerResourcesForGroupVersion(func(groupVersion string) (*metav1.APIResourceList, error) {
+				return &metav1.APIResourceList{
+					GroupVersion: groupVersion,
+					APIResources: []metav1.APIResource{
+						{Name: "myissuers", Kind: "MyIssuer", Namespaced: true},
+					},
+				}, nil
+			})
+	}
+
+	certificate := func(ref cmmeta.ObjectReference, mods ...gen.CertificateModifier) *cmapi.Certificate {
+		return gen.Certificate("test", append([]gen.CertificateModifier{
+			gen.SetCertificateNamespace("testns"),
+			gen.SetCertificateIssuer(ref),
+		}, mods...)...)
+	}
+
+	tests := map[string]struct {
+		crt          *cmapi.Certificate
+		groupsErr    error
+		expKnown     bool
+		expCondition bool
+	}{
+		"an empty kind is known": {
+			crt:      certificate(cmmeta.ObjectReference{Name: "test-issuer"}),
+			expKnown: true,
+		},
+		"an Issuer is known": {
+			crt:      certificate(cmmeta.ObjectReference{Name: "test-issuer", Kind: "Issuer", Group: "cert-manager.io"}),
+			expKnown: true,
+		},
+		"a ClusterIssuer is known": {
+			crt:      certificate(cmmeta.ObjectReference{Name: "test-issuer", Kind: "ClusterIssuer"}),
+			expKnown: true,
+		},
+		"a bogus cert-manager kind is unknown": {
+			crt:          certificate(cmmeta.ObjectReference{Name: "test-issuer", Kind: "Isuer"}),
+			expKnown:     false,
+			expCondition: true,
+		},
+		"a registered external kind is known": {
+			crt:      certificate(cmmeta.ObjectReference{Name: "test-issuer", Kind: "MyIssuer", Group: "example.com"}),
+			expKnown: true,
+		},
+		"a bogus external kind is unknown": {
+			crt:          certificate(cmmeta.ObjectReference{Name: "test-issuer", Kind: "MyIsuer", Group: "example.com"}),
+			expKnown:     false,
+			expCondition: true,
+		},
+		"an external kind in an unregistered group is unknown": {
+			crt:          certificate(cmmeta.ObjectReference{Name: "test-issuer", Kind: "MyIssuer", Group: "example.org"}),
+			expKnown:     false,
+			expCondition: true,
+		},
+		"the condition is removed once the kind is known": {
+			crt:      certificate(cmmeta.ObjectReference{Name: "test-issuer", Kind: "Issuer"}, gen.SetCertificateStatusCondition(unknownIssuerKind)),
+			expKnown: true,
+		},
+		"the condition is unchanged if discovery fails": {
+			crt:          certificate(cmmeta.ObjectReference{Name: "test-issuer", Kind: "MyIsuer", Group: "example.com"}, gen.SetCertificateStatusCondition(unknownIssuerKind)),
+			groupsErr:    errors.New("discovery failed"),
+			expKnown:     false,
+			expCondition: true,
+		},
+		"the kind is assumed to be known if discovery fails": {
+			crt:       certificate(cmmeta.ObjectReference{Name: "test-issuer", Kind: "MyIssuer", Group: "example.com"}),
+			groupsErr: errors.New("discovery failed"),
+			expKnown:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := &controller{
+				issuerKinds: newIssuerKindChecker(discoveryClient(test.groupsErr)),
+			}
+
+			crt := test.crt.DeepCopy()
+			if known := c.updateUnknownIssuerKindCondition(logr.Discard(), crt); known != test.expKnown {
+				t.Errorf("unexpected result, exp=%t got=%t", test.expKnown, known)
+			}
+			if got := apiutil.CertificateHasCondition(crt, unknownIssuerKind); got != test.expCondition {
+				t.Errorf("unexpected UnknownIssuerKind condition, exp=%t got=%t", test.expCondition, got)
+			}
+		})
+	}
+}
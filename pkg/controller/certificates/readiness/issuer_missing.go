@@ -0,0 +1,123 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package readiness
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// IssuerMissingReason is the 'IssuerMissing' reason of a Certificate
+	// whose issuer has not been found.
+	IssuerMissingReason = "NotFound"
+
+	// issuerMissingStablePeriod is how long the issuer referenced by a
+	// Certificate must be continuously absent before the IssuerMissing
+	// condition is set. This prevents an issuer which has just been created,
+	// or which is not yet in the informer cache, from being reported as
+	// missing.
+	issuerMissingStablePeriod = 5 * time.Minute
+)
+
+// issuerMissingTracker records when the issuer referenced by each Certificate
+// was first observed to be missing. The records are only kept in memory, so
+// the stable period restarts whenever the controller restarts.
+type issuerMissingTracker struct {
+	lock  sync.Mutex
+	since map[types.NamespacedName]time.Time
+}
+
+func newIssuerMissingTracker() *issuerMissingTracker {
+	return &issuerMissingTracker{since: make(map[types.NamespacedName]time.Time)}
+}
+
+// missingFor records that the issuer of the given Certificate is missing at
+// now, and returns for how long it has been missing.
+func (t *issuerMissingTracker) missingFor(key types.NamespacedName, now time.Time) time.Duration {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	since, ok := t.since[key]
+	if !ok {
+		t.since[key] = now
+		return 0
+	}
+	return now.Sub(since)
+}
+
+// forget removes the record of the given Certificate.
+func (t *issuerMissingTracker) forget(key types.NamespacedName) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.since, key)
+}
+
+// updateIssuerMissingCondition sets the IssuerMissing condition on the given
+// Certificate once its issuer has been missing for the stable period, and
+// removes it once the issuer exists. It returns the duration after which the
+// Certificate should be processed again, or 0 if it need not be.
+// The condition is left unchanged if the existence of the issuer cannot be
+// determined, for example for external issuers.
+func (c *controller) updateIssuerMissingCondition(log logr.Logger, crt *cmapi.Certificate) time.Duration {
+	key := types.NamespacedName{Namespace: crt.Namespace, Name: crt.Name}
+
+	ref := crt.Spec.IssuerRef
+	if ref.Name == "" || (ref.Group != "" && ref.Group != certmanager.GroupName) {
+		c.issuerMissing.forget(key)
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionIssuerMissing)
+		return 0
+	}
+
+	_, err := c.issuerHelper.GetGenericIssuer(ref, crt.Namespace)
+	switch {
+	case err == nil:
+		c.issuerMissing.forget(key)
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionIssuerMissing)
+		return 0
+
+	case !apierrors.IsNotFound(err):
+		log.V(logf.DebugLevel).Info("cannot determine whether the issuer exists", "error", err.Error())
+		return 0
+	}
+
+	missingFor := c.issuerMissing.missingFor(key, c.clock.Now())
+	if missingFor < issuerMissingStablePeriod {
+		return issuerMissingStablePeriod - missingFor
+	}
+
+	kind := ref.Kind
+	if kind == "" {
+		kind = cmapi.IssuerKind
+	}
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuerMissing, cmmeta.ConditionTrue, IssuerMissingReason,
+		fmt.Sprintf("Referenced %s %q has not been found for at least %s; the Certificate will not be renewed", kind, ref.Name, issuerMissingStablePeriod))
+
+	return 0
+}
@@ -0,0 +1,134 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package readiness
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	issuerfake "github.com/cert-manager/cert-manager/pkg/issuer/fake"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestUpdateIssuerMissingCondition(t *testing.T) {
+	notFound := apierrors.NewNotFound(cmapi.Resource("issuers"), "test-issuer")
+	issuerMissing := cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuerMissing, Status: cmmeta.ConditionTrue}
+
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "test-issuer"}),
+	)
+
+	// step is a single observation of the Certificate's issuer, made after
+	// advancing the clock by the given duration.
+	type step struct {
+		advance      time.Duration
+		issuerErr    error
+		expRequeue   time.Duration
+		expCondition bool
+	}
+
+	tests := map[string]struct {
+		crt   *cmapi.Certificate
+		steps []step
+	}{
+		"an issuer which exists is not missing": {
+			crt:   crt,
+			steps: []step{{issuerErr: nil}},
+		},
+		"a missing issuer is only reported after the stable period": {
+			crt: crt,
+			steps: []step{
+				{issuerErr: notFound, expRequeue: issuerMissingStablePeriod},
+				{advance: time.Minute, issuerErr: notFound, expRequeue: issuerMissingStablePeriod - time.Minute},
+				{advance: issuerMissingStablePeriod - time.Minute, issuerErr: notFound, expCondition: true},
+			},
+		},
+		"an issuer which appears during the stable period is not reported": {
+			crt: crt,
+			steps: []step{
+				{issuerErr: notFound, expRequeue: issuerMissingStablePeriod},
+				{advance: time.Minute, issuerErr: nil},
+				{advance: issuerMissingStablePeriod, issuerErr: notFound, expRequeue: issuerMissingStablePeriod},
+			},
+		},
+		"the condition is removed once the issuer exists": {
+			crt:   gen.CertificateFrom(crt, gen.SetCertificateStatusCondition(issuerMissing)),
+			steps: []step{{issuerErr: nil}},
+		},
+		"the condition is kept while the stable period restarts": {
+			crt: gen.CertificateFrom(crt, gen.SetCertificateStatusCondition(issuerMissing)),
+			steps: []step{
+				{issuerErr: notFound, expRequeue: issuerMissingStablePeriod, expCondition: true},
+			},
+		},
+		"the condition is unchanged if the issuer cannot be read": {
+			crt: gen.CertificateFrom(crt, gen.SetCertificateStatusCondition(issuerMissing)),
+			steps: []step{
+				{issuerErr: errors.New("ambiguous alias"), expCondition: true},
+			},
+		},
+		"external issuers are never reported as missing": {
+			crt: gen.CertificateFrom(crt,
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "test-issuer", Kind: "Issuer", Group: "example.com"}),
+				gen.SetCertificateStatusCondition(issuerMissing),
+			),
+			steps: []step{{issuerErr: notFound}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var issuerErr error
+			clock := fakeclock.NewFakeClock(time.Now())
+			c := &controller{
+				issuerHelper: &issuerfake.Helper{
+					GetGenericIssuerFunc: func(ref cmmeta.ObjectReference, ns string) (cmapi.GenericIssuer, error) {
+						if issuerErr != nil {
+							return nil, issuerErr
+						}
+						return gen.Issuer(ref.Name, gen.SetIssuerNamespace(ns)), nil
+					},
+				},
+				issuerMissing: newIssuerMissingTracker(),
+				clock:         clock,
+			}
+
+			crt := test.crt.DeepCopy()
+			for i, step := range test.steps {
+				clock.Step(step.advance)
+				issuerErr = step.issuerErr
+
+				requeue := c.updateIssuerMissingCondition(logr.Discard(), crt)
+				if requeue != step.expRequeue {
+					t.Errorf("step %d: unexpected requeue duration, exp=%s got=%s", i, step.expRequeue, requeue)
+				}
+				if got := apiutil.CertificateHasCondition(crt, issuerMissing); got != step.expCondition {
+					t.Errorf("step %d: unexpected IssuerMissing condition, exp=%t got=%t", i, step.expCondition, got)
+				}
+			}
+		})
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
@@ -41,6 +42,7 @@ import (
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -71,6 +73,18 @@ type controller struct {
 	// fields created or edited by the cert-manager Kubernetes client during
 	// Apply API calls.
 	fieldManager string
+
+	// issuerHelper and issuerMissing are used to set the IssuerMissing
+	// condition of Certificates whose issuer has not been found for a
+	// sustained period.
+	issuerHelper  issuer.Helper
+	issuerMissing *issuerMissingTracker
+	clock         clock.Clock
+	queue         workqueue.TypedRateLimitingInterface[types.NamespacedName]
+
+	// issuerKinds is used to set the UnknownIssuerKind condition of
+	// Certificates whose issuerRef refers to a kind which is not known.
+	issuerKinds *issuerKindChecker
 }
 
 // readyConditionFunc is custom function type that builds certificate's Ready condition
@@ -96,6 +110,7 @@ func NewController(
 	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
 	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
 
 	if _, err := certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue}); err != nil {
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
@@ -116,12 +131,37 @@ func NewController(
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
 	}
 
+	// When an Issuer resource changes, enqueue any Certificate resources that
+	// reference it so that their IssuerMissing condition is updated.
+	if _, err := issuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractIssuerReference(cmapi.IssuerKind)),
+	}); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
 	mustSync := []cache.InformerSynced{
 		certificateRequestInformer.Informer().HasSynced,
 		secretsInformer.Informer().HasSynced,
 		certificateInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+	}
+
+	// If we are running in non-namespaced mode, we also
+	// register event handlers and obtain a lister for ClusterIssuers.
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		if _, err := clusterIssuerInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+			WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+				predicate.ExtractIssuerReference(cmapi.ClusterIssuerKind)),
+		}); err != nil {
+			return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+		}
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+		clusterIssuerLister = clusterIssuerInformer.Lister()
 	}
 
 	return &controller{
@@ -137,12 +177,18 @@ func NewController(
 		policyEvaluator:       policyEvaluator,
 		renewalTimeCalculator: renewalTimeCalculator,
 		fieldManager:          ctx.FieldManager,
+		issuerHelper:          issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister),
+		issuerMissing:         newIssuerMissingTracker(),
+		clock:                 ctx.Clock,
+		issuerKinds:           newIssuerKindChecker(ctx.DiscoveryClient),
+		queue:                 queue,
 	}, queue, mustSync, nil
 }
 
 // ProcessItem is a worker function that will be called when a new key
 // corresponding to a Certificate to be re-synced is pulled from the workqueue.
-// ProcessItem will update the Ready condition of a Certificate.
+// ProcessItem will update the Ready, IssuerMissing, UnknownIssuerKind and
+// Expired conditions of a Certificate.
 func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName) error {
 	log := logf.FromContext(ctx).WithValues("key", key)
 
@@ -152,6 +198,7 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	crt, err := c.certificateLister.Certificates(namespace).Get(name)
 	if apierrors.IsNotFound(err) {
 		log.V(logf.DebugLevel).Info("certificate not found for key", "error", err.Error())
+		c.issuerMissing.forget(key)
 		return nil
 	}
 	if err != nil {
@@ -168,6 +215,17 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	crt = crt.DeepCopy()
 	apiutil.SetCertificateCondition(crt, crt.Generation, condition.Type, condition.Status, condition.Reason, condition.Message)
 
+	// An issuer of an unknown kind can never be found, so it is not also
+	// reported as missing.
+	if c.updateUnknownIssuerKindCondition(log, crt) {
+		if requeueAfter := c.updateIssuerMissingCondition(log, crt); requeueAfter > 0 {
+			c.queue.AddAfter(key, requeueAfter)
+		}
+	} else {
+		c.issuerMissing.forget(key)
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionIssuerMissing)
+	}
+
 	switch {
 	case input.Secret != nil && input.Secret.Data != nil:
 		x509cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
@@ -176,9 +234,17 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 			crt.Status.NotAfter = nil
 			crt.Status.NotBefore = nil
 			crt.Status.RenewalTime = nil
+			crt.Status.SPKIPinSHA256 = ""
+			apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionExpired)
 			break
 		}
 
+		// Resync once the certificate expires, so that the Expired condition
+		// is set.
+		if expiresIn := c.updateExpiredCondition(crt, input.Secret, x509cert); expiresIn > 0 {
+			c.queue.AddAfter(key, expiresIn)
+		}
+
 		notBefore := metav1.NewTime(x509cert.NotBefore)
 		notAfter := metav1.NewTime(x509cert.NotAfter)
 		renewalTime := c.renewalTimeCalculator(x509cert.NotBefore, x509cert.NotAfter, crt.Spec.RenewBefore, crt.Spec.RenewBeforePercentage)
@@ -187,17 +253,20 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		crt.Status.NotBefore = &notBefore
 		crt.Status.NotAfter = &notAfter
 		crt.Status.RenewalTime = renewalTime
+		crt.Status.SPKIPinSHA256 = pki.SPKIPinSHA256(x509cert)
 
 	default:
 		// clear status fields if the secret does not have any data
 		crt.Status.NotAfter = nil
 		crt.Status.NotBefore = nil
 		crt.Status.RenewalTime = nil
+		crt.Status.SPKIPinSHA256 = ""
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionExpired)
 	}
 	if !apiequality.Semantic.DeepEqual(oldCrt.Status, crt.Status) {
 		log.V(logf.DebugLevel).Info("updating status fields", "notAfter",
 			crt.Status.NotAfter, "notBefore", crt.Status.NotBefore, "renewalTime",
-			crt.Status.RenewalTime)
+			crt.Status.RenewalTime, "spkiPinSHA256", crt.Status.SPKIPinSHA256)
 		return c.updateOrApplyStatus(ctx, crt)
 	}
 	return nil
@@ -209,16 +278,19 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certificate) error {
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
 		var conditions []cmapi.CertificateCondition
-		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionReady); cond != nil {
-			conditions = []cmapi.CertificateCondition{*cond}
+		for _, conditionType := range []cmapi.CertificateConditionType{cmapi.CertificateConditionReady, cmapi.CertificateConditionIssuerMissing, cmapi.CertificateConditionUnknownIssuerKind, cmapi.CertificateConditionExpired} {
+			if cond := apiutil.GetCertificateCondition(crt, conditionType); cond != nil {
+				conditions = append(conditions, *cond)
+			}
 		}
 		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
 			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
 			Status: cmapi.CertificateStatus{
-				NotAfter:    crt.Status.NotAfter,
-				NotBefore:   crt.Status.NotBefore,
-				RenewalTime: crt.Status.RenewalTime,
-				Conditions:  conditions,
+				NotAfter:      crt.Status.NotAfter,
+				NotBefore:     crt.Status.NotBefore,
+				RenewalTime:   crt.Status.RenewalTime,
+				SPKIPinSHA256: crt.Status.SPKIPinSHA256,
+				Conditions:    conditions,
 			},
 		})
 	} else {
@@ -18,6 +18,8 @@ package readiness
 
 import (
 	"context"
+	"crypto/x509"
+	"fmt"
 	"testing"
 	"time"
 
@@ -50,6 +52,14 @@ func renewalTimeBuilder(rt *metav1.Time) pki.RenewalTimeFunc {
 	}
 }
 
+func mustDecodeCertificate(t *testing.T, certBytes []byte) *x509.Certificate {
+	cert, err := pki.DecodeX509CertificateBytes(certBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
 func TestProcessItem(t *testing.T) {
 	// now time is the current UTC time at the start of the test
 	now := time.Now().UTC()
@@ -101,6 +111,13 @@ func TestProcessItem(t *testing.T) {
 		// renewalTime will be the updated Certificate's status.renewalTime
 		renewalTime *metav1.Time
 
+		// secretAnnotations are the annotations of the secret, if it exists
+		secretAnnotations map[string]string
+
+		// Certificate's Expired condition expected to be applied with the
+		// update, if any
+		expiredCondition *cmapi.CertificateCondition
+
 		wantsErr bool
 	}{
 		"do nothing if an empty 'key' is used": {},
@@ -146,6 +163,51 @@ func TestProcessItem(t *testing.T) {
 			notBefore:         func(m metav1.Time) *metav1.Time { return &m }(metav1.NewTime(now.Truncate(time.Second))),
 			renewalTime:       func(m metav1.Time) *metav1.Time { return &m }(metav1.NewTime(now.Add(time.Hour))),
 		},
+		"update status for a Certificate whose spec.secretName secret contains an expired X509 cert": {
+			condition: cmapi.CertificateCondition{
+				Type:               cmapi.CertificateConditionReady,
+				Status:             cmmeta.ConditionFalse,
+				Reason:             "Expired",
+				Message:            "some message",
+				LastTransitionTime: &metaNow,
+			},
+			cert:              gen.CertificateFrom(cert),
+			certShouldUpdate:  true,
+			secretShouldExist: true,
+			notAfter:          func(m metav1.Time) *metav1.Time { return &m }(metav1.NewTime(now.Add(-time.Hour).Truncate(time.Second))),
+			notBefore:         func(m metav1.Time) *metav1.Time { return &m }(metav1.NewTime(now.Add(-time.Hour * 3).Truncate(time.Second))),
+			renewalTime:       func(m metav1.Time) *metav1.Time { return &m }(metav1.NewTime(now.Add(-time.Hour * 2))),
+			expiredCondition: &cmapi.CertificateCondition{
+				Type:               cmapi.CertificateConditionExpired,
+				Status:             cmmeta.ConditionTrue,
+				Reason:             "Expired",
+				Message:            fmt.Sprintf("Certificate expired on %s", now.Add(-time.Hour).Truncate(time.Second).Format(time.RFC1123)),
+				LastTransitionTime: &metaNow,
+			},
+		},
+		"update status for a Certificate whose spec.secretName secret contains a fallback X509 cert": {
+			condition: cmapi.CertificateCondition{
+				Type:               cmapi.CertificateConditionReady,
+				Status:             cmmeta.ConditionFalse,
+				Reason:             "some reason",
+				Message:            "some message",
+				LastTransitionTime: &metaNow,
+			},
+			cert:              gen.CertificateFrom(cert),
+			certShouldUpdate:  true,
+			secretShouldExist: true,
+			secretAnnotations: map[string]string{cmapi.FallbackCertificateAnnotationKey: "true"},
+			notAfter:          func(m metav1.Time) *metav1.Time { return &m }(metav1.NewTime(now.Add(time.Hour * 2).Truncate(time.Second))),
+			notBefore:         func(m metav1.Time) *metav1.Time { return &m }(metav1.NewTime(now.Truncate(time.Second))),
+			renewalTime:       func(m metav1.Time) *metav1.Time { return &m }(metav1.NewTime(now.Add(time.Hour))),
+			expiredCondition: &cmapi.CertificateCondition{
+				Type:               cmapi.CertificateConditionExpired,
+				Status:             cmmeta.ConditionTrue,
+				Reason:             policies.FallbackCertificate,
+				Message:            "Certificate expired and failed to be renewed, the Secret contains a temporary self-signed fallback certificate",
+				LastTransitionTime: &metaNow,
+			},
+		},
 		"update status for a Certificate whose spec.secretName secret does not exist": {
 			condition: cmapi.CertificateCondition{
 				Type:               cmapi.CertificateConditionReady,
@@ -255,11 +317,15 @@ func TestProcessItem(t *testing.T) {
 				builder.CertManagerObjects = append(builder.CertManagerObjects, test.cert)
 			}
 
+			// spkiPin is the expected status.spkiPinSHA256 of the X509 cert
+			// stored in the Secret, if any.
+			var spkiPin string
 			if test.secretShouldExist {
-				mods := make([]gen.SecretModifier, 0)
+				mods := []gen.SecretModifier{gen.SetSecretAnnotations(test.secretAnnotations)}
 				// If the test scenario needs a secret with a valid X509 cert.
 				if test.notBefore != nil && test.notAfter != nil {
 					x509Bytes := testcrypto.MustCreateCertWithNotBeforeAfter(t, privKey, cert, test.notBefore.Time, test.notAfter.Time)
+					spkiPin = pki.SPKIPinSHA256(mustDecodeCertificate(t, x509Bytes))
 					mods = append(mods,
 						gen.SetSecretData(map[string][]byte{
 							"tls.crt": x509Bytes,
@@ -290,11 +356,15 @@ func TestProcessItem(t *testing.T) {
 			if test.certShouldUpdate {
 				c := gen.CertificateFrom(test.cert,
 					gen.SetCertificateStatusCondition(test.condition))
+				if test.expiredCondition != nil {
+					c = gen.CertificateFrom(c, gen.SetCertificateStatusCondition(*test.expiredCondition))
+				}
 
 				// gen package functions don't accept pointers - we need to test setting these values to nil in some scenarios.
 				c.Status.NotAfter = test.notAfter
 				c.Status.NotBefore = test.notBefore
 				c.Status.RenewalTime = test.renewalTime
+				c.Status.SPKIPinSHA256 = spkiPin
 
 				builder.ExpectedActions = append(builder.ExpectedActions,
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
@@ -518,6 +588,21 @@ func TestNewReadinessPolicyChain(t *testing.T) {
 			reason:  "",
 			message: "",
 		},
+		"Certificate not Ready as Secret contains a fallback certificate": {
+			cert: gen.Certificate("test", gen.SetCertificateSecretName("something")),
+			secret: gen.Secret("something",
+				gen.SetSecretAnnotations(map[string]string{cmapi.FallbackCertificateAnnotationKey: "true"}),
+				gen.SetSecretData(map[string][]byte{
+					corev1.TLSPrivateKeyKey: privKey,
+					corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, privKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						clock.Now(), clock.Now().Add(time.Hour),
+					),
+				})),
+			reason:         policies.FallbackCertificate,
+			message:        "Issuing certificate as Secret contains a temporary self-signed fallback certificate",
+			violationFound: true,
+		},
 	}
 	policyChain := policies.NewReadinessPolicyChain(clock)
 	for name, test := range tests {
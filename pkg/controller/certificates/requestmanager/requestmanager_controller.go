@@ -160,6 +160,17 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return nil
 	}
 
+	// If a canary issuer is configured, only request the certificate once
+	// the canary issuance has succeeded for the current generation.
+	if crt.Spec.CanaryIssuerRef != nil && !apiutil.CertificateHasConditionWithObservedGeneration(crt, cmapi.CertificateCondition{
+		Type:               cmapi.CertificateConditionCanaryIssued,
+		Status:             cmmeta.ConditionTrue,
+		ObservedGeneration: crt.Generation,
+	}) {
+		log.V(logf.DebugLevel).Info("canary issuance has not yet succeeded for the current generation, waiting before processing certificate")
+		return nil
+	}
+
 	// Check for and fetch the 'status.nextPrivateKeySecretName' secret
 	if crt.Status.NextPrivateKeySecretName == nil {
 		log.V(logf.DebugLevel).Info("status.nextPrivateKeySecretName not yet set, waiting for keymanager before processing certificate")
@@ -0,0 +1,240 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretdeletion
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// ControllerName is the name of the certificate Secret deletion
+	// controller.
+	ControllerName = "certificates-secret-deletion"
+)
+
+// This controller deletes the Secret of a Certificate when the Certificate is
+// deleted, if the Certificate's secretDeletionPolicy is `Delete`. The
+// Secret is deleted before the Certificate is removed using a finalizer, so
+// that it does not depend on the Secret being owned by the Certificate.
+type controller struct {
+	certificateLister cmlisters.CertificateLister
+	secretLister      internalinformers.SecretLister
+	client            kubernetes.Interface
+	cmClient          cmclient.Interface
+
+	// defaultPolicy is used for Certificates which do not set a
+	// secretDeletionPolicy.
+	defaultPolicy cmapi.SecretDeletionPolicy
+}
+
+func NewController(
+	log logr.Logger,
+	ctx *controllerpkg.Context,
+) (*controller, workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewTypedRateLimitingQueueWithConfig(
+		controllerpkg.DefaultCertificateRateLimiter(),
+		workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+			Name: ControllerName,
+		},
+	)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := ctx.SharedInformerFactory.Certmanager().V1().Certificates()
+	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
+
+	if _, err := certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue}); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		secretsInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	defaultPolicy := cmapi.SecretDeletionPolicyRetain
+	if ctx.CertificateOptions.SecretDeletionPolicy == controllerpkg.SecretDeletionPolicyDelete {
+		defaultPolicy = cmapi.SecretDeletionPolicyDelete
+	}
+
+	return &controller{
+		certificateLister: certificateInformer.Lister(),
+		secretLister:      secretsInformer.Lister(),
+		client:            ctx.Client,
+		cmClient:          ctx.CMClient,
+		defaultPolicy:     defaultPolicy,
+	}, queue, mustSync, nil
+}
+
+// ProcessItem ensures that a Certificate whose Secret should be deleted with
+// it has the SecretDeletionFinalizer, and that other Certificates do not.
+// Once a Certificate with the finalizer is being deleted, its Secret is
+// deleted and the finalizer is removed.
+func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name := key.Namespace, key.Name
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("certificate not found for key", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	log = logf.WithResource(log, crt)
+	ctx = logf.NewContext(ctx, log)
+
+	deleteSecret := c.policyFor(crt) == cmapi.SecretDeletionPolicyDelete
+	hasFinalizer := slices.Contains(crt.Finalizers, cmapi.SecretDeletionFinalizer)
+
+	if crt.DeletionTimestamp == nil {
+		switch {
+		case deleteSecret && !hasFinalizer:
+			log.V(logf.DebugLevel).Info("adding finalizer to delete the Secret with the Certificate")
+			crt = crt.DeepCopy()
+			crt.Finalizers = append(crt.Finalizers, cmapi.SecretDeletionFinalizer)
+			return c.updateFinalizers(ctx, crt)
+
+		case !deleteSecret && hasFinalizer:
+			log.V(logf.DebugLevel).Info("removing finalizer as the Secret is retained with the Certificate")
+			return c.removeFinalizer(ctx, crt)
+		}
+
+		return nil
+	}
+
+	if !hasFinalizer {
+		return nil
+	}
+
+	// The policy may have been changed to `Retain` after the Certificate was
+	// deleted, in which case only the finalizer is removed.
+	if deleteSecret {
+		if err := c.deleteSecret(ctx, crt); err != nil {
+			return err
+		}
+	}
+
+	return c.removeFinalizer(ctx, crt)
+}
+
+// policyFor returns the secretDeletionPolicy of the Certificate, or the
+// default policy if it does not set one.
+func (c *controller) policyFor(crt *cmapi.Certificate) cmapi.SecretDeletionPolicy {
+	if crt.Spec.SecretDeletionPolicy != "" {
+		return crt.Spec.SecretDeletionPolicy
+	}
+	return c.defaultPolicy
+}
+
+// deleteSecret deletes the Secret named by the Certificate's
+// `spec.secretName`, unless the Secret was written for a different
+// Certificate which shares the same `spec.secretName`.
+func (c *controller) deleteSecret(ctx context.Context, crt *cmapi.Certificate) error {
+	log := logf.FromContext(ctx).WithValues("secret", crt.Spec.SecretName)
+
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if owner, ok := secret.Annotations[cmapi.CertificateNameKey]; ok && owner != crt.Name {
+		log.Info("not deleting Secret as it belongs to another Certificate", "certificate", owner)
+		return nil
+	}
+
+	log.Info("deleting Secret as the Certificate is being deleted")
+	// The UID acts as a precondition, so that a Secret which has been
+	// re-created since it was observed is not deleted.
+	err = c.client.CoreV1().Secrets(secret.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{
+		Preconditions: metav1.NewUIDPreconditions(string(secret.UID)),
+	})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// removeFinalizer removes the SecretDeletionFinalizer from the Certificate.
+func (c *controller) removeFinalizer(ctx context.Context, crt *cmapi.Certificate) error {
+	crt = crt.DeepCopy()
+	crt.Finalizers = slices.DeleteFunc(crt.Finalizers, func(finalizer string) bool {
+		return finalizer == cmapi.SecretDeletionFinalizer
+	})
+	return c.updateFinalizers(ctx, crt)
+}
+
+// updateFinalizers updates the Certificate with its modified finalizers. A
+// conflicting update is returned as an error, so that the Certificate is
+// processed again once the informer has observed the latest version.
+func (c *controller) updateFinalizers(ctx context.Context, crt *cmapi.Certificate) error {
+	_, err := c.cmClient.CertmanagerV1().Certificates(crt.Namespace).Update(ctx, crt, metav1.UpdateOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.TypedRateLimitingInterface[types.NamespacedName], []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync, err := NewController(log, ctx)
+	c.controller = ctrl
+
+	return queue, mustSync, err
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,226 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretdeletion
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	coretesting "k8s.io/client-go/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	deletionTimestamp := metav1.NewTime(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	baseCrt := gen.Certificate("test-cert",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateSecretName("test-secret"),
+	)
+	secret := gen.Secret("test-secret",
+		gen.SetSecretNamespace("testns"),
+		gen.SetSecretAnnotations(map[string]string{cmapi.CertificateNameKey: "test-cert"}),
+	)
+	secret.UID = "secret-uid"
+
+	deleteSecretAction := testpkg.NewAction(coretesting.NewDeleteActionWithOptions(
+		corev1.SchemeGroupVersion.WithResource("secrets"), "testns", "test-secret",
+		metav1.DeleteOptions{Preconditions: metav1.NewUIDPreconditions("secret-uid")},
+	))
+	updateCertificateAction := func(crt *cmapi.Certificate) testpkg.Action {
+		return testpkg.NewAction(coretesting.NewUpdateAction(
+			cmapi.SchemeGroupVersion.WithResource("certificates"), "testns", crt,
+		))
+	}
+
+	tests := map[string]struct {
+		// certificate to be synced for the test.
+		certificate *cmapi.Certificate
+
+		// defaultPolicy is the secretDeletionPolicy configured for the
+		// controller.
+		defaultPolicy controllerpkg.SecretDeletionPolicy
+
+		// secret, if set, will exist in the apiserver before the test is run.
+		secret *corev1.Secret
+
+		expectedActions []testpkg.Action
+	}{
+		"Retain policy without the finalizer should do nothing": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateSecretDeletionPolicy(cmapi.SecretDeletionPolicyRetain),
+			),
+			defaultPolicy: controllerpkg.SecretDeletionPolicyDelete,
+			secret:        secret,
+		},
+		"Delete policy without the finalizer should add the finalizer": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateSecretDeletionPolicy(cmapi.SecretDeletionPolicyDelete),
+			),
+			secret: secret,
+			expectedActions: []testpkg.Action{
+				updateCertificateAction(gen.CertificateFrom(baseCrt,
+					gen.SetCertificateSecretDeletionPolicy(cmapi.SecretDeletionPolicyDelete),
+					gen.SetCertificateFinalizers(cmapi.SecretDeletionFinalizer),
+				)),
+			},
+		},
+		"Delete default policy should add the finalizer to a Certificate without a policy": {
+			certificate:   baseCrt,
+			defaultPolicy: controllerpkg.SecretDeletionPolicyDelete,
+			secret:        secret,
+			expectedActions: []testpkg.Action{
+				updateCertificateAction(gen.CertificateFrom(baseCrt,
+					gen.SetCertificateFinalizers(cmapi.SecretDeletionFinalizer),
+				)),
+			},
+		},
+		"Retain default policy should not add the finalizer to a Certificate without a policy": {
+			certificate:   baseCrt,
+			defaultPolicy: controllerpkg.SecretDeletionPolicyRetain,
+			secret:        secret,
+		},
+		"Retain policy with the finalizer should remove the finalizer": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateSecretDeletionPolicy(cmapi.SecretDeletionPolicyRetain),
+				gen.SetCertificateFinalizers("other-finalizer", cmapi.SecretDeletionFinalizer),
+			),
+			secret: secret,
+			expectedActions: []testpkg.Action{
+				updateCertificateAction(gen.CertificateFrom(baseCrt,
+					gen.SetCertificateSecretDeletionPolicy(cmapi.SecretDeletionPolicyRetain),
+					gen.SetCertificateFinalizers("other-finalizer"),
+				)),
+			},
+		},
+		"Delete policy on a deleted Certificate should delete the Secret and remove the finalizer": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateSecretDeletionPolicy(cmapi.SecretDeletionPolicyDelete),
+				gen.SetCertificateFinalizers(cmapi.SecretDeletionFinalizer),
+				gen.SetCertificateDeletionTimestamp(deletionTimestamp),
+			),
+			secret: secret,
+			expectedActions: []testpkg.Action{
+				deleteSecretAction,
+				updateCertificateAction(gen.CertificateFrom(baseCrt,
+					gen.SetCertificateSecretDeletionPolicy(cmapi.SecretDeletionPolicyDelete),
+					gen.SetCertificateFinalizers([]string{}...),
+					gen.SetCertificateDeletionTimestamp(deletionTimestamp),
+				)),
+			},
+		},
+		"Delete policy on a deleted Certificate should remove the finalizer if the Secret does not exist": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateSecretDeletionPolicy(cmapi.SecretDeletionPolicyDelete),
+				gen.SetCertificateFinalizers(cmapi.SecretDeletionFinalizer),
+				gen.SetCertificateDeletionTimestamp(deletionTimestamp),
+			),
+			expectedActions: []testpkg.Action{
+				updateCertificateAction(gen.CertificateFrom(baseCrt,
+					gen.SetCertificateSecretDeletionPolicy(cmapi.SecretDeletionPolicyDelete),
+					gen.SetCertificateFinalizers([]string{}...),
+					gen.SetCertificateDeletionTimestamp(deletionTimestamp),
+				)),
+			},
+		},
+		"Delete policy on a deleted Certificate should not delete a Secret belonging to another Certificate": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateSecretDeletionPolicy(cmapi.SecretDeletionPolicyDelete),
+				gen.SetCertificateFinalizers(cmapi.SecretDeletionFinalizer),
+				gen.SetCertificateDeletionTimestamp(deletionTimestamp),
+			),
+			secret: gen.SecretFrom(secret,
+				gen.SetSecretAnnotations(map[string]string{cmapi.CertificateNameKey: "other-cert"}),
+			),
+			expectedActions: []testpkg.Action{
+				updateCertificateAction(gen.CertificateFrom(baseCrt,
+					gen.SetCertificateSecretDeletionPolicy(cmapi.SecretDeletionPolicyDelete),
+					gen.SetCertificateFinalizers([]string{}...),
+					gen.SetCertificateDeletionTimestamp(deletionTimestamp),
+				)),
+			},
+		},
+		"Retain policy on a deleted Certificate with the finalizer should only remove the finalizer": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateSecretDeletionPolicy(cmapi.SecretDeletionPolicyRetain),
+				gen.SetCertificateFinalizers(cmapi.SecretDeletionFinalizer),
+				gen.SetCertificateDeletionTimestamp(deletionTimestamp),
+			),
+			secret: secret,
+			expectedActions: []testpkg.Action{
+				updateCertificateAction(gen.CertificateFrom(baseCrt,
+					gen.SetCertificateSecretDeletionPolicy(cmapi.SecretDeletionPolicyRetain),
+					gen.SetCertificateFinalizers([]string{}...),
+					gen.SetCertificateDeletionTimestamp(deletionTimestamp),
+				)),
+			},
+		},
+		"Delete policy on a deleted Certificate without the finalizer should do nothing": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateSecretDeletionPolicy(cmapi.SecretDeletionPolicyDelete),
+				gen.SetCertificateFinalizers("other-finalizer"),
+				gen.SetCertificateDeletionTimestamp(deletionTimestamp),
+			),
+			secret: secret,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Create and initialise a new unit test builder
+			builder := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: []runtime.Object{test.certificate},
+				ExpectedActions:    test.expectedActions,
+			}
+			if test.secret != nil {
+				builder.KubeObjects = append(builder.KubeObjects, test.secret)
+			}
+			builder.Init()
+			builder.Context.CertificateOptions.SecretDeletionPolicy = test.defaultPolicy
+
+			// Register informers used by the controller using the registration wrapper
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			// Start the informers and begin processing updates
+			builder.Start()
+			defer builder.Stop()
+
+			key := types.NamespacedName{
+				Name:      test.certificate.Name,
+				Namespace: test.certificate.Namespace,
+			}
+			if err := w.controller.ProcessItem(context.Background(), key); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			if err := builder.AllActionsExecuted(); err != nil {
+				builder.T.Error(err)
+			}
+		})
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -57,6 +59,17 @@ const (
 	stopIncreaseBackoff = 6 // 2 ^ (6 - 1) = 32 = maxDelay
 	// maxDelay is the maximum backoff period
 	maxDelay = 32 * time.Hour
+	// renewalsPausedExpiryWindow is the period before a Certificate expires
+	// during which it is renewed even though renewals are paused, leaving
+	// time for the issuance to complete.
+	renewalsPausedExpiryWindow = time.Hour
+	// renewalsPausedRecheckInterval is the maximum period after which a
+	// Certificate whose renewal was skipped because renewals are paused is
+	// checked again, so that it is renewed soon after renewals are resumed.
+	renewalsPausedRecheckInterval = 5 * time.Minute
+	// reasonDuplicateSecretName is the reason of the SecretOwnershipConflict
+	// condition.
+	reasonDuplicateSecretName = "DuplicateSecretName"
 )
 
 // This controller observes the state of the certificate's currently
@@ -66,6 +79,7 @@ const (
 // certificate is required.
 type controller struct {
 	certificateLister        cmlisters.CertificateLister
+	certificateIndexer       cache.Indexer
 	certificateRequestLister cmlisters.CertificateRequestLister
 	secretLister             internalinformers.SecretLister
 	client                   cmclient.Interface
@@ -77,6 +91,13 @@ type controller struct {
 	// Apply API calls.
 	fieldManager string
 
+	// renewalsPaused returns true if the renewal of Certificates is paused.
+	renewalsPaused func() bool
+
+	// secretOwnershipConflictPolicy controls whether the Certificate which
+	// owns a Secret targeted by other Certificates is still issued.
+	secretOwnershipConflictPolicy controllerpkg.SecretOwnershipConflictPolicy
+
 	// The following are used for testing purposes.
 	clock              clock.Clock
 	shouldReissue      policies.Func
@@ -101,9 +122,41 @@ func NewController(
 	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
 	secretsInformer := ctx.KubeSharedInformerFactory.Secrets()
 
+	if err := certificateInformer.Informer().AddIndexers(cache.Indexers{
+		internalcertificates.SecretNameIndex: internalcertificates.SecretNameIndexFunc,
+	}); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up certificate indexer: %v", err)
+	}
+	certificateIndexer := certificateInformer.Informer().GetIndexer()
+
 	if _, err := certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue}); err != nil {
 		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
 	}
+	// When a Certificate starts or stops targeting a Secret, enqueue the other
+	// Certificates which target that Secret, so that their
+	// SecretOwnershipConflict condition is updated.
+	if _, err := certificateInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			enqueueCertificatesForSecretName(queue, certificateIndexer, obj)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldCrt, oldOK := oldObj.(*cmapi.Certificate)
+			newCrt, newOK := newObj.(*cmapi.Certificate)
+			if oldOK && newOK && oldCrt.Spec.SecretName == newCrt.Spec.SecretName {
+				return
+			}
+			enqueueCertificatesForSecretName(queue, certificateIndexer, oldObj)
+			enqueueCertificatesForSecretName(queue, certificateIndexer, newObj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			enqueueCertificatesForSecretName(queue, certificateIndexer, obj)
+		},
+	}); err != nil {
+		return nil, nil, nil, fmt.Errorf("error setting up event handler: %v", err)
+	}
 
 	// When a CertificateRequest resource changes, enqueue the Certificate resource that owns it.
 	if _, err := certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
@@ -129,13 +182,16 @@ func NewController(
 	}
 
 	return &controller{
-		certificateLister:        certificateInformer.Lister(),
-		certificateRequestLister: certificateRequestInformer.Lister(),
-		secretLister:             secretsInformer.Lister(),
-		client:                   ctx.CMClient,
-		recorder:                 ctx.Recorder,
-		scheduledWorkQueue:       scheduler.NewScheduledWorkQueue(ctx.Clock, queue.Add),
-		fieldManager:             ctx.FieldManager,
+		certificateLister:             certificateInformer.Lister(),
+		certificateIndexer:            certificateIndexer,
+		certificateRequestLister:      certificateRequestInformer.Lister(),
+		secretLister:                  secretsInformer.Lister(),
+		client:                        ctx.CMClient,
+		recorder:                      ctx.Recorder,
+		scheduledWorkQueue:            scheduler.NewScheduledWorkQueue(ctx.Clock, queue.Add),
+		fieldManager:                  ctx.FieldManager,
+		renewalsPaused:                ctx.CertificateOptions.CurrentRenewalsPaused,
+		secretOwnershipConflictPolicy: ctx.CertificateOptions.SecretOwnershipConflictPolicy,
 
 		// The following are used for testing purposes.
 		clock:         ctx.Clock,
@@ -173,6 +229,19 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	// target Secret, triggering the re-issuance of the other Certificate resources whose spec no longer matches
 	// what is in the Secret. This would cause a flood of re-issuance attempts and overloads the Kubernetes API
 	// and the API server of the issuing CA.
+	conflicts, err := c.secretOwnershipConflicts(crt)
+	if err != nil {
+		return err
+	}
+	if updated, err := c.updateSecretOwnershipConflictCondition(ctx, crt, conflicts); err != nil || updated {
+		// The status update re-queues the Certificate.
+		return err
+	}
+	if len(conflicts) > 0 && c.secretOwnershipConflictPolicy == controllerpkg.SecretOwnershipConflictPolicyRefuse {
+		log.V(logf.DebugLevel).Info("Certificate.Spec.SecretName refers to the same Secret as other Certificates in the same namespace, refusing to trigger.", "duplicates", conflicts)
+		return nil
+	}
+
 	isOwner, duplicates, err := internalcertificates.CertificateOwnsSecret(ctx, c.certificateLister, c.secretLister, crt)
 	if err != nil {
 		return err
@@ -189,6 +258,15 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return nil
 	}
 
+	// Don't trigger the initial issuance until the Certificate is about to be
+	// first needed, if it was scheduled with spec.issueAfter.
+	if deferIssuance, delay := shouldDeferInitialIssuance(c.clock, crt); deferIssuance {
+		message := fmt.Sprintf("Deferring the initial issuance until %v, %s before spec.issueAfter", c.clock.Now().Add(delay), issueAhead(crt))
+		log.V(logf.InfoLevel).Info(message)
+		c.scheduleRecheckOfCertificateIfRequired(log, key, delay)
+		return nil
+	}
+
 	input, err := c.dataForCertificate(ctx, crt)
 	if err != nil {
 		return err
@@ -216,6 +294,29 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 		return nil
 	}
 
+	// Only scheduled renewals are paused. Certificates which have expired or
+	// need to be re-issued for any other reason are always issued.
+	if reason == policies.Renewing && c.renewalsPaused() {
+		if pause, delay := shouldPauseRenewal(c.clock, crt); pause {
+			log.V(logf.InfoLevel).Info("Renewals are paused, skipping the renewal of the Certificate", "not_after", crt.Status.NotAfter)
+			c.scheduleRecheckOfCertificateIfRequired(log, key, delay)
+			return nil
+		}
+
+		pausedMessage := fmt.Sprintf("Renewals are paused, but the certificate is renewed as it expires within %s", renewalsPausedExpiryWindow)
+		log.V(logf.WarnLevel).Info(pausedMessage)
+		c.recorder.Event(crt, corev1.EventTypeWarning, "RenewalPaused", pausedMessage)
+	}
+
+	// A Certificate adopting its existing Secret is only issued if the stored
+	// certificate does not match its spec or has expired, which should be
+	// surfaced since it was expected to be adopted without re-issuing.
+	if reason != policies.DoesNotExist && internalcertificates.AdoptsSecret(crt, input.Secret) {
+		adoptionMessage := fmt.Sprintf("Not adopting the existing certificate in Secret %q: %s", crt.Spec.SecretName, message)
+		log.V(logf.InfoLevel).Info(adoptionMessage)
+		c.recorder.Event(crt, corev1.EventTypeWarning, "AdoptionFailed", adoptionMessage)
+	}
+
 	// Although the below recorder.Event already logs the event, the log
 	// line is quite unreadable (very long). Since this information is very
 	// important for the user and the operator, we log the following
@@ -232,14 +333,83 @@ func (c *controller) ProcessItem(ctx context.Context, key types.NamespacedName)
 	return nil
 }
 
+// secretOwnershipConflicts returns the names of the other Certificates in the
+// same namespace which target the same Secret as the given Certificate.
+func (c *controller) secretOwnershipConflicts(crt *cmapi.Certificate) ([]string, error) {
+	objs, err := c.certificateIndexer.ByIndex(internalcertificates.SecretNameIndex, crt.Namespace+"/"+crt.Spec.SecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	var conflicts []string
+	for _, obj := range objs {
+		if other, ok := obj.(*cmapi.Certificate); ok && other.Name != crt.Name {
+			conflicts = append(conflicts, other.Name)
+		}
+	}
+	slices.Sort(conflicts)
+	return conflicts, nil
+}
+
+// updateSecretOwnershipConflictCondition sets the SecretOwnershipConflict
+// condition on the given Certificate if other Certificates target the same
+// Secret, and removes it otherwise. It returns true if the status of the
+// Certificate was updated.
+func (c *controller) updateSecretOwnershipConflictCondition(ctx context.Context, crt *cmapi.Certificate, conflicts []string) (bool, error) {
+	existing := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionSecretOwnershipConflict)
+	if len(conflicts) == 0 {
+		if existing == nil {
+			return false, nil
+		}
+		crt = crt.DeepCopy()
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionSecretOwnershipConflict)
+		return true, c.updateOrApplyStatus(ctx, crt)
+	}
+
+	message := fmt.Sprintf("Secret %q is also the target of the Certificates: %s", crt.Spec.SecretName, strings.Join(conflicts, ", "))
+	if existing != nil && existing.Status == cmmeta.ConditionTrue && existing.Message == message && existing.ObservedGeneration == crt.Generation {
+		return false, nil
+	}
+
+	crt = crt.DeepCopy()
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionSecretOwnershipConflict, cmmeta.ConditionTrue, reasonDuplicateSecretName, message)
+	if err := c.updateOrApplyStatus(ctx, crt); err != nil {
+		return false, err
+	}
+	if existing == nil || existing.Status != cmmeta.ConditionTrue {
+		c.recorder.Event(crt, corev1.EventTypeWarning, string(cmapi.CertificateConditionSecretOwnershipConflict), message)
+	}
+	return true, nil
+}
+
+// enqueueCertificatesForSecretName adds the other Certificates which target
+// the same Secret as the given Certificate to the queue.
+func enqueueCertificatesForSecretName(queue workqueue.TypedInterface[types.NamespacedName], indexer cache.Indexer, obj interface{}) {
+	crt, ok := obj.(*cmapi.Certificate)
+	if !ok || crt.Spec.SecretName == "" {
+		return
+	}
+	objs, err := indexer.ByIndex(internalcertificates.SecretNameIndex, crt.Namespace+"/"+crt.Spec.SecretName)
+	if err != nil {
+		return
+	}
+	for _, obj := range objs {
+		if other, ok := obj.(*cmapi.Certificate); ok && other.Name != crt.Name {
+			queue.Add(types.NamespacedName{Namespace: other.Namespace, Name: other.Name})
+		}
+	}
+}
+
 // updateOrApplyStatus will update the controller status. If the
 // ServerSideApply feature is enabled, the managed fields will instead get
 // applied using the relevant Patch API call.
 func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certificate) error {
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
 		var conditions []cmapi.CertificateCondition
-		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); cond != nil {
-			conditions = []cmapi.CertificateCondition{*cond}
+		for _, condType := range []cmapi.CertificateConditionType{cmapi.CertificateConditionIssuing, cmapi.CertificateConditionSecretOwnershipConflict} {
+			if cond := apiutil.GetCertificateCondition(crt, condType); cond != nil {
+				conditions = append(conditions, *cond)
+			}
 		}
 		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
 			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
@@ -330,6 +500,50 @@ func shouldBackoffReissuingOnFailure(log logr.Logger, c clock.Clock, crt *cmapi.
 	return true, delay - durationSinceFailure
 }
 
+// shouldDeferInitialIssuance returns true if the initial issuance of the
+// Certificate must be deferred because it has an spec.issueAfter time which is
+// further away than spec.issueAhead, along with the remaining delay.
+// Once the Certificate has been issued, as recorded by its status.revision,
+// issuance is never deferred.
+func shouldDeferInitialIssuance(c clock.Clock, crt *cmapi.Certificate) (bool, time.Duration) {
+	if crt.Spec.IssueAfter == nil || crt.Status.Revision != nil {
+		return false, 0
+	}
+
+	issueAt := crt.Spec.IssueAfter.Add(-issueAhead(crt))
+	delay := issueAt.Sub(c.Now())
+	if delay <= 0 {
+		return false, 0
+	}
+
+	return true, delay
+}
+
+// shouldPauseRenewal returns true if the renewal of the Certificate can be
+// skipped while renewals are paused, along with the delay after which it
+// should be checked again. A Certificate which expires within the
+// renewalsPausedExpiryWindow, or whose expiry is unknown, is not paused.
+func shouldPauseRenewal(c clock.Clock, crt *cmapi.Certificate) (bool, time.Duration) {
+	if crt.Status.NotAfter == nil {
+		return false, 0
+	}
+
+	delay := crt.Status.NotAfter.Add(-renewalsPausedExpiryWindow).Sub(c.Now())
+	if delay <= 0 {
+		return false, 0
+	}
+
+	return true, min(delay, renewalsPausedRecheckInterval)
+}
+
+// issueAhead returns the spec.issueAhead of the Certificate, or zero if unset.
+func issueAhead(crt *cmapi.Certificate) time.Duration {
+	if crt.Spec.IssueAhead == nil {
+		return 0
+	}
+	return crt.Spec.IssueAhead.Duration
+}
+
 // scheduleRecheckOfCertificateIfRequired will schedule the resource with the
 // given key to be re-queued for processing after the given amount of time
 // has elapsed.
@@ -34,6 +34,7 @@ import (
 
 	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
 	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
@@ -48,6 +49,18 @@ func Test_controller_ProcessItem(t *testing.T) {
 		return testcrypto.MustCreateCryptoBundle(t, crt, fixedClock).CertificateRequest
 	}
 
+	// The SecretOwnershipConflict condition of a Certificate targeting
+	// 'secret-1' along with the given Certificates.
+	secretOwnershipConflictCondition := func(conflicts string) cmapi.CertificateCondition {
+		return cmapi.CertificateCondition{
+			Type:               cmapi.CertificateConditionSecretOwnershipConflict,
+			Status:             "True",
+			Reason:             "DuplicateSecretName",
+			Message:            fmt.Sprintf("Secret %q is also the target of the Certificates: %s", "secret-1", conflicts),
+			LastTransitionTime: &fixedNow,
+		}
+	}
+
 	tests := map[string]struct {
 		// key that should be passed to ProcessItem. If not set, the
 		// 'namespace/name' of the 'Certificate' field will be used. If neither
@@ -67,6 +80,17 @@ func Test_controller_ProcessItem(t *testing.T) {
 		mockShouldReissue       func(t *testing.T) policies.Func
 		wantShouldReissueCalled bool
 
+		// renewalsPaused pauses the renewal of Certificates.
+		renewalsPaused bool
+
+		// secretOwnershipConflictPolicy is the policy for Certificates which
+		// target the same Secret.
+		secretOwnershipConflictPolicy controllerpkg.SecretOwnershipConflictPolicy
+
+		// wantWarningEvent, if set, is an 'event string' that is expected to
+		// be fired before wantEvent.
+		wantWarningEvent string
+
 		// wantEvent, if set, is an 'event string' that is expected to be fired.
 		// For example, "Normal Issuing Re-issuance forced by unit test case"
 		// where 'Normal' is the event severity, 'Issuing' is the reason and the
@@ -169,6 +193,133 @@ func Test_controller_ProcessItem(t *testing.T) {
 				ObservedGeneration: 42,
 			}},
 		},
+		// The combinations of expiry and time that do or do not pause a
+		// renewal are tested in Test_shouldPauseRenewal
+		"should not set Issuing=True for a renewal while renewals are paused": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateGeneration(42),
+				gen.SetCertificateNotAfter(metav1.NewTime(fixedNow.Add(240*time.Hour))),
+			),
+			renewalsPaused:               true,
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{},
+			wantShouldReissueCalled:      true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return policies.Renewing, "Renewing certificate as renewal was scheduled", true
+				}
+			},
+		},
+		"should set Issuing=True with a warning for a renewal while renewals are paused if the certificate is about to expire": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateGeneration(42),
+				gen.SetCertificateNotAfter(metav1.NewTime(fixedNow.Add(30*time.Minute))),
+			),
+			renewalsPaused:               true,
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{},
+			wantShouldReissueCalled:      true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return policies.Renewing, "Renewing certificate as renewal was scheduled", true
+				}
+			},
+			wantWarningEvent: "Warning RenewalPaused Renewals are paused, but the certificate is renewed as it expires within 1h0m0s",
+			wantEvent:        "Normal Issuing Renewing certificate as renewal was scheduled",
+			wantConditions: []cmapi.CertificateCondition{{
+				Type:               "Issuing",
+				Status:             "True",
+				Reason:             policies.Renewing,
+				Message:            "Renewing certificate as renewal was scheduled",
+				LastTransitionTime: &fixedNow,
+				ObservedGeneration: 42,
+			}},
+		},
+		"should set Issuing=True with a warning if the existing Secret to adopt does not match the spec": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateGeneration(42),
+				gen.AddCertificateAnnotations(map[string]string{cmapi.AdoptExistingSecretAnnotation: "true"}),
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{
+				Secret: gen.Secret("secret-1", gen.SetSecretNamespace("testns")),
+			},
+			wantShouldReissueCalled: true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return policies.SecretMismatch, "Issuing certificate as Existing issued Secret is not up to date for spec: [spec.dnsNames]", true
+				}
+			},
+			wantWarningEvent: `Warning AdoptionFailed Not adopting the existing certificate in Secret "secret-1": Issuing certificate as Existing issued Secret is not up to date for spec: [spec.dnsNames]`,
+			wantEvent:        "Normal Issuing Issuing certificate as Existing issued Secret is not up to date for spec: [spec.dnsNames]",
+			wantConditions: []cmapi.CertificateCondition{{
+				Type:               "Issuing",
+				Status:             "True",
+				Reason:             policies.SecretMismatch,
+				Message:            "Issuing certificate as Existing issued Secret is not up to date for spec: [spec.dnsNames]",
+				LastTransitionTime: &fixedNow,
+				ObservedGeneration: 42,
+			}},
+		},
+		"should set Issuing=True for reasons other than a renewal while renewals are paused": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateGeneration(42),
+				gen.SetCertificateNotAfter(metav1.NewTime(fixedNow.Add(240*time.Hour))),
+			),
+			renewalsPaused:               true,
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{},
+			wantShouldReissueCalled:      true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return policies.DoesNotExist, "Issuing certificate as Secret does not exist", true
+				}
+			},
+			wantEvent: "Normal Issuing Issuing certificate as Secret does not exist",
+			wantConditions: []cmapi.CertificateCondition{{
+				Type:               "Issuing",
+				Status:             "True",
+				Reason:             policies.DoesNotExist,
+				Message:            "Issuing certificate as Secret does not exist",
+				LastTransitionTime: &fixedNow,
+				ObservedGeneration: 42,
+			}},
+		},
+		// The combinations of issueAfter, issueAhead and revision that do or
+		// do not defer issuance are tested in Test_shouldDeferInitialIssuance
+		"should not call shouldReissue when the initial issuance is deferred by issueAfter": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateIssueAfter(metav1.NewTime(fixedNow.Add(72*time.Hour))),
+				gen.SetCertificateIssueAhead(&metav1.Duration{Duration: 48 * time.Hour}),
+			),
+			wantDataForCertificateCalled: false,
+			wantShouldReissueCalled:      false,
+		},
+		"should set Issuing=True once the issueAhead lead time before issueAfter is reached": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateGeneration(42),
+				gen.SetCertificateIssueAfter(metav1.NewTime(fixedNow.Add(24*time.Hour))),
+				gen.SetCertificateIssueAhead(&metav1.Duration{Duration: 48 * time.Hour}),
+			),
+			wantDataForCertificateCalled: true,
+			mockDataForCertificateReturn: policies.Input{},
+			wantShouldReissueCalled:      true,
+			mockShouldReissue: func(*testing.T) policies.Func {
+				return func(policies.Input) (string, string, bool) {
+					return "DoesNotExist", "Issuing certificate as Secret does not exist", true
+				}
+			},
+			wantEvent: "Normal Issuing Issuing certificate as Secret does not exist",
+			wantConditions: []cmapi.CertificateCondition{{
+				Type:               "Issuing",
+				Status:             "True",
+				Reason:             "DoesNotExist",
+				Message:            "Issuing certificate as Secret does not exist",
+				LastTransitionTime: &fixedNow,
+				ObservedGeneration: 42,
+			}},
+		},
 		// The combinations of number of failed issuances and last
 		// failed issuance time that do or do not result in re-issuance
 		// are tested in Test_shouldBackoffReissuingOnFailure below
@@ -255,6 +406,7 @@ func Test_controller_ProcessItem(t *testing.T) {
 				gen.SetCertificateRevision(1),
 				gen.SetCertificateDNSNames("example.com"),
 				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateStatusCondition(secretOwnershipConflictCondition("cert-1")),
 			),
 			existingCertManagerObjects: []runtime.Object{
 				gen.Certificate("cert-1",
@@ -275,6 +427,7 @@ func Test_controller_ProcessItem(t *testing.T) {
 				gen.SetCertificateRevision(1),
 				gen.SetCertificateDNSNames("example.com"),
 				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateStatusCondition(secretOwnershipConflictCondition("cert-2")),
 			),
 			existingCertManagerObjects: []runtime.Object{
 				gen.Certificate("cert-2",
@@ -294,7 +447,7 @@ func Test_controller_ProcessItem(t *testing.T) {
 				}
 			},
 			wantEvent: "Normal Issuing Re-issuance forced by unit test case",
-			wantConditions: []cmapi.CertificateCondition{{
+			wantConditions: []cmapi.CertificateCondition{secretOwnershipConflictCondition("cert-2"), {
 				Type:               "Issuing",
 				Status:             "True",
 				Reason:             "ForceTriggered",
@@ -309,6 +462,7 @@ func Test_controller_ProcessItem(t *testing.T) {
 				gen.SetCertificateRevision(1),
 				gen.SetCertificateDNSNames("example.com"),
 				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateStatusCondition(secretOwnershipConflictCondition("cert-1")),
 			),
 			existingCertManagerObjects: []runtime.Object{
 				gen.Certificate("cert-1",
@@ -339,7 +493,7 @@ func Test_controller_ProcessItem(t *testing.T) {
 				}
 			},
 			wantEvent: "Normal Issuing Re-issuance forced by unit test case",
-			wantConditions: []cmapi.CertificateCondition{{
+			wantConditions: []cmapi.CertificateCondition{secretOwnershipConflictCondition("cert-1"), {
 				Type:               "Issuing",
 				Status:             "True",
 				Reason:             "ForceTriggered",
@@ -354,6 +508,7 @@ func Test_controller_ProcessItem(t *testing.T) {
 				gen.SetCertificateRevision(1),
 				gen.SetCertificateDNSNames("example.com"),
 				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateStatusCondition(secretOwnershipConflictCondition("cert-2")),
 			),
 			existingCertManagerObjects: []runtime.Object{
 				gen.Certificate("cert-2",
@@ -378,6 +533,63 @@ func Test_controller_ProcessItem(t *testing.T) {
 			wantDataForCertificateCalled: false,
 			wantShouldReissueCalled:      false,
 		},
+		"should set SecretOwnershipConflict=True without triggering when another Certificate targets the same Secret": {
+			existingCertificate: gen.Certificate("cert-1",
+				gen.SetCertificateCreationTimestamp(fixedNow),
+				gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateDNSNames("example.com"),
+				gen.SetCertificateSecretName("secret-1"),
+			),
+			existingCertManagerObjects: []runtime.Object{
+				gen.Certificate("cert-2",
+					gen.SetCertificateCreationTimestamp(metav1.NewTime(fixedNow.Add(1*time.Minute))),
+					gen.SetCertificateNamespace("testns"),
+					gen.SetCertificateDNSNames("example.com"),
+					gen.SetCertificateSecretName("secret-1"),
+				),
+			},
+			wantDataForCertificateCalled: false,
+			wantShouldReissueCalled:      false,
+			wantEvent:                    `Warning SecretOwnershipConflict Secret "secret-1" is also the target of the Certificates: cert-2`,
+			wantConditions:               []cmapi.CertificateCondition{secretOwnershipConflictCondition("cert-2")},
+		},
+		"should not set Issuing=True for the owner of a Secret targeted by other Certificates if the policy is Refuse": {
+			existingCertificate: gen.Certificate("cert-1",
+				gen.SetCertificateCreationTimestamp(fixedNow),
+				gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateDNSNames("example.com"),
+				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateStatusCondition(secretOwnershipConflictCondition("cert-2")),
+			),
+			existingCertManagerObjects: []runtime.Object{
+				gen.Certificate("cert-2",
+					gen.SetCertificateCreationTimestamp(metav1.NewTime(fixedNow.Add(1*time.Minute))),
+					gen.SetCertificateNamespace("testns"),
+					gen.SetCertificateDNSNames("example.com"),
+					gen.SetCertificateSecretName("secret-1"),
+				),
+			},
+			secretOwnershipConflictPolicy: controllerpkg.SecretOwnershipConflictPolicyRefuse,
+			wantDataForCertificateCalled:  false,
+			wantShouldReissueCalled:       false,
+		},
+		"should remove SecretOwnershipConflict once no other Certificate targets the same Secret": {
+			existingCertificate: gen.Certificate("cert-1",
+				gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateSecretName("secret-1"),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: "Ready", Status: "True"}),
+				gen.SetCertificateStatusCondition(secretOwnershipConflictCondition("cert-2")),
+			),
+			existingCertManagerObjects: []runtime.Object{
+				gen.Certificate("cert-2",
+					gen.SetCertificateNamespace("testns"),
+					gen.SetCertificateSecretName("secret-2"),
+				),
+			},
+			wantDataForCertificateCalled: false,
+			wantShouldReissueCalled:      false,
+			wantConditions:               []cmapi.CertificateCondition{{Type: "Ready", Status: "True"}},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -395,6 +607,8 @@ func Test_controller_ProcessItem(t *testing.T) {
 				builder.KubeObjects = append(builder.KubeObjects, test.existingKubeObjects...)
 			}
 			builder.Init()
+			builder.Context.CertificateOptions.RenewalsPaused = test.renewalsPaused
+			builder.Context.CertificateOptions.SecretOwnershipConflictPolicy = test.secretOwnershipConflictPolicy
 
 			w := &controllerWrapper{}
 			_, _, err := w.Register(builder.Context)
@@ -438,8 +652,11 @@ func Test_controller_ProcessItem(t *testing.T) {
 					)),
 				)
 			}
+			if test.wantWarningEvent != "" {
+				builder.ExpectedEvents = append(builder.ExpectedEvents, test.wantWarningEvent)
+			}
 			if test.wantEvent != "" {
-				builder.ExpectedEvents = []string{test.wantEvent}
+				builder.ExpectedEvents = append(builder.ExpectedEvents, test.wantEvent)
 			}
 
 			builder.Start()
@@ -473,6 +690,106 @@ func Test_controller_ProcessItem(t *testing.T) {
 	}
 }
 
+func Test_shouldDeferInitialIssuance(t *testing.T) {
+	clock := fakeclock.NewFakeClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	now := clock.Now()
+
+	tests := map[string]struct {
+		givenCert *cmapi.Certificate
+		wantDefer bool
+		wantDelay time.Duration
+	}{
+		"should not defer when issueAfter is not set": {
+			givenCert: gen.Certificate("cert-1"),
+		},
+		"should defer until issueAfter when issueAhead is not set": {
+			givenCert: gen.Certificate("cert-1",
+				gen.SetCertificateIssueAfter(metav1.NewTime(now.Add(time.Hour))),
+			),
+			wantDefer: true,
+			wantDelay: time.Hour,
+		},
+		"should defer until issueAhead before issueAfter": {
+			givenCert: gen.Certificate("cert-1",
+				gen.SetCertificateIssueAfter(metav1.NewTime(now.Add(72*time.Hour))),
+				gen.SetCertificateIssueAhead(&metav1.Duration{Duration: 48 * time.Hour}),
+			),
+			wantDefer: true,
+			wantDelay: 24 * time.Hour,
+		},
+		"should not defer once issueAhead before issueAfter has passed": {
+			givenCert: gen.Certificate("cert-1",
+				gen.SetCertificateIssueAfter(metav1.NewTime(now.Add(24*time.Hour))),
+				gen.SetCertificateIssueAhead(&metav1.Duration{Duration: 48 * time.Hour}),
+			),
+		},
+		"should not defer when issueAfter is in the past": {
+			givenCert: gen.Certificate("cert-1",
+				gen.SetCertificateIssueAfter(metav1.NewTime(now.Add(-time.Hour))),
+			),
+		},
+		"should not defer once the certificate has been issued": {
+			givenCert: gen.Certificate("cert-1",
+				gen.SetCertificateIssueAfter(metav1.NewTime(now.Add(72*time.Hour))),
+				gen.SetCertificateRevision(1),
+			),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotDefer, gotDelay := shouldDeferInitialIssuance(clock, test.givenCert)
+			assert.Equal(t, test.wantDefer, gotDefer)
+			assert.Equal(t, test.wantDelay, gotDelay)
+		})
+	}
+}
+
+func Test_shouldPauseRenewal(t *testing.T) {
+	clock := fakeclock.NewFakeClock(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	now := clock.Now()
+
+	tests := map[string]struct {
+		givenCert *cmapi.Certificate
+		wantPause bool
+		wantDelay time.Duration
+	}{
+		"should not pause when the expiry is unknown": {
+			givenCert: gen.Certificate("cert-1"),
+		},
+		"should pause and recheck after the recheck interval": {
+			givenCert: gen.Certificate("cert-1",
+				gen.SetCertificateNotAfter(metav1.NewTime(now.Add(240*time.Hour))),
+			),
+			wantPause: true,
+			wantDelay: 5 * time.Minute,
+		},
+		"should pause and recheck once the expiry window is reached": {
+			givenCert: gen.Certificate("cert-1",
+				gen.SetCertificateNotAfter(metav1.NewTime(now.Add(time.Hour+2*time.Minute))),
+			),
+			wantPause: true,
+			wantDelay: 2 * time.Minute,
+		},
+		"should not pause within the expiry window": {
+			givenCert: gen.Certificate("cert-1",
+				gen.SetCertificateNotAfter(metav1.NewTime(now.Add(59*time.Minute))),
+			),
+		},
+		"should not pause when the certificate has expired": {
+			givenCert: gen.Certificate("cert-1",
+				gen.SetCertificateNotAfter(metav1.NewTime(now.Add(-time.Hour))),
+			),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotPause, gotDelay := shouldPauseRenewal(clock, test.givenCert)
+			assert.Equal(t, test.wantPause, gotPause)
+			assert.Equal(t, test.wantDelay, gotDelay)
+		})
+	}
+}
+
 func Test_shouldBackoffReissuingOnFailure(t *testing.T) {
 	clock := fakeclock.NewFakeClock(time.Date(2020, 11, 20, 16, 05, 00, 0000, time.Local))
 
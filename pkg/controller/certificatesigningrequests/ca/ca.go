@@ -19,6 +19,7 @@ package ca
 import (
 	"context"
 	"crypto"
+	"crypto/rsa"
 	"crypto/x509"
 	"fmt"
 
@@ -34,9 +35,9 @@ import (
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/util"
+	issuerca "github.com/cert-manager/cert-manager/pkg/issuer/ca"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	cmerrors "github.com/cert-manager/cert-manager/pkg/util/errors"
-	"github.com/cert-manager/cert-manager/pkg/util/kube"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
@@ -97,8 +98,9 @@ func (c *CA) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningReq
 	secretName := issuerObj.GetSpec().CA.SecretName
 	resourceNamespace := c.issuerOptions.ResourceNamespace(issuerObj)
 
-	// get a copy of the CA certificate named on the Issuer
-	caCerts, caKey, err := kube.SecretTLSKeyPairAndCA(ctx, c.secretsLister, resourceNamespace, issuerObj.GetSpec().CA.SecretName)
+	// get a copy of the CA certificate named on the Issuer, completed with the
+	// chain up to the root CA if one is referenced
+	caCerts, caKey, err := issuerca.SigningKeyPairAndChain(ctx, c.secretsLister, resourceNamespace, issuerObj.GetSpec().CA)
 	if apierrors.IsNotFound(err) {
 		message := fmt.Sprintf("Referenced secret %s/%s not found", resourceNamespace, secretName)
 		c.recorder.Event(csr, corev1.EventTypeWarning, "SecretMissing", message)
@@ -131,6 +133,39 @@ func (c *CA) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningReq
 	template.OCSPServer = issuerObj.GetSpec().CA.OCSPServers
 	template.IssuingCertificateURL = issuerObj.GetSpec().CA.IssuingCertificateURLs
 
+	if method := issuerObj.GetSpec().CA.SubjectKeyIdentifierMethod; method != "" {
+		template.SubjectKeyId, err = pki.SubjectKeyIdentifier(template.PublicKey, method)
+		if err != nil {
+			message := fmt.Sprintf("Error computing subject key identifier: %s", err)
+			c.recorder.Event(csr, corev1.EventTypeWarning, "SigningError", message)
+			util.CertificateSigningRequestSetFailed(csr, "SigningError", message)
+			_, err := util.UpdateOrApplyStatus(ctx, c.certClient, csr, certificatesv1.CertificateFailed, c.fieldManager)
+			return err
+		}
+	}
+
+	// Clamp the certificate duration to the policy matching the requester, if any.
+	if maxDuration, ok := apiutil.RequesterMaxDuration(issuerObj.GetSpec().CA.RequesterDurationPolicies, csr.Spec.Username, csr.Spec.Groups); ok {
+		apiutil.ClampCertificateTemplateDuration(template, maxDuration)
+	}
+
+	apiutil.AlignCertificateTemplateNotAfter(template, issuerObj.GetSpec().CA.NotAfterBoundary)
+
+	// CertificateSigningRequests are not namespaced, so are always subject to
+	// the client auth only policy.
+	if apiutil.ClientAuthOnlyApplies(issuerObj.GetSpec().CA.ClientAuthOnly, "") {
+		apiutil.RestrictCertificateTemplateToClientAuth(template)
+	}
+
+	// RSASSA-PSS signatures can only be made by a CA with an RSA key.
+	if _, isRSA := caKey.Public().(*rsa.PublicKey); pki.IsRSAPSSSignatureAlgorithm(template.SignatureAlgorithm) && !isRSA {
+		message := "RSASSA-PSS signatures are not supported by this CA issuer: the CA's private key is not an RSA key"
+		c.recorder.Event(csr, corev1.EventTypeWarning, "SigningError", message)
+		util.CertificateSigningRequestSetFailed(csr, "SigningError", message)
+		_, err := util.UpdateOrApplyStatus(ctx, c.certClient, csr, certificatesv1.CertificateFailed, c.fieldManager)
+		return err
+	}
+
 	bundle, err := c.signingFn(caCerts, caKey, template)
 	if err != nil {
 		message := fmt.Sprintf("Error signing certificate: %s", err)
@@ -174,6 +174,34 @@ func (s *SelfSigned) Sign(ctx context.Context, csr *certificatesv1.CertificateSi
 	}
 
 	template.CRLDistributionPoints = issuerObj.GetSpec().SelfSigned.CRLDistributionPoints
+	apiutil.AlignCertificateTemplateNotAfter(template, issuerObj.GetSpec().SelfSigned.NotAfterBoundary)
+
+	if method := issuerObj.GetSpec().SelfSigned.SubjectKeyIdentifierMethod; method != "" {
+		template.SubjectKeyId, err = pki.SubjectKeyIdentifier(template.PublicKey, method)
+		if err != nil {
+			message := fmt.Sprintf("Error computing subject key identifier: %s", err)
+			log.Error(err, message)
+			s.recorder.Event(csr, corev1.EventTypeWarning, "ErrorGenerating", message)
+			util.CertificateSigningRequestSetFailed(csr, "ErrorGenerating", message)
+			_, err = util.UpdateOrApplyStatus(ctx, s.certClient, csr, certificatesv1.CertificateFailed, s.fieldManager)
+			return err
+		}
+	}
+
+	// As the certificate is self-signed, x509.CreateCertificate uses the
+	// authority key identifier of the template rather than the subject key
+	// identifier of a parent.
+	if authorityCertificate := issuerObj.GetSpec().SelfSigned.AuthorityCertificate; len(authorityCertificate) > 0 {
+		template.AuthorityKeyId, err = pki.AuthorityKeyIdentifier(authorityCertificate)
+		if err != nil {
+			message := fmt.Sprintf("Error reading authority key identifier from the authority certificate: %s", err)
+			log.Error(err, message)
+			s.recorder.Event(csr, corev1.EventTypeWarning, "ErrorGenerating", message)
+			util.CertificateSigningRequestSetFailed(csr, "ErrorGenerating", message)
+			_, err = util.UpdateOrApplyStatus(ctx, s.certClient, csr, certificatesv1.CertificateFailed, s.fieldManager)
+			return err
+		}
+	}
 
 	// extract the public component of the key
 	publickey, err := pki.PublicKeyForPrivateKey(privatekey)
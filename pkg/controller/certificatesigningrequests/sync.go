@@ -18,6 +18,7 @@ package certificatesigningrequests
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
 
 	authzv1 "k8s.io/api/authorization/v1"
@@ -65,6 +66,12 @@ func (c *Controller) Sync(ctx context.Context, csr *certificatesv1.CertificateSi
 		return nil
 	}
 	if !util.CertificateSigningRequestIsApproved(csr) {
+		// Deny requests for usages which the issuer will never sign, rather
+		// than waiting for them to be approved.
+		if denied, err := c.denyForbiddenUsages(ctx, csr, ref); denied || err != nil {
+			return err
+		}
+
 		c.recorder.Event(csr, corev1.EventTypeNormal, "WaitingApproval", "Waiting for the Approved condition before issuing")
 		dbg.Info("certificate signing request is not approved so skipping processing")
 		return nil
@@ -148,6 +155,18 @@ func (c *Controller) Sync(ctx context.Context, csr *certificatesv1.CertificateSi
 
 	}
 
+	// Requests for usages which the issuer's usage policy does not permit are
+	// denied before they are approved. A request which has been approved
+	// regardless can no longer be denied, so fail it rather than calling the
+	// signer.
+	if violations := usagesViolatingIssuerPolicy(csr, issuerObj); len(violations) > 0 {
+		message := fmt.Sprintf("Requested usages %v are not permitted by the usage policy of %s %s/%s", violations, kind, ref.Namespace, ref.Name)
+		c.recorder.Event(csr, corev1.EventTypeWarning, "ForbiddenUsages", message)
+		util.CertificateSigningRequestSetFailed(csr, "ForbiddenUsages", message)
+		_, err := util.UpdateOrApplyStatus(ctx, c.certClient, csr, certificatesv1.CertificateFailed, c.fieldManager)
+		return err
+	}
+
 	// check ready condition
 	if !apiutil.IssuerHasCondition(issuerObj, cmapi.IssuerCondition{
 		Type:   cmapi.IssuerConditionReady,
@@ -163,6 +182,60 @@ func (c *Controller) Sync(ctx context.Context, csr *certificatesv1.CertificateSi
 	return c.signer.Sign(ctx, csr, issuerObj)
 }
 
+// denyForbiddenUsages sets the Denied condition on a CertificateSigningRequest
+// which has not been approved yet if the usage policy of the referenced issuer
+// does not permit the requested usages. It returns true if the request was
+// denied. Requests whose issuer cannot be read are left to be checked once
+// they have been approved.
+func (c *Controller) denyForbiddenUsages(ctx context.Context, csr *certificatesv1.CertificateSigningRequest, ref util.SignerIssuerRef) (bool, error) {
+	kind, ok := util.IssuerKindFromType(ref.Type)
+	if !ok {
+		return false, nil
+	}
+
+	issuerObj, err := c.helper.GetGenericIssuer(cmmeta.ObjectReference{
+		Name:  ref.Name,
+		Kind:  kind,
+		Group: ref.Group,
+	}, ref.Namespace)
+	if err != nil {
+		return false, nil
+	}
+
+	// Only the controller of the issuer's type may deny the request.
+	if signerType, err := apiutil.NameForIssuer(issuerObj); err != nil || signerType != c.signerType {
+		return false, nil
+	}
+
+	violations := usagesViolatingIssuerPolicy(csr, issuerObj)
+	if len(violations) == 0 {
+		return false, nil
+	}
+
+	message := fmt.Sprintf("Requested usages %v are not permitted by the usage policy of %s %s/%s", violations, kind, ref.Namespace, ref.Name)
+	c.recorder.Event(csr, corev1.EventTypeWarning, "ForbiddenUsages", message)
+	util.CertificateSigningRequestSetDenied(csr, "ForbiddenUsages", message)
+	if _, err := c.certClient.UpdateApproval(ctx, csr.Name, csr, metav1.UpdateOptions{}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// usagesViolatingIssuerPolicy returns the usages requested by the
+// CertificateSigningRequest which are not permitted by the usage policy of the
+// given issuer. Requests with unknown usages are left to the signer to reject.
+func usagesViolatingIssuerPolicy(csr *certificatesv1.CertificateSigningRequest, issuerObj cmapi.GenericIssuer) []cmapi.KeyUsage {
+	keyUsage, extKeyUsage, err := pki.BuildKeyUsagesKube(csr.Spec.Usages)
+	if err != nil {
+		return nil
+	}
+	if csr.Annotations[experimentalapi.CertificateSigningRequestIsCAAnnotationKey] == "true" {
+		keyUsage |= x509.KeyUsageCertSign
+	}
+	return apiutil.UsagesViolatingPolicy(issuerObj.GetSpec().UsagePolicy, keyUsage, extKeyUsage)
+}
+
 // userCanReferenceSigner will return true if the CSR requester has a bound
 // role that allows them to reference a given Namespaced signer. The user must
 // have the permissions:
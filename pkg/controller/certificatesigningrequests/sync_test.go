@@ -222,6 +222,84 @@ func TestController_Sync(t *testing.T) {
 					Type: certificatesv1.CertificateApproved,
 				})),
 		},
+		"CertificateSigningRequest which has not yet been approved has usages forbidden by the usage policy of the ClusterIssuer": {
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.ClusterIssuer("foo-issuer",
+						gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+						gen.SetIssuerUsagePolicy(cmapi.IssuerUsagePolicy{
+							ForbiddenUsages: []cmapi.KeyUsage{cmapi.UsageCertSign, cmapi.UsageCodeSigning},
+						})),
+				},
+				ExpectedEvents: []string{
+					"Warning ForbiddenUsages Requested usages [code signing] are not permitted by the usage policy of ClusterIssuer /foo-issuer",
+				},
+
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
+						"approval",
+						"",
+						gen.CertificateSigningRequest("test",
+							gen.SetCertificateSigningRequestSignerName("clusterissuers.cert-manager.io/foo-issuer"),
+							gen.SetCertificateSigningRequestUsages([]certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageCodeSigning}),
+							gen.SetCertificateSigningRequestStatusCondition(certificatesv1.CertificateSigningRequestCondition{
+								Type:               certificatesv1.CertificateDenied,
+								Status:             corev1.ConditionTrue,
+								Reason:             "ForbiddenUsages",
+								Message:            "Requested usages [code signing] are not permitted by the usage policy of ClusterIssuer /foo-issuer",
+								LastTransitionTime: metaFixedTime,
+								LastUpdateTime:     metaFixedTime,
+							})),
+					)),
+				},
+			},
+			csr: gen.CertificateSigningRequest("test",
+				gen.SetCertificateSigningRequestSignerName("clusterissuers.cert-manager.io/foo-issuer"),
+				gen.SetCertificateSigningRequestUsages([]certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageCodeSigning})),
+		},
+		"CertificateSigningRequest which has been approved has usages forbidden by the usage policy of the ClusterIssuer": {
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.ClusterIssuer("foo-issuer",
+						gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{}),
+						gen.SetIssuerUsagePolicy(cmapi.IssuerUsagePolicy{
+							ForbiddenUsages: []cmapi.KeyUsage{cmapi.UsageCertSign, cmapi.UsageCodeSigning},
+						})),
+				},
+				ExpectedEvents: []string{
+					"Warning ForbiddenUsages Requested usages [code signing] are not permitted by the usage policy of ClusterIssuer /foo-issuer",
+				},
+
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
+						"status",
+						"",
+						gen.CertificateSigningRequest("test",
+							gen.SetCertificateSigningRequestSignerName("clusterissuers.cert-manager.io/foo-issuer"),
+							gen.SetCertificateSigningRequestUsages([]certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageCodeSigning}),
+							gen.SetCertificateSigningRequestStatusCondition(certificatesv1.CertificateSigningRequestCondition{
+								Type: certificatesv1.CertificateApproved,
+							}),
+							gen.SetCertificateSigningRequestStatusCondition(certificatesv1.CertificateSigningRequestCondition{
+								Type:               certificatesv1.CertificateFailed,
+								Status:             corev1.ConditionTrue,
+								Reason:             "ForbiddenUsages",
+								Message:            "Requested usages [code signing] are not permitted by the usage policy of ClusterIssuer /foo-issuer",
+								LastTransitionTime: metaFixedTime,
+								LastUpdateTime:     metaFixedTime,
+							})),
+					)),
+				},
+			},
+			csr: gen.CertificateSigningRequest("test",
+				gen.SetCertificateSigningRequestSignerName("clusterissuers.cert-manager.io/foo-issuer"),
+				gen.SetCertificateSigningRequestUsages([]certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageCodeSigning}),
+				gen.SetCertificateSigningRequestStatusCondition(certificatesv1.CertificateSigningRequestCondition{
+					Type: certificatesv1.CertificateApproved,
+				})),
+		},
 		// TODO (irbekrm) Test the scenario where the user is not allowed to reference Issuer
 		// Perhaps restructure and use fake SubjectAccessReview https://github.com/kubernetes/client-go/blob/master/kubernetes/typed/authorization/v1/fake/fake_subjectaccessreview.go
 		"Referenced ClusterIssuer is not ready": {
@@ -76,6 +76,26 @@ func CertificateSigningRequestSetFailed(csr *certificatesv1.CertificateSigningRe
 		"lastTransitionTime", nowTime.Time)
 }
 
+func CertificateSigningRequestSetDenied(csr *certificatesv1.CertificateSigningRequest, reason, message string) {
+	nowTime := metav1.NewTime(Clock.Now())
+
+	// The Denied condition is only set on requests which have been neither
+	// approved nor denied, so it needn't be checked whether it is already set.
+	csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		Type:               certificatesv1.CertificateDenied,
+		Status:             corev1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: nowTime,
+		LastUpdateTime:     nowTime,
+	})
+
+	logf.Log.V(logf.InfoLevel).Info("Setting lastTransitionTime for CertificateSigningRequest condition",
+		"certificateSigningRequest", klog.KObj(csr),
+		"condition", certificatesv1.CertificateDenied,
+		"lastTransitionTime", nowTime.Time)
+}
+
 func certificateSigningRequestGetCondition(csr *certificatesv1.CertificateSigningRequest, condType certificatesv1.RequestConditionType) *certificatesv1.CertificateSigningRequestCondition {
 	for _, cond := range csr.Status.Conditions {
 		if cond.Type == condType {
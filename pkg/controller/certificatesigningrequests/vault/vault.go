@@ -125,6 +125,19 @@ func (v *Vault) Sign(ctx context.Context, csr *certificatesv1.CertificateSigning
 		return err
 	}
 
+	// CertificateSigningRequests are not namespaced, so are always subject to
+	// the client auth only policy.
+	if apiutil.ClientAuthOnlyApplies(issuerObj.GetSpec().Vault.ClientAuthOnly, "") {
+		if err := internalvault.VerifyClientAuthOnly(certPEM); err != nil {
+			message := fmt.Sprintf("Vault signed a certificate which is not restricted to client authentication, as required by the issuer: %s", err)
+			log.Error(err, message)
+			v.recorder.Event(csr, corev1.EventTypeWarning, "ErrorSigning", message)
+			util.CertificateSigningRequestSetFailed(csr, "ErrorSigning", message)
+			_, err := util.UpdateOrApplyStatus(ctx, v.certClient, csr, certificatesv1.CertificateFailed, v.fieldManager)
+			return err
+		}
+	}
+
 	log.V(logf.DebugLevel).Info("certificate issued")
 
 	csr.Status.Certificate = certPEM
@@ -180,6 +180,12 @@ func (v *Venafi) Sign(ctx context.Context, csr *certificatesv1.CertificateSignin
 	certPem, err := client.RetrieveCertificate(pickupID, csr.Spec.Request, duration, customFields)
 	if err != nil {
 		switch err.(type) {
+		case venaficlient.ErrAwaitingApproval:
+			message := "Venafi certificate is awaiting approval, waiting"
+			log.V(2).Info(message, "error", err.Error())
+			v.recorder.Event(csr, corev1.EventTypeNormal, "AwaitingApproval", message)
+			return err
+
 		case endpoint.ErrCertificatePending:
 			message := "Venafi certificate still in a pending state, waiting"
 			log.V(2).Info(message, "error", err.Error())
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -39,7 +40,6 @@ import (
 	"k8s.io/client-go/metadata/metadatainformer"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/utils/clock"
 	gwapi "sigs.k8s.io/gateway-api/apis/v1"
 	gwclient "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
@@ -58,6 +58,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/metrics"
 	"github.com/cert-manager/cert-manager/pkg/util"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
 // This sets the informer's resync period to 10 hours
@@ -134,6 +135,11 @@ type ContextOptions struct {
 	// KubernetesAPIBurst is the value of the Maximum burst for throttle.
 	KubernetesAPIBurst int
 
+	// InitialSyncItemsPerSecond limits the rate at which each controller
+	// processes the items queued when its informers first sync. If 0, the
+	// initial backlog is not throttled.
+	InitialSyncItemsPerSecond float32
+
 	// Namespace is the namespace to operate within.
 	// If unset, operates on all namespaces
 	Namespace string
@@ -145,12 +151,19 @@ type ContextOptions struct {
 	// Metrics is used for exposing Prometheus metrics across the controllers
 	Metrics *metrics.Metrics
 
+	// IssuanceLimiter limits the number of issuances in flight at once across
+	// the certificaterequests and orders controllers. If nil, the number of
+	// issuances is not limited.
+	IssuanceLimiter *IssuanceLimiter
+
 	IssuerOptions
 	ACMEOptions
 	IngressShimOptions
 	CertificateOptions
+	ApproverOptions
 	SchedulerOptions
 	ConfigOptions
+	EventRecorderOptions
 }
 
 type ConfigOptions struct {
@@ -158,6 +171,34 @@ type ConfigOptions struct {
 	EnableGatewayAPI bool
 }
 
+// EventRecorderOptions limits the Events recorded by the controllers, to
+// protect the API server from Event floods when many resources fail at once.
+// A zero value for any field uses the client-go default.
+type EventRecorderOptions struct {
+	// EventRecorderQPS is the rate, in Events per second, at which Events
+	// about a single object may be recorded once the burst has been used up.
+	// Events exceeding the rate are dropped.
+	EventRecorderQPS float32
+
+	// EventRecorderBurst is the maximum number of Events about a single
+	// object which may be recorded in a burst.
+	EventRecorderBurst int
+
+	// EventRecorderDeduplicationWindow is the window within which similar
+	// Events about the same object are aggregated into a single Event.
+	EventRecorderDeduplicationWindow time.Duration
+}
+
+// correlatorOptions returns the client-go Event correlator options which
+// apply the limits to recorded Events.
+func (o EventRecorderOptions) correlatorOptions() record.CorrelatorOptions {
+	return record.CorrelatorOptions{
+		QPS:                  o.EventRecorderQPS,
+		BurstSize:            o.EventRecorderBurst,
+		MaxIntervalInSeconds: int(o.EventRecorderDeduplicationWindow / time.Second),
+	}
+}
+
 type IssuerOptions struct {
 	// ClusterResourceNamespace is the namespace to store resources created by
 	// non-namespaced resources (e.g. ClusterIssuer) in.
@@ -211,6 +252,43 @@ type ACMEOptions struct {
 
 	// DNS01CheckRetryPeriod is the time the controller should wait between checking if a ACME dns entry exists.
 	DNS01CheckRetryPeriod time.Duration
+
+	// DNS01PreValidationURL is the URL of an optional HTTP endpoint which
+	// confirms that a DNS01 challenge record exists before the ACME server is
+	// asked to validate the challenge.
+	DNS01PreValidationURL string
+
+	// DNS01PreValidationCAFile is the path to the CA certificates used to
+	// verify the DNS01 pre-validation endpoint.
+	DNS01PreValidationCAFile string
+
+	// DNS01PreValidationTimeout is the timeout for requests to the DNS01
+	// pre-validation endpoint.
+	DNS01PreValidationTimeout time.Duration
+
+	// DNS01CleanUpParallelism is the maximum number of DNS01 challenge records
+	// cleaned up at once in the background. If 0, records are cleaned up by
+	// the challenge controller's workers.
+	DNS01CleanUpParallelism int
+
+	// ChallengeProcessingTimeout is the maximum amount of time an accepted
+	// challenge's authorization may remain 'processing' before it is
+	// deactivated.
+	ChallengeProcessingTimeout time.Duration
+
+	// NamespaceOrdersPerHour is the maximum number of ACME orders per hour
+	// created for the Orders of a single namespace. 0 disables the limit.
+	NamespaceOrdersPerHour int
+
+	// NamespaceOrderBurst is the maximum number of ACME orders created for the
+	// Orders of a single namespace in a burst. If 0, the burst is equal to
+	// NamespaceOrdersPerHour.
+	NamespaceOrderBurst int
+
+	// AuthorizationRefreshWindow is the window before the expiry of a valid
+	// ACME authorization in which it is refreshed when reused by a new Order.
+	// 0 disables refreshing authorizations.
+	AuthorizationRefreshWindow time.Duration
 }
 
 // IngressShimOptions contain default Issuer GVK config for the certificate-shim controllers.
@@ -221,6 +299,17 @@ type IngressShimOptions struct {
 	DefaultIssuerKind                 string
 	DefaultIssuerGroup                string
 	DefaultAutoCertificateAnnotations []string
+	// DefaultIssuerRules select the default issuer by the DNS names of a
+	// Certificate, in preference to the default issuer above.
+	DefaultIssuerRules []DefaultIssuerRule
+	// AllowedIngressClasses and DeniedIngressClasses select the Ingresses
+	// for which the ingress-shim creates Certificates by their class.
+	AllowedIngressClasses []string
+	DeniedIngressClasses  []string
+	// AllowedGatewayClasses and DeniedGatewayClasses select the Gateways
+	// for which the gateway-shim creates Certificates by their class.
+	AllowedGatewayClasses []string
+	DeniedGatewayClasses  []string
 }
 
 type CertificateOptions struct {
@@ -230,12 +319,87 @@ type CertificateOptions struct {
 	// CopiedAnnotationPrefixes defines which annotations should be copied
 	// Certificate -> CertificateRequest, CertificateRequest -> Order.
 	CopiedAnnotationPrefixes []string
+	// SecretTypeMismatchPolicy controls how an existing certificate Secret
+	// which is not of type `kubernetes.io/tls` is handled.
+	SecretTypeMismatchPolicy SecretTypeMismatchPolicy
+	// IssuedCertificateVerificationPolicy controls how a certificate returned
+	// by an issuer which does not match its CertificateRequest is handled.
+	IssuedCertificateVerificationPolicy IssuedCertificateVerificationPolicy
+	// SecretOwnershipConflictPolicy controls how Certificates which target
+	// the same Secret are handled.
+	SecretOwnershipConflictPolicy SecretOwnershipConflictPolicy
+	// SecretDeletionPolicy controls whether the Secret of a Certificate
+	// which does not set a secretDeletionPolicy is deleted with it.
+	SecretDeletionPolicy SecretDeletionPolicy
+	// SecretWritesPerSecond limits the rate of Certificate Secret writes made
+	// by the certificates controller. 0 disables rate limiting.
+	SecretWritesPerSecond float32
+	// MaxConcurrentSecretWrites limits the number of Certificate Secret writes
+	// which can be in flight at once. 0 disables the limit.
+	MaxConcurrentSecretWrites int
+	// SecretRecreateConflictRetries is the number of times deleting a
+	// Certificate Secret of the wrong type, which conflicts because the
+	// Secret was replaced concurrently, is retried.
+	SecretRecreateConflictRetries int
+	// RenewalsPaused stops the renewal of Certificates from being triggered,
+	// apart from Certificates which are about to expire.
+	RenewalsPaused bool
+	// OCSPStapleRefreshInterval is the interval after which the OCSP response
+	// stored in the Secret of a Certificate which opts in to OCSP stapling is
+	// refreshed.
+	OCSPStapleRefreshInterval time.Duration
+	// DeprecatedCryptoPolicy defines the thresholds below which the
+	// cryptography used by an issued certificate is reported as deprecated.
+	DeprecatedCryptoPolicy pki.DeprecatedCryptoPolicy
+
+	// renewalsPausedOverride holds a value for RenewalsPaused which has been
+	// updated at runtime. It is shared between all Contexts built by the same
+	// ContextFactory.
+	renewalsPausedOverride *atomic.Pointer[bool]
+}
+
+// CurrentRenewalsPaused returns whether the renewal of Certificates is
+// paused, taking into account any update made at runtime using
+// ContextFactory.SetRenewalsPaused.
+func (o CertificateOptions) CurrentRenewalsPaused() bool {
+	if o.renewalsPausedOverride != nil {
+		if paused := o.renewalsPausedOverride.Load(); paused != nil {
+			return *paused
+		}
+	}
+	return o.RenewalsPaused
+}
+
+// ApproverOptions configures which CertificateRequests are approved by the
+// certificaterequests-approver controller.
+type ApproverOptions struct {
+	// CertificateRequestApprovalRules restricts the approved
+	// CertificateRequests to those matching at least one of the rules. If
+	// empty, all CertificateRequests are approved.
+	CertificateRequestApprovalRules []CertificateRequestApprovalRule
 }
 
 type SchedulerOptions struct {
 	// MaxConcurrentChallenges determines the maximum number of challenges that can be
 	// scheduled as 'processing' at once.
 	MaxConcurrentChallenges int
+
+	// maxConcurrentChallengesOverride holds a value for MaxConcurrentChallenges
+	// which has been updated at runtime. It is shared between all Contexts
+	// built by the same ContextFactory.
+	maxConcurrentChallengesOverride *atomic.Int64
+}
+
+// CurrentMaxConcurrentChallenges returns the maximum number of challenges that
+// can be scheduled as 'processing' at once, taking into account any update
+// made at runtime using ContextFactory.SetMaxConcurrentChallenges.
+func (o SchedulerOptions) CurrentMaxConcurrentChallenges() int {
+	if o.maxConcurrentChallengesOverride != nil {
+		if n := o.maxConcurrentChallengesOverride.Load(); n > 0 {
+			return int(n)
+		}
+	}
+	return o.MaxConcurrentChallenges
 }
 
 // ContextFactory is used for constructing new Contexts whose clients have been
@@ -250,6 +414,10 @@ type ContextFactory struct {
 
 	// ctx is the base controller Context that all Contexts will be built from.
 	ctx *Context
+
+	// rateLimiter is the RateLimiter shared by all clients of built Contexts.
+	// It is nil if client side rate limiting is disabled.
+	rateLimiter *reloadableRateLimiter
 }
 
 // NewContextFactory builds a ContextFactory that builds controller Contexts
@@ -271,13 +439,19 @@ func NewContextFactory(ctx context.Context, opts ContextOptions) (*ContextFactor
 	// preserved for all Contexts.
 	// Adapted from
 	// https://github.com/kubernetes/client-go/blob/v0.23.3/kubernetes/clientset.go#L431-L435
+	// The RateLimiter can be updated at runtime using SetKubernetesAPIRateLimits.
+	var rateLimiter *reloadableRateLimiter
 	if restConfig.RateLimiter == nil && restConfig.QPS > 0 {
 		if restConfig.Burst <= 0 {
 			return nil, errors.New("burst is required to be greater than 0 when RateLimiter is not set and QPS is set to greater than 0")
 		}
-		restConfig.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(restConfig.QPS, restConfig.Burst)
+		rateLimiter = newReloadableRateLimiter(restConfig.QPS, restConfig.Burst)
+		restConfig.RateLimiter = rateLimiter
 	}
 
+	opts.SchedulerOptions.maxConcurrentChallengesOverride = &atomic.Int64{}
+	opts.CertificateOptions.renewalsPausedOverride = &atomic.Pointer[bool]{}
+
 	clients, err := buildClients(restConfig, opts)
 	if err != nil {
 		return nil, err
@@ -320,9 +494,37 @@ func NewContextFactory(ctx context.Context, opts ContextOptions) (*ContextFactor
 			HTTP01ResourceMetadataInformersFactory: http01ResourceMetadataInformerFactory,
 			ContextOptions:                         opts,
 		},
+		rateLimiter: rateLimiter,
 	}, nil
 }
 
+// SetKubernetesAPIRateLimits updates the QPS and burst of the RateLimiter
+// shared by the clients of all built Contexts. An error is returned if client
+// side rate limiting was disabled when the ContextFactory was created.
+func (c *ContextFactory) SetKubernetesAPIRateLimits(qps float32, burst int) error {
+	if c.rateLimiter == nil {
+		return errors.New("client side rate limiting is disabled")
+	}
+	if qps <= 0 || burst <= 0 {
+		return errors.New("qps and burst are required to be greater than 0")
+	}
+
+	c.rateLimiter.setRateLimits(qps, burst)
+	return nil
+}
+
+// SetMaxConcurrentChallenges updates the maximum number of challenges that can
+// be scheduled as 'processing' at once for all built Contexts.
+func (c *ContextFactory) SetMaxConcurrentChallenges(n int) {
+	c.ctx.SchedulerOptions.maxConcurrentChallengesOverride.Store(int64(n))
+}
+
+// SetRenewalsPaused pauses or resumes the renewal of Certificates for all
+// built Contexts.
+func (c *ContextFactory) SetRenewalsPaused(paused bool) {
+	c.ctx.CertificateOptions.renewalsPausedOverride.Store(&paused)
+}
+
 // Build builds a new controller Context whose clients have a User Agent
 // derived from the optional component name.
 func (c *ContextFactory) Build(component ...string) (*Context, error) {
@@ -343,7 +545,7 @@ func (c *ContextFactory) Build(component ...string) (*Context, error) {
 	// logged properly.
 
 	c.log.V(logf.DebugLevel).Info("creating event broadcaster")
-	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster := record.NewBroadcaster(record.WithCorrelatorOptions(c.ctx.EventRecorderOptions.correlatorOptions()))
 	eventBroadcaster.StartLogging(logf.WithInfof(c.log.V(logf.DebugLevel)).Infof)
 	eventBroadcaster.StartRecordingToSink(&clientv1.EventSinkImpl{Interface: clients.kubeClient.CoreV1().Events("")})
 	recorder := eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: util.PrefixFromUserAgent(restConfig.UserAgent)})
@@ -19,8 +19,10 @@ package controller
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/record"
 )
 
 func Test_NewContextFactory(t *testing.T) {
@@ -40,3 +42,18 @@ func Test_NewContextFactory(t *testing.T) {
 	assert.NotNil(t, ctx1.RESTConfig.RateLimiter)
 	assert.Same(t, ctx1.RESTConfig.RateLimiter, ctx2.RESTConfig.RateLimiter)
 }
+
+func Test_EventRecorderOptions_correlatorOptions(t *testing.T) {
+	opts := EventRecorderOptions{
+		EventRecorderQPS:                 0.5,
+		EventRecorderBurst:               10,
+		EventRecorderDeduplicationWindow: 90 * time.Second,
+	}.correlatorOptions()
+
+	assert.Equal(t, float32(0.5), opts.QPS)
+	assert.Equal(t, 10, opts.BurstSize)
+	assert.Equal(t, 90, opts.MaxIntervalInSeconds)
+
+	// Zero values are passed through so that client-go applies its defaults.
+	assert.Equal(t, record.CorrelatorOptions{}, EventRecorderOptions{}.correlatorOptions())
+}
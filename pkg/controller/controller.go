@@ -21,12 +21,16 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/go-logr/logr"
+
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/flowcontrol"
 	"k8s.io/client-go/util/workqueue"
 
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
@@ -53,6 +57,17 @@ func NewController(
 	runDurationFuncs []runDurationFunc,
 	queue workqueue.TypedRateLimitingInterface[types.NamespacedName],
 ) Interface {
+	return newController(name, metrics, syncFunc, mustSync, runDurationFuncs, queue)
+}
+
+func newController(
+	name string,
+	metrics *metrics.Metrics,
+	syncFunc func(ctx context.Context, key types.NamespacedName) error,
+	mustSync []cache.InformerSynced,
+	runDurationFuncs []runDurationFunc,
+	queue workqueue.TypedRateLimitingInterface[types.NamespacedName],
+) *controller {
 	return &controller{
 		name:             name,
 		metrics:          metrics,
@@ -87,6 +102,14 @@ type controller struct {
 
 	// metrics is used to expose Prometheus, shared by all controllers
 	metrics *metrics.Metrics
+
+	// initialSyncLimiter, if set, limits the rate at which the items queued
+	// when the informers first synced are processed.
+	initialSyncLimiter flowcontrol.RateLimiter
+
+	// initialSyncRemaining is the number of items of the initial backlog
+	// which are still to be processed at the rate of initialSyncLimiter.
+	initialSyncRemaining atomic.Int64
 }
 
 // Run starts the controller loop
@@ -101,6 +124,12 @@ func (c *controller) Run(workers int, ctx context.Context) error {
 		return fmt.Errorf("error waiting for informer caches to sync")
 	}
 
+	if c.initialSyncLimiter != nil {
+		backlog := c.queue.Len()
+		c.initialSyncRemaining.Store(int64(backlog))
+		log.V(logf.InfoLevel).Info("throttling the processing of the initial backlog", "items", backlog, "items_per_second", c.initialSyncLimiter.QPS())
+	}
+
 	var wg sync.WaitGroup
 	for i := 0; i < workers; i++ {
 		wg.Add(1)
@@ -137,6 +166,12 @@ func (c *controller) worker(ctx context.Context) {
 			break
 		}
 
+		if err := c.waitForInitialSync(ctx, log); err != nil {
+			// The context has been cancelled, so the queue is shutting down.
+			c.queue.Done(obj)
+			continue
+		}
+
 		// use an inlined function so we can use defer
 		func() {
 			defer c.queue.Done(obj)
@@ -167,3 +202,22 @@ func (c *controller) worker(ctx context.Context) {
 	}
 	log.V(logf.DebugLevel).Info("exiting worker loop")
 }
+
+// waitForInitialSync blocks until the next item of the initial backlog may be
+// processed. It returns immediately once the initial backlog has been
+// processed, so that items are then processed as soon as they are queued.
+func (c *controller) waitForInitialSync(ctx context.Context, log logr.Logger) error {
+	if c.initialSyncLimiter == nil {
+		return nil
+	}
+
+	remaining := c.initialSyncRemaining.Add(-1)
+	if remaining < 0 {
+		return nil
+	}
+	if remaining == 0 {
+		log.V(logf.InfoLevel).Info("finished throttling the initial backlog")
+	}
+
+	return c.initialSyncLimiter.Wait(ctx)
+}
@@ -0,0 +1,91 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+)
+
+// countingRateLimiter counts the number of calls to Wait, without blocking.
+type countingRateLimiter struct {
+	flowcontrol.RateLimiter
+	waits atomic.Int32
+}
+
+func (r *countingRateLimiter) Wait(context.Context) error {
+	r.waits.Add(1)
+	return nil
+}
+
+func (r *countingRateLimiter) QPS() float32 {
+	return 1
+}
+
+func TestControllerThrottlesInitialBacklog(t *testing.T) {
+	queue := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[types.NamespacedName]())
+	for _, name := range []string{"a", "b", "c"} {
+		queue.Add(types.NamespacedName{Namespace: "ns", Name: name})
+	}
+
+	processed := make(chan types.NamespacedName)
+	c := newController("test", metrics.New(logr.Discard(), clock.RealClock{}), func(_ context.Context, key types.NamespacedName) error {
+		processed <- key
+		return nil
+	}, nil, nil, queue)
+	limiter := &countingRateLimiter{}
+	c.initialSyncLimiter = limiter
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = c.Run(1, ctx)
+	}()
+
+	receive := func() {
+		select {
+		case <-processed:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for an item to be processed")
+		}
+	}
+
+	for range 3 {
+		receive()
+	}
+	if waits := limiter.waits.Load(); waits != 3 {
+		t.Errorf("expected the 3 items of the initial backlog to be throttled, got %d", waits)
+	}
+
+	// Items queued once the initial backlog has been processed are not
+	// throttled.
+	queue.Add(types.NamespacedName{Namespace: "ns", Name: "d"})
+	receive()
+	if waits := limiter.waits.Load(); waits != 3 {
+		t.Errorf("expected items queued after the initial backlog not to be throttled, got %d waits", waits)
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+)
+
+// IssuanceLimiterRequeuePeriod is the delay after which an issuance which was
+// not allowed to start by the IssuanceLimiter is retried.
+const IssuanceLimiterRequeuePeriod = 10 * time.Second
+
+// IssuanceKey identifies an issuance tracked by an IssuanceLimiter, by the
+// kind and key of the resource being issued.
+type IssuanceKey struct {
+	Kind string
+	types.NamespacedName
+}
+
+// IssuanceLimiter limits the number of issuances in flight at once across all
+// of the controllers which share it, regardless of the number of workers of
+// each controller. An issuance is in flight from the time it is allowed to
+// start until it is finished, which the controllers do once the resource
+// reaches a final state or is deleted. Issuances beyond the limit are not
+// allowed to start, and should be retried after
+// IssuanceLimiterRequeuePeriod. A nil IssuanceLimiter does not limit
+// issuances.
+type IssuanceLimiter struct {
+	maxConcurrent int
+	metrics       *metrics.Metrics
+
+	lock     sync.Mutex
+	inFlight map[IssuanceKey]struct{}
+}
+
+// NewIssuanceLimiter returns an IssuanceLimiter which allows up to
+// maxConcurrent issuances in flight at once, recording its utilization with
+// the given metrics. If maxConcurrent is 0, nil is returned.
+func NewIssuanceLimiter(maxConcurrent int, m *metrics.Metrics) *IssuanceLimiter {
+	if m != nil {
+		m.SetIssuanceLimit(max(maxConcurrent, 0))
+	}
+	if maxConcurrent <= 0 {
+		return nil
+	}
+
+	return &IssuanceLimiter{
+		maxConcurrent: maxConcurrent,
+		metrics:       m,
+		inFlight:      make(map[IssuanceKey]struct{}),
+	}
+}
+
+// Start reports whether the issuance identified by the given key may proceed.
+// This is the case if it is already in flight, or if fewer than the maximum
+// number of issuances are in flight, in which case it is now in flight.
+func (l *IssuanceLimiter) Start(key IssuanceKey) bool {
+	if l == nil {
+		return true
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if _, ok := l.inFlight[key]; ok {
+		return true
+	}
+	if len(l.inFlight) >= l.maxConcurrent {
+		return false
+	}
+
+	l.inFlight[key] = struct{}{}
+	l.updateMetrics()
+	return true
+}
+
+// Finish marks the issuance identified by the given key as no longer in
+// flight. It is a no-op if the issuance is not in flight.
+func (l *IssuanceLimiter) Finish(key IssuanceKey) {
+	if l == nil {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if _, ok := l.inFlight[key]; !ok {
+		return
+	}
+
+	delete(l.inFlight, key)
+	l.updateMetrics()
+}
+
+// updateMetrics records the number of issuances in flight. The lock must be
+// held by the caller.
+func (l *IssuanceLimiter) updateMetrics() {
+	if l.metrics != nil {
+		l.metrics.SetIssuancesInFlight(len(l.inFlight))
+	}
+}
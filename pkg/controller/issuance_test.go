@@ -0,0 +1,67 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+)
+
+func TestIssuanceLimiter(t *testing.T) {
+	key := func(kind, name string) IssuanceKey {
+		return IssuanceKey{Kind: kind, NamespacedName: types.NamespacedName{Namespace: "ns", Name: name}}
+	}
+
+	t.Run("no limit configured does not limit issuances", func(t *testing.T) {
+		l := NewIssuanceLimiter(0, metrics.New(logr.Discard(), clock.RealClock{}))
+		assert.Nil(t, l)
+
+		for i := range 100 {
+			assert.True(t, l.Start(key("CertificateRequest", fmt.Sprintf("cr-%d", i))))
+		}
+		l.Finish(key("CertificateRequest", "cr-0"))
+	})
+
+	t.Run("issuances beyond the limit are not started until an issuance is finished", func(t *testing.T) {
+		l := NewIssuanceLimiter(2, metrics.New(logr.Discard(), clock.RealClock{}))
+
+		assert.True(t, l.Start(key("CertificateRequest", "a")))
+		assert.True(t, l.Start(key("CertificateRequest", "b")))
+		assert.False(t, l.Start(key("CertificateRequest", "c")), "expected the issuance beyond the limit not to start")
+
+		// Issuances which are in flight keep going without taking up another
+		// issuance, while the issuances of other kinds are tracked
+		// separately.
+		assert.True(t, l.Start(key("CertificateRequest", "a")))
+		assert.False(t, l.Start(key("Order", "a")))
+
+		// Finishing an issuance which is not in flight has no effect.
+		l.Finish(key("CertificateRequest", "c"))
+		assert.False(t, l.Start(key("CertificateRequest", "c")))
+
+		l.Finish(key("CertificateRequest", "a"))
+		assert.True(t, l.Start(key("CertificateRequest", "c")), "expected the issuance to start once an issuance was finished")
+		assert.False(t, l.Start(key("CertificateRequest", "a")))
+	})
+}
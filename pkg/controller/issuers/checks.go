@@ -56,6 +56,12 @@ func (c *controller) issuersForSecret(secret *corev1.Secret) ([]*v1.Issuer, erro
 				affected = append(affected, iss)
 				continue
 			}
+			if iss.Spec.CA.RootCASecretRef != nil {
+				if iss.Spec.CA.RootCASecretRef.Name == secret.Name {
+					affected = append(affected, iss)
+					continue
+				}
+			}
 		case iss.Spec.Venafi != nil:
 			if iss.Spec.Venafi.TPP != nil {
 				if iss.Spec.Venafi.TPP.CredentialsRef.Name == secret.Name {
@@ -88,6 +94,12 @@ func (c *controller) issuersForSecret(secret *corev1.Secret) ([]*v1.Issuer, erro
 					continue
 				}
 			}
+			if iss.Spec.Vault.Auth.ClientCertificate != nil {
+				if iss.Spec.Vault.Auth.ClientCertificate.SecretName == secret.Name {
+					affected = append(affected, iss)
+					continue
+				}
+			}
 			if iss.Spec.Vault.Auth.Kubernetes != nil {
 				if iss.Spec.Vault.Auth.Kubernetes.SecretRef.Name == secret.Name {
 					affected = append(affected, iss)
@@ -100,6 +112,25 @@ func (c *controller) issuersForSecret(secret *corev1.Secret) ([]*v1.Issuer, erro
 					continue
 				}
 			}
+			if iss.Spec.Vault.ClientCertSecretRef != nil {
+				if iss.Spec.Vault.ClientCertSecretRef.Name == secret.Name {
+					affected = append(affected, iss)
+					continue
+				}
+			}
+			if iss.Spec.Vault.ClientKeySecretRef != nil {
+				if iss.Spec.Vault.ClientKeySecretRef.Name == secret.Name {
+					affected = append(affected, iss)
+					continue
+				}
+			}
+		case iss.Spec.GoogleCAS != nil:
+			if iss.Spec.GoogleCAS.ServiceAccountSecretRef != nil {
+				if iss.Spec.GoogleCAS.ServiceAccountSecretRef.Name == secret.Name {
+					affected = append(affected, iss)
+					continue
+				}
+			}
 		}
 	}
 
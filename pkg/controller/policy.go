@@ -0,0 +1,103 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"github.com/cert-manager/cert-manager/internal/dnsglob"
+)
+
+// SecretTypeMismatchPolicy denotes how the controller handles an existing
+// Certificate Secret which is not of type `kubernetes.io/tls`.
+type SecretTypeMismatchPolicy string
+
+const (
+	// SecretTypeMismatchPolicyPreserve keeps the type of the existing Secret
+	// and writes the certificate data to it.
+	SecretTypeMismatchPolicyPreserve SecretTypeMismatchPolicy = "Preserve"
+
+	// SecretTypeMismatchPolicyFail refuses to write to an existing Secret of
+	// the wrong type and fails the issuance.
+	SecretTypeMismatchPolicyFail SecretTypeMismatchPolicy = "Fail"
+
+	// SecretTypeMismatchPolicyRecreate deletes an existing Secret of the wrong
+	// type and recreates it with the type `kubernetes.io/tls`.
+	SecretTypeMismatchPolicyRecreate SecretTypeMismatchPolicy = "Recreate"
+)
+
+// IssuedCertificateVerificationPolicy denotes how the controller handles a
+// certificate returned by an issuer which does not match what was requested.
+type IssuedCertificateVerificationPolicy string
+
+const (
+	// IssuedCertificateVerificationPolicyNone stores the returned certificate
+	// without verifying it against the request.
+	IssuedCertificateVerificationPolicyNone IssuedCertificateVerificationPolicy = "None"
+
+	// IssuedCertificateVerificationPolicyWarn records a warning event for a
+	// returned certificate which does not match the request, but stores it.
+	IssuedCertificateVerificationPolicyWarn IssuedCertificateVerificationPolicy = "Warn"
+
+	// IssuedCertificateVerificationPolicyStrict rejects a returned certificate
+	// which does not match the request and fails the CertificateRequest.
+	IssuedCertificateVerificationPolicyStrict IssuedCertificateVerificationPolicy = "Strict"
+)
+
+// SecretOwnershipConflictPolicy denotes how the controller handles multiple
+// Certificates which target the same Secret.
+type SecretOwnershipConflictPolicy string
+
+const (
+	// SecretOwnershipConflictPolicyOwner only issues the Certificate which
+	// owns the Secret.
+	SecretOwnershipConflictPolicyOwner SecretOwnershipConflictPolicy = "Owner"
+
+	// SecretOwnershipConflictPolicyRefuse does not issue any of the
+	// Certificates which target the same Secret.
+	SecretOwnershipConflictPolicyRefuse SecretOwnershipConflictPolicy = "Refuse"
+)
+
+// SecretDeletionPolicy denotes what happens to the Secret of a Certificate
+// when the Certificate is deleted.
+type SecretDeletionPolicy string
+
+const (
+	// SecretDeletionPolicyRetain leaves the Secret in place.
+	SecretDeletionPolicyRetain SecretDeletionPolicy = "Retain"
+
+	// SecretDeletionPolicyDelete deletes the Secret before the Certificate is
+	// removed.
+	SecretDeletionPolicyDelete SecretDeletionPolicy = "Delete"
+)
+
+// CertificateRequestApprovalRule matches CertificateRequests by the issuer
+// they reference and their namespace. Fields which are not set match any
+// CertificateRequest.
+type CertificateRequestApprovalRule struct {
+	IssuerName  string
+	IssuerKind  string
+	IssuerGroup string
+	Namespaces  []string
+}
+
+// DefaultIssuerRule selects the issuer used by the certificate-shim
+// controllers for the Certificates whose DNS names all match a pattern.
+type DefaultIssuerRule struct {
+	DNSNamePattern *dnsglob.Pattern
+	IssuerName     string
+	IssuerKind     string
+	IssuerGroup    string
+}
@@ -0,0 +1,77 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+var _ flowcontrol.RateLimiter = &reloadableRateLimiter{}
+
+// reloadableRateLimiter is a token bucket flowcontrol.RateLimiter whose QPS
+// and burst can be changed while clients are using it. Requests which are
+// already waiting for a token continue to wait on the previous bucket.
+type reloadableRateLimiter struct {
+	lock    sync.RWMutex
+	limiter flowcontrol.RateLimiter
+}
+
+func newReloadableRateLimiter(qps float32, burst int) *reloadableRateLimiter {
+	return &reloadableRateLimiter{
+		limiter: flowcontrol.NewTokenBucketRateLimiter(qps, burst),
+	}
+}
+
+// setRateLimits replaces the underlying token bucket with one using the given
+// QPS and burst.
+func (r *reloadableRateLimiter) setRateLimits(qps float32, burst int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.limiter.Stop()
+	r.limiter = flowcontrol.NewTokenBucketRateLimiter(qps, burst)
+}
+
+func (r *reloadableRateLimiter) current() flowcontrol.RateLimiter {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.limiter
+}
+
+func (r *reloadableRateLimiter) TryAccept() bool {
+	return r.current().TryAccept()
+}
+
+func (r *reloadableRateLimiter) Stop() {
+	r.current().Stop()
+}
+
+func (r *reloadableRateLimiter) QPS() float32 {
+	return r.current().QPS()
+}
+
+func (r *reloadableRateLimiter) Accept() {
+	r.current().Accept()
+}
+
+func (r *reloadableRateLimiter) Wait(ctx context.Context) error {
+	return r.current().Wait(ctx)
+}
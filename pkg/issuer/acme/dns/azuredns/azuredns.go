@@ -28,6 +28,7 @@ import (
 	"github.com/go-logr/logr"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/federated"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
@@ -43,15 +44,17 @@ type DNSProvider struct {
 }
 
 // NewDNSProviderCredentials returns a DNSProvider instance configured for the Azure
-// DNS service using static credentials from its parameters
-func NewDNSProviderCredentials(environment, clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, zoneName string, dns01Nameservers []string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity) (*DNSProvider, error) {
+// DNS service using static credentials from its parameters.
+// If tokenSource is set, the credentials of clientID are instead obtained and
+// refreshed by exchanging the tokens it provides.
+func NewDNSProviderCredentials(environment, clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, zoneName string, dns01Nameservers []string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity, tokenSource federated.TokenSource) (*DNSProvider, error) {
 	cloudCfg, err := getCloudConfiguration(environment)
 	if err != nil {
 		return nil, err
 	}
 
 	clientOpt := policy.ClientOptions{Cloud: cloudCfg}
-	cred, err := getAuthorization(clientOpt, clientID, clientSecret, tenantID, ambient, managedIdentity)
+	cred, err := getAuthorization(clientOpt, clientID, clientSecret, tenantID, ambient, managedIdentity, tokenSource)
 	if err != nil {
 		return nil, err
 	}
@@ -86,7 +89,21 @@ func getCloudConfiguration(name string) (cloud.Configuration, error) {
 	return cloud.Configuration{}, fmt.Errorf("unknown cloud configuration name: %s", name)
 }
 
-func getAuthorization(clientOpt policy.ClientOptions, clientID, clientSecret, tenantID string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity) (azcore.TokenCredential, error) {
+func getAuthorization(clientOpt policy.ClientOptions, clientID, clientSecret, tenantID string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity, tokenSource federated.TokenSource) (azcore.TokenCredential, error) {
+	if tokenSource != nil {
+		if clientID == "" || tenantID == "" {
+			return nil, fmt.Errorf("ClientID and TenantID must be set when using federated credentials")
+		}
+		logf.Log.V(logf.InfoLevel).Info("azuredns authenticating with clientID and federated credentials")
+		// The assertion is requested each time the credential is refreshed,
+		// so that the current token of the token source is exchanged.
+		cred, err := azidentity.NewClientAssertionCredential(tenantID, clientID, tokenSource.Token, &azidentity.ClientAssertionCredentialOptions{ClientOptions: clientOpt})
+		if err != nil {
+			return nil, err
+		}
+		return cred, nil
+	}
+
 	if clientID != "" {
 		logf.Log.V(logf.InfoLevel).Info("azuredns authenticating with clientID and secret key")
 		cred, err := azidentity.NewClientSecretCredential(tenantID, clientID, clientSecret, &azidentity.ClientSecretCredentialOptions{ClientOptions: clientOpt})
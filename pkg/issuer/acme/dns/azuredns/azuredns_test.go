@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/rand"
 
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/federated"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 )
 
@@ -62,7 +63,7 @@ func TestLiveAzureDnsPresent(t *testing.T) {
 	if !azureLiveTest {
 		t.Skip("skipping live test")
 	}
-	provider, err := NewDNSProviderCredentials("", azureClientID, azureClientSecret, azuresubscriptionID, azureTenantID, azureResourceGroupName, azureHostedZoneName, util.RecursiveNameservers, false, &v1.AzureManagedIdentity{})
+	provider, err := NewDNSProviderCredentials("", azureClientID, azureClientSecret, azuresubscriptionID, azureTenantID, azureResourceGroupName, azureHostedZoneName, util.RecursiveNameservers, false, &v1.AzureManagedIdentity{}, nil)
 	assert.NoError(t, err)
 
 	err = provider.Present(context.TODO(), azureDomain, "_acme-challenge."+azureDomain+".", "123d==")
@@ -73,7 +74,7 @@ func TestLiveAzureDnsPresentMultiple(t *testing.T) {
 	if !azureLiveTest {
 		t.Skip("skipping live test")
 	}
-	provider, err := NewDNSProviderCredentials("", azureClientID, azureClientSecret, azuresubscriptionID, azureTenantID, azureResourceGroupName, azureHostedZoneName, util.RecursiveNameservers, false, &v1.AzureManagedIdentity{})
+	provider, err := NewDNSProviderCredentials("", azureClientID, azureClientSecret, azuresubscriptionID, azureTenantID, azureResourceGroupName, azureHostedZoneName, util.RecursiveNameservers, false, &v1.AzureManagedIdentity{}, nil)
 	assert.NoError(t, err)
 
 	err = provider.Present(context.TODO(), azureDomain, "_acme-challenge."+azureDomain+".", "123d==")
@@ -89,7 +90,7 @@ func TestLiveAzureDnsCleanUp(t *testing.T) {
 
 	time.Sleep(time.Second * 5)
 
-	provider, err := NewDNSProviderCredentials("", azureClientID, azureClientSecret, azuresubscriptionID, azureTenantID, azureResourceGroupName, azureHostedZoneName, util.RecursiveNameservers, false, &v1.AzureManagedIdentity{})
+	provider, err := NewDNSProviderCredentials("", azureClientID, azureClientSecret, azuresubscriptionID, azureTenantID, azureResourceGroupName, azureHostedZoneName, util.RecursiveNameservers, false, &v1.AzureManagedIdentity{}, nil)
 	assert.NoError(t, err)
 
 	err = provider.CleanUp(context.TODO(), azureDomain, "_acme-challenge."+azureDomain+".", "123d==")
@@ -103,7 +104,7 @@ func TestLiveAzureDnsCleanUpMultiple(t *testing.T) {
 
 	time.Sleep(time.Second * 10)
 
-	provider, err := NewDNSProviderCredentials("", azureClientID, azureClientSecret, azuresubscriptionID, azureTenantID, azureResourceGroupName, azureHostedZoneName, util.RecursiveNameservers, false, &v1.AzureManagedIdentity{})
+	provider, err := NewDNSProviderCredentials("", azureClientID, azureClientSecret, azuresubscriptionID, azureTenantID, azureResourceGroupName, azureHostedZoneName, util.RecursiveNameservers, false, &v1.AzureManagedIdentity{}, nil)
 	assert.NoError(t, err)
 
 	err = provider.CleanUp(context.TODO(), azureDomain, "_acme-challenge."+azureDomain+".", "123d==")
@@ -115,21 +116,21 @@ func TestLiveAzureDnsCleanUpMultiple(t *testing.T) {
 func TestInvalidAzureDns(t *testing.T) {
 	validEnv := []string{"", "AzurePublicCloud", "AzureChinaCloud", "AzureUSGovernmentCloud"}
 	for _, env := range validEnv {
-		_, err := NewDNSProviderCredentials(env, "cid", "secret", "", "tenid", "", "", util.RecursiveNameservers, false, &v1.AzureManagedIdentity{})
+		_, err := NewDNSProviderCredentials(env, "cid", "secret", "", "tenid", "", "", util.RecursiveNameservers, false, &v1.AzureManagedIdentity{}, nil)
 		assert.NoError(t, err)
 	}
 
 	// Invalid environment
-	_, err := NewDNSProviderCredentials("invalid env", "cid", "secret", "", "tenid", "", "", util.RecursiveNameservers, false, &v1.AzureManagedIdentity{})
+	_, err := NewDNSProviderCredentials("invalid env", "cid", "secret", "", "tenid", "", "", util.RecursiveNameservers, false, &v1.AzureManagedIdentity{}, nil)
 	assert.Error(t, err)
 
 	// Invalid tenantID
-	_, err = NewDNSProviderCredentials("", "cid", "secret", "", "invalid env value", "", "", util.RecursiveNameservers, false, &v1.AzureManagedIdentity{})
+	_, err = NewDNSProviderCredentials("", "cid", "secret", "", "invalid env value", "", "", util.RecursiveNameservers, false, &v1.AzureManagedIdentity{}, nil)
 	assert.Error(t, err)
 }
 
 func TestAuthenticationError(t *testing.T) {
-	provider, err := NewDNSProviderCredentials("", "invalid-client-id", "invalid-client-secret", "subid", "tenid", "rg", "example.com", util.RecursiveNameservers, false, &v1.AzureManagedIdentity{})
+	provider, err := NewDNSProviderCredentials("", "invalid-client-id", "invalid-client-secret", "subid", "tenid", "rg", "example.com", util.RecursiveNameservers, false, &v1.AzureManagedIdentity{}, nil)
 	assert.NoError(t, err)
 
 	err = provider.Present(context.TODO(), "example.com", "_acme-challenge.example.com.", "123d==")
@@ -246,7 +247,7 @@ func TestGetAuthorizationFederatedSPT(t *testing.T) {
 		}
 		managedIdentity := &v1.AzureManagedIdentity{ClientID: ""}
 
-		spt, err := getAuthorization(clientOpt, "", "", "", ambient, managedIdentity)
+		spt, err := getAuthorization(clientOpt, "", "", "", ambient, managedIdentity, nil)
 		assert.NoError(t, err)
 
 		for federatedToken, accessToken := range tokens {
@@ -310,7 +311,7 @@ func TestGetAuthorizationFederatedSPT(t *testing.T) {
 			Transport: ts.Client(),
 		}
 
-		spt, err := getAuthorization(clientOpt, "", "", "", ambient, managedIdentity)
+		spt, err := getAuthorization(clientOpt, "", "", "", ambient, managedIdentity, nil)
 		assert.NoError(t, err)
 
 		token, err := spt.GetToken(context.TODO(), policy.TokenRequestOptions{Scopes: []string{"test"}})
@@ -361,7 +362,7 @@ func TestGetAuthorizationFederatedSPT(t *testing.T) {
 			Transport: ts.Client(),
 		}
 
-		spt, err := getAuthorization(clientOpt, "", "", "", ambient, managedIdentity)
+		spt, err := getAuthorization(clientOpt, "", "", "", ambient, managedIdentity, nil)
 		assert.NoError(t, err)
 
 		_, err = spt.GetToken(context.TODO(), policy.TokenRequestOptions{Scopes: []string{"test"}})
@@ -376,6 +377,59 @@ see logs for more information`, ts.URL))
 	})
 }
 
+func TestGetAuthorizationFederatedCredentials(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.RequestURI, "/.well-known/openid-configuration") {
+			tenantURL := strings.TrimSuffix("https://"+r.Host+r.RequestURI, "/.well-known/openid-configuration")
+
+			w.Header().Set("Content-Type", "application/json")
+			openidConfiguration := map[string]string{
+				"token_endpoint":         tenantURL + "/oauth2/token",
+				"authorization_endpoint": tenantURL + "/oauth2/authorize",
+				"issuer":                 "https://fakeIssuer.com",
+			}
+
+			if err := json.NewEncoder(w).Encode(openidConfiguration); err != nil {
+				assert.FailNow(t, err.Error())
+			}
+
+			return
+		}
+
+		if err := r.ParseForm(); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+
+		assert.Equal(t, "federatedToken", r.FormValue("client_assertion"), "client_assertion should be read from the token source")
+		assert.Equal(t, "fakeClientID", r.FormValue("client_id"), "client_id should match the ClientID of the issuer")
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]string{"access_token": "abc"}); err != nil {
+			assert.FailNow(t, err.Error())
+		}
+	}))
+	defer ts.Close()
+
+	clientOpt := policy.ClientOptions{
+		Cloud:     cloud.Configuration{ActiveDirectoryAuthorityHost: ts.URL},
+		Transport: ts.Client(),
+	}
+	tokenSource := federated.TokenSourceFunc(func(context.Context) (string, error) {
+		return "federatedToken", nil
+	})
+
+	// See TestGetAuthorizationFederatedSPT for why the `adfs` tenant is used.
+	spt, err := getAuthorization(clientOpt, "fakeClientID", "", "adfs", false, nil, tokenSource)
+	require.NoError(t, err)
+
+	token, err := spt.GetToken(context.TODO(), policy.TokenRequestOptions{Scopes: []string{"test"}})
+	require.NoError(t, err)
+	assert.Equal(t, "abc", token.Token)
+
+	_, err = getAuthorization(clientOpt, "", "", "adfs", false, nil, tokenSource)
+	assert.EqualError(t, err, "ClientID and TenantID must be set when using federated credentials")
+}
+
 // TestStabilizeResponseError tests that the ResponseError errors returned by the AzureDNS API are
 // changed to be stable. We want our error messages to be the same when the cause
 // is the same to avoid spurious challenge updates.
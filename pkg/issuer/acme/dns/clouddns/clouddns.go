@@ -19,9 +19,11 @@ import (
 
 	"github.com/go-logr/logr"
 	"golang.org/x/oauth2/google"
+	"golang.org/x/oauth2/google/externalaccount"
 	"google.golang.org/api/dns/v1"
 	"google.golang.org/api/option"
 
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/federated"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
@@ -36,11 +38,16 @@ type DNSProvider struct {
 }
 
 // NewDNSProvider returns a new DNSProvider Instance with configuration
-func NewDNSProvider(ctx context.Context, project string, saBytes []byte, dns01Nameservers []string, ambient bool, hostedZoneName string) (*DNSProvider, error) {
+func NewDNSProvider(ctx context.Context, project string, saBytes []byte, dns01Nameservers []string, ambient bool, hostedZoneName string, audience string, tokenSource federated.TokenSource) (*DNSProvider, error) {
 	// project is a required field
 	if project == "" {
 		return nil, fmt.Errorf("Google Cloud project name missing")
 	}
+	// if a token source is provided, we instantiate using federated
+	// credentials
+	if tokenSource != nil {
+		return NewDNSProviderFederated(ctx, project, audience, tokenSource, dns01Nameservers, hostedZoneName)
+	}
 	// if the service account bytes are not provided, we will attempt to instantiate
 	// with 'ambient credentials' (if they are allowed/enabled)
 	if len(saBytes) == 0 {
@@ -94,6 +101,53 @@ func NewDNSProviderCredentials(ctx context.Context, project string, dns01Nameser
 	}, nil
 }
 
+// NewDNSProviderFederated uses Workload Identity Federation to return a
+// DNSProvider instance configured for Google Cloud DNS. The tokens provided by
+// tokenSource are exchanged for short-lived credentials using the workload
+// identity pool provider given by audience, each time the credentials expire.
+func NewDNSProviderFederated(ctx context.Context, project, audience string, tokenSource federated.TokenSource, dns01Nameservers []string, hostedZoneName string) (*DNSProvider, error) {
+	if project == "" {
+		return nil, fmt.Errorf("Google Cloud project name missing")
+	}
+	if audience == "" {
+		return nil, fmt.Errorf("Google Cloud workload identity pool provider audience missing")
+	}
+
+	ts, err := externalaccount.NewTokenSource(ctx, externalaccount.Config{
+		Audience:             audience,
+		SubjectTokenType:     "urn:ietf:params:oauth:token-type:jwt",
+		TokenURL:             "https://sts.googleapis.com/v1/token",
+		Scopes:               []string{dns.NdevClouddnsReadwriteScope},
+		SubjectTokenSupplier: subjectTokenSupplier{tokenSource},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Unable to create Google Cloud federated token source: %v", err)
+	}
+
+	svc, err := dns.NewService(ctx, option.WithTokenSource(ts))
+	if err != nil {
+		return nil, fmt.Errorf("Unable to create Google Cloud DNS service: %v", err)
+	}
+
+	return &DNSProvider{
+		project:          project,
+		client:           svc,
+		dns01Nameservers: dns01Nameservers,
+		hostedZoneName:   hostedZoneName,
+		log:              logf.Log.WithName("clouddns"),
+	}, nil
+}
+
+// subjectTokenSupplier adapts a federated.TokenSource to the interface used
+// by the Google Cloud SDK to retrieve subject tokens.
+type subjectTokenSupplier struct {
+	tokenSource federated.TokenSource
+}
+
+func (s subjectTokenSupplier) SubjectToken(ctx context.Context, _ externalaccount.SupplierOptions) (string, error) {
+	return s.tokenSource.Token(ctx)
+}
+
 // NewDNSProviderServiceAccount uses the supplied service account JSON file to
 // return a DNSProvider instance configured for Google Cloud DNS.
 func NewDNSProviderServiceAccount(ctx context.Context, project string, saFile string, dns01Nameservers []string, hostedZoneName string) (*DNSProvider, error) {
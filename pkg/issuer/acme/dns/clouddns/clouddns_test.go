@@ -15,9 +15,12 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/oauth2/google"
+	"golang.org/x/oauth2/google/externalaccount"
 	"google.golang.org/api/dns/v1"
 
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/federated"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 )
 
@@ -54,6 +57,25 @@ func TestNewDNSProviderValidEnv(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestNewDNSProviderFederated(t *testing.T) {
+	tokenSource := federated.TokenSourceFunc(func(context.Context) (string, error) {
+		return "federated-token", nil
+	})
+	audience := "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool/providers/provider"
+
+	// federated credentials do not require ambient credentials
+	provider, err := NewDNSProvider(context.TODO(), "my-project", nil, util.RecursiveNameservers, false, "", audience, tokenSource)
+	require.NoError(t, err)
+	assert.Equal(t, "my-project", provider.project)
+
+	_, err = NewDNSProvider(context.TODO(), "my-project", nil, util.RecursiveNameservers, false, "", "", tokenSource)
+	assert.EqualError(t, err, "Google Cloud workload identity pool provider audience missing")
+
+	token, err := subjectTokenSupplier{tokenSource}.SubjectToken(context.TODO(), externalaccount.SupplierOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "federated-token", token)
+}
+
 func TestNewDNSProviderMissingCredErr(t *testing.T) {
 	t.Setenv("GCE_PROJECT", "")
 	_, err := NewDNSProviderEnvironment(context.TODO(), util.RecursiveNameservers, "")
@@ -23,6 +23,7 @@ import (
 	"strings"
 	"time"
 
+	miekgdns "github.com/miekg/dns"
 	authv1 "k8s.io/api/authentication/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,6 +42,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/clouddns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/cloudflare"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/digitalocean"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/federated"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/rfc2136"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/route53"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
@@ -59,10 +61,10 @@ type solver interface {
 // It is useful for mocking out a given provider since an alternate set of
 // constructors may be set.
 type dnsProviderConstructors struct {
-	cloudDNS     func(ctx context.Context, project string, serviceAccount []byte, dns01Nameservers []string, ambient bool, hostedZoneName string) (*clouddns.DNSProvider, error)
+	cloudDNS     func(ctx context.Context, project string, serviceAccount []byte, dns01Nameservers []string, ambient bool, hostedZoneName string, audience string, tokenSource federated.TokenSource) (*clouddns.DNSProvider, error)
 	cloudFlare   func(email, apikey, apiToken string, dns01Nameservers []string, userAgent string) (*cloudflare.DNSProvider, error)
-	route53      func(ctx context.Context, accessKey, secretKey, hostedZoneID, region, role, webIdentityToken string, ambient bool, dns01Nameservers []string, userAgent string) (*route53.DNSProvider, error)
-	azureDNS     func(environment, clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, hostedZoneName string, dns01Nameservers []string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity) (*azuredns.DNSProvider, error)
+	route53      func(ctx context.Context, accessKey, secretKey, hostedZoneID, region, role, webIdentityToken string, tokenSource federated.TokenSource, ambient bool, dns01Nameservers []string, userAgent string) (*route53.DNSProvider, error)
+	azureDNS     func(environment, clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, hostedZoneName string, dns01Nameservers []string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity, tokenSource federated.TokenSource) (*azuredns.DNSProvider, error)
 	acmeDNS      func(host string, accountJson []byte, dns01Nameservers []string) (*acmedns.DNSProvider, error)
 	digitalOcean func(token string, dns01Nameservers []string, userAgent string) (*digitalocean.DNSProvider, error)
 }
@@ -91,12 +93,12 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 		return webhookSolver.Present(req)
 	}
 
-	slv, providerConfig, err := s.solverForChallenge(ctx, ch)
+	slv, _, err := s.solverForChallenge(ctx, ch)
 	if err != nil {
 		return err
 	}
 
-	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME(providerConfig.CNAMEStrategy), s.DNS01Nameservers...)
+	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME(cnameStrategyForChallenge(ch)), s.DNS01Nameservers...)
 	if err != nil {
 		return err
 	}
@@ -110,7 +112,7 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
 	log := logf.WithResource(logf.FromContext(ctx, "Check"), ch).WithValues("domain", ch.Spec.DNSName)
 
-	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, false, s.DNS01Nameservers...)
+	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME(cnameStrategyForChallenge(ch)), s.DNS01Nameservers...)
 	if err != nil {
 		return err
 	}
@@ -149,12 +151,12 @@ func (s *Solver) CleanUp(ctx context.Context, ch *cmacme.Challenge) error {
 		return webhookSolver.CleanUp(req)
 	}
 
-	slv, providerConfig, err := s.solverForChallenge(ctx, ch)
+	slv, _, err := s.solverForChallenge(ctx, ch)
 	if err != nil {
 		return err
 	}
 
-	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME(providerConfig.CNAMEStrategy), s.DNS01Nameservers...)
+	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME(cnameStrategyForChallenge(ch)), s.DNS01Nameservers...)
 	if err != nil {
 		return err
 	}
@@ -166,6 +168,35 @@ func followCNAME(strategy cmacme.CNAMEStrategy) bool {
 	return strategy == cmacme.FollowStrategy
 }
 
+// cnameStrategyForChallenge returns the CNAME strategy to use for the
+// challenge's domain. The strategy of the most specific DNS zone in the
+// solver's selector which contains the domain is used, falling back to the
+// strategy configured on the DNS01 solver.
+func cnameStrategyForChallenge(ch *cmacme.Challenge) cmacme.CNAMEStrategy {
+	var strategy cmacme.CNAMEStrategy
+	if ch.Spec.Solver.DNS01 != nil {
+		strategy = ch.Spec.Solver.DNS01.CNAMEStrategy
+	}
+	if ch.Spec.Solver.Selector == nil {
+		return strategy
+	}
+
+	maxMatchingLabels := 0
+	for _, zoneStrategy := range ch.Spec.Solver.Selector.DNSZoneCNAMEStrategies {
+		numMatchingLabels := miekgdns.CompareDomainName(zoneStrategy.DNSZone, ch.Spec.DNSName)
+		if numMatchingLabels != miekgdns.CountLabel(zoneStrategy.DNSZone) {
+			continue
+		}
+
+		if numMatchingLabels > maxMatchingLabels {
+			maxMatchingLabels = numMatchingLabels
+			strategy = zoneStrategy.CNAMEStrategy
+		}
+	}
+
+	return strategy
+}
+
 func extractChallengeSolverConfig(ch *cmacme.Challenge) (*cmacme.ACMEChallengeSolverDNS01, error) {
 	if ch.Spec.Solver.DNS01 == nil {
 		return nil, fmt.Errorf("no dns01 challenge solver configuration found")
@@ -237,8 +268,17 @@ func (s *Solver) solverForChallenge(ctx context.Context, ch *cmacme.Challenge) (
 			}
 		}
 
+		var audience string
+		if providerConfig.CloudDNS.FederatedCredentials != nil {
+			audience = providerConfig.CloudDNS.FederatedCredentials.Audience
+		}
+		// the default audience accepted by a workload identity pool provider
+		// is its full resource name prefixed with `https:`
+		tokenSource := s.federatedTokenSource(providerConfig.CloudDNS.FederatedCredentials, resourceNamespace, "https:"+audience)
+
 		// attempt to construct the cloud dns provider
-		impl, err = s.dnsProviderConstructors.cloudDNS(ctx, providerConfig.CloudDNS.Project, keyData, s.DNS01Nameservers, s.CanUseAmbientCredentialsFromRef(ch.Spec.IssuerRef), providerConfig.CloudDNS.HostedZoneName)
+		impl, err = s.dnsProviderConstructors.cloudDNS(ctx, providerConfig.CloudDNS.Project, keyData, s.DNS01Nameservers, s.CanUseAmbientCredentialsFromRef(ch.Spec.IssuerRef), providerConfig.CloudDNS.HostedZoneName,
+			audience, tokenSource)
 		if err != nil {
 			return nil, nil, fmt.Errorf("error instantiating google clouddns challenge solver: %s", err)
 		}
@@ -373,6 +413,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, ch *cmacme.Challenge) (
 			providerConfig.Route53.Region,
 			providerConfig.Route53.Role,
 			webIdentityToken,
+			s.federatedTokenSource(providerConfig.Route53.FederatedCredentials, resourceNamespace, "sts.amazonaws.com"),
 			canUseAmbientCredentials,
 			s.DNS01Nameservers,
 			s.RESTConfig.UserAgent,
@@ -385,7 +426,8 @@ func (s *Solver) solverForChallenge(ctx context.Context, ch *cmacme.Challenge) (
 		secret := ""
 		// if ClientID is empty, then we try to use MSI (azure metadata API for credentials)
 		// if ClientID is empty we don't even try to get the ClientSecret because it would not be used
-		if providerConfig.AzureDNS.ClientID != "" {
+		// if FederatedCredentials are set, they are used instead of the ClientSecret
+		if providerConfig.AzureDNS.ClientID != "" && providerConfig.AzureDNS.FederatedCredentials == nil {
 			clientSecret, err := s.secretLister.Secrets(resourceNamespace).Get(providerConfig.AzureDNS.ClientSecret.Name)
 			if err != nil {
 				return nil, nil, fmt.Errorf("error getting azuredns client secret: %s", err)
@@ -408,6 +450,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, ch *cmacme.Challenge) (
 			s.DNS01Nameservers,
 			canUseAmbientCredentials,
 			providerConfig.AzureDNS.ManagedIdentity,
+			s.federatedTokenSource(providerConfig.AzureDNS.FederatedCredentials, resourceNamespace, "api://AzureADTokenExchange"),
 		)
 		if err != nil {
 			return nil, nil, fmt.Errorf("error instantiating azuredns challenge solver: %s", err)
@@ -450,7 +493,7 @@ func (s *Solver) prepareChallengeRequest(ctx context.Context, ch *cmacme.Challen
 		return nil, nil, err
 	}
 
-	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME(dns01Config.CNAMEStrategy), s.DNS01Nameservers...)
+	fqdn, err := util.DNS01LookupFQDN(ctx, ch.Spec.DNSName, followCNAME(cnameStrategyForChallenge(ch)), s.DNS01Nameservers...)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -547,6 +590,25 @@ func NewSolver(ctx *controller.Context) (*Solver, error) {
 	}, nil
 }
 
+// federatedTokenSource returns a source of bound tokens for the ServiceAccount
+// referenced by the given federated credentials, or nil if they are not set.
+// The ServiceAccount is always in the namespace of the issuer's resources, so
+// that an Issuer can only use the ServiceAccounts of its own namespace.
+// The tokens are requested for defaultAudience unless the reference sets its
+// own audiences.
+func (s *Solver) federatedTokenSource(fc *cmacme.ACMEIssuerDNS01FederatedCredentials, namespace, defaultAudience string) federated.TokenSource {
+	if fc == nil {
+		return nil
+	}
+
+	audiences := []string{defaultAudience}
+	if len(fc.ServiceAccountRef.TokenAudiences) != 0 {
+		audiences = fc.ServiceAccountRef.TokenAudiences
+	}
+
+	return federated.NewServiceAccountTokenSource(s.Client, namespace, fc.ServiceAccountRef.Name, audiences)
+}
+
 func (s *Solver) loadSecretData(selector *cmmeta.SecretKeySelector, ns string) ([]byte, error) {
 	secret, err := s.secretLister.Secrets(ns).Get(selector.Name)
 	if err != nil {
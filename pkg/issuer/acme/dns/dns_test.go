@@ -21,10 +21,12 @@ import (
 	"reflect"
 	"testing"
 
+	authv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	coretesting "k8s.io/client-go/testing"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -32,6 +34,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/test"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/acmedns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/cloudflare"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/federated"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 )
 
@@ -489,7 +492,7 @@ func TestRoute53TrimCreds(t *testing.T) {
 	expectedR53Call := []fakeDNSProviderCall{
 		{
 			name: "route53",
-			args: []interface{}{"test_with_spaces", "AKIENDINNEWLINE", "", "us-west-2", "", "", false, util.RecursiveNameservers},
+			args: []interface{}{"test_with_spaces", "AKIENDINNEWLINE", "", "us-west-2", "", "", nil, false, util.RecursiveNameservers},
 		},
 	}
 
@@ -552,7 +555,7 @@ func TestRoute53SecretAccessKey(t *testing.T) {
 	expectedR53Call := []fakeDNSProviderCall{
 		{
 			name: "route53",
-			args: []interface{}{"AWSACCESSKEYID", "AKIENDINNEWLINE", "", "us-west-2", "", "", false, util.RecursiveNameservers},
+			args: []interface{}{"AWSACCESSKEYID", "AKIENDINNEWLINE", "", "us-west-2", "", "", nil, false, util.RecursiveNameservers},
 		},
 	}
 
@@ -605,7 +608,7 @@ func TestRoute53AmbientCreds(t *testing.T) {
 			result{
 				expectedCall: &fakeDNSProviderCall{
 					name: "route53",
-					args: []interface{}{"", "", "", "us-west-2", "", "", true, util.RecursiveNameservers},
+					args: []interface{}{"", "", "", "us-west-2", "", "", nil, true, util.RecursiveNameservers},
 				},
 			},
 		},
@@ -643,7 +646,7 @@ func TestRoute53AmbientCreds(t *testing.T) {
 			result{
 				expectedCall: &fakeDNSProviderCall{
 					name: "route53",
-					args: []interface{}{"", "", "", "us-west-2", "", "", false, util.RecursiveNameservers},
+					args: []interface{}{"", "", "", "us-west-2", "", "", nil, false, util.RecursiveNameservers},
 				},
 			},
 		},
@@ -712,7 +715,7 @@ func TestRoute53AssumeRole(t *testing.T) {
 			result{
 				expectedCall: &fakeDNSProviderCall{
 					name: "route53",
-					args: []interface{}{"", "", "", "us-west-2", "my-role", "", true, util.RecursiveNameservers},
+					args: []interface{}{"", "", "", "us-west-2", "my-role", "", nil, true, util.RecursiveNameservers},
 				},
 			},
 		},
@@ -751,7 +754,7 @@ func TestRoute53AssumeRole(t *testing.T) {
 			result{
 				expectedCall: &fakeDNSProviderCall{
 					name: "route53",
-					args: []interface{}{"", "", "", "us-west-2", "my-other-role", "", false, util.RecursiveNameservers},
+					args: []interface{}{"", "", "", "us-west-2", "my-other-role", "", nil, false, util.RecursiveNameservers},
 				},
 			},
 		},
@@ -774,3 +777,201 @@ func TestRoute53AssumeRole(t *testing.T) {
 		}
 	}
 }
+
+func TestFederatedCredentials(t *testing.T) {
+	fc := &cmacme.ACMEIssuerDNS01FederatedCredentials{
+		ServiceAccountRef: cmacme.ServiceAccountRef{Name: "dns01"},
+	}
+	poolProvider := "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool/providers/provider"
+
+	tests := map[string]struct {
+		dns01             *cmacme.ACMEChallengeSolverDNS01
+		issuerKind        string
+		tokenSourceArg    int
+		expectedNamespace string
+		expectedAudiences []string
+	}{
+		"route53 requests tokens for the sts.amazonaws.com audience": {
+			dns01: &cmacme.ACMEChallengeSolverDNS01{
+				Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{Role: "my-role", FederatedCredentials: fc},
+			},
+			tokenSourceArg:    6,
+			expectedNamespace: fakeIssuerNamespace,
+			expectedAudiences: []string{"sts.amazonaws.com"},
+		},
+		"azuredns requests tokens for the api://AzureADTokenExchange audience": {
+			dns01: &cmacme.ACMEChallengeSolverDNS01{
+				AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{ClientID: "client-id", TenantID: "tenant-id", FederatedCredentials: fc},
+			},
+			tokenSourceArg:    9,
+			expectedNamespace: fakeIssuerNamespace,
+			expectedAudiences: []string{"api://AzureADTokenExchange"},
+		},
+		"clouddns requests tokens for the workload identity pool provider": {
+			dns01: &cmacme.ACMEChallengeSolverDNS01{
+				CloudDNS: &cmacme.ACMEIssuerDNS01ProviderCloudDNS{
+					Project: "my-project",
+					FederatedCredentials: &cmacme.ACMEIssuerDNS01FederatedCredentials{
+						ServiceAccountRef: cmacme.ServiceAccountRef{Name: "dns01"},
+						Audience:          poolProvider,
+					},
+				},
+			},
+			tokenSourceArg:    6,
+			expectedNamespace: fakeIssuerNamespace,
+			expectedAudiences: []string{"https:" + poolProvider},
+		},
+		"tokens are requested for the audiences of the service account reference if set": {
+			dns01: &cmacme.ACMEChallengeSolverDNS01{
+				Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{
+					Role: "my-role",
+					FederatedCredentials: &cmacme.ACMEIssuerDNS01FederatedCredentials{
+						ServiceAccountRef: cmacme.ServiceAccountRef{Name: "dns01", TokenAudiences: []string{"my-audience"}},
+					},
+				},
+			},
+			tokenSourceArg:    6,
+			expectedNamespace: fakeIssuerNamespace,
+			expectedAudiences: []string{"my-audience"},
+		},
+		"a ClusterIssuer requests tokens in the cluster resource namespace": {
+			dns01: &cmacme.ACMEChallengeSolverDNS01{
+				Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{Role: "my-role", FederatedCredentials: fc},
+			},
+			issuerKind:        "ClusterIssuer",
+			tokenSourceArg:    6,
+			expectedNamespace: fakeClusterIssuerResourceNamespace,
+			expectedAudiences: []string{"sts.amazonaws.com"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := &solverFixture{
+				Builder: &test.Builder{
+					Context: &controller.Context{
+						RESTConfig: new(rest.Config),
+						ContextOptions: controller.ContextOptions{
+							IssuerOptions: controller.IssuerOptions{
+								ClusterResourceNamespace: fakeClusterIssuerResourceNamespace,
+							},
+						},
+					},
+				},
+				dnsProviders: newFakeDNSProviders(),
+				Challenge: &cmacme.Challenge{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: fakeIssuerNamespace,
+					},
+					Spec: cmacme.ChallengeSpec{
+						Solver: cmacme.ACMEChallengeSolver{
+							DNS01: tt.dns01,
+						},
+						IssuerRef: cmmeta.ObjectReference{
+							Name: "test-issuer",
+							Kind: tt.issuerKind,
+						},
+					},
+				},
+			}
+			f.Setup(t)
+			defer f.Finish(t)
+
+			var requests []coretesting.CreateActionImpl
+			f.FakeKubeClient().PrependReactor("create", "serviceaccounts", func(action coretesting.Action) (bool, runtime.Object, error) {
+				requests = append(requests, action.(coretesting.CreateActionImpl))
+				return true, &authv1.TokenRequest{Status: authv1.TokenRequestStatus{Token: "federated-token"}}, nil
+			})
+
+			if _, _, err := f.Solver.solverForChallenge(context.Background(), f.Challenge); err != nil {
+				t.Fatalf("expected solverFor to not error, but got: %s", err)
+			}
+			if len(f.dnsProviders.calls) != 1 {
+				t.Fatalf("expected a single DNS provider to be constructed, got %+v", f.dnsProviders.calls)
+			}
+
+			tokenSource, ok := f.dnsProviders.calls[0].args[tt.tokenSourceArg].(federated.TokenSource)
+			if !ok || tokenSource == nil {
+				t.Fatalf("expected the DNS provider to be constructed with a token source, got %+v", f.dnsProviders.calls[0].args)
+			}
+			token, err := tokenSource.Token(context.Background())
+			if err != nil {
+				t.Fatalf("expected the token source to not error, but got: %s", err)
+			}
+			if token != "federated-token" {
+				t.Errorf("expected token %q, got %q", "federated-token", token)
+			}
+
+			if len(requests) != 1 {
+				t.Fatalf("expected a single token request, got %d", len(requests))
+			}
+			if requests[0].GetNamespace() != tt.expectedNamespace || requests[0].Name != "dns01" || requests[0].GetSubresource() != "token" {
+				t.Errorf("expected a token request for %s/dns01, got %s/%s/%s", tt.expectedNamespace, requests[0].GetNamespace(), requests[0].Name, requests[0].GetSubresource())
+			}
+			if audiences := requests[0].GetObject().(*authv1.TokenRequest).Spec.Audiences; !reflect.DeepEqual(tt.expectedAudiences, audiences) {
+				t.Errorf("expected audiences %v, got %v", tt.expectedAudiences, audiences)
+			}
+		})
+	}
+}
+
+func TestCNAMEStrategyForChallenge(t *testing.T) {
+	selector := &cmacme.CertificateDNSNameSelector{
+		DNSZoneCNAMEStrategies: []cmacme.DNSZoneCNAMEStrategy{
+			{DNSZone: "example.com", CNAMEStrategy: cmacme.FollowStrategy},
+			{DNSZone: "internal.example.com", CNAMEStrategy: cmacme.NoneStrategy},
+		},
+	}
+	tests := map[string]struct {
+		dnsName  string
+		selector *cmacme.CertificateDNSNameSelector
+		expected cmacme.CNAMEStrategy
+	}{
+		"no selector uses the solver's strategy": {
+			dnsName:  "www.example.com",
+			expected: cmacme.FollowStrategy,
+		},
+		"matching zone strategy is used": {
+			dnsName:  "www.example.com",
+			selector: selector,
+			expected: cmacme.FollowStrategy,
+		},
+		"most specific matching zone strategy is used": {
+			dnsName:  "app.internal.example.com",
+			selector: selector,
+			expected: cmacme.NoneStrategy,
+		},
+		"zone apex matches its own zone": {
+			dnsName:  "internal.example.com",
+			selector: selector,
+			expected: cmacme.NoneStrategy,
+		},
+		"no matching zone uses the solver's strategy": {
+			dnsName: "www.example.org",
+			selector: &cmacme.CertificateDNSNameSelector{
+				DNSZoneCNAMEStrategies: []cmacme.DNSZoneCNAMEStrategy{
+					{DNSZone: "example.com", CNAMEStrategy: cmacme.NoneStrategy},
+				},
+			},
+			expected: cmacme.FollowStrategy,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ch := &cmacme.Challenge{
+				Spec: cmacme.ChallengeSpec{
+					DNSName: tt.dnsName,
+					Solver: cmacme.ACMEChallengeSolver{
+						Selector: tt.selector,
+						DNS01: &cmacme.ACMEChallengeSolverDNS01{
+							CNAMEStrategy: cmacme.FollowStrategy,
+						},
+					},
+				},
+			}
+			if got := cnameStrategyForChallenge(ch); got != tt.expected {
+				t.Errorf("expected strategy %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package federated provides the bound ServiceAccount tokens which DNS01
+// providers exchange for short-lived credentials of their cloud provider,
+// using OIDC federation.
+package federated
+
+import (
+	"context"
+	"fmt"
+
+	authv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+)
+
+// TokenSource returns a ServiceAccount token to be exchanged for cloud
+// provider credentials. It is called each time the cloud provider SDK
+// refreshes its credentials, so that it must always return a valid token.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenSourceFunc is an adapter to allow the use of ordinary functions as a
+// TokenSource.
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token returns f(ctx).
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+// ServiceAccountTokenSource is a TokenSource which requests bound tokens for a
+// ServiceAccount using the TokenRequest API.
+// A new token is requested each time a token is requested, which only
+// happens when the cloud provider credentials need to be refreshed.
+type ServiceAccountTokenSource struct {
+	client         kubernetes.Interface
+	namespace      string
+	serviceAccount string
+	audiences      []string
+}
+
+// NewServiceAccountTokenSource returns a TokenSource which requests tokens for
+// the given ServiceAccount, with the given audiences.
+func NewServiceAccountTokenSource(client kubernetes.Interface, namespace, serviceAccount string, audiences []string) *ServiceAccountTokenSource {
+	return &ServiceAccountTokenSource{
+		client:         client,
+		namespace:      namespace,
+		serviceAccount: serviceAccount,
+		audiences:      audiences,
+	}
+}
+
+// Token requests a new token for the ServiceAccount.
+func (t *ServiceAccountTokenSource) Token(ctx context.Context) (string, error) {
+	tokenRequest, err := t.client.CoreV1().ServiceAccounts(t.namespace).CreateToken(ctx, t.serviceAccount, &authv1.TokenRequest{
+		Spec: authv1.TokenRequestSpec{
+			Audiences:         t.audiences,
+			ExpirationSeconds: ptr.To(int64(600)),
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to request federated token for %s/%s: %w", t.namespace, t.serviceAccount, err)
+	}
+
+	return tokenRequest.Status.Token, nil
+}
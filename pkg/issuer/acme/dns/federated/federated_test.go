@@ -0,0 +1,70 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package federated
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	coretesting "k8s.io/client-go/testing"
+)
+
+func TestServiceAccountTokenSource(t *testing.T) {
+	client := fake.NewSimpleClientset()
+
+	var requests []coretesting.CreateActionImpl
+	client.PrependReactor("create", "serviceaccounts", func(action coretesting.Action) (bool, runtime.Object, error) {
+		create := action.(coretesting.CreateActionImpl)
+		if create.GetSubresource() != "token" {
+			return false, nil, nil
+		}
+		requests = append(requests, create)
+		if len(requests) == 3 {
+			return true, nil, errors.New("forbidden")
+		}
+		return true, &authv1.TokenRequest{Status: authv1.TokenRequestStatus{Token: fmt.Sprintf("token-%d", len(requests))}}, nil
+	})
+
+	ts := NewServiceAccountTokenSource(client, "test-ns", "dns01", []string{"sts.amazonaws.com"})
+
+	// A new token is requested each time, so that credentials refreshed by
+	// the cloud provider SDK are never exchanged for an expired token.
+	token, err := ts.Token(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", token)
+
+	token, err = ts.Token(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, "token-2", token)
+
+	_, err = ts.Token(context.TODO())
+	assert.EqualError(t, err, "failed to request federated token for test-ns/dns01: forbidden")
+
+	require.Len(t, requests, 3)
+	for _, request := range requests {
+		assert.Equal(t, "test-ns", request.GetNamespace())
+		assert.Equal(t, "dns01", request.Name)
+		assert.Equal(t, []string{"sts.amazonaws.com"}, request.GetObject().(*authv1.TokenRequest).Spec.Audiences)
+	}
+}
@@ -22,12 +22,14 @@ import (
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/smithy-go/logging"
 	"github.com/aws/smithy-go/middleware"
 
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/federated"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
@@ -51,6 +53,7 @@ type sessionProvider struct {
 	Region           string
 	Role             string
 	WebIdentityToken string
+	TokenSource      federated.TokenSource
 	StsProvider      func(aws.Config) StsClient
 	userAgent        string
 }
@@ -64,8 +67,12 @@ func (d *sessionProvider) GetSession(ctx context.Context) (aws.Config, error) {
 	switch {
 	case d.Role == "" && d.WebIdentityToken != "":
 		return aws.Config{}, fmt.Errorf("unable to construct route53 provider: role must be set when web identity token is set")
+	case d.TokenSource != nil && d.Role == "":
+		return aws.Config{}, fmt.Errorf("unable to construct route53 provider: role must be set when federated credentials are used")
+	case d.TokenSource != nil && (d.WebIdentityToken != "" || d.AccessKeyID != "" || d.SecretAccessKey != ""):
+		return aws.Config{}, fmt.Errorf("unable to construct route53 provider: federated credentials cannot be used with access keys or a web identity token")
 	case d.AccessKeyID == "" && d.SecretAccessKey == "":
-		if !d.Ambient && d.WebIdentityToken == "" {
+		if !d.Ambient && d.WebIdentityToken == "" && d.TokenSource == nil {
 			return aws.Config{}, fmt.Errorf("unable to construct route53 provider: empty credentials; perhaps you meant to enable ambient credentials?")
 		}
 	case d.AccessKeyID == "" || d.SecretAccessKey == "":
@@ -73,7 +80,7 @@ func (d *sessionProvider) GetSession(ctx context.Context) (aws.Config, error) {
 		return aws.Config{}, fmt.Errorf("unable to construct route53 provider: only one of access and secret key was provided")
 	}
 
-	useAmbientCredentials := d.Ambient && (d.AccessKeyID == "" && d.SecretAccessKey == "") && d.WebIdentityToken == ""
+	useAmbientCredentials := d.Ambient && (d.AccessKeyID == "" && d.SecretAccessKey == "") && d.WebIdentityToken == "" && d.TokenSource == nil
 
 	log := logf.FromContext(ctx)
 	optFns := []func(*config.LoadOptions) error{
@@ -131,6 +138,8 @@ func (d *sessionProvider) GetSession(ctx context.Context) (aws.Config, error) {
 	switch {
 	case d.Role != "" && d.WebIdentityToken != "":
 		log.V(logf.DebugLevel).Info("using assume role with web identity")
+	case d.TokenSource != nil:
+		log.V(logf.DebugLevel).Info("using federated credentials")
 	case useAmbientCredentials:
 		log.V(logf.DebugLevel).Info("using ambient credentials")
 		// Leaving credentials unset results in a default credential chain being
@@ -146,7 +155,7 @@ func (d *sessionProvider) GetSession(ctx context.Context) (aws.Config, error) {
 		return aws.Config{}, fmt.Errorf("unable to create aws config: %s", err)
 	}
 
-	if d.Role != "" && d.WebIdentityToken == "" {
+	if d.Role != "" && d.WebIdentityToken == "" && d.TokenSource == nil {
 		log.V(logf.DebugLevel).WithValues("role", d.Role).Info("assuming role")
 		stsSvc := d.StsProvider(cfg)
 		result, err := stsSvc.AssumeRole(ctx, &sts.AssumeRoleInput{
@@ -184,6 +193,22 @@ func (d *sessionProvider) GetSession(ctx context.Context) (aws.Config, error) {
 		)
 	}
 
+	if d.TokenSource != nil {
+		log.V(logf.DebugLevel).WithValues("role", d.Role).Info("assuming role with federated credentials")
+
+		// Unlike the static credentials above, the credentials are obtained
+		// lazily and refreshed by the cache before they expire, each time
+		// exchanging the current token of the token source.
+		cfg.Credentials = aws.NewCredentialsCache(stscreds.NewWebIdentityRoleProvider(
+			d.StsProvider(cfg),
+			d.Role,
+			identityTokenRetriever{ctx: context.WithoutCancel(ctx), tokenSource: d.TokenSource},
+			func(o *stscreds.WebIdentityRoleOptions) {
+				o.RoleSessionName = "cert-manager"
+			},
+		))
+	}
+
 	// Log some key values of the loaded configuration, so that users can
 	// self-diagnose problems in the field. If users shared logs in their bug
 	// reports, we can know whether the region was detected and whether an
@@ -203,7 +228,22 @@ func (d *sessionProvider) GetSession(ctx context.Context) (aws.Config, error) {
 	return cfg, nil
 }
 
-func newSessionProvider(accessKeyID, secretAccessKey, region, role string, webIdentityToken string, ambient bool, userAgent string) *sessionProvider {
+// identityTokenRetriever adapts a federated.TokenSource to the interface used
+// by the AWS SDK to retrieve web identity tokens.
+type identityTokenRetriever struct {
+	ctx         context.Context
+	tokenSource federated.TokenSource
+}
+
+func (r identityTokenRetriever) GetIdentityToken() ([]byte, error) {
+	token, err := r.tokenSource.Token(r.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(token), nil
+}
+
+func newSessionProvider(accessKeyID, secretAccessKey, region, role string, webIdentityToken string, tokenSource federated.TokenSource, ambient bool, userAgent string) *sessionProvider {
 	return &sessionProvider{
 		AccessKeyID:      accessKeyID,
 		SecretAccessKey:  secretAccessKey,
@@ -211,6 +251,7 @@ func newSessionProvider(accessKeyID, secretAccessKey, region, role string, webId
 		Region:           region,
 		Role:             role,
 		WebIdentityToken: webIdentityToken,
+		TokenSource:      tokenSource,
 		StsProvider:      defaultSTSProvider,
 		userAgent:        userAgent,
 	}
@@ -223,14 +264,17 @@ func defaultSTSProvider(cfg aws.Config) StsClient {
 // NewDNSProvider returns a DNSProvider instance configured for the AWS
 // Route 53 service using static credentials from its parameters or, if they're
 // unset and the 'ambient' option is set, credentials from the environment.
+// If tokenSource is set, short-lived credentials of role are obtained and
+// refreshed using AssumeRoleWithWebIdentity with the tokens it provides.
 func NewDNSProvider(
 	ctx context.Context,
 	accessKeyID, secretAccessKey, hostedZoneID, region, role, webIdentityToken string,
+	tokenSource federated.TokenSource,
 	ambient bool,
 	dns01Nameservers []string,
 	userAgent string,
 ) (*DNSProvider, error) {
-	provider := newSessionProvider(accessKeyID, secretAccessKey, region, role, webIdentityToken, ambient, userAgent)
+	provider := newSessionProvider(accessKeyID, secretAccessKey, region, role, webIdentityToken, tokenSource, ambient, userAgent)
 
 	cfg, err := provider.GetSession(ctx)
 	if err != nil {
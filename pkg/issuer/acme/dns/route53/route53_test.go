@@ -15,6 +15,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/klog/v2/ktesting"
 
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/federated"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 )
 
@@ -59,7 +61,7 @@ func TestAmbientCredentialsFromEnv(t *testing.T) {
 	t.Setenv("AWS_REGION", "us-east-1")
 
 	_, ctx := ktesting.NewTestContext(t)
-	provider, err := NewDNSProvider(ctx, "", "", "", "", "", "", true, util.RecursiveNameservers, "cert-manager-test")
+	provider, err := NewDNSProvider(ctx, "", "", "", "", "", "", nil, true, util.RecursiveNameservers, "cert-manager-test")
 	assert.NoError(t, err, "Expected no error constructing DNSProvider")
 
 	_, err = provider.client.Options().Credentials.Retrieve(ctx)
@@ -74,7 +76,7 @@ func TestNoCredentialsFromEnv(t *testing.T) {
 	t.Setenv("AWS_REGION", "us-east-1")
 
 	_, ctx := ktesting.NewTestContext(t)
-	_, err := NewDNSProvider(ctx, "", "", "", "", "", "", false, util.RecursiveNameservers, "cert-manager-test")
+	_, err := NewDNSProvider(ctx, "", "", "", "", "", "", nil, false, util.RecursiveNameservers, "cert-manager-test")
 	assert.Error(t, err, "Expected error constructing DNSProvider with no credentials and not ambient")
 }
 
@@ -125,7 +127,7 @@ func TestSessionProviderGetSessionRegion(t *testing.T) {
 			region = fakeIssuerRegion
 		}
 
-		p := newSessionProvider(accessKeyID, secretAccessKey, region, role, webIdentityToken, allowAmbientCredentials, userAgent)
+		p := newSessionProvider(accessKeyID, secretAccessKey, region, role, webIdentityToken, nil, allowAmbientCredentials, userAgent)
 		p.StsProvider = func(cfg aws.Config) StsClient {
 			return &mockSTS{
 				AssumeRoleWithWebIdentityFn: func(
@@ -472,6 +474,54 @@ func TestAssumeRole(t *testing.T) {
 	}
 }
 
+func TestAssumeRoleWithFederatedCredentials(t *testing.T) {
+	_, ctx := ktesting.NewTestContext(t)
+
+	var tokens []string
+	tokenSource := federated.TokenSourceFunc(func(_ context.Context) (string, error) {
+		return fmt.Sprintf("token-%d", len(tokens)), nil
+	})
+	mock := &mockSTS{
+		AssumeRoleWithWebIdentityFn: func(ctx context.Context, params *sts.AssumeRoleWithWebIdentityInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error) {
+			tokens = append(tokens, *params.WebIdentityToken)
+			return &sts.AssumeRoleWithWebIdentityOutput{
+				Credentials: &ststypes.Credentials{
+					AccessKeyId:     aws.String("foo"),
+					SecretAccessKey: aws.String("bar"),
+					SessionToken:    aws.String("my-token"),
+					// Already expired, so that each retrieval refreshes the
+					// credentials.
+					Expiration: aws.Time(time.Now().Add(-time.Minute)),
+				},
+			}, nil
+		},
+	}
+
+	provider := newSessionProvider("", "", "eu-central-1", "my-role", "", tokenSource, false, "")
+	provider.StsProvider = func(aws.Config) StsClient { return mock }
+
+	cfg, err := provider.GetSession(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, tokens, "credentials should only be obtained when first used")
+
+	for range 2 {
+		creds, err := cfg.Credentials.Retrieve(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "foo", creds.AccessKeyID)
+		assert.Equal(t, "bar", creds.SecretAccessKey)
+	}
+	assert.Equal(t, "my-role", mock.assumedRole)
+	assert.Equal(t, []string{"token-0", "token-1"}, tokens, "each refresh should exchange the current token")
+
+	provider = newSessionProvider("", "", "eu-central-1", "", "", tokenSource, false, "")
+	_, err = provider.GetSession(ctx)
+	assert.EqualError(t, err, "unable to construct route53 provider: role must be set when federated credentials are used")
+
+	provider = newSessionProvider("key", "secret", "eu-central-1", "my-role", "", tokenSource, false, "")
+	_, err = provider.GetSession(ctx)
+	assert.EqualError(t, err, "unable to construct route53 provider: federated credentials cannot be used with access keys or a web identity token")
+}
+
 type mockSTS struct {
 	AssumeRoleFn                func(ctx context.Context, params *sts.AssumeRoleInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleOutput, error)
 	AssumeRoleWithWebIdentityFn func(ctx context.Context, params *sts.AssumeRoleWithWebIdentityInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleWithWebIdentityOutput, error)
@@ -25,6 +25,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -45,6 +46,12 @@ const (
 	// HTTP01Timeout is the max amount of time to wait for an HTTP01 challenge
 	// to succeed
 	HTTP01Timeout = time.Minute * 15
+	// defaultSelfCheckTimeout is the default time to wait for a response to
+	// each HTTP01 self check request
+	defaultSelfCheckTimeout = time.Second * 10
+	// defaultSelfCheckInterval is the default time to wait between HTTP01
+	// self check requests
+	defaultSelfCheckInterval = time.Second * 2
 	// acmeSolverListenPort is the port acmesolver should listen on
 	acmeSolverListenPort = 8089
 
@@ -73,7 +80,7 @@ type Solver struct {
 	requiredPasses   int
 }
 
-type reachabilityTest func(ctx context.Context, url *url.URL, key string, dnsServers []string, userAgent string) error
+type reachabilityTest func(ctx context.Context, url *url.URL, key string, dnsServers []string, userAgent string, timeout time.Duration) error
 
 // NewSolver returns a new ACME HTTP01 solver for the given *controller.Context.
 func NewSolver(ctx *controller.Context) (*Solver, error) {
@@ -167,17 +174,21 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 	log = log.WithValues("url", url)
 	ctx = logf.NewContext(ctx, log)
 
-	log.V(logf.DebugLevel).Info("running self check multiple times to ensure challenge has propagated", "required_passes", s.requiredPasses)
+	timeout, interval := selfCheckTimings(ch)
+	log.V(logf.DebugLevel).Info("running self check multiple times to ensure challenge has propagated", "required_passes", s.requiredPasses, "timeout", timeout, "interval", interval)
 	for i := 0; i < s.requiredPasses; i++ {
-		err := s.testReachability(ctx, url, ch.Spec.Key, s.HTTP01SolverNameservers, s.Context.RESTConfig.UserAgent)
+		err := s.testReachability(ctx, url, ch.Spec.Key, s.HTTP01SolverNameservers, s.Context.RESTConfig.UserAgent, timeout)
 		if err != nil {
 			return err
 		}
-		log.V(logf.DebugLevel).Info("reachability test passed, re-checking in 2s time")
 
 		if i != s.requiredPasses-1 {
-			// sleep for 2s between checks
-			time.Sleep(time.Second * 2)
+			log.V(logf.DebugLevel).Info("reachability test passed, re-checking after interval", "interval", interval)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("HTTP01 self check did not complete within %s: %w", HTTP01Timeout, ctx.Err())
+			case <-time.After(interval):
+			}
 		}
 	}
 
@@ -186,6 +197,25 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 	return nil
 }
 
+// selfCheckTimings returns the timeout for each self check request and the
+// interval between requests configured on the challenge's HTTP01 solver, or
+// the defaults if they are not configured.
+func selfCheckTimings(ch *cmacme.Challenge) (timeout, interval time.Duration) {
+	timeout, interval = defaultSelfCheckTimeout, defaultSelfCheckInterval
+	if ch.Spec.Solver.HTTP01 == nil || ch.Spec.Solver.HTTP01.SelfCheck == nil {
+		return timeout, interval
+	}
+
+	selfCheck := ch.Spec.Solver.HTTP01.SelfCheck
+	if selfCheck.Timeout != nil {
+		timeout = selfCheck.Timeout.Duration
+	}
+	if selfCheck.Interval != nil {
+		interval = selfCheck.Interval.Duration
+	}
+	return timeout, interval
+}
+
 // CleanUp will ensure the created service, ingress and pod are clean/deleted of any
 // cert-manager created data.
 func (s *Solver) CleanUp(ctx context.Context, ch *cmacme.Challenge) error {
@@ -210,9 +240,47 @@ func (s *Solver) buildChallengeUrl(ch *cmacme.Challenge) *url.URL {
 	return url
 }
 
+// selfCheckDialer returns the dialer used to connect to the HTTP01 solver
+// during the self check. If dnsServers is not empty, names are resolved using
+// those servers rather than the system resolver.
+// Like ACME servers validating a challenge, the dialer connects to the
+// addresses of a name one at a time, preferring IPv6 addresses when the host
+// has IPv6 connectivity and only falling back to IPv4 addresses if connecting
+// over IPv6 fails. This avoids the self check passing over IPv4 on a
+// dual-stack cluster whose solver is not reachable over IPv6, and racing
+// connections to unreachable IPv4 addresses on IPv6-only clusters.
+func selfCheckDialer(dnsServers []string) *net.Dialer {
+	dialer := &net.Dialer{
+		// A negative FallbackDelay disables the racing of IPv4 connections
+		// against IPv6 connections ("Happy Eyeballs"), so the addresses are
+		// dialed serially in the order of preference.
+		FallbackDelay: -1,
+	}
+	if len(dnsServers) == 0 {
+		return dialer
+	}
+
+	// we need to increment a counter to iterate through the dns servers as the dialer will not
+	// return an error if the dns server is not responding. The A and AAAA
+	// records of a name are looked up concurrently, so the counter is atomic.
+	var counter atomic.Uint32
+	dialer.Timeout = 3 * time.Second
+	dialer.Resolver = &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			d := net.Dialer{
+				Timeout: 3 * time.Second,
+			}
+			s := dnsServers[int(counter.Add(1)-1)%len(dnsServers)]
+			return d.DialContext(ctx, network, s)
+		},
+	}
+	return dialer
+}
+
 // testReachability will attempt to connect to the 'domain' with 'path' and
 // check if the returned body equals 'key'
-func testReachability(ctx context.Context, url *url.URL, key string, dnsServers []string, userAgent string) error {
+func testReachability(ctx context.Context, url *url.URL, key string, dnsServers []string, userAgent string, timeout time.Duration) error {
 	log := logf.FromContext(ctx)
 	log.V(logf.DebugLevel).Info("performing HTTP01 reachability check")
 
@@ -242,7 +310,8 @@ func testReachability(ctx context.Context, url *url.URL, key string, dnsServers
 
 	// Boulder uses a much more complex timeout setup involving shaving time off the deadline to be able to differentiate
 	// between timeouts at different stages of the connection and in turn provide for better error messages. We're a little
-	// more blunt than that, and just use a static timeout of 10 seconds in http.Client.
+	// more blunt than that, and just use a single timeout in http.Client, which defaults to 10 seconds
+	// and can be increased on the solver for validation targets which are slow to respond.
 
 	// That said, IdleConnTimeout is not covered by `Timeout` in http.Client, so we also set it in our Transport
 
@@ -270,31 +339,10 @@ func testReachability(ctx context.Context, url *url.URL, key string, dnsServers
 		},
 	}
 
-	if len(dnsServers) != 0 {
-		transport.DialContext = func(ctx context.Context, network, addr string) (conn net.Conn, err error) {
-			// we need to increment a counter to iterate through the dns servers as the dialer will not
-			// return an error if the dns server is not responding.
-			counter := 0
-			dialer := &net.Dialer{
-				Timeout: 3 * time.Second,
-				Resolver: &net.Resolver{
-					PreferGo: true,
-					Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
-						d := net.Dialer{
-							Timeout: 3 * time.Second,
-						}
-						s := dnsServers[counter%len(dnsServers)]
-						counter++
-						return d.DialContext(ctx, network, s)
-					},
-				},
-			}
-			return dialer.DialContext(ctx, network, addr)
-		}
-	}
+	transport.DialContext = selfCheckDialer(dnsServers).DialContext
 	client := &http.Client{
 		Transport: transport,
-		Timeout:   time.Second * 10,
+		Timeout:   timeout,
 	}
 
 	response, err := client.Do(req)
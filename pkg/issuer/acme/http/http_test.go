@@ -20,12 +20,16 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/miekg/dns"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
@@ -35,9 +39,9 @@ import (
 // countReachabilityTestCalls is a wrapper function that allows us to count the number
 // of calls to a reachabilityTest.
 func countReachabilityTestCalls(counter *int, t reachabilityTest) reachabilityTest {
-	return func(ctx context.Context, url *url.URL, key string, dnsServers []string, userAgent string) error {
+	return func(ctx context.Context, url *url.URL, key string, dnsServers []string, userAgent string, timeout time.Duration) error {
 		*counter++
-		return t(ctx, url, key, dnsServers, userAgent)
+		return t(ctx, url, key, dnsServers, userAgent, timeout)
 	}
 }
 
@@ -51,18 +55,50 @@ func TestCheck(t *testing.T) {
 	tests := []testT{
 		{
 			name: "should pass",
-			reachabilityTest: func(context.Context, *url.URL, string, []string, string) error {
+			reachabilityTest: func(context.Context, *url.URL, string, []string, string, time.Duration) error {
 				return nil
 			},
 			expectedErr: false,
 		},
 		{
 			name: "should error",
-			reachabilityTest: func(context.Context, *url.URL, string, []string, string) error {
+			reachabilityTest: func(context.Context, *url.URL, string, []string, string, time.Duration) error {
 				return fmt.Errorf("failed")
 			},
 			expectedErr: true,
 		},
+		{
+			name: "should use the default self check timeout",
+			reachabilityTest: func(_ context.Context, _ *url.URL, _ string, _ []string, _ string, timeout time.Duration) error {
+				if timeout != defaultSelfCheckTimeout {
+					return fmt.Errorf("expected timeout %s but got %s", defaultSelfCheckTimeout, timeout)
+				}
+				return nil
+			},
+			expectedErr: false,
+		},
+		{
+			name: "should use the self check timeout configured on the solver",
+			reachabilityTest: func(_ context.Context, _ *url.URL, _ string, _ []string, _ string, timeout time.Duration) error {
+				if timeout != time.Minute {
+					return fmt.Errorf("expected timeout %s but got %s", time.Minute, timeout)
+				}
+				return nil
+			},
+			challenge: &cmacme.Challenge{
+				Spec: cmacme.ChallengeSpec{
+					Solver: cmacme.ACMEChallengeSolver{
+						HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+							SelfCheck: &cmacme.ACMEChallengeSolverHTTP01SelfCheck{
+								Timeout:  &metav1.Duration{Duration: time.Minute},
+								Interval: &metav1.Duration{Duration: time.Millisecond},
+							},
+						},
+					},
+				},
+			},
+			expectedErr: false,
+		},
 	}
 
 	for i := range tests {
@@ -191,7 +227,7 @@ func TestReachabilityCustomDnsServers(t *testing.T) {
 
 	for _, tt := range tests {
 		atomic.StoreInt32(&dnsServerCalled, 0)
-		err = testReachability(context.Background(), u, key, tt.dnsServers, "cert-manager-test")
+		err = testReachability(context.Background(), u, key, tt.dnsServers, "cert-manager-test", defaultSelfCheckTimeout)
 		switch {
 		case err == nil:
 			t.Errorf("Expected error for testReachability, but got none")
@@ -205,3 +241,68 @@ func TestReachabilityCustomDnsServers(t *testing.T) {
 		}
 	}
 }
+
+func TestReachabilitySlowSolver(t *testing.T) {
+	key := "the-challenge-key"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Simulate a validation target which is slow to respond.
+		time.Sleep(200 * time.Millisecond)
+		fmt.Fprint(w, key)
+	}))
+	defer server.Close()
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse url %s: %v", server.URL, err)
+	}
+
+	tests := map[string]struct {
+		timeout     time.Duration
+		expectedErr bool
+	}{
+		"should fail if the solver responds after the timeout": {
+			timeout:     50 * time.Millisecond,
+			expectedErr: true,
+		},
+		"should pass if the solver responds within the timeout": {
+			timeout:     5 * time.Second,
+			expectedErr: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := testReachability(context.Background(), u, key, nil, "cert-manager-test", test.timeout)
+			if err != nil && !test.expectedErr {
+				t.Errorf("Expected testReachability to pass, but got %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("Expected error from testReachability, but got none")
+			}
+		})
+	}
+}
+
+func TestReachabilityIPv6(t *testing.T) {
+	l, err := net.Listen("tcp6", "[::1]:0")
+	if err != nil {
+		t.Skipf("IPv6 is not available: %v", err)
+	}
+
+	key := "the-challenge-key"
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, key)
+	}))
+	server.Listener = l
+	server.Start()
+	defer server.Close()
+
+	u, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("Failed to parse url %s: %v", server.URL, err)
+	}
+
+	if err := testReachability(context.Background(), u, key, nil, "cert-manager-test", 5*time.Second); err != nil {
+		t.Errorf("Expected testReachability to pass for %s, but got %v", u, err)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/selection"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
@@ -128,6 +129,11 @@ func buildService(ch *cmacme.Challenge) (*corev1.Service, error) {
 				},
 			},
 			Selector: podLabels,
+			// Request an address of each IP family the cluster supports, so
+			// that the solver can be reached over IPv6 on dual-stack
+			// clusters. Single-stack clusters, including IPv6-only ones,
+			// assign an address of their only IP family.
+			IPFamilyPolicy: ptr.To(corev1.IPFamilyPolicyPreferDualStack),
 		},
 	}
 
@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	coretesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
@@ -73,7 +74,8 @@ func TestEnsureService(t *testing.T) {
 						TargetPort: intstr.FromInt(acmeSolverListenPort),
 					},
 				},
-				Selector: podLabels(chal),
+				Selector:       podLabels(chal),
+				IPFamilyPolicy: ptr.To(corev1.IPFamilyPolicyPreferDualStack),
 			},
 		}
 		serviceMeta = &metav1.PartialObjectMetadata{
@@ -95,7 +95,9 @@ func (h *HTTP01Solver) challengeHandler(log logr.Logger) http.HandlerFunc {
 		}
 
 		log.Info("comparing host", "expected_host", h.Domain)
-		if h.Domain != host {
+		// IP addresses are compared in their canonical form, as the same
+		// IPv6 address can be written in several ways.
+		if parseHost(h.Domain) != host {
 			log.Info("invalid host", "expected_host", h.Domain)
 			http.NotFound(w, r)
 			return
@@ -123,6 +125,11 @@ func parseHost(s string) string {
 		return addrPort.Addr().String()
 	}
 
+	// ip v6 without port, which is enclosed in brackets in the Host header
+	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+		s = s[1 : len(s)-1]
+	}
+
 	// ip v4/v6 without port
 	addr, err := netip.ParseAddr(s)
 	if err == nil {
@@ -112,6 +112,29 @@ func TestSolver(t *testing.T) {
 			requestTarget:        "http://2001:db8:3333:4444:5555:6666:7777:8888" + HTTPChallengePath + "/secret",
 			expectedResponseCode: http.StatusOK,
 		},
+		"return ok with ipv6 in brackets without specified port in the request": {
+			solverPort:           80,
+			solverDomain:         "2001:db8::1",
+			solverToken:          "secret",
+			solverKey:            "test-key",
+			requestTarget:        "http://[2001:db8::1]" + HTTPChallengePath + "/secret",
+			expectedResponseCode: http.StatusOK,
+		},
+		"return ok with non-canonical ipv6 domain": {
+			solverPort:           80,
+			solverDomain:         "2001:0DB8:0:0::1",
+			solverToken:          "secret",
+			solverKey:            "test-key",
+			requestTarget:        "http://[2001:db8::1]" + HTTPChallengePath + "/secret",
+			expectedResponseCode: http.StatusOK,
+		},
+		"return not found if ipv6 addresses do not match": {
+			solverPort:           80,
+			solverDomain:         "2001:db8::2",
+			solverToken:          "secret",
+			requestTarget:        "http://[2001:db8::1]" + HTTPChallengePath + "/secret",
+			expectedResponseCode: http.StatusNotFound,
+		},
 	}
 
 	for name, tc := range cases {
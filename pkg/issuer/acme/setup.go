@@ -38,6 +38,7 @@ import (
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util"
 	"github.com/cert-manager/cert-manager/pkg/util/errors"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
@@ -48,6 +49,13 @@ const (
 	errorAccountUpdateFailed       = "ErrUpdateACMEAccount"
 	errorInvalidConfig             = "InvalidConfig"
 	errorInvalidURL                = "InvalidURL"
+	errorEABRequired               = "ExternalAccountBindingRequired"
+
+	warningEABNotRequired = "ExternalAccountBindingNotRequired"
+
+	// The ACME problem type returned when registering an account without an
+	// External Account Binding on an ACME server which requires one.
+	acmeErrorExternalAccountRequired = "urn:ietf:params:acme:error:externalAccountRequired"
 
 	successAccountRegistered = "ACMEAccountRegistered"
 	successAccountVerified   = "ACMEAccountVerified"
@@ -59,6 +67,8 @@ const (
 	messageAccountVerified               = "The ACME account was verified with the ACME server"
 	messageNoSecretKeyGenerationDisabled = "the ACME issuer config has 'disableAccountKeyGeneration' set to true, but the secret was not found: "
 	messageInvalidPrivateKey             = "Account private key is invalid: "
+	messageEABRequired                   = "The ACME server requires an External Account Binding to register an account: configure spec.acme.externalAccountBinding with the key ID and HMAC key provided by the ACME CA"
+	messageEABNotRequired                = "spec.acme.externalAccountBinding is set, but the ACME server does not require an External Account Binding"
 
 	messageTemplateUpdateToV2              = "Your ACME server URL is set to a v1 endpoint (%s). You should update the spec.acme.server field to %q"
 	messageTemplateNotRSA                  = "ACME private key in %q is not of type RSA"
@@ -161,6 +171,12 @@ func (a *Acme) Setup(ctx context.Context) error {
 	a.accountRegistry.RemoveClient(string(a.issuer.GetUID()))
 
 	httpClient := accounts.BuildHTTPClientWithCABundle(a.metrics, a.issuer.GetSpec().ACME.SkipTLSVerify, a.issuer.GetSpec().ACME.CABundle)
+	if headers := a.issuer.GetSpec().ACME.HTTPHeaders; len(headers) > 0 {
+		// Only the names of the headers are logged, as their values may be
+		// credentials.
+		log.V(logf.DebugLevel).Info("setting custom HTTP headers on requests to the ACME server", "headers", util.HTTPHeaderNames(headers))
+		httpClient.Transport = util.HeaderRoundTripper(httpClient.Transport, headers)
+	}
 
 	cl := a.clientBuilder(httpClient, *a.issuer.GetSpec().ACME, rsaPk, a.userAgent)
 
@@ -227,8 +243,23 @@ func (a *Acme) Setup(ctx context.Context) error {
 		a.issuer.GetStatus().ACMEStatus().URI = ""
 	}
 
+	eabObj := a.issuer.GetSpec().ACME.ExternalAccountBinding
+	if eabObj != nil {
+		// The External Account Binding is only a warning if the ACME server
+		// does not require it, so failing to discover the directory is not
+		// treated as an error here.
+		directory, err := cl.Discover(ctx)
+		switch {
+		case err != nil:
+			log.V(logf.DebugLevel).Info("failed to discover the ACME directory, skipping the External Account Binding requirement check", "error", err)
+		case !directory.ExternalAccountRequired:
+			log.V(logf.InfoLevel).Info("the ACME server does not require an External Account Binding, but one is configured")
+			a.recorder.Event(a.issuer, corev1.EventTypeWarning, warningEABNotRequired, messageEABNotRequired)
+		}
+	}
+
 	var eabAccount *acmeapi.ExternalAccountBinding
-	if eabObj := a.issuer.GetSpec().ACME.ExternalAccountBinding; eabObj != nil {
+	if eabObj != nil {
 		eabKey, err := a.getEABKey(ctx, ns)
 		switch {
 		// Do not re-try if we fail to get the MAC key as it does not exist at the reference.
@@ -270,6 +301,17 @@ func (a *Acme) Setup(ctx context.Context) error {
 			return err
 		}
 
+		// The error returned by the ACME server when it requires an External
+		// Account Binding does not say how to fix it, so replace it with a
+		// message telling the operator what to configure.
+		if acmeErr.ProblemType == acmeErrorExternalAccountRequired && eabObj == nil {
+			reason = errorEABRequired
+			msg = messageEABRequired
+			a.recorder.Event(a.issuer, corev1.EventTypeWarning, errorEABRequired, msg)
+			// Return nil, because we do not want to re-queue an Issuer with an invalid spec.
+			return nil
+		}
+
 		// If the status code is 400 (BadRequest), we will *not* retry this registration
 		// as it implies that something about the request (i.e. email address or private key)
 		// is invalid.
@@ -98,6 +98,13 @@ func TestAcme_Setup(t *testing.T) {
 		// This is the decoded EAB key that we send to the ACME server.
 		// TODO: could the newline cause any issues?
 		eabKey = "dGVzdAo=\n"
+
+		// eabRequiredDirectory is the directory of an ACME server which
+		// requires an External Account Binding.
+		eabRequiredDirectory = acmeapi.Directory{ExternalAccountRequired: true}
+		// acmeErrEABRequired is returned by an ACME server which requires an
+		// External Account Binding when registering an account without one.
+		acmeErrEABRequired = &acmeapi.Error{StatusCode: 400, ProblemType: "urn:ietf:params:acme:error:externalAccountRequired"}
 	)
 
 	tests := map[string]struct {
@@ -114,6 +121,11 @@ func TestAcme_Setup(t *testing.T) {
 		// Whether AddClient should be called.
 		addClientShouldBeCalled bool
 
+		// Directory returned by cl.Discover
+		directory acmeapi.Directory
+		// Error returned by cl.Discover
+		discoverErr error
+
 		// Error returned by cl.Register
 		registerErr error
 
@@ -270,6 +282,7 @@ func TestAcme_Setup(t *testing.T) {
 		"EAB for issuer specified, but the corresponding secret is not found": {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEEAB(someString, someString)),
+			directory:                  eabRequiredDirectory,
 			kfsKey:                     rsaPrivKey,
 			removeClientShouldBeCalled: true,
 			eabSecretGetErr:            notFoundErr,
@@ -285,6 +298,7 @@ func TestAcme_Setup(t *testing.T) {
 		"EAB for issuer specified, attempting to retrieve secret fails with unknown error": {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEEAB(someString, someString)),
+			directory:                  eabRequiredDirectory,
 			kfsKey:                     rsaPrivKey,
 			removeClientShouldBeCalled: true,
 			eabSecretGetErr:            someErr,
@@ -350,6 +364,7 @@ func TestAcme_Setup(t *testing.T) {
 		"ACME account with EAB registered successfully": {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEEAB(someString, someString)),
+			directory:                  eabRequiredDirectory,
 			kfsKey:                     rsaPrivKey,
 			removeClientShouldBeCalled: true,
 			addClientShouldBeCalled:    true,
@@ -365,10 +380,60 @@ func TestAcme_Setup(t *testing.T) {
 					gen.SetIssuerConditionMessage(messageAccountRegistered)),
 			},
 		},
+		"ACME server requires EAB, but EAB for issuer is not specified": {
+			issuer:                     gen.IssuerFrom(baseIssuer),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			expectedRegisteredAcc:      &acmeapi.Account{},
+			registerErr:                acmeErrEABRequired,
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyFalseCondition,
+					gen.SetIssuerConditionReason(errorEABRequired),
+					gen.SetIssuerConditionMessage(messageEABRequired)),
+			},
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorEABRequired, messageEABRequired),
+			},
+		},
+		"ACME server does not require EAB, but EAB for issuer is specified": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEEAB(someString, someString)),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			eabSecret:                  eabSecret,
+			expectedRegisteredAcc: &acmeapi.Account{ExternalAccountBinding: &acmeapi.ExternalAccountBinding{
+				KID: someString,
+				Key: []byte(eabKey),
+			}},
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition),
+			},
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, warningEABNotRequired, messageEABNotRequired),
+			},
+		},
+		"EAB for issuer is specified, but discovering the ACME server's directory returns an error": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEEAB(someString, someString)),
+			discoverErr:                someErr,
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			eabSecret:                  eabSecret,
+			expectedRegisteredAcc: &acmeapi.Account{ExternalAccountBinding: &acmeapi.ExternalAccountBinding{
+				KID: someString,
+				Key: []byte(eabKey),
+			}},
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition),
+			},
+		},
 		"ACME account with legacy EAB key algorithm set and with an email is registered successfully": {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEEmail(someEmail),
 				gen.SetIssuerACMEEABWithKeyAlgorithm(someString, someString, cmacme.HS256)),
+			directory:                  eabRequiredDirectory,
 			kfsKey:                     rsaPrivKey,
 			removeClientShouldBeCalled: true,
 			addClientShouldBeCalled:    true,
@@ -390,6 +455,7 @@ func TestAcme_Setup(t *testing.T) {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEEmail(someEmail),
 				gen.SetIssuerACMEEABWithKeyAlgorithm(someString, someString, cmacme.HS256)),
+			directory:                  eabRequiredDirectory,
 			kfsKey:                     rsaPrivKey,
 			removeClientShouldBeCalled: true,
 			addClientShouldBeCalled:    true,
@@ -418,6 +484,7 @@ func TestAcme_Setup(t *testing.T) {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEEmail(someEmail),
 				gen.SetIssuerACMEEABWithKeyAlgorithm(someString, someString, cmacme.HS256)),
+			directory:                  eabRequiredDirectory,
 			kfsKey:                     rsaPrivKey,
 			removeClientShouldBeCalled: true,
 			eabSecret:                  eabSecret,
@@ -449,6 +516,7 @@ func TestAcme_Setup(t *testing.T) {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEEmail(someEmail),
 				gen.SetIssuerACMEEABWithKeyAlgorithm(someString, someString, cmacme.HS256)),
+			directory:                  eabRequiredDirectory,
 			kfsKey:                     rsaPrivKey,
 			removeClientShouldBeCalled: true,
 			eabSecret:                  eabSecret,
@@ -480,6 +548,7 @@ func TestAcme_Setup(t *testing.T) {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEEmail(someEmail),
 				gen.SetIssuerACMEEABWithKeyAlgorithm(someString, someString, cmacme.HS256)),
+			directory:                  eabRequiredDirectory,
 			kfsKey:                     rsaPrivKey,
 			removeClientShouldBeCalled: true,
 			eabSecret:                  eabSecret,
@@ -547,6 +616,9 @@ func TestAcme_Setup(t *testing.T) {
 			// Mock ACME client.
 			var gotAcc *acmeapi.Account
 			cl := acmecl.FakeACME{
+				FakeDiscover: func(context.Context) (acmeapi.Directory, error) {
+					return test.directory, test.discoverErr
+				},
 				FakeRegister: func(_ context.Context, a *acmeapi.Account, _ func(string) bool) (*acmeapi.Account, error) {
 					gotAcc = a
 					return a, test.registerErr
@@ -0,0 +1,113 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/x509"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/errors"
+	"github.com/cert-manager/cert-manager/pkg/util/kube"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// SigningKeyPairAndChain returns the certificate chain and private key of the
+// signing CA configured on the given CA issuer. The chain starts with the
+// signing CA certificate. If the issuer references a root CA secret, the
+// certificates it contains are appended to the chain, and an InvalidData error
+// is returned unless they complete the chain up to a self-signed root.
+func SigningKeyPairAndChain(ctx context.Context, secretsLister internalinformers.SecretLister, namespace string, iss *v1.CAIssuer) ([]*x509.Certificate, crypto.Signer, error) {
+	caCerts, caKey, err := kube.SecretTLSKeyPairAndCA(ctx, secretsLister, namespace, iss.SecretName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if iss.RootCASecretRef == nil {
+		return caCerts, caKey, nil
+	}
+
+	rootCerts, err := rootCACertificates(secretsLister, namespace, iss.RootCASecretRef)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	caCerts = append(caCerts, rootCerts...)
+	if err := verifyChainToRoot(caCerts); err != nil {
+		return nil, nil, err
+	}
+
+	return caCerts, caKey, nil
+}
+
+// rootCACertificates decodes the PEM encoded certificates stored in the
+// referenced Secret, defaulting to the ca.crt key.
+func rootCACertificates(secretsLister internalinformers.SecretLister, namespace string, ref *cmmeta.SecretKeySelector) ([]*x509.Certificate, error) {
+	secret, err := secretsLister.Secrets(namespace).Get(ref.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	key := ref.Key
+	if key == "" {
+		key = cmmeta.TLSCAKey
+	}
+
+	certBytes, ok := secret.Data[key]
+	if !ok || len(certBytes) == 0 {
+		return nil, errors.NewInvalidData("no root CA certificate data for %q in secret '%s/%s'", key, namespace, ref.Name)
+	}
+
+	certs, err := pki.DecodeX509CertificateChainBytes(certBytes)
+	if err != nil {
+		return nil, errors.NewInvalidData("failed to decode root CA certificates from secret '%s/%s': %s", namespace, ref.Name, err)
+	}
+
+	return certs, nil
+}
+
+// verifyChainToRoot returns an InvalidData error unless the given certificates
+// form a single chain which starts with the first certificate and ends with a
+// self-signed root certificate.
+func verifyChainToRoot(certs []*x509.Certificate) error {
+	bundle, err := pki.ParseSingleCertificateChain(certs)
+	if err != nil {
+		return errors.NewInvalidData("failed to build the chain from the signing CA to the root CA: %s", err)
+	}
+
+	chain, err := pki.DecodeX509CertificateChainBytes(bundle.ChainPEM)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(chain[0].Raw, certs[0].Raw) {
+		return errors.NewInvalidData("the root CA certificates include a certificate issued by the signing CA")
+	}
+
+	root, err := pki.DecodeX509CertificateBytes(bundle.CAPEM)
+	if err != nil {
+		return errors.NewInvalidData("the chain from the signing CA does not end with a root CA")
+	}
+	if root.CheckSignatureFrom(root) != nil {
+		return errors.NewInvalidData("the chain from the signing CA ends with %q, which is not a self-signed root CA", root.Subject)
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ca
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientcorev1 "k8s.io/client-go/listers/core/v1"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/errors"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcorelisters "github.com/cert-manager/cert-manager/test/unit/listers"
+)
+
+type testCA struct {
+	key  crypto.Signer
+	cert *x509.Certificate
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, serial int64, commonName string, parent *testCA) *testCA {
+	key, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	issuerCert, issuerKey := template, crypto.Signer(key)
+	if parent != nil {
+		issuerCert, issuerKey = parent.cert, parent.key
+	}
+
+	certPEM, cert, err := pki.SignCertificate(template, issuerCert, key.Public(), issuerKey)
+	require.NoError(t, err)
+
+	return &testCA{key: key, cert: cert, pem: certPEM}
+}
+
+func TestSigningKeyPairAndChain(t *testing.T) {
+	// root -> intermediate -> signing, where only the signing CA and its
+	// private key are stored in the issuer's Secret.
+	root := newTestCA(t, 1, "root", nil)
+	intermediate := newTestCA(t, 2, "intermediate", root)
+	signing := newTestCA(t, 3, "signing", intermediate)
+	otherRoot := newTestCA(t, 4, "other-root", nil)
+
+	signingKeyPEM, err := pki.EncodePKCS8PrivateKey(signing.key)
+	require.NoError(t, err)
+
+	bundle := func(cas ...*testCA) []byte {
+		var out []byte
+		for _, ca := range cas {
+			out = append(out, ca.pem...)
+		}
+		return out
+	}
+	rootRef := func(key string) *cmmeta.SecretKeySelector {
+		return &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "root-ca"}, Key: key}
+	}
+
+	tests := map[string]struct {
+		rootCASecretRef *cmmeta.SecretKeySelector
+		rootCASecret    map[string][]byte
+		// expectedChain is the chain returned for the signing CA.
+		expectedChain []*testCA
+		// expectedCertificateChain is the tls.crt chain of an issued
+		// certificate, excluding the leaf.
+		expectedCertificateChain []*testCA
+		expectedCA               *testCA
+		expectedErr              func(error) bool
+	}{
+		"without a root CA secret, only the signing CA is used": {
+			expectedChain:            []*testCA{signing},
+			expectedCertificateChain: []*testCA{signing},
+			expectedCA:               signing,
+		},
+		"the chain is completed with the intermediate and root CA": {
+			rootCASecretRef:          rootRef(""),
+			rootCASecret:             map[string][]byte{cmmeta.TLSCAKey: bundle(root, intermediate)},
+			expectedChain:            []*testCA{signing, root, intermediate},
+			expectedCertificateChain: []*testCA{signing, intermediate},
+			expectedCA:               root,
+		},
+		"the root CA bundle is read from the referenced key": {
+			rootCASecretRef:          rootRef("chain.pem"),
+			rootCASecret:             map[string][]byte{"chain.pem": bundle(intermediate, root)},
+			expectedChain:            []*testCA{signing, intermediate, root},
+			expectedCertificateChain: []*testCA{signing, intermediate},
+			expectedCA:               root,
+		},
+		"a chain missing the intermediate CA does not build": {
+			rootCASecretRef: rootRef(""),
+			rootCASecret:    map[string][]byte{cmmeta.TLSCAKey: bundle(root)},
+			expectedErr:     errors.IsInvalidData,
+		},
+		"a chain which does not end with a self-signed root CA is invalid": {
+			rootCASecretRef: rootRef(""),
+			rootCASecret:    map[string][]byte{cmmeta.TLSCAKey: bundle(intermediate)},
+			expectedErr:     errors.IsInvalidData,
+		},
+		"a chain ending with an unrelated root CA does not build": {
+			rootCASecretRef: rootRef(""),
+			rootCASecret:    map[string][]byte{cmmeta.TLSCAKey: bundle(intermediate, otherRoot)},
+			expectedErr:     errors.IsInvalidData,
+		},
+		"a root CA secret without the referenced key is invalid": {
+			rootCASecretRef: rootRef("missing.crt"),
+			rootCASecret:    map[string][]byte{cmmeta.TLSCAKey: bundle(intermediate, root)},
+			expectedErr:     errors.IsInvalidData,
+		},
+		"a missing root CA secret returns a not found error": {
+			rootCASecretRef: rootRef(""),
+			expectedErr:     apierrors.IsNotFound,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			secrets := map[string]*corev1.Secret{
+				"signing-ca": {
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "signing-ca"},
+					Data: map[string][]byte{
+						corev1.TLSCertKey:       signing.pem,
+						corev1.TLSPrivateKeyKey: signingKeyPEM,
+					},
+				},
+			}
+			if test.rootCASecret != nil {
+				secrets["root-ca"] = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "root-ca"},
+					Data:       test.rootCASecret,
+				}
+			}
+			secretsLister := testcorelisters.NewFakeSecretLister(testcorelisters.SetFakeSecretListerSecret(func(namespace string) clientcorev1.SecretNamespaceLister {
+				return &testcorelisters.FakeSecretNamespaceLister{
+					GetFn: func(name string) (*corev1.Secret, error) {
+						if secret, ok := secrets[name]; ok && namespace == "ns" {
+							return secret, nil
+						}
+						return nil, apierrors.NewNotFound(corev1.Resource("secrets"), name)
+					},
+				}
+			}))
+
+			caCerts, caKey, err := SigningKeyPairAndChain(context.Background(), secretsLister, "ns", &v1.CAIssuer{
+				SecretName:      "signing-ca",
+				RootCASecretRef: test.rootCASecretRef,
+			})
+			if test.expectedErr != nil {
+				require.Error(t, err)
+				assert.True(t, test.expectedErr(err), "unexpected error: %v", err)
+				return
+			}
+			require.NoError(t, err)
+
+			var expectedChain []*x509.Certificate
+			for _, ca := range test.expectedChain {
+				expectedChain = append(expectedChain, ca.cert)
+			}
+			assert.Equal(t, expectedChain, caCerts)
+
+			// Issue a leaf certificate and check that the full path to the
+			// root is stored.
+			leafKey, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+			require.NoError(t, err)
+			issued, err := pki.SignCSRTemplate(caCerts, caKey, &x509.Certificate{
+				SerialNumber: big.NewInt(100),
+				Subject:      pkix.Name{CommonName: "leaf"},
+				NotBefore:    time.Now(),
+				NotAfter:     time.Now().Add(time.Minute),
+				PublicKey:    leafKey.Public(),
+			})
+			require.NoError(t, err)
+
+			issuedChain, err := pki.DecodeX509CertificateChainBytes(issued.ChainPEM)
+			require.NoError(t, err)
+			require.Len(t, issuedChain, len(test.expectedCertificateChain)+1)
+			assert.Equal(t, "leaf", issuedChain[0].Subject.CommonName)
+			for i, ca := range test.expectedCertificateChain {
+				assert.Equal(t, ca.cert.Raw, issuedChain[i+1].Raw)
+			}
+			assert.Equal(t, test.expectedCA.pem, issued.CAPEM)
+		})
+	}
+}
@@ -25,16 +25,19 @@ import (
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/errors"
 	"github.com/cert-manager/cert-manager/pkg/util/kube"
 )
 
 const (
 	errorGetKeyPair     = "ErrGetKeyPair"
 	errorInvalidKeyPair = "ErrInvalidKeyPair"
+	errorInvalidChain   = "ErrInvalidChain"
 
 	successKeyPairVerified = "KeyPairVerified"
 
-	messageErrorGetKeyPair = "Error getting keypair for CA issuer: "
+	messageErrorGetKeyPair   = "Error getting keypair for CA issuer: "
+	messageErrorInvalidChain = "Error building chain to root CA for CA issuer: "
 
 	messageKeyPairVerified = "Signing CA verified"
 )
@@ -71,6 +74,25 @@ func (c *CA) Setup(ctx context.Context) error {
 		return nil
 	}
 
+	if ref := c.issuer.GetSpec().CA.RootCASecretRef; ref != nil {
+		_, _, err := SigningKeyPairAndChain(ctx, c.secretsLister, c.resourceNamespace, c.issuer.GetSpec().CA)
+		if errors.IsInvalidData(err) {
+			s := messageErrorInvalidChain + err.Error()
+			log.Error(err, "signing CA chain does not build to a root CA", "root_ca_secret", ref.Name)
+			c.Recorder.Event(c.issuer, corev1.EventTypeWarning, errorInvalidChain, s)
+			apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, errorInvalidChain, s)
+			// Don't return an error here as there is nothing more we can do
+			return nil
+		}
+		if err != nil {
+			log.Error(err, "error getting signing CA chain", "root_ca_secret", ref.Name)
+			s := messageErrorGetKeyPair + err.Error()
+			c.Recorder.Event(c.issuer, corev1.EventTypeWarning, errorGetKeyPair, s)
+			apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, errorGetKeyPair, s)
+			return err
+		}
+	}
+
 	log.V(logf.DebugLevel).Info("signing CA verified")
 	c.Recorder.Event(c.issuer, corev1.EventTypeNormal, successKeyPairVerified, messageKeyPairVerified)
 	apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionTrue, successKeyPairVerified, messageKeyPairVerified)
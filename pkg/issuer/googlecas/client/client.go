@@ -0,0 +1,240 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+	"golang.org/x/oauth2/google/externalaccount"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	privateca "google.golang.org/api/privateca/v1"
+	authv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+const (
+	// The subject token type of a Kubernetes ServiceAccount token exchanged
+	// using workload identity federation.
+	jwtSubjectTokenType = "urn:ietf:params:oauth:token-type:jwt"
+	stsTokenURL         = "https://sts.googleapis.com/v1/token"
+	// #nosec G101 -- This is a URL template, not a credential
+	impersonationURLFormat = "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/%s:generateAccessToken"
+)
+
+// ClientBuilder is a function type that returns a new Interface.
+// Can be used in tests to create a mock Google CAS client.
+type ClientBuilder func(ctx context.Context, namespace string, _ func(ns string) CreateToken, _ internalinformers.SecretLister, _ cmapi.GenericIssuer, ambient bool) (Interface, error)
+
+// For mocking purposes.
+type CreateToken func(ctx context.Context, saName string, req *authv1.TokenRequest, opts metav1.CreateOptions) (*authv1.TokenRequest, error)
+
+// Interface implements the Google CAS functionality used by the issuer.
+type Interface interface {
+	// GetCAPool verifies that the configured CA pool exists and can be
+	// accessed with the configured credentials.
+	GetCAPool(ctx context.Context) error
+
+	// Sign requests a certificate for the given CSR from the CA pool. The
+	// certificateID identifies the certificate within the CA pool; if a
+	// certificate with that ID already exists, it is returned instead of a
+	// new certificate being created.
+	// The returned PEM contains the signed certificate followed by the chain
+	// returned by CAS, in issuer to root order.
+	Sign(ctx context.Context, certificateID string, csrPEM []byte, duration time.Duration) ([]byte, error)
+}
+
+// Client implements Interface using the Google CAS API.
+type Client struct {
+	service *privateca.Service
+	issuer  *cmapi.GoogleCASIssuer
+}
+
+var _ Interface = &Client{}
+
+// New returns a new Google CAS client, authenticated using the credentials
+// configured on the issuer.
+// If neither a service account key nor workload identity is configured,
+// ambient credentials are used if ambient is true.
+func New(ctx context.Context, namespace string, createTokenFn func(ns string) CreateToken, secretsLister internalinformers.SecretLister, issuer cmapi.GenericIssuer, ambient bool) (Interface, error) {
+	cfg := issuer.GetSpec().GoogleCAS
+	if cfg == nil {
+		return nil, fmt.Errorf("issuer %q does not have a googleCAS configuration", issuer.GetObjectMeta().Name)
+	}
+
+	ts, err := tokenSource(ctx, cfg, namespace, createTokenFn, secretsLister, ambient)
+	if err != nil {
+		return nil, err
+	}
+
+	service, err := privateca.NewService(ctx, option.WithTokenSource(ts))
+	if err != nil {
+		return nil, fmt.Errorf("error creating Google CAS client: %w", err)
+	}
+
+	return &Client{
+		service: service,
+		issuer:  cfg,
+	}, nil
+}
+
+func tokenSource(ctx context.Context, cfg *cmapi.GoogleCASIssuer, namespace string, createTokenFn func(ns string) CreateToken, secretsLister internalinformers.SecretLister, ambient bool) (oauth2.TokenSource, error) {
+	switch {
+	case cfg.ServiceAccountSecretRef != nil:
+		ref := cfg.ServiceAccountSecretRef
+		secret, err := secretsLister.Secrets(namespace).Get(ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		key, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("no data for %q in secret '%s/%s'", ref.Key, namespace, ref.Name)
+		}
+		conf, err := google.JWTConfigFromJSON(key, privateca.CloudPlatformScope)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing Google Cloud service account key: %w", err)
+		}
+		return conf.TokenSource(ctx), nil
+
+	case cfg.WorkloadIdentity != nil:
+		wi := cfg.WorkloadIdentity
+		conf := externalaccount.Config{
+			Audience:         wi.Audience,
+			SubjectTokenType: jwtSubjectTokenType,
+			TokenURL:         stsTokenURL,
+			Scopes:           []string{privateca.CloudPlatformScope},
+			SubjectTokenSupplier: &serviceAccountTokenSupplier{
+				createToken:        createTokenFn(namespace),
+				serviceAccountName: wi.ServiceAccountName,
+			},
+		}
+		if wi.ServiceAccountEmail != "" {
+			conf.ServiceAccountImpersonationURL = fmt.Sprintf(impersonationURLFormat, wi.ServiceAccountEmail)
+		}
+		ts, err := externalaccount.NewTokenSource(ctx, conf)
+		if err != nil {
+			return nil, fmt.Errorf("error configuring workload identity federation: %w", err)
+		}
+		return ts, nil
+
+	case ambient:
+		ts, err := google.DefaultTokenSource(ctx, privateca.CloudPlatformScope)
+		if err != nil {
+			return nil, fmt.Errorf("error loading ambient Google Cloud credentials: %w", err)
+		}
+		return ts, nil
+
+	default:
+		return nil, errors.New("no credentials are configured and ambient credentials are disabled for this issuer")
+	}
+}
+
+// serviceAccountTokenSupplier supplies a Kubernetes ServiceAccount token to
+// exchange for a Google Cloud access token using workload identity
+// federation.
+type serviceAccountTokenSupplier struct {
+	createToken        CreateToken
+	serviceAccountName string
+}
+
+func (s *serviceAccountTokenSupplier) SubjectToken(ctx context.Context, options externalaccount.SupplierOptions) (string, error) {
+	tokenRequest, err := s.createToken(ctx, s.serviceAccountName, &authv1.TokenRequest{
+		Spec: authv1.TokenRequestSpec{
+			Audiences: []string{options.Audience},
+			// The token is exchanged immediately and then discarded, so use
+			// the minimum duration allowed by the Kubernetes API.
+			ExpirationSeconds: ptr.To(int64(600)),
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("while requesting a token for the service account %q: %w", s.serviceAccountName, err)
+	}
+	return tokenRequest.Status.Token, nil
+}
+
+func (c *Client) caPoolName() string {
+	return fmt.Sprintf("projects/%s/locations/%s/caPools/%s", c.issuer.Project, c.issuer.Location, c.issuer.CAPool)
+}
+
+func (c *Client) GetCAPool(ctx context.Context) error {
+	_, err := c.service.Projects.Locations.CaPools.Get(c.caPoolName()).Context(ctx).Do()
+	return err
+}
+
+func (c *Client) Sign(ctx context.Context, certificateID string, csrPEM []byte, duration time.Duration) ([]byte, error) {
+	certificates := c.service.Projects.Locations.CaPools.Certificates
+
+	call := certificates.Create(c.caPoolName(), &privateca.Certificate{
+		PemCsr:              string(csrPEM),
+		Lifetime:            fmt.Sprintf("%ds", int64(duration.Seconds())),
+		CertificateTemplate: c.issuer.CertificateTemplate,
+	}).CertificateId(certificateID)
+	if c.issuer.CertificateAuthority != "" {
+		call = call.IssuingCertificateAuthorityId(c.issuer.CertificateAuthority)
+	}
+
+	cert, err := call.Context(ctx).Do()
+	if IsConflict(err) {
+		// The certificate has already been created, for example by an
+		// earlier attempt whose response was lost, so fetch it instead.
+		cert, err = certificates.Get(c.caPoolName() + "/certificates/" + certificateID).Context(ctx).Do()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return certificatePEM(cert), nil
+}
+
+// certificatePEM returns the PEM encoded certificate followed by its chain.
+func certificatePEM(cert *privateca.Certificate) []byte {
+	var b strings.Builder
+	for _, pem := range append([]string{cert.PemCertificate}, cert.PemCertificateChain...) {
+		b.WriteString(pem)
+		if !strings.HasSuffix(pem, "\n") {
+			b.WriteString("\n")
+		}
+	}
+	return []byte(b.String())
+}
+
+// IsConflict returns true if the error was returned by the Google CAS API
+// because the resource already exists.
+func IsConflict(err error) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusConflict
+}
+
+// IsInvalidRequest returns true if the error was returned by the Google CAS
+// API because the request was rejected, for example because the CSR does not
+// satisfy the issuance policy of the CA pool. Retrying the same request will
+// not succeed.
+func IsInvalidRequest(err error) bool {
+	var apiErr *googleapi.Error
+	return errors.As(err, &apiErr) && apiErr.Code == http.StatusBadRequest
+}
@@ -0,0 +1,217 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2/google/externalaccount"
+	"google.golang.org/api/option"
+	privateca "google.golang.org/api/privateca/v1"
+	authv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	"github.com/cert-manager/cert-manager/test/unit/listers"
+)
+
+func TestNew(t *testing.T) {
+	baseCAS := cmapi.GoogleCASIssuer{
+		Project:  "my-project",
+		Location: "us-central1",
+		CAPool:   "my-pool",
+	}
+	secretRef := &cmmeta.SecretKeySelector{
+		LocalObjectReference: cmmeta.LocalObjectReference{Name: "cas-credentials"},
+		Key:                  "key.json",
+	}
+	createTokenFn := func(string) CreateToken {
+		return func(context.Context, string, *authv1.TokenRequest, metav1.CreateOptions) (*authv1.TokenRequest, error) {
+			return nil, errors.New("not called during construction")
+		}
+	}
+
+	tests := map[string]struct {
+		cas        func(*cmapi.GoogleCASIssuer)
+		fakeLister *listers.FakeSecretLister
+		ambient    bool
+
+		expectedErr string
+	}{
+		"no credentials and ambient credentials disabled should error": {
+			fakeLister:  listers.FakeSecretListerFrom(listers.NewFakeSecretLister()),
+			expectedErr: "no credentials are configured and ambient credentials are disabled for this issuer",
+		},
+		"service account secret which does not exist should error": {
+			cas: func(cas *cmapi.GoogleCASIssuer) { cas.ServiceAccountSecretRef = secretRef },
+			fakeLister: listers.FakeSecretListerFrom(listers.NewFakeSecretLister(),
+				listers.SetFakeSecretNamespaceListerGet(nil, errors.New("secret does not exist")),
+			),
+			expectedErr: "secret does not exist",
+		},
+		"service account secret without the key should error": {
+			cas: func(cas *cmapi.GoogleCASIssuer) { cas.ServiceAccountSecretRef = secretRef },
+			fakeLister: listers.FakeSecretListerFrom(listers.NewFakeSecretLister(),
+				listers.SetFakeSecretNamespaceListerGet(&corev1.Secret{Data: map[string][]byte{"other": nil}}, nil),
+			),
+			expectedErr: `no data for "key.json" in secret 'test-namespace/cas-credentials'`,
+		},
+		"service account secret with an invalid key should error": {
+			cas: func(cas *cmapi.GoogleCASIssuer) { cas.ServiceAccountSecretRef = secretRef },
+			fakeLister: listers.FakeSecretListerFrom(listers.NewFakeSecretLister(),
+				listers.SetFakeSecretNamespaceListerGet(&corev1.Secret{Data: map[string][]byte{"key.json": []byte("not json")}}, nil),
+			),
+			expectedErr: "error parsing Google Cloud service account key: invalid character 'o' in literal null (expecting 'u')",
+		},
+		"workload identity should build a client": {
+			cas: func(cas *cmapi.GoogleCASIssuer) {
+				cas.WorkloadIdentity = &cmapi.GoogleCASWorkloadIdentity{
+					Audience:            "//iam.googleapis.com/projects/1234/locations/global/workloadIdentityPools/pool/providers/provider",
+					ServiceAccountName:  "cas-issuer",
+					ServiceAccountEmail: "cas-issuer@my-project.iam.gserviceaccount.com",
+				}
+			},
+			fakeLister: listers.FakeSecretListerFrom(listers.NewFakeSecretLister()),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cas := baseCAS
+			if test.cas != nil {
+				test.cas(&cas)
+			}
+			issuer := gen.Issuer("cas-issuer", gen.SetIssuerGoogleCAS(cas))
+
+			_, err := New(context.TODO(), "test-namespace", createTokenFn, test.fakeLister, issuer, test.ambient)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestServiceAccountTokenSupplier(t *testing.T) {
+	supplier := &serviceAccountTokenSupplier{
+		serviceAccountName: "cas-issuer",
+		createToken: func(_ context.Context, saName string, req *authv1.TokenRequest, _ metav1.CreateOptions) (*authv1.TokenRequest, error) {
+			assert.Equal(t, "cas-issuer", saName)
+			assert.Equal(t, []string{"//iam.googleapis.com/test"}, req.Spec.Audiences)
+			return &authv1.TokenRequest{Status: authv1.TokenRequestStatus{Token: "kubernetes-token"}}, nil
+		},
+	}
+
+	token, err := supplier.SubjectToken(context.TODO(), externalaccount.SupplierOptions{Audience: "//iam.googleapis.com/test"})
+	require.NoError(t, err)
+	assert.Equal(t, "kubernetes-token", token)
+}
+
+func TestSign(t *testing.T) {
+	const (
+		caPoolPath      = "/v1/projects/my-project/locations/us-central1/caPools/my-pool"
+		certificatePath = caPoolPath + "/certificates/cert-manager-test"
+	)
+	issued := &privateca.Certificate{
+		PemCertificate:      "-----BEGIN CERTIFICATE-----\nleaf\n-----END CERTIFICATE-----",
+		PemCertificateChain: []string{"-----BEGIN CERTIFICATE-----\nintermediate\n-----END CERTIFICATE-----\n", "-----BEGIN CERTIFICATE-----\nroot\n-----END CERTIFICATE-----"},
+	}
+	expectedPEM := "-----BEGIN CERTIFICATE-----\nleaf\n-----END CERTIFICATE-----\n" +
+		"-----BEGIN CERTIFICATE-----\nintermediate\n-----END CERTIFICATE-----\n" +
+		"-----BEGIN CERTIFICATE-----\nroot\n-----END CERTIFICATE-----\n"
+
+	tests := map[string]struct {
+		createStatus int
+
+		expectedPEM string
+		expectedErr bool
+	}{
+		"created certificate is returned with its chain": {
+			createStatus: http.StatusOK,
+			expectedPEM:  expectedPEM,
+		},
+		"existing certificate is fetched if the certificate ID is already in use": {
+			createStatus: http.StatusConflict,
+			expectedPEM:  expectedPEM,
+		},
+		"rejected request is returned as an invalid request": {
+			createStatus: http.StatusBadRequest,
+			expectedErr:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var resp any = issued
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == caPoolPath+"/certificates":
+					assert.Equal(t, "cert-manager-test", r.URL.Query().Get("certificateId"))
+					assert.Equal(t, "my-ca", r.URL.Query().Get("issuingCertificateAuthorityId"))
+					var req privateca.Certificate
+					assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+					assert.Equal(t, "csr", req.PemCsr)
+					assert.Equal(t, "3600s", req.Lifetime)
+					if test.createStatus != http.StatusOK {
+						w.WriteHeader(test.createStatus)
+						resp = map[string]any{"error": map[string]any{"code": test.createStatus}}
+					}
+				case r.Method == http.MethodGet && r.URL.Path == certificatePath:
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				assert.NoError(t, json.NewEncoder(w).Encode(resp))
+			}))
+			defer srv.Close()
+
+			service, err := privateca.NewService(context.TODO(), option.WithEndpoint(srv.URL), option.WithoutAuthentication())
+			require.NoError(t, err)
+
+			c := &Client{
+				service: service,
+				issuer: &cmapi.GoogleCASIssuer{
+					Project:              "my-project",
+					Location:             "us-central1",
+					CAPool:               "my-pool",
+					CertificateAuthority: "my-ca",
+				},
+			}
+
+			pem, err := c.Sign(context.TODO(), "cert-manager-test", []byte("csr"), time.Hour)
+			if test.expectedErr {
+				assert.True(t, IsInvalidRequest(err), "expected an invalid request error, got: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPEM, string(pem))
+		})
+	}
+}
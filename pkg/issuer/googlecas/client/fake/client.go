@@ -0,0 +1,39 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"time"
+)
+
+type GoogleCAS struct {
+	GetCAPoolFn func(ctx context.Context) error
+	SignFn      func(ctx context.Context, certificateID string, csrPEM []byte, duration time.Duration) ([]byte, error)
+}
+
+// GetCAPool will return GetCAPoolFn if set, otherwise nil.
+func (g *GoogleCAS) GetCAPool(ctx context.Context) error {
+	if g.GetCAPoolFn != nil {
+		return g.GetCAPoolFn(ctx)
+	}
+	return nil
+}
+
+func (g *GoogleCAS) Sign(ctx context.Context, certificateID string, csrPEM []byte, duration time.Duration) ([]byte, error) {
+	return g.SignFn(ctx, certificateID, csrPEM, duration)
+}
@@ -0,0 +1,66 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlecas
+
+import (
+	"github.com/go-logr/logr"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	"github.com/cert-manager/cert-manager/pkg/issuer/googlecas/client"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// GoogleCAS is an issuer which signs certificates using a Google Cloud
+// Certificate Authority Service CA pool.
+type GoogleCAS struct {
+	issuer cmapi.GenericIssuer
+	*controller.Context
+
+	secretsLister internalinformers.SecretLister
+
+	// Namespace in which to read resources related to this Issuer from.
+	// For Issuers, this will be the namespace of the Issuer.
+	// For ClusterIssuers, this will be the cluster resource namespace.
+	resourceNamespace string
+
+	clientBuilder client.ClientBuilder
+
+	// For testing purposes.
+	createTokenFn func(ns string) client.CreateToken
+
+	log logr.Logger
+}
+
+func NewGoogleCAS(ctx *controller.Context, issuer cmapi.GenericIssuer) (issuer.Interface, error) {
+	return &GoogleCAS{
+		issuer:            issuer,
+		secretsLister:     ctx.KubeSharedInformerFactory.Secrets().Lister(),
+		resourceNamespace: ctx.IssuerOptions.ResourceNamespace(issuer),
+		clientBuilder:     client.New,
+		createTokenFn:     func(ns string) client.CreateToken { return ctx.Client.CoreV1().ServiceAccounts(ns).CreateToken },
+		Context:           ctx,
+		log:               logf.Log.WithName("googlecas"),
+	}, nil
+}
+
+func init() {
+	issuer.RegisterIssuer(apiutil.IssuerGoogleCAS, NewGoogleCAS)
+}
@@ -0,0 +1,62 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlecas
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+func (g *GoogleCAS) Setup(ctx context.Context) (err error) {
+	defer func() {
+		if err != nil {
+			errorMessage := "Failed to setup Google CAS issuer"
+			g.log.Error(err, errorMessage)
+			apiutil.SetIssuerCondition(g.issuer, g.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionFalse, "ErrorSetup", fmt.Sprintf("%s: %v", errorMessage, err))
+			err = fmt.Errorf("%s: %v", errorMessage, err)
+		}
+	}()
+
+	client, err := g.clientBuilder(ctx, g.resourceNamespace, g.createTokenFn, g.secretsLister, g.issuer, g.IssuerOptions.CanUseAmbientCredentials(g.issuer))
+	if err != nil {
+		return fmt.Errorf("error building client: %v", err)
+	}
+
+	if err := client.GetCAPool(ctx); err != nil {
+		return fmt.Errorf("error getting CA pool: %v", err)
+	}
+
+	// If it does not already have a 'ready' condition, we'll also log an event
+	// to make it really clear to users that this Issuer is ready.
+	if !apiutil.IssuerHasCondition(g.issuer, cmapi.IssuerCondition{
+		Type:   cmapi.IssuerConditionReady,
+		Status: cmmeta.ConditionTrue,
+	}) {
+		g.Recorder.Eventf(g.issuer, corev1.EventTypeNormal, "Ready", "Verified access to the Google CAS CA pool")
+	}
+	g.log.V(logf.DebugLevel).Info("Google CAS issuer started")
+	apiutil.SetIssuerCondition(g.issuer, g.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionTrue, "GoogleCASVerified", "Verified access to the Google CAS CA pool")
+
+	return nil
+}
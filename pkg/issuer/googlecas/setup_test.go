@@ -0,0 +1,139 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlecas
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	internalinformers "github.com/cert-manager/cert-manager/internal/informers"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer/googlecas/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/googlecas/client/fake"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestSetup(t *testing.T) {
+	baseIssuer := gen.Issuer("test-issuer",
+		gen.SetIssuerGoogleCAS(cmapi.GoogleCASIssuer{
+			Project:  "my-project",
+			Location: "us-central1",
+			CAPool:   "my-pool",
+		}),
+	)
+
+	clientBuilder := func(c client.Interface, err error) client.ClientBuilder {
+		return func(context.Context, string, func(string) client.CreateToken, internalinformers.SecretLister, cmapi.GenericIssuer, bool) (client.Interface, error) {
+			return c, err
+		}
+	}
+
+	tests := map[string]struct {
+		clientBuilder client.ClientBuilder
+
+		expectedErr       bool
+		expectedEvents    []string
+		expectedCondition *cmapi.IssuerCondition
+	}{
+		"if client builder fails then should error": {
+			clientBuilder: clientBuilder(nil, errors.New("this is an error")),
+			expectedErr:   true,
+			expectedCondition: &cmapi.IssuerCondition{
+				Reason:  "ErrorSetup",
+				Message: "Failed to setup Google CAS issuer: error building client: this is an error",
+				Status:  "False",
+			},
+		},
+		"if the CA pool cannot be fetched then should error": {
+			clientBuilder: clientBuilder(&fake.GoogleCAS{
+				GetCAPoolFn: func(context.Context) error {
+					return errors.New("403 Forbidden")
+				},
+			}, nil),
+			expectedErr: true,
+			expectedCondition: &cmapi.IssuerCondition{
+				Reason:  "ErrorSetup",
+				Message: "Failed to setup Google CAS issuer: error getting CA pool: 403 Forbidden",
+				Status:  "False",
+			},
+		},
+		"if the CA pool can be fetched then should set ready condition": {
+			clientBuilder: clientBuilder(&fake.GoogleCAS{}, nil),
+			expectedCondition: &cmapi.IssuerCondition{
+				Reason:  "GoogleCASVerified",
+				Message: "Verified access to the Google CAS CA pool",
+				Status:  "True",
+			},
+			expectedEvents: []string{
+				"Normal Ready Verified access to the Google CAS CA pool",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := &controllertest.FakeRecorder{}
+			iss := baseIssuer.DeepCopy()
+
+			g := &GoogleCAS{
+				resourceNamespace: "test-namespace",
+				Context: &controllerpkg.Context{
+					Recorder: rec,
+				},
+				issuer:        iss,
+				clientBuilder: test.clientBuilder,
+				log:           logf.Log.WithName("googlecas"),
+			}
+
+			err := g.Setup(context.TODO())
+			if err != nil && !test.expectedErr {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("expected to get an error but did not get one")
+			}
+
+			if !slices.Equal(test.expectedEvents, rec.Events) {
+				t.Errorf("got unexpected events, exp='%s' got='%s'",
+					test.expectedEvents, rec.Events)
+			}
+
+			conditions := iss.GetStatus().Conditions
+			if len(conditions) != 1 {
+				t.Fatalf("expected one condition but got=%+v", conditions)
+			}
+			c := conditions[0]
+			if test.expectedCondition.Message != c.Message {
+				t.Errorf("unexpected condition message, exp=%s got=%s",
+					test.expectedCondition.Message, c.Message)
+			}
+			if test.expectedCondition.Reason != c.Reason {
+				t.Errorf("unexpected condition reason, exp=%s got=%s",
+					test.expectedCondition.Reason, c.Reason)
+			}
+			if test.expectedCondition.Status != c.Status {
+				t.Errorf("unexpected condition status, exp=%s got=%s",
+					test.expectedCondition.Status, c.Status)
+			}
+		})
+	}
+}
@@ -18,6 +18,11 @@ package issuer
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -54,10 +59,12 @@ func NewHelper(issuerLister cmlisters.IssuerLister, clusterIssuerLister cmlister
 // This namespace will be used to read the Issuer resource.
 // In most cases, the ns parameter should be set to the namespace of the resource
 // that defines the IssuerRef (i.e. the namespace of the Certificate resource).
+// If no issuer with the referenced name exists, the name is resolved as an
+// alias using the `cert-manager.io/issuer-alias` annotation.
 func (h *helperImpl) GetGenericIssuer(ref cmmeta.ObjectReference, ns string) (cmapi.GenericIssuer, error) {
 	switch ref.Kind {
 	case "", cmapi.IssuerKind:
-		return h.issuerLister.Issuers(ns).Get(ref.Name)
+		return getIssuerOrAlias(ref, h.issuerLister.Issuers(ns).Get, h.issuerLister.Issuers(ns).List)
 	case cmapi.ClusterIssuerKind:
 		// handle edge case where the ClusterIssuerLister is not set.
 		// this isn't actually a supported operating mode right now, nor is it
@@ -67,8 +74,60 @@ func (h *helperImpl) GetGenericIssuer(ref cmmeta.ObjectReference, ns string) (cm
 		if h.clusterIssuerLister == nil {
 			return nil, fmt.Errorf("cannot get ClusterIssuer named %q as cert-manager is scoped to a single namespace", ref.Name)
 		}
-		return h.clusterIssuerLister.Get(ref.Name)
+		return getIssuerOrAlias(ref, h.clusterIssuerLister.Get, h.clusterIssuerLister.List)
 	default:
 		return nil, fmt.Errorf(`invalid value %q for issuerRef.kind. Must be empty, %q or %q`, ref.Kind, cmapi.IssuerKind, cmapi.ClusterIssuerKind)
 	}
 }
+
+// getIssuerOrAlias returns the issuer with the referenced name using get. If
+// it does not exist, it returns the single issuer listed using list whose
+// alias matches the referenced name instead. An alias claimed by more than one
+// issuer is an error, as it cannot be resolved uniquely.
+func getIssuerOrAlias[T cmapi.GenericIssuer](
+	ref cmmeta.ObjectReference,
+	get func(name string) (T, error),
+	list func(selector labels.Selector) ([]T, error),
+) (cmapi.GenericIssuer, error) {
+	iss, err := get(ref.Name)
+	if !apierrors.IsNotFound(err) {
+		return iss, err
+	}
+
+	issuers, listErr := list(labels.Everything())
+	if listErr != nil {
+		return nil, listErr
+	}
+
+	var matches []string
+	var match cmapi.GenericIssuer
+	for _, candidate := range issuers {
+		if hasIssuerAlias(candidate, ref.Name) {
+			matches = append(matches, candidate.GetName())
+			match = candidate
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return iss, err
+	case 1:
+		return match, nil
+	default:
+		sort.Strings(matches)
+		return nil, fmt.Errorf("issuer alias %q cannot be resolved uniquely as it is claimed by multiple issuers: %s", ref.Name, strings.Join(matches, ", "))
+	}
+}
+
+// IssuerRefNameMatches returns true if an issuerRef with the given name refers
+// to the given issuer, either by its name or by the alias set using its
+// `cert-manager.io/issuer-alias` annotation.
+func IssuerRefNameMatches(iss cmapi.GenericIssuer, name string) bool {
+	return iss.GetName() == name || hasIssuerAlias(iss, name)
+}
+
+// hasIssuerAlias returns true if the given issuer claims the given alias.
+func hasIssuerAlias(iss cmapi.GenericIssuer, alias string) bool {
+	issuerAlias, ok := iss.GetAnnotations()[cmapi.IssuerAliasAnnotationKey]
+	return ok && issuerAlias == alias
+}
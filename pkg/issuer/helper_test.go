@@ -77,6 +77,52 @@ func TestGetGenericIssuer(t *testing.T) {
 			NilClusterIssuerLister: true,
 			Err:                    true,
 		},
+		{
+			Name:      "alias-of-issuer",
+			Kind:      "Issuer",
+			Namespace: gen.DefaultTestNamespace,
+			CMObjects: []runtime.Object{
+				gen.Issuer("name-of-issuer", gen.SetIssuerAnnotations(map[string]string{v1.IssuerAliasAnnotationKey: "alias-of-issuer"})),
+				gen.Issuer("other-issuer"),
+			},
+			Expected: gen.Issuer("name-of-issuer", gen.SetIssuerAnnotations(map[string]string{v1.IssuerAliasAnnotationKey: "alias-of-issuer"})),
+		},
+		{
+			Name:      "alias-of-issuer",
+			Kind:      "Issuer",
+			Namespace: "other-namespace",
+			CMObjects: []runtime.Object{
+				gen.Issuer("name-of-issuer", gen.SetIssuerAnnotations(map[string]string{v1.IssuerAliasAnnotationKey: "alias-of-issuer"})),
+			},
+			Err:      true,
+			Expected: nilIssuer,
+		},
+		{
+			Name: "alias-of-clusterissuer",
+			Kind: "ClusterIssuer",
+			CMObjects: []runtime.Object{
+				gen.ClusterIssuer("le-prod-v2", gen.SetIssuerAnnotations(map[string]string{v1.IssuerAliasAnnotationKey: "alias-of-clusterissuer"})),
+			},
+			Expected: gen.ClusterIssuer("le-prod-v2", gen.SetIssuerAnnotations(map[string]string{v1.IssuerAliasAnnotationKey: "alias-of-clusterissuer"})),
+		},
+		{
+			Name: "le-prod-v1",
+			Kind: "ClusterIssuer",
+			CMObjects: []runtime.Object{
+				gen.ClusterIssuer("le-prod-v1"),
+				gen.ClusterIssuer("le-prod-v2", gen.SetIssuerAnnotations(map[string]string{v1.IssuerAliasAnnotationKey: "le-prod-v1"})),
+			},
+			Expected: gen.ClusterIssuer("le-prod-v1"),
+		},
+		{
+			Name: "ambiguous-alias",
+			Kind: "ClusterIssuer",
+			CMObjects: []runtime.Object{
+				gen.ClusterIssuer("le-prod-v1", gen.SetIssuerAnnotations(map[string]string{v1.IssuerAliasAnnotationKey: "ambiguous-alias"})),
+				gen.ClusterIssuer("le-prod-v2", gen.SetIssuerAnnotations(map[string]string{v1.IssuerAliasAnnotationKey: "ambiguous-alias"})),
+			},
+			Err: true,
+		},
 	}
 
 	for _, row := range tests {
@@ -103,9 +149,45 @@ func TestGetGenericIssuer(t *testing.T) {
 			if err != nil && !row.Err {
 				t.Errorf("Expected no error, but got: %s", err)
 			}
+			if err == nil && row.Err {
+				t.Errorf("Expected an error, but got none")
+			}
 			if !reflect.DeepEqual(actual, row.Expected) {
 				t.Errorf("Expected %#v but got %#v", row.Expected, actual)
 			}
 		})
 	}
 }
+
+func TestIssuerRefNameMatches(t *testing.T) {
+	aliased := gen.Issuer("le-prod-v2", gen.SetIssuerAnnotations(map[string]string{v1.IssuerAliasAnnotationKey: "le-prod"}))
+	tests := map[string]struct {
+		issuer   v1.GenericIssuer
+		name     string
+		expected bool
+	}{
+		"matches the issuer name": {
+			issuer:   aliased,
+			name:     "le-prod-v2",
+			expected: true,
+		},
+		"matches the issuer alias": {
+			issuer:   aliased,
+			name:     "le-prod",
+			expected: true,
+		},
+		"does not match another name": {
+			issuer:   aliased,
+			name:     "le-staging",
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if actual := IssuerRefNameMatches(test.issuer, test.name); actual != test.expected {
+				t.Errorf("Expected %t but got %t", test.expected, actual)
+			}
+		})
+	}
+}
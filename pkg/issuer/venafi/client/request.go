@@ -17,6 +17,7 @@ limitations under the License.
 package client
 
 import (
+	"bytes"
 	"crypto/x509"
 	"errors"
 	"fmt"
@@ -24,6 +25,7 @@ import (
 	"time"
 
 	"github.com/Venafi/vcert/v5/pkg/certificate"
+	"github.com/Venafi/vcert/v5/pkg/endpoint"
 	"github.com/Venafi/vcert/v5/pkg/util"
 	"github.com/Venafi/vcert/v5/pkg/venafi/tpp"
 
@@ -40,6 +42,39 @@ func (err ErrCustomFieldsType) Error() string {
 	return fmt.Sprintf("certificate request contains an invalid Venafi custom fields type: %q", err.Type)
 }
 
+// ErrPolicyViolation is returned when a certificate request violates the
+// policy of the Venafi zone, so would be rejected by Venafi.
+type ErrPolicyViolation struct {
+	Err error
+}
+
+func (err ErrPolicyViolation) Error() string {
+	return fmt.Sprintf("certificate request violates the policy of the Venafi zone: %v", err.Err)
+}
+
+func (err ErrPolicyViolation) Unwrap() error {
+	return err.Err
+}
+
+// ErrAwaitingApproval is returned when a certificate has been enrolled in TPP
+// but is still awaiting approval in a change-approval workflow.
+type ErrAwaitingApproval struct {
+	CertificateID string
+	Status        string
+}
+
+func (err ErrAwaitingApproval) Error() string {
+	return fmt.Sprintf("certificate %q is awaiting approval in Venafi TPP, status: %q", err.CertificateID, err.Status)
+}
+
+// defaultWorkToDoValue is the value of the WorkToDo custom field if none is
+// configured on the issuer.
+const defaultWorkToDoValue = "true"
+
+// defaultAwaitingApprovalStatuses are matched against the TPP certificate
+// status if no statuses are configured on the issuer.
+var defaultAwaitingApprovalStatuses = []string{"approval"}
+
 var ErrorMissingSubject = errors.New("Certificate requests submitted to Venafi issuers must have the 'commonName' field or at least one other subject field set.")
 
 // This function sends a request to Venafi to for a signed certificate.
@@ -94,6 +129,10 @@ func (v *Venafi) RetrieveCertificate(pickupID string, csrPEM []byte, duration ti
 	// Retrieve the certificate from request
 	pemCollection, err := v.vcertClient.RetrieveCertificate(vreq)
 	if err != nil {
+		var pendingErr endpoint.ErrCertificatePending
+		if errors.As(err, &pendingErr) && v.isAwaitingApproval(pendingErr.Status) {
+			return nil, ErrAwaitingApproval{CertificateID: pendingErr.CertificateID, Status: pendingErr.Status}
+		}
 		return nil, err
 	}
 
@@ -101,14 +140,32 @@ func (v *Venafi) RetrieveCertificate(pickupID string, csrPEM []byte, duration ti
 	cs := append([]string{pemCollection.Certificate}, pemCollection.Chain...)
 	chain := strings.Join(cs, "\n")
 
-	return []byte(chain), nil
+	return orderChainLeafFirst([]byte(chain))
+}
+
+// orderChainLeafFirst returns the given PEM encoded certificate chain ordered
+// from the leaf certificate up to the root. Depending on its configuration,
+// TPP may return the chain root-first even though it is requested root-last,
+// in which case vcert mistakes the root for the issued certificate.
+func orderChainLeafFirst(chain []byte) ([]byte, error) {
+	bundle, err := pki.ParseSingleCertificateChainPEM(chain)
+	if err != nil {
+		return nil, err
+	}
+
+	// A self-signed root is excluded from the ChainPEM of the bundle, so is
+	// added back to keep the chain returned by TPP complete.
+	if bytes.Contains(bundle.ChainPEM, bundle.CAPEM) {
+		return bundle.ChainPEM, nil
+	}
+	return append(bundle.ChainPEM, bundle.CAPEM...), nil
 }
 
 func (v *Venafi) buildVReq(csrPEM []byte, duration time.Duration, customFields []api.CustomField) (*certificate.Request, error) {
 	// Retrieve a copy of the Venafi zone.
 	// This contains default values and policy control info that we can apply
 	// and check against locally.
-	zoneCfg, err := v.vcertClient.ReadZoneConfiguration()
+	zoneCfg, err := v.readZoneConfiguration()
 	if err != nil {
 		return nil, err
 	}
@@ -132,6 +189,19 @@ func (v *Venafi) buildVReq(csrPEM []byte, duration time.Duration, customFields [
 	}
 	vreq.CustomFields = append(vreq.CustomFields, vfields...)
 
+	// Signal the TPP change-approval workflow, if configured.
+	if v.approval != nil {
+		value := v.approval.WorkToDoValue
+		if value == "" {
+			value = defaultWorkToDoValue
+		}
+		vreq.CustomFields = append(vreq.CustomFields, certificate.CustomField{
+			Type:  certificate.CustomFieldPlain,
+			Name:  v.approval.WorkToDoField,
+			Value: value,
+		})
+	}
+
 	// Apply default values from the Venafi zone
 	zoneCfg.UpdateCertificateRequest(vreq)
 
@@ -140,7 +210,7 @@ func (v *Venafi) buildVReq(csrPEM []byte, duration time.Duration, customFields [
 	// however, as this will be done again server side.
 	err = zoneCfg.ValidateCertificateRequest(vreq)
 	if err != nil {
-		return nil, err
+		return nil, ErrPolicyViolation{Err: err}
 	}
 
 	friendlyName, err := getVcertFriendlyName(tmpl)
@@ -160,6 +230,29 @@ func (v *Venafi) buildVReq(csrPEM []byte, duration time.Duration, customFields [
 	return vreq, nil
 }
 
+// isAwaitingApproval returns true if the given TPP certificate status
+// indicates that the certificate is awaiting approval. It always returns false
+// if the change-approval workflow integration has not been configured.
+func (v *Venafi) isAwaitingApproval(status string) bool {
+	if v.approval == nil {
+		return false
+	}
+
+	statuses := v.approval.AwaitingApprovalStatuses
+	if len(statuses) == 0 {
+		statuses = defaultAwaitingApprovalStatuses
+	}
+
+	status = strings.ToLower(status)
+	for _, s := range statuses {
+		if strings.Contains(status, strings.ToLower(s)) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func convertCustomFieldsToVcert(customFields []api.CustomField) ([]certificate.CustomField, error) {
 	var out []certificate.CustomField
 	if len(customFields) > 0 {
@@ -19,6 +19,7 @@ package client
 import (
 	"crypto"
 	"errors"
+	"reflect"
 	"testing"
 	"time"
 
@@ -26,10 +27,12 @@ import (
 	"github.com/Venafi/vcert/v5/pkg/endpoint"
 	"github.com/Venafi/vcert/v5/pkg/venafi/fake"
 
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/api"
 	internalfake "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/fake"
 	"github.com/cert-manager/cert-manager/pkg/util"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
 
@@ -100,9 +103,11 @@ func TestVenafi_RequestCertificate(t *testing.T) {
 	tests := []struct {
 		name         string
 		vcertClient  connector
+		approval     *cmapi.VenafiTPPApproval
 		args         args
 		wantPickupID bool
 		wantErr      bool
+		wantErrType  error
 	}{
 		{
 			name: "error if reading the zone configuration fails",
@@ -115,7 +120,7 @@ func TestVenafi_RequestCertificate(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "error if validating the certificate fails",
+			name: "policy violation error if validating the certificate fails",
 			args: args{},
 			vcertClient: internalfake.Connector{
 				ReadZoneConfigurationFunc: func() (*endpoint.ZoneConfiguration, error) {
@@ -126,7 +131,8 @@ func TestVenafi_RequestCertificate(t *testing.T) {
 					}, nil
 				},
 			}.Default(),
-			wantErr: true,
+			wantErr:     true,
+			wantErrType: ErrPolicyViolation{},
 		},
 		{
 			name: "error if requesting the certificate fails",
@@ -200,6 +206,23 @@ func TestVenafi_RequestCertificate(t *testing.T) {
 			wantPickupID: true,
 			wantErr:      false,
 		},
+		{
+			name:     "get a success for a certificate with the WorkToDo field set when approval is configured",
+			args:     args{},
+			approval: &cmapi.VenafiTPPApproval{WorkToDoField: "Change Ticket Required"},
+			vcertClient: internalfake.Connector{
+				RequestCertificateFunc: func(r *certificate.Request) (string, error) {
+					for _, field := range r.CustomFields {
+						if field.Type == certificate.CustomFieldPlain && field.Name == "Change Ticket Required" && field.Value == "true" {
+							return internalfake.Connector{}.Default().RequestCertificate(r)
+						}
+					}
+					return "", errors.New("WorkToDo custom field not set")
+				},
+			}.Default(),
+			wantPickupID: true,
+			wantErr:      false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -208,6 +231,7 @@ func TestVenafi_RequestCertificate(t *testing.T) {
 			}
 			v := &Venafi{
 				vcertClient: tt.vcertClient,
+				approval:    tt.approval,
 			}
 
 			if tt.args.csrPEM == nil {
@@ -220,6 +244,10 @@ func TestVenafi_RequestCertificate(t *testing.T) {
 				t.Errorf("RequestCertificate() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.wantErrType != nil && reflect.TypeOf(err) != reflect.TypeOf(tt.wantErrType) {
+				t.Errorf("RequestCertificate() error type = %T, want %T", err, tt.wantErrType)
+				return
+			}
 			if (got != "") != tt.wantPickupID {
 				t.Errorf("RequestCertificate() got = %v, want empty string", got)
 			}
@@ -239,13 +267,63 @@ func TestVenafi_RetrieveCertificate(t *testing.T) {
 		duration     time.Duration
 		customFields []api.CustomField
 	}
+	pendingApproval := internalfake.Connector{
+		RetrieveCertificateFunc: func(r *certificate.Request) (*certificate.PEMCollection, error) {
+			return nil, endpoint.ErrCertificatePending{CertificateID: r.PickupID, Status: "Pending Approval"}
+		},
+	}.Default()
+
+	leaf, intermediate, root := testcrypto.MustCreateCertificateChain(t)
+	returnsChain := func(crt []byte, chain ...[]byte) connector {
+		return internalfake.Connector{
+			RetrieveCertificateFunc: func(*certificate.Request) (*certificate.PEMCollection, error) {
+				pc := &certificate.PEMCollection{Certificate: string(crt)}
+				for _, c := range chain {
+					pc.Chain = append(pc.Chain, string(c))
+				}
+				return pc, nil
+			},
+		}.Default()
+	}
+	checkChainLeafFirst := func(t *testing.T, _ []byte, resp []byte) {
+		expected := string(leaf) + string(intermediate) + string(root)
+		if string(resp) != expected {
+			t.Errorf("expected the chain to be ordered leaf-first, got:\n%s", resp)
+		}
+	}
+
 	tests := []struct {
 		name        string
 		vcertClient connector
+		approval    *cmapi.VenafiTPPApproval
 		args        args
 		wantErr     bool
+		wantErrType error
 		checkFn     func(*testing.T, []byte, []byte)
 	}{
+		{
+			name:        "pending error is returned as is if approval is not configured",
+			vcertClient: pendingApproval,
+			args:        args{},
+			wantErr:     true,
+			wantErrType: endpoint.ErrCertificatePending{},
+		},
+		{
+			name:        "awaiting approval error if pending status matches the default approval statuses",
+			vcertClient: pendingApproval,
+			approval:    &cmapi.VenafiTPPApproval{WorkToDoField: "Change Ticket Required"},
+			args:        args{},
+			wantErr:     true,
+			wantErrType: ErrAwaitingApproval{},
+		},
+		{
+			name:        "pending error is returned as is if pending status does not match the configured approval statuses",
+			vcertClient: pendingApproval,
+			approval:    &cmapi.VenafiTPPApproval{WorkToDoField: "Change Ticket Required", AwaitingApprovalStatuses: []string{"WorkToDo"}},
+			args:        args{},
+			wantErr:     true,
+			wantErrType: endpoint.ErrCertificatePending{},
+		},
 		{
 			name: "error if retrieve certificate fails",
 			vcertClient: internalfake.Connector{
@@ -262,6 +340,26 @@ func TestVenafi_RetrieveCertificate(t *testing.T) {
 			wantErr: false,
 			checkFn: checkCertificateIssued,
 		},
+		{
+			name:        "a chain returned leaf-first is returned leaf-first",
+			vcertClient: returnsChain(leaf, intermediate, root),
+			args:        args{},
+			wantErr:     false,
+			checkFn:     checkChainLeafFirst,
+		},
+		{
+			name:        "a chain returned root-first is returned leaf-first",
+			vcertClient: returnsChain(root, intermediate, leaf),
+			args:        args{},
+			wantErr:     false,
+			checkFn:     checkChainLeafFirst,
+		},
+		{
+			name:        "error if the returned chain is broken",
+			vcertClient: returnsChain(leaf, root),
+			args:        args{},
+			wantErr:     true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -270,6 +368,7 @@ func TestVenafi_RetrieveCertificate(t *testing.T) {
 			}
 			v := &Venafi{
 				vcertClient: tt.vcertClient,
+				approval:    tt.approval,
 			}
 
 			if tt.args.csrPEM == nil {
@@ -289,6 +388,9 @@ func TestVenafi_RetrieveCertificate(t *testing.T) {
 				t.Errorf("RetrieveCertificate() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
+			if tt.wantErrType != nil && reflect.TypeOf(err) != reflect.TypeOf(tt.wantErrType) {
+				t.Errorf("RetrieveCertificate() error type = %T, want %T", err, tt.wantErrType)
+			}
 			if tt.checkFn != nil {
 				tt.checkFn(t, tt.args.csrPEM, got)
 			}
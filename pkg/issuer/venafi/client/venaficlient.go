@@ -36,6 +36,7 @@ import (
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/api"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/pkg/metrics"
 	"github.com/cert-manager/cert-manager/pkg/util"
 )
@@ -77,6 +78,14 @@ type Venafi struct {
 	tppClient   *tpp.Connector
 	cloudClient *cloud.Connector
 	config      *vcert.Config
+
+	// approval configures the TPP change-approval workflow integration. It
+	// is nil if the issuer does not use TPP or has not configured approval.
+	approval *cmapi.VenafiTPPApproval
+
+	// zoneCacheKey identifies the issuer in the cache of zone configurations
+	// used to build requests. If empty, the zone configuration is not cached.
+	zoneCacheKey string
 }
 
 // connector exposes a subset of the vcert Connector interface to make stubbing
@@ -98,6 +107,12 @@ func New(namespace string, secretsLister internalinformers.SecretLister, issuer
 		return nil, err
 	}
 
+	if headers := issuer.GetSpec().Venafi.HTTPHeaders; len(headers) > 0 {
+		// Only the names of the headers are logged, as their values may be
+		// credentials.
+		logger.V(logf.DebugLevel).Info("setting custom HTTP headers on requests to Venafi", "headers", util.HTTPHeaderNames(headers))
+	}
+
 	// Using `false` here ensures we do not immediately authenticate to the
 	// Venafi backend. Doing so invokes a call which forces the use of APIKey
 	// on the TPP side. This auth method has been removed since 22.4 of TPP.
@@ -139,6 +154,16 @@ func New(namespace string, secretsLister internalinformers.SecretLister, issuer
 		config:        cfg,
 	}
 
+	// A change to the spec of the issuer may change its zone, so its
+	// generation is part of the key.
+	if uid := issuer.GetUID(); uid != "" {
+		v.zoneCacheKey = fmt.Sprintf("%s/%d", uid, issuer.GetGeneration())
+	}
+
+	if tpp := issuer.GetSpec().Venafi.TPP; tpp != nil {
+		v.approval = tpp.Approval
+	}
+
 	// Since we did not authenticate when creating the client, authenticate
 	// now to verify the credentials passed. Ensure that upon leaving this
 	// function that credentials have been verified.
@@ -197,6 +222,7 @@ func configForIssuer(iss cmapi.GenericIssuer, secretsLister internalinformers.Se
 			},
 			Client: httpClientForVcert(&httpClientForVcertOptions{
 				UserAgent:               ptr.To(userAgent),
+				HTTPHeaders:             venaCfg.HTTPHeaders,
 				CABundle:                caBundle,
 				TLSRenegotiationSupport: ptr.To(tls.RenegotiateOnceAsClient),
 			}),
@@ -224,7 +250,8 @@ func configForIssuer(iss cmapi.GenericIssuer, secretsLister internalinformers.Se
 				APIKey: apiKey,
 			},
 			Client: httpClientForVcert(&httpClientForVcertOptions{
-				UserAgent: ptr.To(userAgent),
+				UserAgent:   ptr.To(userAgent),
+				HTTPHeaders: venaCfg.HTTPHeaders,
 			}),
 		}, nil
 	}
@@ -238,6 +265,8 @@ func configForIssuer(iss cmapi.GenericIssuer, secretsLister internalinformers.Se
 type httpClientForVcertOptions struct {
 	// UserAgent will add a User-Agent header to all HTTP requests.
 	UserAgent *string
+	// HTTPHeaders will add the given headers to all HTTP requests.
+	HTTPHeaders map[string]string
 	// CABundle will override the CA certificates used to verify server
 	// certificates.
 	CABundle []byte
@@ -247,7 +276,8 @@ type httpClientForVcertOptions struct {
 }
 
 // httpClientForVcert creates an HTTP client which matches the default HTTP client of vcert,
-// but allows you to customize client TLS renegotiation, and User-Agent.
+// but allows you to customize client TLS renegotiation, User-Agent and
+// additional headers.
 //
 // Why is it necessary to create our own HTTP client for vcert?
 //
@@ -334,8 +364,9 @@ func httpClientForVcert(options *httpClientForVcertOptions) *http.Client {
 
 	var roundTripper http.RoundTripper = transport
 	if options.UserAgent != nil {
-		roundTripper = util.UserAgentRoundTripper(transport, *options.UserAgent)
+		roundTripper = util.UserAgentRoundTripper(roundTripper, *options.UserAgent)
 	}
+	roundTripper = util.HeaderRoundTripper(roundTripper, options.HTTPHeaders)
 
 	// Copy vcert's initialization of the HTTP client, which overrides the default timeout.
 	// https://github.com/Venafi/vcert/blob/89645a7710a7b529765274cb60dc5e28066217a1/pkg/venafi/tpp/tpp.go#L481-L513
@@ -0,0 +1,96 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Venafi/vcert/v5/pkg/endpoint"
+)
+
+// zoneConfigurationCacheTTL is the time for which the zone configuration read
+// from Venafi is used to build requests before it is read again.
+const zoneConfigurationCacheTTL = 5 * time.Minute
+
+// zoneConfigurations is shared by the clients, as a new client is built for
+// each CertificateRequest.
+var zoneConfigurations = &zoneConfigurationCache{
+	entries: make(map[string]cachedZoneConfiguration),
+}
+
+// readZoneConfiguration returns the zone configuration of the issuer, which is
+// read from Venafi at most once per zoneConfigurationCacheTTL. Errors are not
+// cached.
+func (v *Venafi) readZoneConfiguration() (*endpoint.ZoneConfiguration, error) {
+	if v.zoneCacheKey != "" {
+		if zoneCfg := zoneConfigurations.get(v.zoneCacheKey, time.Now()); zoneCfg != nil {
+			return zoneCfg, nil
+		}
+	}
+
+	zoneCfg, err := v.vcertClient.ReadZoneConfiguration()
+	if err != nil {
+		return nil, err
+	}
+
+	if v.zoneCacheKey != "" {
+		zoneConfigurations.set(v.zoneCacheKey, zoneCfg, time.Now())
+	}
+	return zoneCfg, nil
+}
+
+type cachedZoneConfiguration struct {
+	zoneCfg *endpoint.ZoneConfiguration
+	expiry  time.Time
+}
+
+// zoneConfigurationCache caches the zone configuration of each issuer. The
+// cached zone configurations must not be modified.
+type zoneConfigurationCache struct {
+	lock    sync.Mutex
+	entries map[string]cachedZoneConfiguration
+}
+
+func (c *zoneConfigurationCache) get(key string, now time.Time) *endpoint.ZoneConfiguration {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !now.Before(entry.expiry) {
+		return nil
+	}
+	return entry.zoneCfg
+}
+
+func (c *zoneConfigurationCache) set(key string, zoneCfg *endpoint.ZoneConfiguration, now time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// Remove expired entries, such as those of previous generations of an
+	// issuer, so that the cache does not grow without bound.
+	for k, entry := range c.entries {
+		if !now.Before(entry.expiry) {
+			delete(c.entries, k)
+		}
+	}
+
+	c.entries[key] = cachedZoneConfiguration{
+		zoneCfg: zoneCfg,
+		expiry:  now.Add(zoneConfigurationCacheTTL),
+	}
+}
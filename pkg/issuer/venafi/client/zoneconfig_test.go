@@ -0,0 +1,120 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Venafi/vcert/v5/pkg/endpoint"
+	"github.com/Venafi/vcert/v5/pkg/venafi/fake"
+
+	internalfake "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/fake"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+func TestVenafi_readZoneConfiguration(t *testing.T) {
+	privateKey, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrPEM := generateCSR(t, privateKey, "common-name", []string{"foo.example.com"})
+
+	var reads int
+	var readErr error
+	vcertClient := internalfake.Connector{
+		ReadZoneConfigurationFunc: func() (*endpoint.ZoneConfiguration, error) {
+			reads++
+			if readErr != nil {
+				return nil, readErr
+			}
+			return fake.NewConnector(true, nil).ReadZoneConfiguration()
+		},
+	}.Default()
+
+	// A new client is built for each CertificateRequest, so each request is
+	// made by its own client.
+	request := func(zoneCacheKey string) error {
+		v := &Venafi{vcertClient: vcertClient, zoneCacheKey: zoneCacheKey}
+		_, err := v.RequestCertificate(csrPEM, time.Minute, nil)
+		return err
+	}
+
+	if err := request("test-uid/1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := request("test-uid/1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reads != 1 {
+		t.Errorf("expected requests for the same issuer to share one zone configuration read, got %d reads", reads)
+	}
+
+	if err := request("test-uid/2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reads != 2 {
+		t.Errorf("expected a new generation of the issuer to read the zone configuration, got %d reads", reads)
+	}
+
+	readErr = errors.New("zone configuration error")
+	if err := request("test-uid/3"); err == nil {
+		t.Fatal("expected an error reading the zone configuration")
+	}
+	readErr = nil
+	if err := request("test-uid/3"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reads != 4 {
+		t.Errorf("expected a failed zone configuration read not to be cached, got %d reads", reads)
+	}
+
+	if err := request(""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := request(""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if reads != 6 {
+		t.Errorf("expected a client without a cache key not to cache the zone configuration, got %d reads", reads)
+	}
+}
+
+func TestZoneConfigurationCache(t *testing.T) {
+	cache := &zoneConfigurationCache{entries: make(map[string]cachedZoneConfiguration)}
+	now := time.Now()
+	zoneCfg := &endpoint.ZoneConfiguration{}
+
+	if got := cache.get("a", now); got != nil {
+		t.Errorf("expected no zone configuration before it is set, got %v", got)
+	}
+
+	cache.set("a", zoneCfg, now)
+	if got := cache.get("a", now.Add(zoneConfigurationCacheTTL-time.Second)); got != zoneCfg {
+		t.Errorf("expected the cached zone configuration before it expires, got %v", got)
+	}
+	if got := cache.get("a", now.Add(zoneConfigurationCacheTTL)); got != nil {
+		t.Errorf("expected no zone configuration once it has expired, got %v", got)
+	}
+
+	// Setting an entry removes the expired entries.
+	cache.set("b", zoneCfg, now.Add(zoneConfigurationCacheTTL))
+	if _, ok := cache.entries["a"]; ok {
+		t.Error("expected the expired entry to be removed")
+	}
+}
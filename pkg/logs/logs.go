@@ -20,6 +20,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"strconv"
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/pflag"
@@ -89,6 +90,13 @@ func ValidateAndApply(opts *logsapi.LoggingConfiguration) error {
 	return logsapi.ValidateAndApply(opts, nil)
 }
 
+// SetVerbosity changes the verbosity of the logger which was configured using
+// ValidateAndApply while the process is running.
+func SetVerbosity(v logsapi.VerbosityLevel) error {
+	_, err := logs.GlogSetter(strconv.FormatUint(uint64(v), 10))
+	return err
+}
+
 // FlushLogs flushes logs immediately.
 func FlushLogs() {
 	logs.FlushLogs()
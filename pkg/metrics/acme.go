@@ -29,3 +29,15 @@ func (m *Metrics) ObserveACMERequestDuration(duration time.Duration, labels ...s
 func (m *Metrics) IncrementACMERequestCount(labels ...string) {
 	m.acmeClientRequestCount.WithLabelValues(labels...).Inc()
 }
+
+// IncrementACMEOrderRateLimitedCount increases the counter of ACME orders
+// delayed by the rate limit of the given namespace.
+func (m *Metrics) IncrementACMEOrderRateLimitedCount(namespace string) {
+	m.acmeOrderRateLimitedCount.WithLabelValues(namespace).Inc()
+}
+
+// IncrementACMEChallengeCleanUpErrorCount increases the counter of failed
+// clean ups of ACME challenges of the given type.
+func (m *Metrics) IncrementACMEChallengeCleanUpErrorCount(challengeType string) {
+	m.acmeChallengeCleanUpErrorCount.WithLabelValues(challengeType).Inc()
+}
@@ -25,9 +25,10 @@ import (
 )
 
 // UpdateCertificate will update the given Certificate's metrics for its expiry, renewal, and status
-// condition.
+// conditions.
 func (m *Metrics) UpdateCertificate(crt *cmapi.Certificate) {
 	m.updateCertificateStatus(crt)
+	m.updateCertificateIssuerMissing(crt)
 	m.updateCertificateExpiry(crt)
 	m.updateCertificateRenewalTime(crt)
 }
@@ -97,6 +98,25 @@ func (m *Metrics) updateCertificateReadyStatus(crt *cmapi.Certificate, current c
 	}
 }
 
+// updateCertificateIssuerMissing sets the metric to 1 if the Certificate has
+// the IssuerMissing condition set to True, and 0 otherwise.
+func (m *Metrics) updateCertificateIssuerMissing(crt *cmapi.Certificate) {
+	value := 0.0
+
+	for _, c := range crt.Status.Conditions {
+		if c.Type == cmapi.CertificateConditionIssuerMissing && c.Status == cmmeta.ConditionTrue {
+			value = 1.0
+		}
+	}
+
+	m.certificateIssuerMissing.With(prometheus.Labels{
+		"name":         crt.Name,
+		"namespace":    crt.Namespace,
+		"issuer_name":  crt.Spec.IssuerRef.Name,
+		"issuer_kind":  crt.Spec.IssuerRef.Kind,
+		"issuer_group": crt.Spec.IssuerRef.Group}).Set(value)
+}
+
 // RemoveCertificate will delete the Certificate metrics from continuing to be
 // exposed.
 func (m *Metrics) RemoveCertificate(key types.NamespacedName) {
@@ -105,4 +125,5 @@ func (m *Metrics) RemoveCertificate(key types.NamespacedName) {
 	m.certificateExpiryTimeSeconds.DeletePartialMatch(prometheus.Labels{"name": name, "namespace": namespace})
 	m.certificateRenewalTimeSeconds.DeletePartialMatch(prometheus.Labels{"name": name, "namespace": namespace})
 	m.certificateReadyStatus.DeletePartialMatch(prometheus.Labels{"name": name, "namespace": namespace})
+	m.certificateIssuerMissing.DeletePartialMatch(prometheus.Labels{"name": name, "namespace": namespace})
 }
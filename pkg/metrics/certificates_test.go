@@ -370,3 +370,47 @@ func TestCertificateCache(t *testing.T) {
 		t.Errorf("unexpected collecting result")
 	}
 }
+
+func TestCertificateIssuerMissingMetric(t *testing.T) {
+	m := New(logtesting.NewTestLogger(t), clock.RealClock{})
+
+	issuerRef := gen.SetCertificateIssuer(cmmeta.ObjectReference{
+		Name:  "test-issuer",
+		Kind:  "Issuer",
+		Group: "cert-manager.io",
+	})
+	m.UpdateCertificate(gen.Certificate("missing",
+		gen.SetCertificateNamespace("test-ns"), issuerRef,
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:   cmapi.CertificateConditionIssuerMissing,
+			Status: cmmeta.ConditionTrue,
+		}),
+	))
+	m.UpdateCertificate(gen.Certificate("present",
+		gen.SetCertificateNamespace("test-ns"), issuerRef,
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:   cmapi.CertificateConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}),
+	))
+
+	const issuerMissingMetadata = `
+	# HELP certmanager_certificate_issuer_missing Whether the issuer referenced by the certificate has not been found for a sustained period.
+	# TYPE certmanager_certificate_issuer_missing gauge
+`
+	if err := testutil.CollectAndCompare(m.certificateIssuerMissing,
+		strings.NewReader(issuerMissingMetadata+`
+	certmanager_certificate_issuer_missing{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",name="missing",namespace="test-ns"} 1
+	certmanager_certificate_issuer_missing{issuer_group="cert-manager.io",issuer_kind="Issuer",issuer_name="test-issuer",name="present",namespace="test-ns"} 0
+`),
+		"certmanager_certificate_issuer_missing",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	m.RemoveCertificate(types.NamespacedName{Namespace: "test-ns", Name: "missing"})
+	m.RemoveCertificate(types.NamespacedName{Namespace: "test-ns", Name: "present"})
+	if testutil.CollectAndCount(m.certificateIssuerMissing, "certmanager_certificate_issuer_missing") != 0 {
+		t.Errorf("unexpected collecting result")
+	}
+}
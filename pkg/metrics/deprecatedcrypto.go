@@ -0,0 +1,26 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import "strconv"
+
+// IncrementDeprecatedCrypto will increase the number of issued certificates
+// using the given deprecated key or signature algorithm, whose key or hash
+// has the given size in bits.
+func (m *Metrics) IncrementDeprecatedCrypto(algorithm string, size int) {
+	m.deprecatedCryptoCount.WithLabelValues(algorithm, strconv.Itoa(size)).Inc()
+}
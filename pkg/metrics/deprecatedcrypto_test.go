@@ -0,0 +1,44 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	logtesting "github.com/go-logr/logr/testing"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/utils/clock"
+)
+
+func TestDeprecatedCryptoMetrics(t *testing.T) {
+	m := New(logtesting.NewTestLogger(t), clock.RealClock{})
+
+	m.IncrementDeprecatedCrypto("RSA", 1024)
+	m.IncrementDeprecatedCrypto("RSA", 1024)
+	m.IncrementDeprecatedCrypto("SHA1-RSA", 160)
+
+	expected := `
+	# HELP certmanager_deprecated_crypto_total The number of issued certificates using a key or signature algorithm below the configured deprecated crypto thresholds, by algorithm and size in bits.
+	# TYPE certmanager_deprecated_crypto_total counter
+	certmanager_deprecated_crypto_total{algorithm="RSA",size="1024"} 2
+	certmanager_deprecated_crypto_total{algorithm="SHA1-RSA",size="160"} 1
+`
+	if err := testutil.CollectAndCompare(m.deprecatedCryptoCount, strings.NewReader(expected), "certmanager_deprecated_crypto_total"); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
@@ -0,0 +1,29 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+// SetIssuanceLimit will set the maximum number of issuances which may be in
+// flight at once. A limit of 0 means the number is not limited.
+func (m *Metrics) SetIssuanceLimit(limit int) {
+	m.issuanceLimit.Set(float64(limit))
+}
+
+// SetIssuancesInFlight will set the number of issuances which are in flight,
+// from the time they are allowed to start until they have completed.
+func (m *Metrics) SetIssuancesInFlight(inFlight int) {
+	m.issuancesInFlight.Set(float64(inFlight))
+}
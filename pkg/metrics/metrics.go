@@ -19,10 +19,12 @@ limitations under the License.
 // certificate_expiration_timestamp_seconds{name, namespace, issuer_name, issuer_kind, issuer_group}
 // certificate_renewal_timestamp_seconds{name, namespace, issuer_name, issuer_kind, issuer_group}
 // certificate_ready_status{name, namespace, condition, issuer_name, issuer_kind, issuer_group}
+// certificate_issuer_missing{name, namespace, issuer_name, issuer_kind, issuer_group}
 // acme_client_request_count{"scheme", "host", "path", "method", "status"}
 // acme_client_request_duration_seconds{"scheme", "host", "path", "method", "status"}
 // venafi_client_request_duration_seconds{"scheme", "host", "path", "method", "status"}
 // controller_sync_call_count{"controller"}
+// shim_san_drift{name, namespace, source_kind, source_name}
 package metrics
 
 import (
@@ -58,11 +60,18 @@ type Metrics struct {
 	certificateExpiryTimeSeconds       *prometheus.GaugeVec
 	certificateRenewalTimeSeconds      *prometheus.GaugeVec
 	certificateReadyStatus             *prometheus.GaugeVec
+	certificateIssuerMissing           *prometheus.GaugeVec
 	acmeClientRequestDurationSeconds   *prometheus.SummaryVec
 	acmeClientRequestCount             *prometheus.CounterVec
+	acmeOrderRateLimitedCount          *prometheus.CounterVec
+	acmeChallengeCleanUpErrorCount     *prometheus.CounterVec
 	venafiClientRequestDurationSeconds *prometheus.SummaryVec
 	controllerSyncCallCount            *prometheus.CounterVec
 	controllerSyncErrorCount           *prometheus.CounterVec
+	shimSANDrift                       *prometheus.GaugeVec
+	issuancesInFlight                  prometheus.Gauge
+	issuanceLimit                      prometheus.Gauge
+	deprecatedCryptoCount              *prometheus.CounterVec
 }
 
 var readyConditionStatuses = [...]cmmeta.ConditionStatus{cmmeta.ConditionTrue, cmmeta.ConditionFalse, cmmeta.ConditionUnknown}
@@ -128,6 +137,15 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 			[]string{"name", "namespace", "condition", "issuer_name", "issuer_kind", "issuer_group"},
 		)
 
+		certificateIssuerMissing = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "certificate_issuer_missing",
+				Help:      "Whether the issuer referenced by the certificate has not been found for a sustained period.",
+			},
+			[]string{"name", "namespace", "issuer_name", "issuer_kind", "issuer_group"},
+		)
+
 		// acmeClientRequestCount is a Prometheus summary to collect the number of
 		// requests made to each endpoint with the ACME client.
 		acmeClientRequestCount = prometheus.NewCounterVec(
@@ -140,6 +158,30 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 			[]string{"scheme", "host", "path", "method", "status"},
 		)
 
+		// acmeOrderRateLimitedCount is a Prometheus counter of the number of
+		// times the creation of an ACME order was delayed by the rate limit
+		// of its namespace.
+		acmeOrderRateLimitedCount = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "acme_order_rate_limited_count",
+				Help:      "The number of times the creation of an ACME order was delayed by the per-namespace ACME order rate limit.",
+			},
+			[]string{"namespace"},
+		)
+
+		// acmeChallengeCleanUpErrorCount is a Prometheus counter of the
+		// number of times cleaning up the records presented for an ACME
+		// challenge failed.
+		acmeChallengeCleanUpErrorCount = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "acme_challenge_cleanup_error_count",
+				Help:      "The number of times cleaning up the records presented for an ACME challenge failed, by challenge type. Records which failed to be cleaned up may have been left behind.",
+			},
+			[]string{"type"},
+		)
+
 		// acmeClientRequestDurationSeconds is a Prometheus summary to collect request
 		// times for the ACME client.
 		acmeClientRequestDurationSeconds = prometheus.NewSummaryVec(
@@ -185,6 +227,43 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 			},
 			[]string{"controller"},
 		)
+
+		shimSANDrift = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "shim_san_drift",
+				Help:      "Whether the SANs of a certificate managed by the certificate-shim differ from the hosts of its source Ingress or Gateway.",
+			},
+			[]string{"name", "namespace", "source_kind", "source_name"},
+		)
+
+		// issuancesInFlight and issuanceLimit expose the utilization of the
+		// issuance limit shared by the certificaterequests and orders
+		// controllers.
+		issuancesInFlight = prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "controller_issuances_in_flight",
+				Help:      "The number of issuances in flight in the certificaterequests and orders controllers, from the time they start until their resource reaches a final state or is deleted.",
+			},
+		)
+
+		issuanceLimit = prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "controller_issuance_limit",
+				Help:      "The maximum number of issuances which may be in flight at once, or 0 if the number is not limited.",
+			},
+		)
+
+		deprecatedCryptoCount = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "deprecated_crypto_total",
+				Help:      "The number of issued certificates using a key or signature algorithm below the configured deprecated crypto thresholds, by algorithm and size in bits.",
+			},
+			[]string{"algorithm", "size"},
+		)
 	)
 
 	// Create Registry and register the recommended collectors
@@ -203,11 +282,18 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 		certificateExpiryTimeSeconds:       certificateExpiryTimeSeconds,
 		certificateRenewalTimeSeconds:      certificateRenewalTimeSeconds,
 		certificateReadyStatus:             certificateReadyStatus,
+		certificateIssuerMissing:           certificateIssuerMissing,
 		acmeClientRequestCount:             acmeClientRequestCount,
 		acmeClientRequestDurationSeconds:   acmeClientRequestDurationSeconds,
+		acmeOrderRateLimitedCount:          acmeOrderRateLimitedCount,
+		acmeChallengeCleanUpErrorCount:     acmeChallengeCleanUpErrorCount,
 		venafiClientRequestDurationSeconds: venafiClientRequestDurationSeconds,
 		controllerSyncCallCount:            controllerSyncCallCount,
 		controllerSyncErrorCount:           controllerSyncErrorCount,
+		shimSANDrift:                       shimSANDrift,
+		issuancesInFlight:                  issuancesInFlight,
+		issuanceLimit:                      issuanceLimit,
+		deprecatedCryptoCount:              deprecatedCryptoCount,
 	}
 
 	return m
@@ -220,11 +306,18 @@ func (m *Metrics) NewServer(ln net.Listener) *http.Server {
 	m.registry.MustRegister(m.certificateExpiryTimeSeconds)
 	m.registry.MustRegister(m.certificateRenewalTimeSeconds)
 	m.registry.MustRegister(m.certificateReadyStatus)
+	m.registry.MustRegister(m.certificateIssuerMissing)
 	m.registry.MustRegister(m.acmeClientRequestDurationSeconds)
 	m.registry.MustRegister(m.venafiClientRequestDurationSeconds)
 	m.registry.MustRegister(m.acmeClientRequestCount)
+	m.registry.MustRegister(m.acmeOrderRateLimitedCount)
+	m.registry.MustRegister(m.acmeChallengeCleanUpErrorCount)
 	m.registry.MustRegister(m.controllerSyncCallCount)
 	m.registry.MustRegister(m.controllerSyncErrorCount)
+	m.registry.MustRegister(m.shimSANDrift)
+	m.registry.MustRegister(m.issuancesInFlight)
+	m.registry.MustRegister(m.issuanceLimit)
+	m.registry.MustRegister(m.deprecatedCryptoCount)
 
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
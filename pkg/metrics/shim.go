@@ -0,0 +1,55 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// UpdateShimSANDrift will update the SAN drift metric of a Certificate
+// managed by the certificate-shim for the given Ingress-like source object.
+// The metric is set to 1 if the SANs of the issued certificate differ from
+// the hosts of the source, and 0 once they have converged.
+func (m *Metrics) UpdateShimSANDrift(namespace, name, sourceKind, sourceName string, drifted bool) {
+	value := 0.0
+	if drifted {
+		value = 1.0
+	}
+
+	m.shimSANDrift.With(prometheus.Labels{
+		"name":        name,
+		"namespace":   namespace,
+		"source_kind": sourceKind,
+		"source_name": sourceName,
+	}).Set(value)
+}
+
+// RemoveShimSANDrift will delete the SAN drift metric of a Certificate which
+// is no longer managed by the certificate-shim for the given source object.
+// If name is empty, the metrics of all Certificates of the source are deleted.
+func (m *Metrics) RemoveShimSANDrift(namespace, name, sourceKind, sourceName string) {
+	labels := prometheus.Labels{
+		"namespace":   namespace,
+		"source_kind": sourceKind,
+		"source_name": sourceName,
+	}
+	if name != "" {
+		labels["name"] = name
+	}
+
+	m.shimSANDrift.DeletePartialMatch(labels)
+}
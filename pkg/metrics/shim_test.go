@@ -0,0 +1,78 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	logtesting "github.com/go-logr/logr/testing"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/utils/clock"
+)
+
+const shimSANDriftMetadata = `
+	# HELP certmanager_shim_san_drift Whether the SANs of a certificate managed by the certificate-shim differ from the hosts of its source Ingress or Gateway.
+	# TYPE certmanager_shim_san_drift gauge
+`
+
+func TestShimSANDriftMetrics(t *testing.T) {
+	m := New(logtesting.NewTestLogger(t), clock.RealClock{})
+
+	m.UpdateShimSANDrift("test-ns", "crt1", "Ingress", "ing1", true)
+	m.UpdateShimSANDrift("test-ns", "crt2", "Ingress", "ing1", false)
+	m.UpdateShimSANDrift("test-ns", "crt3", "Gateway", "gw1", true)
+
+	if err := testutil.CollectAndCompare(m.shimSANDrift,
+		strings.NewReader(shimSANDriftMetadata+`
+	certmanager_shim_san_drift{name="crt1",namespace="test-ns",source_kind="Ingress",source_name="ing1"} 1
+	certmanager_shim_san_drift{name="crt2",namespace="test-ns",source_kind="Ingress",source_name="ing1"} 0
+	certmanager_shim_san_drift{name="crt3",namespace="test-ns",source_kind="Gateway",source_name="gw1"} 1
+`),
+		"certmanager_shim_san_drift",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	// Once converged, the drift is cleared.
+	m.UpdateShimSANDrift("test-ns", "crt1", "Ingress", "ing1", false)
+	// Removing a single Certificate keeps the metrics of the other
+	// Certificates of the source.
+	m.RemoveShimSANDrift("test-ns", "crt2", "Ingress", "ing1")
+
+	if err := testutil.CollectAndCompare(m.shimSANDrift,
+		strings.NewReader(shimSANDriftMetadata+`
+	certmanager_shim_san_drift{name="crt1",namespace="test-ns",source_kind="Ingress",source_name="ing1"} 0
+	certmanager_shim_san_drift{name="crt3",namespace="test-ns",source_kind="Gateway",source_name="gw1"} 1
+`),
+		"certmanager_shim_san_drift",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	// Removing the source removes the metrics of all of its Certificates.
+	m.RemoveShimSANDrift("test-ns", "", "Gateway", "gw1")
+
+	if err := testutil.CollectAndCompare(m.shimSANDrift,
+		strings.NewReader(shimSANDriftMetadata+`
+	certmanager_shim_san_drift{name="crt1",namespace="test-ns",source_kind="Ingress",source_name="ing1"} 0
+`),
+		"certmanager_shim_san_drift",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
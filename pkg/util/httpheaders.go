@@ -0,0 +1,74 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"net/http"
+	"sort"
+)
+
+// headerRoundTripper implements the http.RoundTripper interface and adds a
+// fixed set of headers to every request.
+type headerRoundTripper struct {
+	inner   http.RoundTripper
+	headers http.Header
+}
+
+// HeaderRoundTripper returns a RoundTripper that functions identically to the
+// provided 'inner' round tripper, other than also setting the given headers
+// on every request. If no headers are given, 'inner' is returned unchanged.
+func HeaderRoundTripper(inner http.RoundTripper, headers map[string]string) http.RoundTripper {
+	if len(headers) == 0 {
+		return inner
+	}
+
+	h := make(http.Header, len(headers))
+	for name, value := range headers {
+		h.Set(name, value)
+	}
+
+	return headerRoundTripper{
+		inner:   inner,
+		headers: h,
+	}
+}
+
+// RoundTrip implements http.RoundTripper
+func (h headerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// A RoundTripper must not modify the request, so set the headers on a
+	// copy of it.
+	req = req.Clone(req.Context())
+	for name, values := range h.headers {
+		req.Header[name] = values
+	}
+	return h.inner.RoundTrip(req)
+}
+
+// HTTPHeaderNames returns the sorted names of the given headers. The values of
+// custom headers often hold credentials, so only their names may be logged.
+func HTTPHeaderNames(headers map[string]string) []string {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, http.CanonicalHeaderKey(name))
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,68 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HeaderRoundTripper(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: HeaderRoundTripper(http.DefaultTransport, map[string]string{
+			"x-api-gateway-key": "secret",
+			"X-Tenant":          "team-a",
+		}),
+	}
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, "secret", received.Get("X-Api-Gateway-Key"))
+	assert.Equal(t, "team-a", received.Get("X-Tenant"))
+	assert.Equal(t, "application/json", received.Get("Accept"))
+
+	// The request of the caller is not modified.
+	assert.Equal(t, http.Header{"Accept": []string{"application/json"}}, req.Header)
+}
+
+func Test_HeaderRoundTripperWithoutHeaders(t *testing.T) {
+	assert.Equal(t, http.DefaultTransport, HeaderRoundTripper(http.DefaultTransport, nil))
+}
+
+func Test_HTTPHeaderNames(t *testing.T) {
+	assert.Nil(t, HTTPHeaderNames(nil))
+	assert.Equal(t, []string{"X-Api-Gateway-Key", "X-Tenant"}, HTTPHeaderNames(map[string]string{
+		"x-tenant":          "team-a",
+		"X-API-Gateway-Key": "secret",
+	}))
+}
@@ -0,0 +1,242 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"net/url"
+	"unicode/utf8"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+var (
+	// OIDExtensionCertificatePolicies is the OID of the Certificate Policies
+	// extension (RFC 5280, 4.2.1.4).
+	OIDExtensionCertificatePolicies = asn1.ObjectIdentifier{2, 5, 29, 32}
+
+	// oidPolicyQualifierCPS is the OID of the CPS pointer policy qualifier.
+	oidPolicyQualifierCPS = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 2, 1}
+
+	// oidPolicyQualifierUserNotice is the OID of the user notice policy
+	// qualifier.
+	oidPolicyQualifierUserNotice = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 7, 2, 2}
+)
+
+// maxUserNoticeLength is the maximum number of characters of the explicit
+// text of a user notice (RFC 5280, 4.2.1.4).
+const maxUserNoticeLength = 200
+
+// tagVisibleString is the ASN.1 tag of a VisibleString, which is one of the
+// encodings of the explicit text of a user notice.
+const tagVisibleString = 26
+
+// policyInformation is an element of the Certificate Policies extension:
+//
+//	PolicyInformation ::= SEQUENCE {
+//	    policyIdentifier   CertPolicyId,
+//	    policyQualifiers   SEQUENCE SIZE (1..MAX) OF
+//	                            PolicyQualifierInfo OPTIONAL }
+//
+//	PolicyQualifierInfo ::= SEQUENCE {
+//	    policyQualifierId  PolicyQualifierId,
+//	    qualifier          ANY DEFINED BY policyQualifierId }
+type policyInformation struct {
+	PolicyIdentifier asn1.ObjectIdentifier
+	PolicyQualifiers []policyQualifierInfo `asn1:"optional,omitempty"`
+}
+
+type policyQualifierInfo struct {
+	PolicyQualifierID asn1.ObjectIdentifier
+	Qualifier         asn1.RawValue
+}
+
+// userNotice is the user notice policy qualifier. The noticeRef field is not
+// supported, as RFC 5280 recommends against its use.
+//
+//	UserNotice ::= SEQUENCE {
+//	    noticeRef        NoticeReference OPTIONAL,
+//	    explicitText     DisplayText OPTIONAL }
+type userNotice struct {
+	ExplicitText string `asn1:"utf8"`
+}
+
+// ValidateCertificatePolicyCPSURI returns an error if the given URI cannot be
+// encoded as a CPS pointer qualifier, which stores it as an IA5String.
+func ValidateCertificatePolicyCPSURI(cpsURI string) error {
+	for _, r := range cpsURI {
+		if r > 0x7F {
+			return fmt.Errorf("must only contain ASCII characters, got %q", r)
+		}
+	}
+	u, err := url.Parse(cpsURI)
+	if err != nil {
+		return err
+	}
+	if !u.IsAbs() {
+		return errors.New("must be an absolute URI")
+	}
+	return nil
+}
+
+// ValidateCertificatePolicyUserNotice returns an error if the given text
+// cannot be encoded as the explicit text of a user notice qualifier.
+func ValidateCertificatePolicyUserNotice(text string) error {
+	if !utf8.ValidString(text) {
+		return errors.New("must be a valid UTF8 string")
+	}
+	if n := utf8.RuneCountInString(text); n > maxUserNoticeLength {
+		return fmt.Errorf("must be at most %d characters long, got %d", maxUserNoticeLength, n)
+	}
+	return nil
+}
+
+// MarshalCertificatePolicies encodes the given policies as the Certificate
+// Policies extension.
+func MarshalCertificatePolicies(policies []cmapi.CertificatePolicy) (pkix.Extension, error) {
+	infos := make([]policyInformation, 0, len(policies))
+	for _, policy := range policies {
+		oid, err := ParseObjectIdentifier(policy.OID)
+		if err != nil {
+			return pkix.Extension{}, fmt.Errorf("invalid certificate policy oid %q: %w", policy.OID, err)
+		}
+		info := policyInformation{PolicyIdentifier: oid}
+
+		if policy.CPSURI != "" {
+			if err := ValidateCertificatePolicyCPSURI(policy.CPSURI); err != nil {
+				return pkix.Extension{}, fmt.Errorf("invalid CPS URI for certificate policy %q: %w", policy.OID, err)
+			}
+			qualifier, err := asn1.MarshalWithParams(policy.CPSURI, "ia5")
+			if err != nil {
+				return pkix.Extension{}, err
+			}
+			info.PolicyQualifiers = append(info.PolicyQualifiers, policyQualifierInfo{
+				PolicyQualifierID: oidPolicyQualifierCPS,
+				Qualifier:         asn1.RawValue{FullBytes: qualifier},
+			})
+		}
+
+		if policy.UserNotice != "" {
+			if err := ValidateCertificatePolicyUserNotice(policy.UserNotice); err != nil {
+				return pkix.Extension{}, fmt.Errorf("invalid user notice for certificate policy %q: %w", policy.OID, err)
+			}
+			qualifier, err := asn1.Marshal(userNotice{ExplicitText: policy.UserNotice})
+			if err != nil {
+				return pkix.Extension{}, err
+			}
+			info.PolicyQualifiers = append(info.PolicyQualifiers, policyQualifierInfo{
+				PolicyQualifierID: oidPolicyQualifierUserNotice,
+				Qualifier:         asn1.RawValue{FullBytes: qualifier},
+			})
+		}
+
+		infos = append(infos, info)
+	}
+
+	value, err := asn1.Marshal(infos)
+	if err != nil {
+		return pkix.Extension{}, err
+	}
+	return pkix.Extension{Id: OIDExtensionCertificatePolicies, Value: value}, nil
+}
+
+// UnmarshalCertificatePolicies returns the policies encoded in the
+// Certificate Policies extension found in the given extensions, or nil if
+// they don't contain the extension. Only the first CPS pointer and user
+// notice qualifier of each policy are returned, and unknown qualifiers are
+// ignored.
+func UnmarshalCertificatePolicies(extensions []pkix.Extension) ([]cmapi.CertificatePolicy, error) {
+	for _, ext := range extensions {
+		if !ext.Id.Equal(OIDExtensionCertificatePolicies) {
+			continue
+		}
+
+		var infos []policyInformation
+		if rest, err := asn1.Unmarshal(ext.Value, &infos); err != nil {
+			return nil, err
+		} else if len(rest) != 0 {
+			return nil, errors.New("x509: trailing data after certificate policies")
+		}
+
+		policies := make([]cmapi.CertificatePolicy, 0, len(infos))
+		for _, info := range infos {
+			policy := cmapi.CertificatePolicy{OID: info.PolicyIdentifier.String()}
+			for _, qualifier := range info.PolicyQualifiers {
+				switch {
+				case qualifier.PolicyQualifierID.Equal(oidPolicyQualifierCPS) && policy.CPSURI == "":
+					var cpsURI string
+					if _, err := asn1.UnmarshalWithParams(qualifier.Qualifier.FullBytes, &cpsURI, "ia5"); err != nil {
+						return nil, fmt.Errorf("x509: invalid CPS URI for certificate policy %q: %w", policy.OID, err)
+					}
+					policy.CPSURI = cpsURI
+
+				case qualifier.PolicyQualifierID.Equal(oidPolicyQualifierUserNotice) && policy.UserNotice == "":
+					text, err := unmarshalUserNotice(qualifier.Qualifier.FullBytes)
+					if err != nil {
+						return nil, fmt.Errorf("x509: invalid user notice for certificate policy %q: %w", policy.OID, err)
+					}
+					policy.UserNotice = text
+				}
+			}
+			policies = append(policies, policy)
+		}
+		return policies, nil
+	}
+	return nil, nil
+}
+
+// unmarshalUserNotice returns the explicit text of the given user notice
+// qualifier, ignoring any notice reference.
+func unmarshalUserNotice(der []byte) (string, error) {
+	var notice asn1.RawValue
+	if rest, err := asn1.Unmarshal(der, &notice); err != nil {
+		return "", err
+	} else if len(rest) != 0 {
+		return "", errors.New("trailing data after user notice")
+	}
+	if notice.Class != asn1.ClassUniversal || notice.Tag != asn1.TagSequence {
+		return "", errors.New("user notice is not a sequence")
+	}
+
+	rest := notice.Bytes
+	for len(rest) > 0 {
+		var field asn1.RawValue
+		var err error
+		if rest, err = asn1.Unmarshal(rest, &field); err != nil {
+			return "", err
+		}
+		if field.Class != asn1.ClassUniversal {
+			return "", errors.New("user notice contains an unexpected field")
+		}
+
+		switch field.Tag {
+		case asn1.TagSequence:
+			// noticeRef
+			continue
+		case asn1.TagUTF8String, asn1.TagIA5String, tagVisibleString:
+			return string(field.Bytes), nil
+		case asn1.TagBMPString:
+			return decodeBMPString(field.Bytes)
+		default:
+			return "", fmt.Errorf("unsupported explicit text encoding with tag %d", field.Tag)
+		}
+	}
+	return "", nil
+}
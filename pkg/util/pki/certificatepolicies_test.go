@@ -0,0 +1,181 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestMarshalCertificatePolicies(t *testing.T) {
+	tests := map[string]struct {
+		policies []cmapi.CertificatePolicy
+		// expectedValue is only checked if set.
+		expectedValue []byte
+		expectedErr   bool
+	}{
+		"policy without qualifiers": {
+			policies: []cmapi.CertificatePolicy{{OID: "2.23.140.1.2.1"}},
+			expectedValue: []byte{
+				0x30, 0x0a,
+				0x30, 0x08,
+				0x06, 0x06, 0x67, 0x81, 0x0c, 0x01, 0x02, 0x01,
+			},
+		},
+		"policy with a CPS URI": {
+			policies: []cmapi.CertificatePolicy{{OID: "2.23.140.1.2.1", CPSURI: "http://a/"}},
+			expectedValue: []byte{
+				0x30, 0x23,
+				0x30, 0x21,
+				0x06, 0x06, 0x67, 0x81, 0x0c, 0x01, 0x02, 0x01,
+				0x30, 0x17,
+				0x30, 0x15,
+				0x06, 0x08, 0x2b, 0x06, 0x01, 0x05, 0x05, 0x07, 0x02, 0x01,
+				0x16, 0x09, 'h', 't', 't', 'p', ':', '/', '/', 'a', '/',
+			},
+		},
+		"policies with CPS URIs and user notices": {
+			policies: []cmapi.CertificatePolicy{
+				{OID: "2.23.140.1.2.1", CPSURI: "https://pki.example.com/cps"},
+				{OID: "1.3.6.1.4.1.99999.1", UserNotice: "For internal use only"},
+				{OID: "1.3.6.1.4.1.99999.2", CPSURI: "https://pki.example.com/cps", UserNotice: "Zugelassen für Ämter"},
+			},
+		},
+		"invalid policy OID": {
+			policies:    []cmapi.CertificatePolicy{{OID: "2.23.foo"}},
+			expectedErr: true,
+		},
+		"CPS URI which is not an IA5String": {
+			policies:    []cmapi.CertificatePolicy{{OID: "2.23.140.1.2.1", CPSURI: "https://pki.example.com/ç"}},
+			expectedErr: true,
+		},
+		"user notice which is too long": {
+			policies:    []cmapi.CertificatePolicy{{OID: "2.23.140.1.2.1", UserNotice: strings.Repeat("a", 201)}},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ext, err := MarshalCertificatePolicies(test.policies)
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, OIDExtensionCertificatePolicies, ext.Id)
+			assert.False(t, ext.Critical)
+			if test.expectedValue != nil {
+				assert.Equal(t, test.expectedValue, ext.Value)
+			}
+
+			// The encoded policies must decode to the original ones.
+			decoded, err := UnmarshalCertificatePolicies([]pkix.Extension{ext})
+			require.NoError(t, err)
+			assert.Equal(t, test.policies, decoded)
+		})
+	}
+}
+
+func TestUnmarshalCertificatePolicies(t *testing.T) {
+	decoded, err := UnmarshalCertificatePolicies([]pkix.Extension{{Id: OIDExtensionKeyUsage, Value: []byte{0x03, 0x02, 0x05, 0xa0}}})
+	require.NoError(t, err)
+	assert.Nil(t, decoded, "extensions without certificate policies should decode to nil")
+
+	// A user notice with a notice reference and a BMPString explicit text,
+	// followed by a qualifier which is not supported.
+	notice, err := asn1.Marshal(struct {
+		NoticeRef struct {
+			Organization  string `asn1:"utf8"`
+			NoticeNumbers []int
+		}
+		ExplicitText asn1.RawValue
+	}{
+		NoticeRef: struct {
+			Organization  string `asn1:"utf8"`
+			NoticeNumbers []int
+		}{Organization: "Example", NoticeNumbers: []int{1}},
+		ExplicitText: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagBMPString, Bytes: []byte{0x00, 'H', 0x00, 'i'}},
+	})
+	require.NoError(t, err)
+	unknown, err := asn1.Marshal("unknown")
+	require.NoError(t, err)
+
+	value, err := asn1.Marshal([]policyInformation{{
+		PolicyIdentifier: asn1.ObjectIdentifier{2, 23, 140, 1, 2, 1},
+		PolicyQualifiers: []policyQualifierInfo{
+			{PolicyQualifierID: oidPolicyQualifierUserNotice, Qualifier: asn1.RawValue{FullBytes: notice}},
+			{PolicyQualifierID: asn1.ObjectIdentifier{1, 2, 3}, Qualifier: asn1.RawValue{FullBytes: unknown}},
+		},
+	}})
+	require.NoError(t, err)
+
+	decoded, err = UnmarshalCertificatePolicies([]pkix.Extension{{Id: OIDExtensionCertificatePolicies, Value: value}})
+	require.NoError(t, err)
+	assert.Equal(t, []cmapi.CertificatePolicy{{OID: "2.23.140.1.2.1", UserNotice: "Hi"}}, decoded)
+
+	_, err = UnmarshalCertificatePolicies([]pkix.Extension{{Id: OIDExtensionCertificatePolicies, Value: []byte{0x30, 0x03, 0x02, 0x01, 0x01}}})
+	assert.Error(t, err, "certificate policies which are not a sequence of policy information should be rejected")
+}
+
+func TestCertificatePoliciesAreIssued(t *testing.T) {
+	policies := []cmapi.CertificatePolicy{
+		{OID: "2.23.140.1.2.1"},
+		{OID: "1.3.6.1.4.1.99999.1", CPSURI: "https://pki.example.com/cps", UserNotice: "For internal use only"},
+	}
+	crt := &cmapi.Certificate{
+		Spec: cmapi.CertificateSpec{
+			CommonName:          "example.com",
+			PrivateKey:          &cmapi.CertificatePrivateKey{Algorithm: cmapi.ECDSAKeyAlgorithm},
+			CertificatePolicies: policies,
+		},
+	}
+
+	pk, err := GenerateECPrivateKey(ECCurve256)
+	require.NoError(t, err)
+	csr, err := GenerateCSR(crt)
+	require.NoError(t, err)
+	csrDER, err := EncodeCSR(csr, pk)
+	require.NoError(t, err)
+	csr, err = x509.ParseCertificateRequest(csrDER)
+	require.NoError(t, err)
+
+	// The policies are copied from the CSR to the certificate issued for it.
+	template, err := CertificateTemplateFromCSR(csr)
+	require.NoError(t, err)
+	_, cert, err := SignCertificate(template, template, pk.Public(), pk)
+	require.NoError(t, err)
+
+	decoded, err := UnmarshalCertificatePolicies(cert.Extensions)
+	require.NoError(t, err)
+	assert.Equal(t, policies, decoded)
+
+	oids := make([]string, 0, len(cert.Policies))
+	for _, oid := range cert.Policies {
+		oids = append(oids, oid.String())
+	}
+	assert.Equal(t, []string{"2.23.140.1.2.1", "1.3.6.1.4.1.99999.1"}, oids)
+}
@@ -143,6 +143,8 @@ func (k printKeyUsage) String() string {
 
 // CertificateTemplateFromCSR will create a x509.Certificate for the
 // given *x509.CertificateRequest.
+// If the CSR is signed using RSASSA-PSS, the template requests that the
+// certificate is signed using RSASSA-PSS too.
 func CertificateTemplateFromCSR(csr *x509.CertificateRequest, validatorMutators ...CertificateTemplateValidatorMutator) (*x509.Certificate, error) {
 	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
 	if err != nil {
@@ -165,6 +167,10 @@ func CertificateTemplateFromCSR(csr *x509.CertificateRequest, validatorMutators
 		URIs:           csr.URIs,
 	}
 
+	if IsRSAPSSSignatureAlgorithm(csr.SignatureAlgorithm) {
+		cert.SignatureAlgorithm = csr.SignatureAlgorithm
+	}
+
 	// Start by copying all extensions from the CSR
 	extractExtensions := func(template *x509.Certificate, val pkix.Extension) error {
 		// Check the CSR for the X.509 BasicConstraints (RFC 5280, 4.2.1.9)
@@ -230,6 +236,24 @@ func CertificateTemplateFromCSR(csr *x509.CertificateRequest, validatorMutators
 			template.ExtraExtensions = append(template.ExtraExtensions, val)
 		}
 
+		// The x509.Certificate policy fields cannot represent policy
+		// qualifiers, so the Certificate Policies extension is copied as is.
+		if val.Id.Equal(OIDExtensionCertificatePolicies) {
+			template.ExtraExtensions = append(template.ExtraExtensions, val)
+		}
+
+		// Legacy extensions are not understood by the x509 package, so they
+		// are copied as is.
+		if val.Id.Equal(OIDExtensionNetscapeCertType) || val.Id.Equal(OIDExtensionNetscapeComment) {
+			template.ExtraExtensions = append(template.ExtraExtensions, val)
+		}
+
+		// The policy constraints extensions are copied as is, and are removed
+		// again below if the certificate is not a CA.
+		if val.Id.Equal(OIDExtensionPolicyConstraints) || val.Id.Equal(OIDExtensionInhibitAnyPolicy) {
+			template.ExtraExtensions = append(template.ExtraExtensions, val)
+		}
+
 		return nil
 	}
 
@@ -271,6 +295,14 @@ func CertificateTemplateFromCSR(csr *x509.CertificateRequest, validatorMutators
 				cert.ExtraExtensions[i].Critical = IsASN1SubjectEmpty(asn1Subject)
 			}
 		}
+
+		// The Policy Constraints and Inhibit anyPolicy extensions may only
+		// be used in CA certificates (RFC 5280, 4.2.1.11 and 4.2.1.14).
+		if !cert.IsCA {
+			cert.ExtraExtensions = slices.DeleteFunc(cert.ExtraExtensions, func(ext pkix.Extension) bool {
+				return ext.Id.Equal(OIDExtensionPolicyConstraints) || ext.Id.Equal(OIDExtensionInhibitAnyPolicy)
+			})
+		}
 	}
 
 	return cert, nil
@@ -0,0 +1,147 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// TruncateCertificateChainPEM returns the given PEM encoded certificate chain
+// from the leaf certificate up to and including the CA certificate identified
+// by the anchor. The chain is ordered and de-duplicated in the same way as by
+// ParseSingleCertificateChain, but a self-signed root is kept if it is the
+// anchor. The leaf certificate itself is never matched against the anchor.
+// An error is returned if the anchor is invalid or is not part of the chain.
+func TruncateCertificateChainPEM(chainPEM []byte, anchor *v1.CertificateChainTruncation) ([]byte, error) {
+	if anchor == nil {
+		return chainPEM, nil
+	}
+
+	matches, err := chainAnchorMatcher(anchor)
+	if err != nil {
+		return nil, err
+	}
+
+	certs, err := DecodeX509CertificateChainBytes(chainPEM)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := buildSingleCertificateChain(certs)
+	if err != nil {
+		return nil, err
+	}
+
+	// The chain is encoded certificate by certificate, since EncodeX509Chain
+	// omits self-signed certificates.
+	truncated, err := EncodeX509(chain.cert)
+	if err != nil {
+		return nil, err
+	}
+	for node := chain.issuer; node != nil; node = node.issuer {
+		certPEM, err := EncodeX509(node.cert)
+		if err != nil {
+			return nil, err
+		}
+		truncated = append(truncated, certPEM...)
+		if matches(node.cert) {
+			return truncated, nil
+		}
+	}
+
+	if anchor.Subject != "" {
+		return nil, fmt.Errorf("no CA certificate with subject %q was found in the certificate chain to truncate it at", anchor.Subject)
+	}
+	return nil, fmt.Errorf("no CA certificate with SHA-256 fingerprint %q was found in the certificate chain to truncate it at", anchor.SHA256Fingerprint)
+}
+
+// ParseChainAnchorSubject parses the RFC 4514 distinguished name identifying
+// the anchor of a certificate chain truncation. Every attribute type must be
+// known or given as an object identifier.
+func ParseChainAnchorSubject(subject string) (pkix.Name, error) {
+	rdns, err := UnmarshalSubjectStringToRDNSequence(subject)
+	if err != nil {
+		return pkix.Name{}, err
+	}
+	if len(rdns) == 0 {
+		return pkix.Name{}, errors.New("subject must not be empty")
+	}
+	for _, rdn := range rdns {
+		for _, atv := range rdn {
+			if atv.Type == nil {
+				return pkix.Name{}, fmt.Errorf("subject contains unrecognized key with value [%s]", atv.Value)
+			}
+		}
+	}
+
+	var name pkix.Name
+	name.FillFromRDNSequence(&rdns)
+	return name, nil
+}
+
+// DecodeSHA256Fingerprint decodes a hex encoded SHA-256 fingerprint. Colons
+// between bytes and upper case characters are accepted.
+func DecodeSHA256Fingerprint(fingerprint string) ([sha256.Size]byte, error) {
+	var sum [sha256.Size]byte
+	b, err := hex.DecodeString(strings.ReplaceAll(fingerprint, ":", ""))
+	if err != nil {
+		return sum, fmt.Errorf("fingerprint is not hex encoded: %w", err)
+	}
+	if len(b) != sha256.Size {
+		return sum, fmt.Errorf("fingerprint must be %d bytes long, got %d", sha256.Size, len(b))
+	}
+	copy(sum[:], b)
+	return sum, nil
+}
+
+// chainAnchorMatcher returns a function reporting whether a certificate is
+// the anchor of the given certificate chain truncation.
+func chainAnchorMatcher(anchor *v1.CertificateChainTruncation) (func(*x509.Certificate) bool, error) {
+	switch {
+	case anchor.Subject != "" && anchor.SHA256Fingerprint != "":
+		return nil, errors.New("only one of subject or sha256Fingerprint may be set to truncate the certificate chain at")
+
+	case anchor.Subject != "":
+		name, err := ParseChainAnchorSubject(anchor.Subject)
+		if err != nil {
+			return nil, fmt.Errorf("invalid subject to truncate the certificate chain at: %w", err)
+		}
+		want := name.String()
+		return func(cert *x509.Certificate) bool {
+			return cert.Subject.String() == want
+		}, nil
+
+	case anchor.SHA256Fingerprint != "":
+		want, err := DecodeSHA256Fingerprint(anchor.SHA256Fingerprint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SHA-256 fingerprint to truncate the certificate chain at: %w", err)
+		}
+		return func(cert *x509.Certificate) bool {
+			return sha256.Sum256(cert.Raw) == want
+		}, nil
+
+	default:
+		return nil, errors.New("one of subject or sha256Fingerprint must be set to truncate the certificate chain at")
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestTruncateCertificateChainPEM(t *testing.T) {
+	root := mustCreateBundle(t, nil, "root")
+	intA := mustCreateBundle(t, root, "intermediate-a")
+	intB := mustCreateBundle(t, intA, "intermediate-b")
+	leaf := mustCreateBundle(t, intB, "leaf")
+	other := mustCreateBundle(t, nil, "other-root")
+
+	fingerprint := func(b *testBundle) string {
+		sum := sha256.Sum256(b.cert.Raw)
+		return hex.EncodeToString(sum[:])
+	}
+
+	// The chain is returned by the issuer out of order, including the root.
+	chainPEM := joinPEM(leaf.pem, intA.pem, root.pem, intB.pem)
+
+	tests := map[string]struct {
+		chainPEM []byte
+		anchor   *v1.CertificateChainTruncation
+		expPEM   []byte
+		expErr   bool
+	}{
+		"no anchor stores the chain as returned": {
+			chainPEM: chainPEM,
+			expPEM:   chainPEM,
+		},
+		"truncated at the issuing intermediate by subject": {
+			chainPEM: chainPEM,
+			anchor:   &v1.CertificateChainTruncation{Subject: "CN=intermediate-b"},
+			expPEM:   joinPEM(leaf.pem, intB.pem),
+		},
+		"truncated at a higher intermediate by fingerprint": {
+			chainPEM: chainPEM,
+			anchor:   &v1.CertificateChainTruncation{SHA256Fingerprint: fingerprint(intA)},
+			expPEM:   joinPEM(leaf.pem, intB.pem, intA.pem),
+		},
+		"fingerprint with colons and upper case characters": {
+			chainPEM: chainPEM,
+			anchor: &v1.CertificateChainTruncation{
+				SHA256Fingerprint: strings.ToUpper(strings.Join(splitPairs(fingerprint(intA)), ":")),
+			},
+			expPEM: joinPEM(leaf.pem, intB.pem, intA.pem),
+		},
+		"truncated at the self-signed root keeps the root": {
+			chainPEM: chainPEM,
+			anchor:   &v1.CertificateChainTruncation{Subject: "CN=root"},
+			expPEM:   joinPEM(leaf.pem, intB.pem, intA.pem, root.pem),
+		},
+		"the leaf certificate is not an anchor": {
+			chainPEM: chainPEM,
+			anchor:   &v1.CertificateChainTruncation{Subject: "CN=leaf"},
+			expErr:   true,
+		},
+		"anchor which is not part of the chain": {
+			chainPEM: chainPEM,
+			anchor:   &v1.CertificateChainTruncation{SHA256Fingerprint: fingerprint(other)},
+			expErr:   true,
+		},
+		"anchor which is missing from a chain without its root": {
+			chainPEM: joinPEM(leaf.pem, intB.pem, intA.pem),
+			anchor:   &v1.CertificateChainTruncation{Subject: "CN=root"},
+			expErr:   true,
+		},
+		"broken chain": {
+			chainPEM: joinPEM(leaf.pem, intA.pem),
+			anchor:   &v1.CertificateChainTruncation{Subject: "CN=intermediate-a"},
+			expErr:   true,
+		},
+		"anchor with both subject and fingerprint": {
+			chainPEM: chainPEM,
+			anchor:   &v1.CertificateChainTruncation{Subject: "CN=root", SHA256Fingerprint: fingerprint(root)},
+			expErr:   true,
+		},
+		"empty anchor": {
+			chainPEM: chainPEM,
+			anchor:   &v1.CertificateChainTruncation{},
+			expErr:   true,
+		},
+		"invalid fingerprint": {
+			chainPEM: chainPEM,
+			anchor:   &v1.CertificateChainTruncation{SHA256Fingerprint: "abcd"},
+			expErr:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			truncated, err := TruncateCertificateChainPEM(test.chainPEM, test.anchor)
+			if test.expErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, string(test.expPEM), string(truncated))
+
+			// Truncating an already truncated chain does not change it.
+			again, err := TruncateCertificateChainPEM(truncated, test.anchor)
+			require.NoError(t, err)
+			assert.Equal(t, string(truncated), string(again))
+		})
+	}
+}
+
+func TestParseChainAnchorSubject(t *testing.T) {
+	name, err := ParseChainAnchorSubject("CN=Example Intermediate CA,O=Example")
+	require.NoError(t, err)
+	assert.Equal(t, "Example Intermediate CA", name.CommonName)
+	assert.Equal(t, []string{"Example"}, name.Organization)
+
+	_, err = ParseChainAnchorSubject("")
+	assert.Error(t, err, "empty subjects should be rejected")
+
+	_, err = ParseChainAnchorSubject("CN=Example,FOO=bar")
+	assert.Error(t, err, "subjects with unknown attribute types should be rejected")
+}
+
+func splitPairs(s string) []string {
+	var pairs []string
+	for i := 0; i < len(s); i += 2 {
+		pairs = append(pairs, s[i:i+2])
+	}
+	return pairs
+}
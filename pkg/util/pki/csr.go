@@ -325,6 +325,51 @@ func GenerateCSR(crt *v1.Certificate, optFuncs ...GenerateCSROption) (*x509.Cert
 		}
 	}
 
+	if crt.Spec.MSTemplate != nil {
+		extension, err := MarshalMSTemplate(crt.Spec.MSTemplate)
+		if err != nil {
+			return nil, err
+		}
+
+		extraExtensions = append(extraExtensions, extension)
+	}
+
+	if len(crt.Spec.CertificatePolicies) > 0 {
+		extension, err := MarshalCertificatePolicies(crt.Spec.CertificatePolicies)
+		if err != nil {
+			return nil, err
+		}
+
+		extraExtensions = append(extraExtensions, extension)
+	}
+
+	if crt.Spec.LegacyExtensions != nil {
+		extensions, err := MarshalLegacyExtensions(crt.Spec.LegacyExtensions)
+		if err != nil {
+			return nil, err
+		}
+
+		extraExtensions = append(extraExtensions, extensions...)
+	}
+
+	if crt.Spec.PolicyConstraints != nil {
+		extension, err := MarshalPolicyConstraints(crt.Spec.PolicyConstraints)
+		if err != nil {
+			return nil, err
+		}
+
+		extraExtensions = append(extraExtensions, extension)
+	}
+
+	if crt.Spec.InhibitAnyPolicy != nil {
+		extension, err := MarshalInhibitAnyPolicy(*crt.Spec.InhibitAnyPolicy)
+		if err != nil {
+			return nil, err
+		}
+
+		extraExtensions = append(extraExtensions, extension)
+	}
+
 	cr := &x509.CertificateRequest{
 		// Version 0 is the only one defined in the PKCS#10 standard, RFC2986.
 		// This value isn't used by Go at the time of writing.
@@ -343,6 +388,9 @@ func GenerateCSR(crt *v1.Certificate, optFuncs ...GenerateCSROption) (*x509.Cert
 // *x509.Certificate crt and an issuer.
 // publicKey is the public key of the signee, and signerKey is the private
 // key of the signer.
+// The signature algorithm is chosen based on the signer's key. If the template
+// requests an RSASSA-PSS signature algorithm, the certificate is signed using
+// RSASSA-PSS, which requires the signer's key to be an RSA key.
 // It returns a PEM encoded copy of the Certificate as well as a *x509.Certificate
 // which can be used for reading the encoded values.
 func SignCertificate(template *x509.Certificate, issuerCert *x509.Certificate, publicKey crypto.PublicKey, signerKey any) ([]byte, *x509.Certificate, error) {
@@ -377,12 +425,19 @@ func SignCertificate(template *x509.Certificate, issuerCert *x509.Certificate, p
 		return nil, nil, fmt.Errorf("unknown public key type on signing certificate: %T", issuerCert.PublicKey)
 	}
 
-	var err error
-	template.SignatureAlgorithm, err = signatureAlgorithmFromPublicKey(pubKeyAlgo, sigAlgoArg)
+	sigAlgo, err := signatureAlgorithmFromPublicKey(pubKeyAlgo, sigAlgoArg)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if IsRSAPSSSignatureAlgorithm(template.SignatureAlgorithm) {
+		if pubKeyAlgo != x509.RSA {
+			return nil, nil, fmt.Errorf("an RSASSA-PSS signature was requested but the signing key is not an RSA key: %s", pubKeyAlgo)
+		}
+		sigAlgo = rsaPSSSignatureAlgorithm(sigAlgo)
+	}
+	template.SignatureAlgorithm = sigAlgo
+
 	derBytes, err := x509.CreateCertificate(rand.Reader, template, issuerCert, publicKey, signerKey)
 	if err != nil {
 		return nil, nil, fmt.Errorf("error creating x509 certificate: %s", err.Error())
@@ -494,7 +549,7 @@ func SignatureAlgorithm(crt *v1.Certificate) (x509.PublicKeyAlgorithm, x509.Sign
 		pubKeyAlgo = x509.RSA
 		sigAlgoArg = MinRSAKeySize
 
-	case v1.RSAKeyAlgorithm:
+	case v1.RSAKeyAlgorithm, v1.RSAPSSKeyAlgorithm:
 		pubKeyAlgo = x509.RSA
 		keySize := crt.Spec.PrivateKey.Size
 		if keySize == 0 {
@@ -530,7 +585,7 @@ func SignatureAlgorithm(crt *v1.Certificate) (x509.PublicKeyAlgorithm, x509.Sign
 		}
 
 	default:
-		return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported algorithm specified: %s. should be either 'ecdsa', 'ed25519' or 'rsa", crt.Spec.PrivateKey.Algorithm)
+		return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported algorithm specified: %s. should be either 'ecdsa', 'ed25519', 'rsa' or 'rsapss'", crt.Spec.PrivateKey.Algorithm)
 	}
 
 	sigAlgo, err := signatureAlgorithmFromPublicKey(pubKeyAlgo, sigAlgoArg)
@@ -538,9 +593,40 @@ func SignatureAlgorithm(crt *v1.Certificate) (x509.PublicKeyAlgorithm, x509.Sign
 		return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, err
 	}
 
+	if specAlgorithm == v1.RSAPSSKeyAlgorithm {
+		sigAlgo = rsaPSSSignatureAlgorithm(sigAlgo)
+	}
+
 	return pubKeyAlgo, sigAlgo, nil
 }
 
+// IsRSAPSSSignatureAlgorithm returns true if the given signature algorithm
+// is one of the RSASSA-PSS signature algorithms.
+func IsRSAPSSSignatureAlgorithm(alg x509.SignatureAlgorithm) bool {
+	switch alg {
+	case x509.SHA256WithRSAPSS, x509.SHA384WithRSAPSS, x509.SHA512WithRSAPSS:
+		return true
+	default:
+		return false
+	}
+}
+
+// rsaPSSSignatureAlgorithm returns the RSASSA-PSS signature algorithm which
+// uses the same hash as the given PKCS #1 v1.5 signature algorithm. Any other
+// signature algorithm is returned unchanged.
+func rsaPSSSignatureAlgorithm(alg x509.SignatureAlgorithm) x509.SignatureAlgorithm {
+	switch alg {
+	case x509.SHA256WithRSA:
+		return x509.SHA256WithRSAPSS
+	case x509.SHA384WithRSA:
+		return x509.SHA384WithRSAPSS
+	case x509.SHA512WithRSA:
+		return x509.SHA512WithRSAPSS
+	default:
+		return alg
+	}
+}
+
 // signatureAlgorithmFromPublicKey takes a public key type and an argument specific to that public
 // key, and returns an appropriate signature algorithm for that key.
 // If alg is x509.RSA, arg must be an integer key size in bits
@@ -166,6 +166,26 @@ func TestSignatureAlgorithmForCertificate(t *testing.T) {
 			expectedSigAlgo: x509.SHA512WithRSA,
 			expectedKeyType: x509.RSA,
 		},
+		{
+			name:            "certificate with KeyAlgorithm rsapss and no size set should default to rsapss256",
+			keyAlgo:         cmapi.RSAPSSKeyAlgorithm,
+			expectedSigAlgo: x509.SHA256WithRSAPSS,
+			expectedKeyType: x509.RSA,
+		},
+		{
+			name:            "certificate with KeyAlgorithm rsapss and size 3072",
+			keyAlgo:         cmapi.RSAPSSKeyAlgorithm,
+			keySize:         3072,
+			expectedSigAlgo: x509.SHA384WithRSAPSS,
+			expectedKeyType: x509.RSA,
+		},
+		{
+			name:            "certificate with KeyAlgorithm rsapss and size 4096",
+			keyAlgo:         cmapi.RSAPSSKeyAlgorithm,
+			keySize:         4096,
+			expectedSigAlgo: x509.SHA512WithRSAPSS,
+			expectedKeyType: x509.RSA,
+		},
 		{
 			name:            "certificate with ecdsa key algorithm set and no key size default to ecdsa256",
 			keyAlgo:         cmapi.ECDSAKeyAlgorithm,
@@ -1120,9 +1140,10 @@ func ed25519Key(t *testing.T) crypto.Signer {
 
 func Test_SignCertificate_Signatures(t *testing.T) {
 	specs := map[string]struct {
-		SignerKey                  crypto.Signer
-		ExpectedSignatureAlgorithm x509.SignatureAlgorithm
-		ExpectErr                  bool
+		SignerKey                   crypto.Signer
+		RequestedSignatureAlgorithm x509.SignatureAlgorithm
+		ExpectedSignatureAlgorithm  x509.SignatureAlgorithm
+		ExpectErr                   bool
 	}{
 		"RSA 2048": {
 			SignerKey:                  rsaKey(t, 2048),
@@ -1166,6 +1187,28 @@ func Test_SignCertificate_Signatures(t *testing.T) {
 			SignerKey:                  ed25519Key(t),
 			ExpectedSignatureAlgorithm: x509.PureEd25519,
 		},
+		"RSA 2048 with RSASSA-PSS requested": {
+			SignerKey:                   rsaKey(t, 2048),
+			RequestedSignatureAlgorithm: x509.SHA256WithRSAPSS,
+			ExpectedSignatureAlgorithm:  x509.SHA256WithRSAPSS,
+		},
+		"RSA 4096 with RSASSA-PSS requested uses the hash chosen for the key size": {
+			SignerKey:                   rsaKey(t, 4096),
+			RequestedSignatureAlgorithm: x509.SHA256WithRSAPSS,
+			ExpectedSignatureAlgorithm:  x509.SHA512WithRSAPSS,
+		},
+		"ECDSA P-256 with RSASSA-PSS requested should error": {
+			SignerKey:                   ecdsaKey(t, elliptic.P256()),
+			RequestedSignatureAlgorithm: x509.SHA256WithRSAPSS,
+			ExpectedSignatureAlgorithm:  x509.UnknownSignatureAlgorithm,
+			ExpectErr:                   true,
+		},
+		"Ed25519 with RSASSA-PSS requested should error": {
+			SignerKey:                   ed25519Key(t),
+			RequestedSignatureAlgorithm: x509.SHA256WithRSAPSS,
+			ExpectedSignatureAlgorithm:  x509.UnknownSignatureAlgorithm,
+			ExpectErr:                   true,
+		},
 	}
 
 	for name, spec := range specs {
@@ -1187,6 +1230,8 @@ func Test_SignCertificate_Signatures(t *testing.T) {
 				Subject:   pkix.Name{CommonName: "abc123"},
 
 				DNSNames: []string{"example.com"},
+
+				SignatureAlgorithm: spec.RequestedSignatureAlgorithm,
 			}
 
 			leafPriv := ed25519Key(t)
@@ -1208,3 +1253,61 @@ func Test_SignCertificate_Signatures(t *testing.T) {
 		})
 	}
 }
+
+func Test_RSAPSSCertificate(t *testing.T) {
+	// id-RSASSA-PSS, RFC 4055 section 3.1
+	oidSignatureRSAPSS := asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 10}
+
+	signatureAlgorithmOID := func(t *testing.T, der []byte) asn1.ObjectIdentifier {
+		t.Helper()
+
+		// Certificates and CSRs share the same outer structure.
+		var signed struct {
+			Raw                asn1.RawContent
+			TBS                asn1.RawValue
+			SignatureAlgorithm pkix.AlgorithmIdentifier
+			Signature          asn1.BitString
+		}
+		if _, err := asn1.Unmarshal(der, &signed); err != nil {
+			t.Fatalf("failed to unmarshal signed structure: %s", err)
+		}
+
+		return signed.SignatureAlgorithm.Algorithm
+	}
+
+	crt := &cmapi.Certificate{
+		Spec: cmapi.CertificateSpec{
+			CommonName: "example.com",
+			DNSNames:   []string{"example.com"},
+			PrivateKey: &cmapi.CertificatePrivateKey{
+				Algorithm: cmapi.RSAPSSKeyAlgorithm,
+			},
+		},
+	}
+
+	pk, err := GeneratePrivateKeyForCertificate(crt)
+	require.NoError(t, err)
+	require.IsType(t, &rsa.PrivateKey{}, pk)
+	assert.Empty(t, PrivateKeyMatchesSpec(pk, crt.Spec))
+
+	csrTemplate, err := GenerateCSR(crt)
+	require.NoError(t, err)
+
+	csrDER, err := EncodeCSR(csrTemplate, pk)
+	require.NoError(t, err)
+	assert.Equal(t, oidSignatureRSAPSS, signatureAlgorithmOID(t, csrDER))
+
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	require.NoError(t, err)
+	require.NoError(t, csr.CheckSignature())
+
+	template, err := CertificateTemplateFromCSR(csr)
+	require.NoError(t, err)
+	assert.Equal(t, x509.SHA256WithRSAPSS, template.SignatureAlgorithm)
+
+	// Self-sign the certificate, as the SelfSigned issuer does.
+	_, cert, err := SignCertificate(template, template, pk.Public(), pk)
+	require.NoError(t, err)
+	assert.Equal(t, x509.SHA256WithRSAPSS, cert.SignatureAlgorithm)
+	assert.Equal(t, oidSignatureRSAPSS, signatureAlgorithmOID(t, cert.Raw))
+}
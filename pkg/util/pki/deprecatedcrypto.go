@@ -0,0 +1,96 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+)
+
+// DeprecatedCryptoPolicy defines the thresholds below which the cryptography
+// used by a certificate is considered deprecated. A threshold of 0 disables
+// the corresponding check.
+type DeprecatedCryptoPolicy struct {
+	// MinimumRSAKeySize is the smallest RSA key size, in bits, which is not
+	// deprecated.
+	MinimumRSAKeySize int
+
+	// MinimumECDSAKeySize is the smallest ECDSA curve size, in bits, which is
+	// not deprecated.
+	MinimumECDSAKeySize int
+
+	// MinimumSignatureHashSize is the smallest size, in bits, of the hash
+	// used by a signature algorithm which is not deprecated.
+	MinimumSignatureHashSize int
+}
+
+// DeprecatedCrypto identifies a deprecated algorithm used by a certificate,
+// along with the size of its key or hash in bits.
+type DeprecatedCrypto struct {
+	Algorithm string
+	Size      int
+}
+
+func (d DeprecatedCrypto) String() string {
+	return fmt.Sprintf("%s (%d bits)", d.Algorithm, d.Size)
+}
+
+// signatureHashSizes are the sizes, in bits, of the hashes used by the
+// signature algorithms which sign using a separate hash function.
+var signatureHashSizes = map[x509.SignatureAlgorithm]int{
+	x509.MD2WithRSA:       128,
+	x509.MD5WithRSA:       128,
+	x509.SHA1WithRSA:      160,
+	x509.DSAWithSHA1:      160,
+	x509.ECDSAWithSHA1:    160,
+	x509.SHA256WithRSA:    256,
+	x509.DSAWithSHA256:    256,
+	x509.ECDSAWithSHA256:  256,
+	x509.SHA256WithRSAPSS: 256,
+	x509.SHA384WithRSA:    384,
+	x509.ECDSAWithSHA384:  384,
+	x509.SHA384WithRSAPSS: 384,
+	x509.SHA512WithRSA:    512,
+	x509.ECDSAWithSHA512:  512,
+	x509.SHA512WithRSAPSS: 512,
+}
+
+// DeprecatedCryptoInCertificate returns the algorithms used by the
+// certificate's public key and signature which are below the thresholds of
+// the policy. Ed25519 keys and signatures are never deprecated.
+func (p DeprecatedCryptoPolicy) DeprecatedCryptoInCertificate(cert *x509.Certificate) []DeprecatedCrypto {
+	var deprecated []DeprecatedCrypto
+
+	switch pub := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if size := pub.N.BitLen(); size < p.MinimumRSAKeySize {
+			deprecated = append(deprecated, DeprecatedCrypto{Algorithm: "RSA", Size: size})
+		}
+	case *ecdsa.PublicKey:
+		if size := pub.Curve.Params().BitSize; size < p.MinimumECDSAKeySize {
+			deprecated = append(deprecated, DeprecatedCrypto{Algorithm: "ECDSA", Size: size})
+		}
+	}
+
+	if size, ok := signatureHashSizes[cert.SignatureAlgorithm]; ok && size < p.MinimumSignatureHashSize {
+		deprecated = append(deprecated, DeprecatedCrypto{Algorithm: cert.SignatureAlgorithm.String(), Size: size})
+	}
+
+	return deprecated
+}
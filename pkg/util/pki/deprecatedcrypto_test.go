@@ -0,0 +1,91 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecatedCryptoInCertificate(t *testing.T) {
+	rsaKey := func(size int) *rsa.PublicKey {
+		return &rsa.PublicKey{N: new(big.Int).Lsh(big.NewInt(1), uint(size-1)), E: 65537}
+	}
+	policy := DeprecatedCryptoPolicy{
+		MinimumRSAKeySize:        2048,
+		MinimumECDSAKeySize:      256,
+		MinimumSignatureHashSize: 256,
+	}
+
+	tests := map[string]struct {
+		policy   DeprecatedCryptoPolicy
+		cert     *x509.Certificate
+		expected []DeprecatedCrypto
+	}{
+		"RSA 2048 key signed using SHA-256 is not deprecated": {
+			policy: policy,
+			cert:   &x509.Certificate{PublicKey: rsaKey(2048), SignatureAlgorithm: x509.SHA256WithRSA},
+		},
+		"RSA 1024 key is deprecated": {
+			policy:   policy,
+			cert:     &x509.Certificate{PublicKey: rsaKey(1024), SignatureAlgorithm: x509.SHA256WithRSA},
+			expected: []DeprecatedCrypto{{Algorithm: "RSA", Size: 1024}},
+		},
+		"ECDSA P-224 key is deprecated": {
+			policy:   policy,
+			cert:     &x509.Certificate{PublicKey: &ecdsa.PublicKey{Curve: elliptic.P224()}, SignatureAlgorithm: x509.ECDSAWithSHA256},
+			expected: []DeprecatedCrypto{{Algorithm: "ECDSA", Size: 224}},
+		},
+		"ECDSA P-384 key is not deprecated": {
+			policy: policy,
+			cert:   &x509.Certificate{PublicKey: &ecdsa.PublicKey{Curve: elliptic.P384()}, SignatureAlgorithm: x509.ECDSAWithSHA384},
+		},
+		"SHA-1 signature is deprecated": {
+			policy:   policy,
+			cert:     &x509.Certificate{PublicKey: rsaKey(2048), SignatureAlgorithm: x509.SHA1WithRSA},
+			expected: []DeprecatedCrypto{{Algorithm: "SHA1-RSA", Size: 160}},
+		},
+		"RSA 1024 key signed using MD5 reports both": {
+			policy: policy,
+			cert:   &x509.Certificate{PublicKey: rsaKey(1024), SignatureAlgorithm: x509.MD5WithRSA},
+			expected: []DeprecatedCrypto{
+				{Algorithm: "RSA", Size: 1024},
+				{Algorithm: "MD5-RSA", Size: 128},
+			},
+		},
+		"Ed25519 is never deprecated": {
+			policy: DeprecatedCryptoPolicy{MinimumRSAKeySize: 8192, MinimumECDSAKeySize: 521, MinimumSignatureHashSize: 512},
+			cert:   &x509.Certificate{PublicKey: ed25519.PublicKey(make([]byte, ed25519.PublicKeySize)), SignatureAlgorithm: x509.PureEd25519},
+		},
+		"thresholds of 0 disable the checks": {
+			cert: &x509.Certificate{PublicKey: rsaKey(1024), SignatureAlgorithm: x509.SHA1WithRSA},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.policy.DeprecatedCryptoInCertificate(test.cert))
+		})
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"math/big"
+
+	"golang.org/x/crypto/hkdf"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// MinDeterministicPrivateKeySeedSize is the minimum size in bytes of a seed
+// used to derive a deterministic private key.
+const MinDeterministicPrivateKeySeedSize = 32
+
+// GenerateDeterministicPrivateKeyForCertificate derives a private key for the
+// provided Certificate from the given seed. The same seed, Certificate
+// namespace, name, key algorithm and size always result in the same private
+// key, so this must only be used in test and development environments.
+// Only the ECDSA and Ed25519 key algorithms are supported.
+func GenerateDeterministicPrivateKeyForCertificate(crt *v1.Certificate, seed []byte) (crypto.Signer, error) {
+	if len(seed) < MinDeterministicPrivateKeySeedSize {
+		return nil, fmt.Errorf("private key seed must be at least %d bytes, got %d", MinDeterministicPrivateKeySeedSize, len(seed))
+	}
+
+	var algorithm v1.PrivateKeyAlgorithm
+	var size int
+	if crt.Spec.PrivateKey != nil {
+		algorithm = crt.Spec.PrivateKey.Algorithm
+		size = crt.Spec.PrivateKey.Size
+	}
+
+	switch algorithm {
+	case v1.ECDSAKeyAlgorithm:
+		if size == 0 {
+			size = ECCurve256
+		}
+		return deterministicECPrivateKey(size, keyDerivationReader(crt, seed, algorithm, size))
+	case v1.Ed25519KeyAlgorithm:
+		keySeed := make([]byte, ed25519.SeedSize)
+		if _, err := io.ReadFull(keyDerivationReader(crt, seed, algorithm, 0), keySeed); err != nil {
+			return nil, err
+		}
+		return ed25519.NewKeyFromSeed(keySeed), nil
+	default:
+		return nil, fmt.Errorf("unsupported private key algorithm for deterministic private keys: %q", algorithm)
+	}
+}
+
+// keyDerivationReader returns a reader of key material derived from the seed
+// and bound to the Certificate and key parameters, so that the same seed used
+// for different Certificates results in different private keys.
+func keyDerivationReader(crt *v1.Certificate, seed []byte, algorithm v1.PrivateKeyAlgorithm, size int) io.Reader {
+	info := fmt.Sprintf("cert-manager.io/deterministic-private-key/%s/%s/%s/%d", crt.Namespace, crt.Name, algorithm, size)
+	return hkdf.New(sha256.New, seed, nil, []byte(info))
+}
+
+// deterministicECPrivateKey derives an ECDSA private key of the given size
+// from r. Candidate scalars outside of the curve's order are rejected and the
+// next candidate is read from r.
+func deterministicECPrivateKey(keySize int, r io.Reader) (*ecdsa.PrivateKey, error) {
+	var ecCurve elliptic.Curve
+	var ecdhCurve ecdh.Curve
+	switch keySize {
+	case ECCurve256:
+		ecCurve, ecdhCurve = elliptic.P256(), ecdh.P256()
+	case ECCurve384:
+		ecCurve, ecdhCurve = elliptic.P384(), ecdh.P384()
+	case ECCurve521:
+		ecCurve, ecdhCurve = elliptic.P521(), ecdh.P521()
+	default:
+		return nil, fmt.Errorf("unsupported ecdsa key size specified: %d", keySize)
+	}
+
+	byteLen := (ecCurve.Params().BitSize + 7) / 8
+	// Clear any bits above the curve's bit size so that as many candidates as
+	// possible are valid scalars.
+	topByteMask := byte(0xff >> (byteLen*8 - ecCurve.Params().BitSize))
+
+	scalar := make([]byte, byteLen)
+	for {
+		if _, err := io.ReadFull(r, scalar); err != nil {
+			return nil, err
+		}
+		scalar[0] &= topByteMask
+
+		// NewPrivateKey rejects zero and scalars not less than the curve's
+		// order.
+		key, err := ecdhCurve.NewPrivateKey(scalar)
+		if err != nil {
+			continue
+		}
+
+		// The uncompressed public key is 0x04 || X || Y.
+		pub := key.PublicKey().Bytes()
+		return &ecdsa.PrivateKey{
+			PublicKey: ecdsa.PublicKey{
+				Curve: ecCurve,
+				X:     new(big.Int).SetBytes(pub[1 : 1+byteLen]),
+				Y:     new(big.Int).SetBytes(pub[1+byteLen:]),
+			},
+			D: new(big.Int).SetBytes(scalar),
+		}, nil
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestGenerateDeterministicPrivateKeyForCertificate(t *testing.T) {
+	seed := bytes.Repeat([]byte("s"), MinDeterministicPrivateKeySeedSize)
+	otherSeed := bytes.Repeat([]byte("o"), MinDeterministicPrivateKeySeedSize)
+
+	tests := map[string]struct {
+		keyAlgo v1.PrivateKeyAlgorithm
+		keySize int
+		seed    []byte
+		expErr  bool
+	}{
+		"ecdsa key with default size": {keyAlgo: v1.ECDSAKeyAlgorithm, seed: seed},
+		"ecdsa P-384 key":             {keyAlgo: v1.ECDSAKeyAlgorithm, keySize: ECCurve384, seed: seed},
+		"ecdsa P-521 key":             {keyAlgo: v1.ECDSAKeyAlgorithm, keySize: ECCurve521, seed: seed},
+		"ed25519 key":                 {keyAlgo: v1.Ed25519KeyAlgorithm, seed: seed},
+		"rsa key is not supported":    {keyAlgo: v1.RSAKeyAlgorithm, seed: seed, expErr: true},
+		"invalid ecdsa key size":      {keyAlgo: v1.ECDSAKeyAlgorithm, keySize: 100, seed: seed, expErr: true},
+		"seed too short":              {keyAlgo: v1.Ed25519KeyAlgorithm, seed: seed[1:], expErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := buildCertificateWithKeyParams(test.keyAlgo, test.keySize)
+			crt.Namespace, crt.Name = "test-namespace", "test"
+
+			key, err := GenerateDeterministicPrivateKeyForCertificate(crt, test.seed)
+			if test.expErr {
+				if err == nil {
+					t.Fatal("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if violations := PrivateKeyMatchesSpec(key, crt.Spec); len(violations) > 0 {
+				t.Errorf("generated key does not match spec: %v", violations)
+			}
+
+			// The key must be usable for signing.
+			var opts crypto.SignerOpts = crypto.SHA256
+			if _, ok := key.(ed25519.PrivateKey); ok {
+				opts = crypto.Hash(0)
+			}
+			digest := sha256.Sum256([]byte("test"))
+			sig, err := key.Sign(rand.Reader, digest[:], opts)
+			if err != nil {
+				t.Fatalf("failed to sign with generated key: %v", err)
+			}
+			if ecKey, ok := key.(*ecdsa.PrivateKey); ok && !ecdsa.VerifyASN1(&ecKey.PublicKey, digest[:], sig) {
+				t.Error("failed to verify signature with generated ecdsa public key")
+			}
+
+			again, err := GenerateDeterministicPrivateKeyForCertificate(crt, test.seed)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if equal, _ := PublicKeysEqual(key.Public(), again.Public()); !equal {
+				t.Error("expected the same seed to generate the same key")
+			}
+
+			other, err := GenerateDeterministicPrivateKeyForCertificate(crt, otherSeed)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if equal, _ := PublicKeysEqual(key.Public(), other.Public()); equal {
+				t.Error("expected a different seed to generate a different key")
+			}
+
+			otherCrt := crt.DeepCopy()
+			otherCrt.Name = "other"
+			other, err = GenerateDeterministicPrivateKeyForCertificate(otherCrt, test.seed)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if equal, _ := PublicKeysEqual(key.Public(), other.Public()); equal {
+				t.Error("expected a different Certificate to generate a different key")
+			}
+		})
+	}
+}
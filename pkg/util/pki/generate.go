@@ -57,7 +57,7 @@ func GeneratePrivateKeyForCertificate(crt *v1.Certificate) (crypto.Signer, error
 		crt.Spec.PrivateKey = &v1.CertificatePrivateKey{}
 	}
 	switch crt.Spec.PrivateKey.Algorithm {
-	case v1.PrivateKeyAlgorithm(""), v1.RSAKeyAlgorithm:
+	case v1.PrivateKeyAlgorithm(""), v1.RSAKeyAlgorithm, v1.RSAPSSKeyAlgorithm:
 		keySize := MinRSAKeySize
 
 		if crt.Spec.PrivateKey.Size > 0 {
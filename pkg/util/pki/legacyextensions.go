@@ -0,0 +1,183 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+var (
+	// OIDExtensionNetscapeCertType is the OID of the Netscape Certificate
+	// Type extension.
+	OIDExtensionNetscapeCertType = asn1.ObjectIdentifier{2, 16, 840, 1, 113730, 1, 1}
+
+	// OIDExtensionNetscapeComment is the OID of the Netscape Comment
+	// extension.
+	OIDExtensionNetscapeComment = asn1.ObjectIdentifier{2, 16, 840, 1, 113730, 1, 13}
+)
+
+// maxNetscapeCommentLength is the maximum number of characters of a Netscape
+// Comment.
+const maxNetscapeCommentLength = 256
+
+// netscapeCertTypeBits are the certificate types of the Netscape Certificate
+// Type extension, in the order of their bits. Bit 4 is reserved.
+//
+//	NetscapeCertType ::= BIT STRING {
+//	    sslClient        (0),
+//	    sslServer        (1),
+//	    smime            (2),
+//	    objectSigning    (3),
+//	    reserved         (4),
+//	    sslCA            (5),
+//	    smimeCA          (6),
+//	    objectSigningCA  (7) }
+var netscapeCertTypeBits = []cmapi.NetscapeCertType{
+	cmapi.NetscapeCertTypeSSLClient,
+	cmapi.NetscapeCertTypeSSLServer,
+	cmapi.NetscapeCertTypeSMIME,
+	cmapi.NetscapeCertTypeObjectSigning,
+	"",
+	cmapi.NetscapeCertTypeSSLCA,
+	cmapi.NetscapeCertTypeSMIMECA,
+	cmapi.NetscapeCertTypeObjectSigningCA,
+}
+
+// ValidateNetscapeComment returns an error if the given comment cannot be
+// encoded as the Netscape Comment extension, which stores it as an IA5String.
+func ValidateNetscapeComment(comment string) error {
+	for _, r := range comment {
+		if r < 0x20 || r > 0x7E {
+			return fmt.Errorf("must only contain printable ASCII characters, got %q", r)
+		}
+	}
+	if n := len(comment); n > maxNetscapeCommentLength {
+		return fmt.Errorf("must be at most %d characters long, got %d", maxNetscapeCommentLength, n)
+	}
+	return nil
+}
+
+// ValidateNetscapeCertType returns an error if the given certificate type is
+// not one of the types of the Netscape Certificate Type extension.
+func ValidateNetscapeCertType(certType cmapi.NetscapeCertType) error {
+	if netscapeCertTypeBit(certType) < 0 {
+		return fmt.Errorf("unknown Netscape certificate type %q", certType)
+	}
+	return nil
+}
+
+func netscapeCertTypeBit(certType cmapi.NetscapeCertType) int {
+	if certType == "" {
+		return -1
+	}
+	for i, t := range netscapeCertTypeBits {
+		if t == certType {
+			return i
+		}
+	}
+	return -1
+}
+
+// MarshalLegacyExtensions encodes the given legacy extensions. Extensions
+// which are not set are omitted.
+func MarshalLegacyExtensions(legacy *cmapi.CertificateLegacyExtensions) ([]pkix.Extension, error) {
+	if legacy == nil {
+		return nil, nil
+	}
+
+	var extensions []pkix.Extension
+
+	if len(legacy.NetscapeCertType) > 0 {
+		var b byte
+		for _, certType := range legacy.NetscapeCertType {
+			bit := netscapeCertTypeBit(certType)
+			if bit < 0 {
+				return nil, fmt.Errorf("unknown Netscape certificate type %q", certType)
+			}
+			b |= 0x80 >> bit
+		}
+
+		bitString := []byte{b}
+		value, err := asn1.Marshal(asn1.BitString{Bytes: bitString, BitLength: asn1BitLength(bitString)})
+		if err != nil {
+			return nil, err
+		}
+		extensions = append(extensions, pkix.Extension{Id: OIDExtensionNetscapeCertType, Value: value})
+	}
+
+	if legacy.NetscapeComment != "" {
+		if err := ValidateNetscapeComment(legacy.NetscapeComment); err != nil {
+			return nil, fmt.Errorf("invalid Netscape comment: %w", err)
+		}
+		value, err := asn1.MarshalWithParams(legacy.NetscapeComment, "ia5")
+		if err != nil {
+			return nil, err
+		}
+		extensions = append(extensions, pkix.Extension{Id: OIDExtensionNetscapeComment, Value: value})
+	}
+
+	return extensions, nil
+}
+
+// UnmarshalLegacyExtensions returns the legacy extensions found in the given
+// extensions, or nil if they don't contain any. Certificate types are
+// returned in the order of their bits in the Netscape Certificate Type
+// extension, and reserved bits are ignored.
+func UnmarshalLegacyExtensions(extensions []pkix.Extension) (*cmapi.CertificateLegacyExtensions, error) {
+	var legacy *cmapi.CertificateLegacyExtensions
+
+	for _, ext := range extensions {
+		switch {
+		case ext.Id.Equal(OIDExtensionNetscapeCertType):
+			var bits asn1.BitString
+			if rest, err := asn1.Unmarshal(ext.Value, &bits); err != nil {
+				return nil, err
+			} else if len(rest) != 0 {
+				return nil, errors.New("x509: trailing data after Netscape certificate type")
+			}
+
+			if legacy == nil {
+				legacy = &cmapi.CertificateLegacyExtensions{}
+			}
+			for i, certType := range netscapeCertTypeBits {
+				if certType != "" && bits.At(i) != 0 {
+					legacy.NetscapeCertType = append(legacy.NetscapeCertType, certType)
+				}
+			}
+
+		case ext.Id.Equal(OIDExtensionNetscapeComment):
+			var comment string
+			if rest, err := asn1.UnmarshalWithParams(ext.Value, &comment, "ia5"); err != nil {
+				return nil, err
+			} else if len(rest) != 0 {
+				return nil, errors.New("x509: trailing data after Netscape comment")
+			}
+
+			if legacy == nil {
+				legacy = &cmapi.CertificateLegacyExtensions{}
+			}
+			legacy.NetscapeComment = comment
+		}
+	}
+
+	return legacy, nil
+}
@@ -0,0 +1,167 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestMarshalLegacyExtensions(t *testing.T) {
+	tests := map[string]struct {
+		legacy             *cmapi.CertificateLegacyExtensions
+		expectedExtensions []pkix.Extension
+		// expectedDecoded is the expected result of decoding the extensions,
+		// if it differs from legacy.
+		expectedDecoded *cmapi.CertificateLegacyExtensions
+		expectedErr     bool
+	}{
+		"no legacy extensions": {
+			legacy: nil,
+		},
+		"netscape comment": {
+			legacy: &cmapi.CertificateLegacyExtensions{NetscapeComment: "hi"},
+			expectedExtensions: []pkix.Extension{
+				{Id: OIDExtensionNetscapeComment, Value: []byte{0x16, 0x02, 'h', 'i'}},
+			},
+		},
+		"netscape cert type": {
+			legacy: &cmapi.CertificateLegacyExtensions{NetscapeCertType: []cmapi.NetscapeCertType{
+				cmapi.NetscapeCertTypeSSLClient,
+				cmapi.NetscapeCertTypeSSLServer,
+			}},
+			expectedExtensions: []pkix.Extension{
+				{Id: OIDExtensionNetscapeCertType, Value: []byte{0x03, 0x02, 0x06, 0xc0}},
+			},
+		},
+		"netscape cert types are decoded in the order of their bits": {
+			legacy: &cmapi.CertificateLegacyExtensions{NetscapeCertType: []cmapi.NetscapeCertType{
+				cmapi.NetscapeCertTypeObjectSigningCA,
+				cmapi.NetscapeCertTypeSSLCA,
+				cmapi.NetscapeCertTypeSMIME,
+			}},
+			expectedExtensions: []pkix.Extension{
+				{Id: OIDExtensionNetscapeCertType, Value: []byte{0x03, 0x02, 0x00, 0x25}},
+			},
+			expectedDecoded: &cmapi.CertificateLegacyExtensions{NetscapeCertType: []cmapi.NetscapeCertType{
+				cmapi.NetscapeCertTypeSMIME,
+				cmapi.NetscapeCertTypeSSLCA,
+				cmapi.NetscapeCertTypeObjectSigningCA,
+			}},
+		},
+		"netscape comment and cert type": {
+			legacy: &cmapi.CertificateLegacyExtensions{
+				NetscapeComment:  "Issued for the legacy appliance",
+				NetscapeCertType: []cmapi.NetscapeCertType{cmapi.NetscapeCertTypeSSLServer},
+			},
+		},
+		"unknown netscape cert type": {
+			legacy:      &cmapi.CertificateLegacyExtensions{NetscapeCertType: []cmapi.NetscapeCertType{"reserved"}},
+			expectedErr: true,
+		},
+		"netscape comment with non printable characters": {
+			legacy:      &cmapi.CertificateLegacyExtensions{NetscapeComment: "line\nbreak"},
+			expectedErr: true,
+		},
+		"netscape comment which is too long": {
+			legacy:      &cmapi.CertificateLegacyExtensions{NetscapeComment: strings.Repeat("a", 257)},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			extensions, err := MarshalLegacyExtensions(test.legacy)
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			if test.expectedExtensions != nil {
+				assert.Equal(t, test.expectedExtensions, extensions)
+			}
+
+			expectedDecoded := test.expectedDecoded
+			if expectedDecoded == nil {
+				expectedDecoded = test.legacy
+			}
+
+			decoded, err := UnmarshalLegacyExtensions(extensions)
+			require.NoError(t, err)
+			assert.Equal(t, expectedDecoded, decoded)
+		})
+	}
+}
+
+func TestUnmarshalLegacyExtensions(t *testing.T) {
+	decoded, err := UnmarshalLegacyExtensions([]pkix.Extension{{Id: OIDExtensionKeyUsage, Value: []byte{0x03, 0x02, 0x05, 0xa0}}})
+	require.NoError(t, err)
+	assert.Nil(t, decoded, "extensions without legacy extensions should decode to nil")
+
+	extensions, err := MarshalLegacyExtensions(&cmapi.CertificateLegacyExtensions{})
+	require.NoError(t, err)
+	assert.Empty(t, extensions, "empty legacy extensions should not be encoded")
+
+	// The reserved bit is ignored.
+	decoded, err = UnmarshalLegacyExtensions([]pkix.Extension{{Id: OIDExtensionNetscapeCertType, Value: []byte{0x03, 0x02, 0x03, 0x48}}})
+	require.NoError(t, err)
+	assert.Equal(t, &cmapi.CertificateLegacyExtensions{NetscapeCertType: []cmapi.NetscapeCertType{cmapi.NetscapeCertTypeSSLServer}}, decoded)
+
+	_, err = UnmarshalLegacyExtensions([]pkix.Extension{{Id: OIDExtensionNetscapeComment, Value: []byte{0x02, 0x01, 0x01}}})
+	assert.Error(t, err, "netscape comments which are not an IA5String should be rejected")
+}
+
+func TestLegacyExtensionsAreIssued(t *testing.T) {
+	legacy := &cmapi.CertificateLegacyExtensions{
+		NetscapeComment:  "Issued for the legacy appliance",
+		NetscapeCertType: []cmapi.NetscapeCertType{cmapi.NetscapeCertTypeSSLClient, cmapi.NetscapeCertTypeSSLServer},
+	}
+	crt := &cmapi.Certificate{
+		Spec: cmapi.CertificateSpec{
+			CommonName:       "example.com",
+			PrivateKey:       &cmapi.CertificatePrivateKey{Algorithm: cmapi.ECDSAKeyAlgorithm},
+			LegacyExtensions: legacy,
+		},
+	}
+
+	pk, err := GenerateECPrivateKey(ECCurve256)
+	require.NoError(t, err)
+	csr, err := GenerateCSR(crt)
+	require.NoError(t, err)
+	csrDER, err := EncodeCSR(csr, pk)
+	require.NoError(t, err)
+	csr, err = x509.ParseCertificateRequest(csrDER)
+	require.NoError(t, err)
+
+	// The extensions are copied from the CSR to the certificate issued for it.
+	template, err := CertificateTemplateFromCSR(csr)
+	require.NoError(t, err)
+	_, cert, err := SignCertificate(template, template, pk.Public(), pk)
+	require.NoError(t, err)
+
+	decoded, err := UnmarshalLegacyExtensions(cert.Extensions)
+	require.NoError(t, err)
+	assert.Equal(t, legacy, decoded)
+}
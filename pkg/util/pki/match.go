@@ -38,7 +38,7 @@ import (
 // PrivateKeyMatchesSpec returns a list of violations for the provided private
 // key against the provided CertificateSpec. It will return an empty list/ nil
 // if there are no violations found. RSA, Ed25519 and ECDSA private keys are
-// supported.
+// supported. RSAPSS keys are RSA keys.
 // The function panics if the CertificateSpec contains an unknown key algorithm,
 // since this should have been caught by the CertificateSpec validation already.
 func PrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) []string {
@@ -47,7 +47,7 @@ func PrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) []s
 		spec.PrivateKey = &cmapi.CertificatePrivateKey{}
 	}
 	switch spec.PrivateKey.Algorithm {
-	case "", cmapi.RSAKeyAlgorithm:
+	case "", cmapi.RSAKeyAlgorithm, cmapi.RSAPSSKeyAlgorithm:
 		return rsaPrivateKeyMatchesSpec(pk, spec)
 	case cmapi.Ed25519KeyAlgorithm:
 		return ed25519PrivateKeyMatchesSpec(pk)
@@ -227,6 +227,54 @@ func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpe
 	if !reflect.DeepEqual(req.Spec.IssuerRef, spec.IssuerRef) {
 		violations = append(violations, "spec.issuerRef")
 	}
+	// RSA and RSAPSS keys can only be told apart by the CSR's signature
+	// algorithm. A mismatched key type is reported by PrivateKeyMatchesSpec.
+	if x509req.PublicKeyAlgorithm == x509.RSA {
+		wantPSS := spec.PrivateKey != nil && spec.PrivateKey.Algorithm == cmapi.RSAPSSKeyAlgorithm
+		if wantPSS != IsRSAPSSSignatureAlgorithm(x509req.SignatureAlgorithm) {
+			violations = append(violations, "spec.privateKey.algorithm")
+		}
+	}
+
+	matched, err := matchMSTemplate(x509req.Extensions, spec.MSTemplate)
+	if err != nil {
+		return nil, err
+	}
+	if !matched {
+		violations = append(violations, "spec.msTemplate")
+	}
+
+	matched, err = matchCertificatePolicies(x509req.Extensions, spec.CertificatePolicies)
+	if err != nil {
+		return nil, err
+	}
+	if !matched {
+		violations = append(violations, "spec.certificatePolicies")
+	}
+
+	matched, err = matchLegacyExtensions(x509req.Extensions, spec.LegacyExtensions)
+	if err != nil {
+		return nil, err
+	}
+	if !matched {
+		violations = append(violations, "spec.legacyExtensions")
+	}
+
+	matched, err = matchPolicyConstraints(x509req.Extensions, spec.PolicyConstraints)
+	if err != nil {
+		return nil, err
+	}
+	if !matched {
+		violations = append(violations, "spec.policyConstraints")
+	}
+
+	requestInhibitAnyPolicy, err := UnmarshalInhibitAnyPolicy(x509req.Extensions)
+	if err != nil {
+		return nil, err
+	}
+	if !reflect.DeepEqual(requestInhibitAnyPolicy, spec.InhibitAnyPolicy) {
+		violations = append(violations, "spec.inhibitAnyPolicy")
+	}
 
 	// TODO: check spec.EncodeBasicConstraintsInRequest and spec.EncodeUsagesInRequest
 
@@ -278,6 +326,90 @@ func matchOtherNames(extension []pkix.Extension, specOtherNames []cmapi.OtherNam
 	return true, nil
 }
 
+// matchMSTemplate returns true if the certificate template encoded in the
+// given extensions is the one in the spec. The spec's template is encoded and
+// decoded before comparing so that equivalent spellings of an OID match.
+func matchMSTemplate(extensions []pkix.Extension, specTemplate *cmapi.CertificateMSTemplate) (bool, error) {
+	requestTemplate, err := UnmarshalMSTemplate(extensions)
+	if err != nil {
+		return false, err
+	}
+
+	if specTemplate == nil || requestTemplate == nil {
+		return specTemplate == nil && requestTemplate == nil, nil
+	}
+
+	specExtension, err := MarshalMSTemplate(specTemplate)
+	if err != nil {
+		return false, err
+	}
+	specTemplate, err = UnmarshalMSTemplate([]pkix.Extension{specExtension})
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(requestTemplate, specTemplate), nil
+}
+
+// matchCertificatePolicies returns true if the certificate policies encoded in
+// the given extensions are the ones in the spec. The spec's policies are
+// encoded and decoded before comparing so that equivalent spellings of an OID
+// match.
+func matchCertificatePolicies(extensions []pkix.Extension, specPolicies []cmapi.CertificatePolicy) (bool, error) {
+	requestPolicies, err := UnmarshalCertificatePolicies(extensions)
+	if err != nil {
+		return false, err
+	}
+
+	if len(specPolicies) == 0 || len(requestPolicies) == 0 {
+		return len(specPolicies) == 0 && len(requestPolicies) == 0, nil
+	}
+
+	specExtension, err := MarshalCertificatePolicies(specPolicies)
+	if err != nil {
+		return false, err
+	}
+	specPolicies, err = UnmarshalCertificatePolicies([]pkix.Extension{specExtension})
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(requestPolicies, specPolicies), nil
+}
+
+// matchLegacyExtensions returns true if the legacy extensions encoded in the
+// given extensions are the ones in the spec. The spec's extensions are
+// encoded and decoded before comparing so that the order of the certificate
+// types does not matter.
+func matchLegacyExtensions(extensions []pkix.Extension, specLegacy *cmapi.CertificateLegacyExtensions) (bool, error) {
+	requestLegacy, err := UnmarshalLegacyExtensions(extensions)
+	if err != nil {
+		return false, err
+	}
+
+	specExtensions, err := MarshalLegacyExtensions(specLegacy)
+	if err != nil {
+		return false, err
+	}
+	specLegacy, err = UnmarshalLegacyExtensions(specExtensions)
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(requestLegacy, specLegacy), nil
+}
+
+// matchPolicyConstraints returns true if the policy constraints encoded in the
+// given extensions are the ones in the spec.
+func matchPolicyConstraints(extensions []pkix.Extension, specConstraints *cmapi.CertificatePolicyConstraints) (bool, error) {
+	requestConstraints, err := UnmarshalPolicyConstraints(extensions)
+	if err != nil {
+		return false, err
+	}
+
+	return reflect.DeepEqual(requestConstraints, specConstraints), nil
+}
+
 // FuzzyX509AltNamesMatchSpec will compare a X509 Certificate to a CertificateSpec
 // and return a list of 'violations' for any fields that do not match their counterparts.
 //
@@ -329,6 +461,52 @@ func FuzzyX509AltNamesMatchSpec(x509cert *x509.Certificate, spec cmapi.Certifica
 	return violations
 }
 
+// IssuedCertificateMatchesRequest returns a list of violations for any of the
+// common name, subject alternative names or key usages of a certificate
+// returned by an issuer which do not match the x509 CertificateRequest and the
+// requested key usages. It will return an empty list/ nil if there are no
+// violations found.
+//
+// The subject alternative names must match exactly. The common name is only
+// checked if one was requested, since some issuers promote a DNS name to be
+// the common name. The certificate may contain more key usages than were
+// requested, but must contain all of the requested ones. The key encipherment
+// usage is not required for non-RSA keys, as it has no meaning for them.
+func IssuedCertificateMatchesRequest(cert *x509.Certificate, req *x509.CertificateRequest, keyUsage x509.KeyUsage, extKeyUsage []x509.ExtKeyUsage) []string {
+	var violations []string
+
+	if req.Subject.CommonName != "" && cert.Subject.CommonName != req.Subject.CommonName {
+		violations = append(violations, "commonName")
+	}
+
+	if !util.EqualUnsorted(cert.DNSNames, req.DNSNames) {
+		violations = append(violations, "dnsNames")
+	}
+
+	if !util.EqualIPsUnsorted(cert.IPAddresses, req.IPAddresses) {
+		violations = append(violations, "ipAddresses")
+	}
+
+	if !util.EqualURLsUnsorted(cert.URIs, req.URIs) {
+		violations = append(violations, "uris")
+	}
+
+	if !util.EqualUnsorted(cert.EmailAddresses, req.EmailAddresses) {
+		violations = append(violations, "emailAddresses")
+	}
+
+	missingKeyUsage := keyUsage &^ cert.KeyUsage
+	if cert.PublicKeyAlgorithm != x509.RSA {
+		missingKeyUsage &^= x509.KeyUsageKeyEncipherment
+	}
+	extKeyUsages := sets.New(cert.ExtKeyUsage...)
+	if missingKeyUsage != 0 || (!extKeyUsages.Has(x509.ExtKeyUsageAny) && !extKeyUsages.HasAll(extKeyUsage...)) {
+		violations = append(violations, "usages")
+	}
+
+	return violations
+}
+
 func extractSANExtension(extensions []pkix.Extension) (pkix.Extension, error) {
 	oidExtensionSubjectAltName := []int{2, 5, 29, 17}
 
@@ -20,11 +20,13 @@ import (
 	"crypto"
 	"crypto/rand"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/asn1"
 	"reflect"
 	"testing"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -94,6 +96,16 @@ func TestPrivateKeyMatchesSpec(t *testing.T) {
 			key:          mustGenerateEd25519(t),
 			expectedAlgo: cmapi.Ed25519KeyAlgorithm,
 		},
+		"should match if keySize and algorithm are correct (RSAPSS)": {
+			key:          mustGenerateRSA(t, 2048),
+			expectedAlgo: cmapi.RSAPSSKeyAlgorithm,
+			expectedSize: 2048,
+		},
+		"should not match if keyAlgorithm is RSAPSS and the key is not an RSA key": {
+			key:          mustGenerateECDSA(t, pki.ECCurve256),
+			expectedAlgo: cmapi.RSAPSSKeyAlgorithm,
+			violations:   []string{"spec.privateKey.algorithm"},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -290,6 +302,400 @@ func TestRequestMatchesSpecSubject(t *testing.T) {
 	}
 }
 
+func TestRequestMatchesSpecMSTemplate(t *testing.T) {
+	templateByOID := &cmapi.CertificateMSTemplate{
+		OID:          "1.3.6.1.4.1.311.21.8.1.2",
+		MajorVersion: ptr.To[int32](100),
+		MinorVersion: ptr.To[int32](4),
+	}
+	csrWithTemplate := func(tmpl *cmapi.CertificateMSTemplate) []byte {
+		ext, err := pki.MarshalMSTemplate(tmpl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		csr, _, err := gen.CSR(x509.ECDSA, func(cr *x509.CertificateRequest) error {
+			cr.ExtraExtensions = append(cr.ExtraExtensions, ext)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return csr
+	}
+	csrWithoutTemplate, _, err := gen.CSR(x509.ECDSA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		specTemplate *cmapi.CertificateMSTemplate
+		x509CSR      []byte
+		violations   []string
+	}{
+		"no template in the spec or the CSR": {
+			x509CSR: csrWithoutTemplate,
+		},
+		"same template name": {
+			specTemplate: &cmapi.CertificateMSTemplate{Name: "WebServer"},
+			x509CSR:      csrWithTemplate(&cmapi.CertificateMSTemplate{Name: "WebServer"}),
+		},
+		"same template OID and version": {
+			specTemplate: templateByOID,
+			x509CSR:      csrWithTemplate(templateByOID),
+		},
+		"equivalent spelling of the template OID": {
+			specTemplate: &cmapi.CertificateMSTemplate{
+				OID:          "1.3.6.1.4.1.311.21.8.01.02",
+				MajorVersion: ptr.To[int32](100),
+				MinorVersion: ptr.To[int32](4),
+			},
+			x509CSR: csrWithTemplate(templateByOID),
+		},
+		"different template version": {
+			specTemplate: &cmapi.CertificateMSTemplate{
+				OID:          "1.3.6.1.4.1.311.21.8.1.2",
+				MajorVersion: ptr.To[int32](101),
+			},
+			x509CSR:    csrWithTemplate(templateByOID),
+			violations: []string{"spec.msTemplate"},
+		},
+		"template added to the spec": {
+			specTemplate: &cmapi.CertificateMSTemplate{Name: "WebServer"},
+			x509CSR:      csrWithoutTemplate,
+			violations:   []string{"spec.msTemplate"},
+		},
+		"template removed from the spec": {
+			x509CSR:    csrWithTemplate(&cmapi.CertificateMSTemplate{Name: "WebServer"}),
+			violations: []string{"spec.msTemplate"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			violations, err := pki.RequestMatchesSpec(
+				&cmapi.CertificateRequest{
+					Spec: cmapi.CertificateRequestSpec{
+						Request: test.x509CSR,
+					},
+				},
+				cmapi.CertificateSpec{
+					MSTemplate: test.specTemplate,
+				},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(violations, test.violations) {
+				t.Errorf("violations did not match, got=%s, exp=%s", violations, test.violations)
+			}
+		})
+	}
+}
+
+func TestRequestMatchesSpecCertificatePolicies(t *testing.T) {
+	policies := []cmapi.CertificatePolicy{
+		{OID: "2.23.140.1.2.1", CPSURI: "https://pki.example.com/cps"},
+	}
+	csrWithPolicies := func(policies []cmapi.CertificatePolicy) []byte {
+		ext, err := pki.MarshalCertificatePolicies(policies)
+		if err != nil {
+			t.Fatal(err)
+		}
+		csr, _, err := gen.CSR(x509.ECDSA, func(cr *x509.CertificateRequest) error {
+			cr.ExtraExtensions = append(cr.ExtraExtensions, ext)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return csr
+	}
+	csrWithoutPolicies, _, err := gen.CSR(x509.ECDSA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		specPolicies []cmapi.CertificatePolicy
+		x509CSR      []byte
+		violations   []string
+	}{
+		"no policies in the spec or the CSR": {
+			x509CSR: csrWithoutPolicies,
+		},
+		"same policies": {
+			specPolicies: policies,
+			x509CSR:      csrWithPolicies(policies),
+		},
+		"equivalent spelling of the policy OID": {
+			specPolicies: []cmapi.CertificatePolicy{{OID: "2.23.140.01.2.1", CPSURI: "https://pki.example.com/cps"}},
+			x509CSR:      csrWithPolicies(policies),
+		},
+		"different qualifiers": {
+			specPolicies: []cmapi.CertificatePolicy{{OID: "2.23.140.1.2.1"}},
+			x509CSR:      csrWithPolicies(policies),
+			violations:   []string{"spec.certificatePolicies"},
+		},
+		"policies added to the spec": {
+			specPolicies: policies,
+			x509CSR:      csrWithoutPolicies,
+			violations:   []string{"spec.certificatePolicies"},
+		},
+		"policies removed from the spec": {
+			x509CSR:    csrWithPolicies(policies),
+			violations: []string{"spec.certificatePolicies"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			violations, err := pki.RequestMatchesSpec(
+				&cmapi.CertificateRequest{
+					Spec: cmapi.CertificateRequestSpec{
+						Request: test.x509CSR,
+					},
+				},
+				cmapi.CertificateSpec{
+					CertificatePolicies: test.specPolicies,
+				},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(violations, test.violations) {
+				t.Errorf("violations did not match, got=%s, exp=%s", violations, test.violations)
+			}
+		})
+	}
+}
+
+func TestRequestMatchesSpecLegacyExtensions(t *testing.T) {
+	legacy := &cmapi.CertificateLegacyExtensions{
+		NetscapeComment:  "Issued for the legacy appliance",
+		NetscapeCertType: []cmapi.NetscapeCertType{cmapi.NetscapeCertTypeSSLClient, cmapi.NetscapeCertTypeSSLServer},
+	}
+	csrWithLegacyExtensions := func(legacy *cmapi.CertificateLegacyExtensions) []byte {
+		extensions, err := pki.MarshalLegacyExtensions(legacy)
+		if err != nil {
+			t.Fatal(err)
+		}
+		csr, _, err := gen.CSR(x509.ECDSA, func(cr *x509.CertificateRequest) error {
+			cr.ExtraExtensions = append(cr.ExtraExtensions, extensions...)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return csr
+	}
+	csrWithoutLegacyExtensions, _, err := gen.CSR(x509.ECDSA)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		specLegacy *cmapi.CertificateLegacyExtensions
+		x509CSR    []byte
+		violations []string
+	}{
+		"no legacy extensions in the spec or the CSR": {
+			x509CSR: csrWithoutLegacyExtensions,
+		},
+		"empty legacy extensions in the spec": {
+			specLegacy: &cmapi.CertificateLegacyExtensions{},
+			x509CSR:    csrWithoutLegacyExtensions,
+		},
+		"same legacy extensions": {
+			specLegacy: legacy,
+			x509CSR:    csrWithLegacyExtensions(legacy),
+		},
+		"netscape cert types in a different order": {
+			specLegacy: &cmapi.CertificateLegacyExtensions{
+				NetscapeComment:  "Issued for the legacy appliance",
+				NetscapeCertType: []cmapi.NetscapeCertType{cmapi.NetscapeCertTypeSSLServer, cmapi.NetscapeCertTypeSSLClient},
+			},
+			x509CSR: csrWithLegacyExtensions(legacy),
+		},
+		"different netscape comment": {
+			specLegacy: &cmapi.CertificateLegacyExtensions{
+				NetscapeComment:  "Issued for another appliance",
+				NetscapeCertType: []cmapi.NetscapeCertType{cmapi.NetscapeCertTypeSSLClient, cmapi.NetscapeCertTypeSSLServer},
+			},
+			x509CSR:    csrWithLegacyExtensions(legacy),
+			violations: []string{"spec.legacyExtensions"},
+		},
+		"legacy extensions added to the spec": {
+			specLegacy: legacy,
+			x509CSR:    csrWithoutLegacyExtensions,
+			violations: []string{"spec.legacyExtensions"},
+		},
+		"legacy extensions removed from the spec": {
+			x509CSR:    csrWithLegacyExtensions(legacy),
+			violations: []string{"spec.legacyExtensions"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			violations, err := pki.RequestMatchesSpec(
+				&cmapi.CertificateRequest{
+					Spec: cmapi.CertificateRequestSpec{
+						Request: test.x509CSR,
+					},
+				},
+				cmapi.CertificateSpec{
+					LegacyExtensions: test.specLegacy,
+				},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(violations, test.violations) {
+				t.Errorf("violations did not match, got=%s, exp=%s", violations, test.violations)
+			}
+		})
+	}
+}
+
+func TestRequestMatchesSpecSignatureAlgorithm(t *testing.T) {
+	rsaCSR, _, err := gen.CSR(x509.RSA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaPSSCSR, _, err := gen.CSR(x509.RSA, func(cr *x509.CertificateRequest) error {
+		cr.SignatureAlgorithm = x509.SHA256WithRSAPSS
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		algorithm  cmapi.PrivateKeyAlgorithm
+		x509CSR    []byte
+		violations []string
+	}{
+		"RSA spec with a PKCS #1 v1.5 signed CSR": {
+			algorithm: cmapi.RSAKeyAlgorithm,
+			x509CSR:   rsaCSR,
+		},
+		"RSAPSS spec with an RSASSA-PSS signed CSR": {
+			algorithm: cmapi.RSAPSSKeyAlgorithm,
+			x509CSR:   rsaPSSCSR,
+		},
+		"RSAPSS spec with a PKCS #1 v1.5 signed CSR": {
+			algorithm:  cmapi.RSAPSSKeyAlgorithm,
+			x509CSR:    rsaCSR,
+			violations: []string{"spec.privateKey.algorithm"},
+		},
+		"RSA spec with an RSASSA-PSS signed CSR": {
+			algorithm:  cmapi.RSAKeyAlgorithm,
+			x509CSR:    rsaPSSCSR,
+			violations: []string{"spec.privateKey.algorithm"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			violations, err := pki.RequestMatchesSpec(
+				&cmapi.CertificateRequest{
+					Spec: cmapi.CertificateRequestSpec{
+						Request: test.x509CSR,
+					},
+				},
+				cmapi.CertificateSpec{
+					PrivateKey: &cmapi.CertificatePrivateKey{
+						Algorithm: test.algorithm,
+					},
+				},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(violations, test.violations) {
+				t.Errorf("violations did not match, got=%s, exp=%s", violations, test.violations)
+			}
+		})
+	}
+}
+
+func TestIssuedCertificateMatchesRequest(t *testing.T) {
+	req := &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: "example.com"},
+		DNSNames: []string{"example.com", "www.example.com"},
+	}
+	keyUsage := x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+	extKeyUsage := []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+
+	tests := map[string]struct {
+		cert       *x509.Certificate
+		violations []string
+	}{
+		"should match if names and usages are the same": {
+			cert: &x509.Certificate{
+				PublicKeyAlgorithm: x509.RSA,
+				Subject:            pkix.Name{CommonName: "example.com"},
+				DNSNames:           []string{"www.example.com", "example.com"},
+				KeyUsage:           keyUsage,
+				ExtKeyUsage:        extKeyUsage,
+			},
+		},
+		"should match if the certificate has additional usages": {
+			cert: &x509.Certificate{
+				PublicKeyAlgorithm: x509.RSA,
+				Subject:            pkix.Name{CommonName: "example.com"},
+				DNSNames:           []string{"example.com", "www.example.com"},
+				KeyUsage:           keyUsage | x509.KeyUsageContentCommitment,
+				ExtKeyUsage:        []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+			},
+		},
+		"should match a non-RSA certificate without the key encipherment usage": {
+			cert: &x509.Certificate{
+				PublicKeyAlgorithm: x509.ECDSA,
+				Subject:            pkix.Name{CommonName: "example.com"},
+				DNSNames:           []string{"example.com", "www.example.com"},
+				KeyUsage:           x509.KeyUsageDigitalSignature,
+				ExtKeyUsage:        extKeyUsage,
+			},
+		},
+		"should not match if the common name and DNS names differ": {
+			cert: &x509.Certificate{
+				PublicKeyAlgorithm: x509.RSA,
+				Subject:            pkix.Name{CommonName: "other.com"},
+				DNSNames:           []string{"example.com", "other.com"},
+				KeyUsage:           keyUsage,
+				ExtKeyUsage:        extKeyUsage,
+			},
+			violations: []string{"commonName", "dnsNames"},
+		},
+		"should not match if the certificate has additional SANs": {
+			cert: &x509.Certificate{
+				PublicKeyAlgorithm: x509.RSA,
+				Subject:            pkix.Name{CommonName: "example.com"},
+				DNSNames:           []string{"example.com", "www.example.com"},
+				EmailAddresses:     []string{"admin@example.com"},
+				KeyUsage:           keyUsage,
+				ExtKeyUsage:        extKeyUsage,
+			},
+			violations: []string{"emailAddresses"},
+		},
+		"should not match if a requested usage is missing": {
+			cert: &x509.Certificate{
+				PublicKeyAlgorithm: x509.RSA,
+				Subject:            pkix.Name{CommonName: "example.com"},
+				DNSNames:           []string{"example.com", "www.example.com"},
+				KeyUsage:           x509.KeyUsageDigitalSignature,
+				ExtKeyUsage:        []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			},
+			violations: []string{"usages"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			violations := pki.IssuedCertificateMatchesRequest(test.cert, req, keyUsage, extKeyUsage)
+			if !reflect.DeepEqual(violations, test.violations) {
+				t.Errorf("violations did not match, got=%s, exp=%s", violations, test.violations)
+			}
+		})
+	}
+}
+
 func TestFuzzyX509AltNamesMatchSpec(t *testing.T) {
 	tests := map[string]struct {
 		x509       *x509.Certificate
@@ -479,3 +885,83 @@ func mustBuildCertificateRequest(t *testing.T, crt *cmapi.Certificate) *cmapi.Ce
 
 	return cr
 }
+
+func TestRequestMatchesSpecPolicyConstraints(t *testing.T) {
+	constraints := &cmapi.CertificatePolicyConstraints{RequireExplicitPolicy: ptr.To(int32(0))}
+	csrWithExtensions := func(constraints *cmapi.CertificatePolicyConstraints, inhibitAnyPolicy *int32) []byte {
+		var extensions []pkix.Extension
+		if constraints != nil {
+			extension, err := pki.MarshalPolicyConstraints(constraints)
+			if err != nil {
+				t.Fatal(err)
+			}
+			extensions = append(extensions, extension)
+		}
+		if inhibitAnyPolicy != nil {
+			extension, err := pki.MarshalInhibitAnyPolicy(*inhibitAnyPolicy)
+			if err != nil {
+				t.Fatal(err)
+			}
+			extensions = append(extensions, extension)
+		}
+		csr, _, err := gen.CSR(x509.ECDSA, func(cr *x509.CertificateRequest) error {
+			cr.ExtraExtensions = append(cr.ExtraExtensions, extensions...)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return csr
+	}
+
+	tests := map[string]struct {
+		specConstraints      *cmapi.CertificatePolicyConstraints
+		specInhibitAnyPolicy *int32
+		x509CSR              []byte
+		violations           []string
+	}{
+		"no policy constraints in the spec or the CSR": {
+			x509CSR: csrWithExtensions(nil, nil),
+		},
+		"same policy constraints and inhibit anyPolicy": {
+			specConstraints:      constraints,
+			specInhibitAnyPolicy: ptr.To(int32(0)),
+			x509CSR:              csrWithExtensions(constraints, ptr.To(int32(0))),
+		},
+		"different policy constraints": {
+			specConstraints: &cmapi.CertificatePolicyConstraints{RequireExplicitPolicy: ptr.To(int32(1))},
+			x509CSR:         csrWithExtensions(constraints, nil),
+			violations:      []string{"spec.policyConstraints"},
+		},
+		"inhibit anyPolicy added to the spec": {
+			specInhibitAnyPolicy: ptr.To(int32(0)),
+			x509CSR:              csrWithExtensions(nil, nil),
+			violations:           []string{"spec.inhibitAnyPolicy"},
+		},
+		"policy constraints and inhibit anyPolicy removed from the spec": {
+			x509CSR:    csrWithExtensions(constraints, ptr.To(int32(2))),
+			violations: []string{"spec.policyConstraints", "spec.inhibitAnyPolicy"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			violations, err := pki.RequestMatchesSpec(
+				&cmapi.CertificateRequest{
+					Spec: cmapi.CertificateRequestSpec{
+						Request: test.x509CSR,
+					},
+				},
+				cmapi.CertificateSpec{
+					PolicyConstraints: test.specConstraints,
+					InhibitAnyPolicy:  test.specInhibitAnyPolicy,
+				},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(violations, test.violations) {
+				t.Errorf("violations did not match, got=%s, exp=%s", violations, test.violations)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"k8s.io/utils/ptr"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+var (
+	// OIDExtensionMSCertificateTemplateName is the OID of the Microsoft
+	// Certificate Template Name extension, which selects an AD CS certificate
+	// template by its name.
+	OIDExtensionMSCertificateTemplateName = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2}
+
+	// OIDExtensionMSCertificateTemplate is the OID of the Microsoft
+	// Certificate Template Information extension, which selects an AD CS
+	// certificate template by its OID and version.
+	OIDExtensionMSCertificateTemplate = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 21, 7}
+)
+
+// msCertificateTemplate is the value of the Certificate Template Information
+// extension:
+//
+//	CertificateTemplate ::= SEQUENCE {
+//	    templateID              EncodedObjectID,
+//	    templateMajorVersion    TemplateVersion,
+//	    templateMinorVersion    TemplateVersion OPTIONAL
+//	}
+type msCertificateTemplate struct {
+	TemplateID   asn1.ObjectIdentifier
+	MajorVersion int32
+	MinorVersion int32 `asn1:"optional,default:-1"`
+}
+
+// ValidateMSTemplateName returns an error if the given certificate template
+// name cannot be encoded in the Certificate Template Name extension, which
+// stores the name as a BMPString.
+func ValidateMSTemplateName(name string) error {
+	if !utf8.ValidString(name) {
+		return errors.New("must be a valid UTF8 string")
+	}
+	for _, r := range name {
+		if r > 0xFFFF {
+			return fmt.Errorf("must only contain characters of the Basic Multilingual Plane, got %q", r)
+		}
+	}
+	return nil
+}
+
+// MarshalMSTemplate encodes the given certificate template as either the
+// Certificate Template Name extension, if the template has a name, or the
+// Certificate Template Information extension, if it has an OID.
+func MarshalMSTemplate(tmpl *cmapi.CertificateMSTemplate) (pkix.Extension, error) {
+	if tmpl.Name != "" {
+		if err := ValidateMSTemplateName(tmpl.Name); err != nil {
+			return pkix.Extension{}, fmt.Errorf("invalid certificate template name: %w", err)
+		}
+
+		// A BMPString is encoded in UCS-2, which is UTF-16 without surrogate
+		// pairs for the characters of the Basic Multilingual Plane.
+		codePoints := utf16.Encode([]rune(tmpl.Name))
+		bmp := make([]byte, 0, 2*len(codePoints))
+		for _, c := range codePoints {
+			bmp = append(bmp, byte(c>>8), byte(c))
+		}
+
+		value, err := asn1.Marshal(asn1.RawValue{
+			Class: asn1.ClassUniversal,
+			Tag:   asn1.TagBMPString,
+			Bytes: bmp,
+		})
+		if err != nil {
+			return pkix.Extension{}, err
+		}
+		return pkix.Extension{Id: OIDExtensionMSCertificateTemplateName, Value: value}, nil
+	}
+
+	oid, err := ParseObjectIdentifier(tmpl.OID)
+	if err != nil {
+		return pkix.Extension{}, fmt.Errorf("invalid certificate template oid: %w", err)
+	}
+
+	info := msCertificateTemplate{
+		TemplateID:   oid,
+		MajorVersion: ptr.Deref(tmpl.MajorVersion, 0),
+		MinorVersion: ptr.Deref(tmpl.MinorVersion, -1),
+	}
+
+	value, err := asn1.Marshal(info)
+	if err != nil {
+		return pkix.Extension{}, err
+	}
+	return pkix.Extension{Id: OIDExtensionMSCertificateTemplate, Value: value}, nil
+}
+
+// UnmarshalMSTemplate returns the certificate template encoded in the given
+// extensions, or nil if they don't contain either of the Microsoft certificate
+// template extensions.
+func UnmarshalMSTemplate(extensions []pkix.Extension) (*cmapi.CertificateMSTemplate, error) {
+	for _, ext := range extensions {
+		switch {
+		case ext.Id.Equal(OIDExtensionMSCertificateTemplateName):
+			var raw asn1.RawValue
+			if rest, err := asn1.Unmarshal(ext.Value, &raw); err != nil {
+				return nil, err
+			} else if len(rest) != 0 {
+				return nil, errors.New("x509: trailing data after certificate template name")
+			}
+			if raw.Class != asn1.ClassUniversal || raw.Tag != asn1.TagBMPString {
+				return nil, errors.New("x509: certificate template name is not a valid BMPString")
+			}
+
+			name, err := decodeBMPString(raw.Bytes)
+			if err != nil {
+				return nil, errors.New("x509: certificate template name is not a valid BMPString")
+			}
+			return &cmapi.CertificateMSTemplate{Name: name}, nil
+
+		case ext.Id.Equal(OIDExtensionMSCertificateTemplate):
+			info := msCertificateTemplate{MinorVersion: -1}
+			if rest, err := asn1.Unmarshal(ext.Value, &info); err != nil {
+				return nil, err
+			} else if len(rest) != 0 {
+				return nil, errors.New("x509: trailing data after certificate template information")
+			}
+
+			tmpl := &cmapi.CertificateMSTemplate{
+				OID:          info.TemplateID.String(),
+				MajorVersion: ptr.To(info.MajorVersion),
+			}
+			if info.MinorVersion >= 0 {
+				tmpl.MinorVersion = ptr.To(info.MinorVersion)
+			}
+			return tmpl, nil
+		}
+	}
+	return nil, nil
+}
+
+// decodeBMPString decodes the contents of an ASN.1 BMPString, which is
+// encoded in UCS-2.
+func decodeBMPString(b []byte) (string, error) {
+	if len(b)%2 != 0 {
+		return "", errors.New("odd length BMPString")
+	}
+
+	codePoints := make([]uint16, 0, len(b)/2)
+	for i := 0; i < len(b); i += 2 {
+		codePoints = append(codePoints, uint16(b[i])<<8|uint16(b[i+1]))
+	}
+	return string(utf16.Decode(codePoints)), nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestMarshalMSTemplate(t *testing.T) {
+	tests := map[string]struct {
+		tmpl        *cmapi.CertificateMSTemplate
+		expectedOID asn1.ObjectIdentifier
+		// expectedValue is only checked if set.
+		expectedValue []byte
+		expectedErr   bool
+	}{
+		"template name is encoded as a BMPString": {
+			tmpl:        &cmapi.CertificateMSTemplate{Name: "WebServer"},
+			expectedOID: OIDExtensionMSCertificateTemplateName,
+			expectedValue: []byte{
+				0x1e, 0x12,
+				0x00, 'W', 0x00, 'e', 0x00, 'b', 0x00, 'S', 0x00, 'e', 0x00, 'r', 0x00, 'v', 0x00, 'e', 0x00, 'r',
+			},
+		},
+		"template name outside of the Basic Multilingual Plane": {
+			tmpl:        &cmapi.CertificateMSTemplate{Name: "web-\U0001F512"},
+			expectedErr: true,
+		},
+		"template OID with a major and minor version": {
+			tmpl: &cmapi.CertificateMSTemplate{
+				OID:          "1.3.6.1.4.1.311.21.8.1.2",
+				MajorVersion: ptr.To[int32](100),
+				MinorVersion: ptr.To[int32](4),
+			},
+			expectedOID: OIDExtensionMSCertificateTemplate,
+			expectedValue: []byte{
+				0x30, 0x13,
+				0x06, 0x0b, 0x2b, 0x06, 0x01, 0x04, 0x01, 0x82, 0x37, 0x15, 0x08, 0x01, 0x02,
+				0x02, 0x01, 0x64,
+				0x02, 0x01, 0x04,
+			},
+		},
+		"template OID without a minor version": {
+			tmpl: &cmapi.CertificateMSTemplate{
+				OID:          "1.3.6.1.4.1.311.21.8.1.2",
+				MajorVersion: ptr.To[int32](100),
+			},
+			expectedOID: OIDExtensionMSCertificateTemplate,
+			expectedValue: []byte{
+				0x30, 0x10,
+				0x06, 0x0b, 0x2b, 0x06, 0x01, 0x04, 0x01, 0x82, 0x37, 0x15, 0x08, 0x01, 0x02,
+				0x02, 0x01, 0x64,
+			},
+		},
+		"invalid template OID": {
+			tmpl:        &cmapi.CertificateMSTemplate{OID: "1.3.foo", MajorVersion: ptr.To[int32](1)},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ext, err := MarshalMSTemplate(test.tmpl)
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedOID, ext.Id)
+			assert.False(t, ext.Critical)
+			if test.expectedValue != nil {
+				assert.Equal(t, test.expectedValue, ext.Value)
+			}
+
+			// The encoded template must decode to the original one.
+			decoded, err := UnmarshalMSTemplate([]pkix.Extension{ext})
+			require.NoError(t, err)
+			assert.Equal(t, test.tmpl, decoded)
+		})
+	}
+}
+
+func TestUnmarshalMSTemplate(t *testing.T) {
+	decoded, err := UnmarshalMSTemplate([]pkix.Extension{{Id: OIDExtensionKeyUsage, Value: []byte{0x03, 0x02, 0x05, 0xa0}}})
+	require.NoError(t, err)
+	assert.Nil(t, decoded, "extensions without a certificate template should decode to nil")
+
+	_, err = UnmarshalMSTemplate([]pkix.Extension{{Id: OIDExtensionMSCertificateTemplateName, Value: []byte{0x0c, 0x01, 'a'}}})
+	assert.Error(t, err, "a certificate template name which is not a BMPString should be rejected")
+}
+
+func TestGenerateCSRWithMSTemplate(t *testing.T) {
+	crt := &cmapi.Certificate{
+		Spec: cmapi.CertificateSpec{
+			CommonName: "example.com",
+			MSTemplate: &cmapi.CertificateMSTemplate{
+				OID:          "1.3.6.1.4.1.311.21.8.1.2",
+				MajorVersion: ptr.To[int32](100),
+				MinorVersion: ptr.To[int32](4),
+			},
+		},
+	}
+
+	csr, err := GenerateCSR(crt)
+	require.NoError(t, err)
+
+	decoded, err := UnmarshalMSTemplate(csr.ExtraExtensions)
+	require.NoError(t, err)
+	assert.Equal(t, crt.Spec.MSTemplate, decoded)
+}
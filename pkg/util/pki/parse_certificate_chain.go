@@ -67,13 +67,24 @@ func ParseSingleCertificateChainPEM(pembundle []byte) (PEMBundle, error) {
 // An error is returned if the passed bundle is not a valid single chain,
 // the bundle is malformed, or the chain is broken.
 func ParseSingleCertificateChain(certs []*x509.Certificate) (PEMBundle, error) {
+	chain, err := buildSingleCertificateChain(certs)
+	if err != nil {
+		return PEMBundle{}, err
+	}
+	return chain.toBundleAndCA()
+}
+
+// buildSingleCertificateChain de-duplicates the given certificates and links
+// them into a single chain, returning the node of the leaf certificate.
+// An error is returned if the certificates do not form a single chain.
+func buildSingleCertificateChain(certs []*x509.Certificate) (*chainNode, error) {
 	for _, cert := range certs {
 		if cert == nil {
-			return PEMBundle{}, errors.NewInvalidData("certificate chain contains nil certificate")
+			return nil, errors.NewInvalidData("certificate chain contains nil certificate")
 		}
 
 		if len(cert.Raw) == 0 {
-			return PEMBundle{}, errors.NewInvalidData("certificate chain contains certificate without Raw set")
+			return nil, errors.NewInvalidData("certificate chain contains certificate without Raw set")
 		}
 	}
 
@@ -95,7 +106,7 @@ func ParseSingleCertificateChain(certs []*x509.Certificate) (PEMBundle, error) {
 	// To prevent a malicious input from causing a DoS, we limit the number of unique
 	// certificates. This helps us avoid issues with O(n^2) time complexity in the algorithm below.
 	if len(certs) > 1000 {
-		return PEMBundle{}, errors.NewInvalidData("certificate chain is too long, must be less than 1000 certificates")
+		return nil, errors.NewInvalidData("certificate chain is too long, must be less than 1000 certificates")
 	}
 
 	// A certificate chain can be well described as a linked list. Here we build
@@ -143,12 +154,12 @@ func ParseSingleCertificateChain(certs []*x509.Certificate) (PEMBundle, error) {
 		// If no chains were merged in this pass, the chain can never be built as a
 		// single list. Error.
 		if !mergedTwoChains {
-			return PEMBundle{}, errors.NewInvalidData("certificate chain is malformed or broken")
+			return nil, errors.NewInvalidData("certificate chain is malformed or broken")
 		}
 	}
 
-	// There is only a single chain left at index 0. Return chain as PEM.
-	return chains[0].toBundleAndCA()
+	// There is only a single chain left at index 0.
+	return chains[0], nil
 }
 
 // toBundleAndCA will return the PEM bundle of this chain.
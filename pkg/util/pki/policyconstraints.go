@@ -0,0 +1,195 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math"
+
+	"k8s.io/utils/ptr"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+var (
+	// OIDExtensionPolicyConstraints is the OID of the Policy Constraints
+	// extension (RFC 5280, 4.2.1.11).
+	OIDExtensionPolicyConstraints = asn1.ObjectIdentifier{2, 5, 29, 36}
+
+	// OIDExtensionInhibitAnyPolicy is the OID of the Inhibit anyPolicy
+	// extension (RFC 5280, 4.2.1.14).
+	OIDExtensionInhibitAnyPolicy = asn1.ObjectIdentifier{2, 5, 29, 54}
+)
+
+// policyConstraints is the encoding of the Policy Constraints extension.
+// Absent skip counts are represented by -1, as a skip count of 0 is
+// meaningful.
+//
+//	PolicyConstraints ::= SEQUENCE {
+//	    requireExplicitPolicy   [0] SkipCerts OPTIONAL,
+//	    inhibitPolicyMapping    [1] SkipCerts OPTIONAL }
+//
+//	SkipCerts ::= INTEGER (0..MAX)
+type policyConstraints struct {
+	RequireExplicitPolicy int64 `asn1:"optional,default:-1,tag:0"`
+	InhibitPolicyMapping  int64 `asn1:"optional,default:-1,tag:1"`
+}
+
+// ValidateSkipCerts returns an error if the given skip count cannot be encoded
+// in the Policy Constraints or Inhibit anyPolicy extensions.
+func ValidateSkipCerts(skipCerts int32) error {
+	if skipCerts < 0 {
+		return errors.New("must not be negative")
+	}
+	return nil
+}
+
+// MarshalPolicyConstraints encodes the given constraints as the Policy
+// Constraints extension, which is always marked as critical.
+func MarshalPolicyConstraints(constraints *cmapi.CertificatePolicyConstraints) (pkix.Extension, error) {
+	pc := policyConstraints{RequireExplicitPolicy: -1, InhibitPolicyMapping: -1}
+
+	if constraints.RequireExplicitPolicy == nil && constraints.InhibitPolicyMapping == nil {
+		// RFC 5280, 4.2.1.11: conforming CAs MUST NOT issue certificates
+		// where policy constraints is an empty sequence.
+		return pkix.Extension{}, errors.New("at least one of requireExplicitPolicy or inhibitPolicyMapping must be set")
+	}
+	if constraints.RequireExplicitPolicy != nil {
+		if err := ValidateSkipCerts(*constraints.RequireExplicitPolicy); err != nil {
+			return pkix.Extension{}, fmt.Errorf("invalid requireExplicitPolicy: %w", err)
+		}
+		pc.RequireExplicitPolicy = int64(*constraints.RequireExplicitPolicy)
+	}
+	if constraints.InhibitPolicyMapping != nil {
+		if err := ValidateSkipCerts(*constraints.InhibitPolicyMapping); err != nil {
+			return pkix.Extension{}, fmt.Errorf("invalid inhibitPolicyMapping: %w", err)
+		}
+		pc.InhibitPolicyMapping = int64(*constraints.InhibitPolicyMapping)
+	}
+
+	value, err := asn1.Marshal(pc)
+	if err != nil {
+		return pkix.Extension{}, err
+	}
+	return pkix.Extension{Id: OIDExtensionPolicyConstraints, Critical: true, Value: value}, nil
+}
+
+// UnmarshalPolicyConstraints returns the constraints encoded in the Policy
+// Constraints extension found in the given extensions, or nil if they don't
+// contain the extension.
+func UnmarshalPolicyConstraints(extensions []pkix.Extension) (*cmapi.CertificatePolicyConstraints, error) {
+	for _, ext := range extensions {
+		if !ext.Id.Equal(OIDExtensionPolicyConstraints) {
+			continue
+		}
+
+		var seq asn1.RawValue
+		if rest, err := asn1.Unmarshal(ext.Value, &seq); err != nil {
+			return nil, err
+		} else if len(rest) != 0 {
+			return nil, errors.New("x509: trailing data after policy constraints")
+		}
+		if seq.Class != asn1.ClassUniversal || seq.Tag != asn1.TagSequence {
+			return nil, errors.New("x509: policy constraints is not a sequence")
+		}
+
+		// The skip counts are decoded one by one, as a default value cannot
+		// distinguish an absent skip count from a negative one.
+		constraints := &cmapi.CertificatePolicyConstraints{}
+		rest := seq.Bytes
+		for len(rest) > 0 {
+			var field asn1.RawValue
+			var err error
+			if rest, err = asn1.Unmarshal(rest, &field); err != nil {
+				return nil, err
+			}
+
+			var target **int32
+			switch {
+			case field.Class == asn1.ClassContextSpecific && field.Tag == 0 && constraints.RequireExplicitPolicy == nil:
+				target = &constraints.RequireExplicitPolicy
+			case field.Class == asn1.ClassContextSpecific && field.Tag == 1 && constraints.InhibitPolicyMapping == nil:
+				target = &constraints.InhibitPolicyMapping
+			default:
+				return nil, errors.New("x509: policy constraints contains an unexpected field")
+			}
+
+			var value int64
+			if _, err := asn1.UnmarshalWithParams(field.FullBytes, &value, fmt.Sprintf("tag:%d", field.Tag)); err != nil {
+				return nil, err
+			}
+			skipCerts, err := unmarshalSkipCerts(value)
+			if err != nil {
+				return nil, fmt.Errorf("x509: invalid policy constraints: %w", err)
+			}
+			*target = &skipCerts
+		}
+		return constraints, nil
+	}
+	return nil, nil
+}
+
+// MarshalInhibitAnyPolicy encodes the given skip count as the Inhibit
+// anyPolicy extension, which is always marked as critical.
+func MarshalInhibitAnyPolicy(skipCerts int32) (pkix.Extension, error) {
+	if err := ValidateSkipCerts(skipCerts); err != nil {
+		return pkix.Extension{}, fmt.Errorf("invalid inhibitAnyPolicy: %w", err)
+	}
+
+	value, err := asn1.Marshal(int64(skipCerts))
+	if err != nil {
+		return pkix.Extension{}, err
+	}
+	return pkix.Extension{Id: OIDExtensionInhibitAnyPolicy, Critical: true, Value: value}, nil
+}
+
+// UnmarshalInhibitAnyPolicy returns the skip count encoded in the Inhibit
+// anyPolicy extension found in the given extensions, or nil if they don't
+// contain the extension.
+func UnmarshalInhibitAnyPolicy(extensions []pkix.Extension) (*int32, error) {
+	for _, ext := range extensions {
+		if !ext.Id.Equal(OIDExtensionInhibitAnyPolicy) {
+			continue
+		}
+
+		var value int64
+		if rest, err := asn1.Unmarshal(ext.Value, &value); err != nil {
+			return nil, err
+		} else if len(rest) != 0 {
+			return nil, errors.New("x509: trailing data after inhibit anyPolicy")
+		}
+
+		skipCerts, err := unmarshalSkipCerts(value)
+		if err != nil {
+			return nil, fmt.Errorf("x509: invalid inhibit anyPolicy: %w", err)
+		}
+		return ptr.To(skipCerts), nil
+	}
+	return nil, nil
+}
+
+// unmarshalSkipCerts converts a decoded skip count to the type used by the
+// Certificate spec.
+func unmarshalSkipCerts(value int64) (int32, error) {
+	if value < 0 || value > math.MaxInt32 {
+		return 0, fmt.Errorf("skip count %d is out of range", value)
+	}
+	return int32(value), nil
+}
@@ -0,0 +1,185 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestMarshalPolicyConstraints(t *testing.T) {
+	tests := map[string]struct {
+		constraints   *cmapi.CertificatePolicyConstraints
+		expectedValue []byte
+		expectedErr   bool
+	}{
+		"requireExplicitPolicy of zero": {
+			constraints:   &cmapi.CertificatePolicyConstraints{RequireExplicitPolicy: ptr.To(int32(0))},
+			expectedValue: []byte{0x30, 0x03, 0x80, 0x01, 0x00},
+		},
+		"inhibitPolicyMapping only": {
+			constraints:   &cmapi.CertificatePolicyConstraints{InhibitPolicyMapping: ptr.To(int32(2))},
+			expectedValue: []byte{0x30, 0x03, 0x81, 0x01, 0x02},
+		},
+		"both skip counts": {
+			constraints: &cmapi.CertificatePolicyConstraints{
+				RequireExplicitPolicy: ptr.To(int32(1)),
+				InhibitPolicyMapping:  ptr.To(int32(300)),
+			},
+			expectedValue: []byte{0x30, 0x07, 0x80, 0x01, 0x01, 0x81, 0x02, 0x01, 0x2c},
+		},
+		"no skip counts": {
+			constraints: &cmapi.CertificatePolicyConstraints{},
+			expectedErr: true,
+		},
+		"negative skip count": {
+			constraints: &cmapi.CertificatePolicyConstraints{RequireExplicitPolicy: ptr.To(int32(-1))},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ext, err := MarshalPolicyConstraints(test.constraints)
+			if test.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, OIDExtensionPolicyConstraints, ext.Id)
+			assert.True(t, ext.Critical)
+			assert.Equal(t, test.expectedValue, ext.Value)
+
+			// The encoded constraints must decode to the original ones.
+			decoded, err := UnmarshalPolicyConstraints([]pkix.Extension{ext})
+			require.NoError(t, err)
+			assert.Equal(t, test.constraints, decoded)
+		})
+	}
+}
+
+func TestUnmarshalPolicyConstraints(t *testing.T) {
+	decoded, err := UnmarshalPolicyConstraints([]pkix.Extension{{Id: OIDExtensionKeyUsage, Value: []byte{0x03, 0x02, 0x05, 0xa0}}})
+	require.NoError(t, err)
+	assert.Nil(t, decoded, "extensions without policy constraints should decode to nil")
+
+	_, err = UnmarshalPolicyConstraints([]pkix.Extension{{Id: OIDExtensionPolicyConstraints, Value: []byte{0x30, 0x03, 0x80, 0x01, 0xff}}})
+	assert.Error(t, err, "negative skip counts should be rejected")
+
+	_, err = UnmarshalPolicyConstraints([]pkix.Extension{{Id: OIDExtensionPolicyConstraints, Value: []byte{0x02, 0x01, 0x01}}})
+	assert.Error(t, err, "policy constraints which are not a sequence should be rejected")
+
+	_, err = UnmarshalPolicyConstraints([]pkix.Extension{{Id: OIDExtensionPolicyConstraints, Value: []byte{0x30, 0x06, 0x80, 0x01, 0x01, 0x80, 0x01, 0x02}}})
+	assert.Error(t, err, "repeated skip counts should be rejected")
+}
+
+func TestMarshalInhibitAnyPolicy(t *testing.T) {
+	for _, skipCerts := range []int32{0, 1, 128} {
+		ext, err := MarshalInhibitAnyPolicy(skipCerts)
+		require.NoError(t, err)
+
+		assert.Equal(t, OIDExtensionInhibitAnyPolicy, ext.Id)
+		assert.True(t, ext.Critical)
+
+		decoded, err := UnmarshalInhibitAnyPolicy([]pkix.Extension{ext})
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To(skipCerts), decoded)
+	}
+
+	ext, err := MarshalInhibitAnyPolicy(0)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x02, 0x01, 0x00}, ext.Value)
+
+	_, err = MarshalInhibitAnyPolicy(-1)
+	assert.Error(t, err)
+
+	decoded, err := UnmarshalInhibitAnyPolicy(nil)
+	require.NoError(t, err)
+	assert.Nil(t, decoded, "extensions without inhibit anyPolicy should decode to nil")
+}
+
+func TestPolicyConstraintsAreIssued(t *testing.T) {
+	constraints := &cmapi.CertificatePolicyConstraints{
+		RequireExplicitPolicy: ptr.To(int32(0)),
+		InhibitPolicyMapping:  ptr.To(int32(1)),
+	}
+
+	issue := func(t *testing.T, isCA bool) *x509.Certificate {
+		crt := &cmapi.Certificate{
+			Spec: cmapi.CertificateSpec{
+				CommonName:        "example.com",
+				IsCA:              isCA,
+				PrivateKey:        &cmapi.CertificatePrivateKey{Algorithm: cmapi.ECDSAKeyAlgorithm},
+				PolicyConstraints: constraints,
+				InhibitAnyPolicy:  ptr.To(int32(0)),
+			},
+		}
+
+		pk, err := GenerateECPrivateKey(ECCurve256)
+		require.NoError(t, err)
+		csr, err := GenerateCSR(crt)
+		require.NoError(t, err)
+		csrDER, err := EncodeCSR(csr, pk)
+		require.NoError(t, err)
+		csr, err = x509.ParseCertificateRequest(csrDER)
+		require.NoError(t, err)
+
+		template, err := CertificateTemplateFromCSR(csr, CertificateTemplateValidateAndOverrideBasicConstraints(isCA, nil))
+		require.NoError(t, err)
+		_, cert, err := SignCertificate(template, template, pk.Public(), pk)
+		require.NoError(t, err)
+		return cert
+	}
+
+	t.Run("the extensions are copied to CA certificates", func(t *testing.T) {
+		cert := issue(t, true)
+
+		decodedConstraints, err := UnmarshalPolicyConstraints(cert.Extensions)
+		require.NoError(t, err)
+		assert.Equal(t, constraints, decodedConstraints)
+
+		decodedInhibitAnyPolicy, err := UnmarshalInhibitAnyPolicy(cert.Extensions)
+		require.NoError(t, err)
+		assert.Equal(t, ptr.To(int32(0)), decodedInhibitAnyPolicy)
+
+		for _, ext := range cert.Extensions {
+			if ext.Id.Equal(OIDExtensionPolicyConstraints) || ext.Id.Equal(OIDExtensionInhibitAnyPolicy) {
+				assert.True(t, ext.Critical, "extension %s should be critical", ext.Id)
+			}
+		}
+	})
+
+	t.Run("the extensions are not copied to other certificates", func(t *testing.T) {
+		cert := issue(t, false)
+
+		decodedConstraints, err := UnmarshalPolicyConstraints(cert.Extensions)
+		require.NoError(t, err)
+		assert.Nil(t, decodedConstraints)
+
+		decodedInhibitAnyPolicy, err := UnmarshalInhibitAnyPolicy(cert.Extensions)
+		require.NoError(t, err)
+		assert.Nil(t, decodedInhibitAnyPolicy)
+	})
+}
@@ -32,6 +32,8 @@ type RenewalTimeFunc func(time.Time, time.Time, *metav1.Duration, *int32) *metav
 // will be the computed period before expiry based on the renewBeforePercentage
 // value and certificate lifetime.
 // Default renewal time is 2/3 through certificate's lifetime.
+// notBefore and notAfter must be read from the issued certificate, so that the
+// lifetime accounts for any backdating of notBefore by the issuer.
 func RenewalTime(notBefore, notAfter time.Time, renewBefore *metav1.Duration, renewBeforePercentage *int32) *metav1.Time {
 	// 1. Calculate how long before expiry a cert should be renewed
 	actualDuration := notAfter.Sub(notBefore)
@@ -90,6 +90,33 @@ func TestRenewalTime(t *testing.T) {
 			notAfter:            now.Add(time.Hour * 24).Add(time.Second * -1),
 			expectedRenewalTime: &metav1.Time{Time: now.Add(time.Hour * 16).Add(time.Second * -1)},
 		},
+		// Issuers may backdate the notBefore of a certificate to tolerate
+		// clock skew, which lengthens its actual duration. The renewal time
+		// is computed from the actual duration, as read from the issued
+		// certificate, rather than from the requested one.
+		"backdated cert, spec.renewBefore is not set": {
+			notBefore:           now.Add(time.Hour * -1),
+			notAfter:            now.Add(time.Hour * 23),
+			expectedRenewalTime: &metav1.Time{Time: now.Add(time.Hour * 15)},
+		},
+		"backdated cert, spec.renewBefore is set": {
+			notBefore:           now.Add(time.Hour * -1),
+			notAfter:            now.Add(time.Hour * 23),
+			renewBefore:         &metav1.Duration{Duration: time.Hour * 20},
+			expectedRenewalTime: &metav1.Time{Time: now.Add(time.Hour * 3)},
+		},
+		"backdated cert, spec.renewBefore is longer than the requested but not the actual duration": {
+			notBefore:           now.Add(time.Hour * -1),
+			notAfter:            now.Add(time.Hour * 23),
+			renewBefore:         &metav1.Duration{Duration: time.Hour*23 + time.Minute*30},
+			expectedRenewalTime: &metav1.Time{Time: now.Add(time.Minute * -30)},
+		},
+		"backdated cert, spec.renewBeforePercentage is set": {
+			notBefore:           now.Add(time.Hour * -1),
+			notAfter:            now.Add(time.Hour * 23),
+			renewBeforePct:      ptr.To(int32(25)),
+			expectedRenewalTime: &metav1.Time{Time: now.Add(time.Hour * 17)},
+		},
 	}
 	for n, s := range tests {
 		t.Run(n, func(t *testing.T) {
@@ -0,0 +1,73 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestSPKIPinSHA256(t *testing.T) {
+	pk, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spki, err := x509.MarshalPKIXPublicKey(pk.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum := sha256.Sum256(spki)
+	expected := base64.StdEncoding.EncodeToString(sum[:])
+
+	issue := func(serial int64, notAfter time.Time) *x509.Certificate {
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: "example.com"},
+			NotBefore:    time.Now(),
+			NotAfter:     notAfter,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, pk.Public(), pk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cert
+	}
+
+	first := issue(1, time.Now().Add(time.Hour))
+	if pin := SPKIPinSHA256(first); pin != expected {
+		t.Errorf("unexpected pin, exp=%s, got=%s", expected, pin)
+	}
+
+	// A renewed certificate using the same key must keep the same pin.
+	renewed := issue(2, time.Now().Add(2*time.Hour))
+	if pin := SPKIPinSHA256(renewed); pin != expected {
+		t.Errorf("expected pin to be stable across renewals, exp=%s, got=%s", expected, pin)
+	}
+}
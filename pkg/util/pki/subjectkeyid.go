@@ -0,0 +1,77 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto"
+	"crypto/sha1" // #nosec G505 -- SHA-1 is mandated by RFC 5280 for subject key identifiers
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// SubjectKeyIdentifier returns the subject key identifier of the given public
+// key, derived using one of the methods described in RFC 5280 section 4.2.1.2.
+// Both methods hash the value of the BIT STRING subjectPublicKey, excluding
+// its tag, length and number of unused bits.
+func SubjectKeyIdentifier(pub crypto.PublicKey, method cmapi.SubjectKeyIdentifierMethod) ([]byte, error) {
+	spkiDER, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal public key: %w", err)
+	}
+
+	var spki struct {
+		Algorithm        pkix.AlgorithmIdentifier
+		SubjectPublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(spkiDER, &spki); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal subject public key info: %w", err)
+	}
+
+	sum := sha1.Sum(spki.SubjectPublicKey.Bytes) // #nosec G401 -- SHA-1 is mandated by RFC 5280 for subject key identifiers
+
+	switch method {
+	case cmapi.SubjectKeyIdentifierMethodSHA1:
+		return sum[:], nil
+	case cmapi.SubjectKeyIdentifierMethodTruncatedSHA1:
+		// A four-bit type field with the value 0100 followed by the least
+		// significant 60 bits of the hash.
+		ski := make([]byte, 8)
+		copy(ski, sum[len(sum)-8:])
+		ski[0] = 0x40 | (ski[0] & 0x0f)
+		return ski, nil
+	default:
+		return nil, fmt.Errorf("unsupported subject key identifier method %q", method)
+	}
+}
+
+// AuthorityKeyIdentifier returns the subject key identifier of the given PEM
+// encoded CA certificate, to be used as the authority key identifier of
+// certificates which are signed or cross-signed by that CA.
+func AuthorityKeyIdentifier(caPEM []byte) ([]byte, error) {
+	ca, err := DecodeX509CertificateBytes(caPEM)
+	if err != nil {
+		return nil, err
+	}
+	if len(ca.SubjectKeyId) == 0 {
+		return nil, fmt.Errorf("certificate %q does not have a subject key identifier", ca.Subject)
+	}
+	return ca.SubjectKeyId, nil
+}
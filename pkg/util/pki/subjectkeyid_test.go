@@ -0,0 +1,160 @@
+/*
+Copyright The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// testSubjectKeyIdentifierPublicKey is a P-256 public key whose method 1
+// subject key identifier, as computed by OpenSSL, is
+// FA:DC:B9:81:FE:60:6B:D4:79:92:C6:AA:2B:53:7F:97:7F:3A:69:00.
+const testSubjectKeyIdentifierPublicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEQkf/HUOunBWXPEtASs6qZl61YnLP
+bMP2Y1btBPuJfNCm4k+YL2696vVUdVFkZ5Ocyq/WydByPfwTIRb/ZDQYhA==
+-----END PUBLIC KEY-----
+`
+
+func TestSubjectKeyIdentifier(t *testing.T) {
+	block, _ := pem.Decode([]byte(testSubjectKeyIdentifierPublicKey))
+	require.NotNil(t, block)
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		method      cmapi.SubjectKeyIdentifierMethod
+		expected    []byte
+		expectedErr string
+	}{
+		"method 1 is the full SHA-1 hash of the public key": {
+			method: cmapi.SubjectKeyIdentifierMethodSHA1,
+			expected: []byte{
+				0xfa, 0xdc, 0xb9, 0x81, 0xfe, 0x60, 0x6b, 0xd4, 0x79, 0x92,
+				0xc6, 0xaa, 0x2b, 0x53, 0x7f, 0x97, 0x7f, 0x3a, 0x69, 0x00,
+			},
+		},
+		"method 2 is the type field 0100 followed by the least significant 60 bits of the hash": {
+			method:   cmapi.SubjectKeyIdentifierMethodTruncatedSHA1,
+			expected: []byte{0x4b, 0x53, 0x7f, 0x97, 0x7f, 0x3a, 0x69, 0x00},
+		},
+		"unknown methods are rejected": {
+			method:      "SHA256",
+			expectedErr: `unsupported subject key identifier method "SHA256"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ski, err := SubjectKeyIdentifier(pub, test.method)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, ski)
+		})
+	}
+}
+
+func TestAuthorityKeyIdentifier(t *testing.T) {
+	sk, err := GenerateECPrivateKey(256)
+	require.NoError(t, err)
+
+	selfSign := func(template *x509.Certificate) ([]byte, *x509.Certificate) {
+		template.SerialNumber = big.NewInt(1)
+		template.NotBefore = time.Now()
+		template.NotAfter = template.NotBefore.Add(time.Hour)
+		certPEM, cert, err := SignCertificate(template, template, sk.Public(), sk)
+		require.NoError(t, err)
+		return certPEM, cert
+	}
+
+	rootSKI := []byte{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a}
+	rootPEM, _ := selfSign(&x509.Certificate{
+		Subject:               pkix.Name{CommonName: "external-root"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		SubjectKeyId:          rootSKI,
+	})
+	leafPEM, _ := selfSign(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "leaf"},
+	})
+
+	tests := map[string]struct {
+		caPEM       []byte
+		expected    []byte
+		expectedErr string
+	}{
+		"the subject key identifier of the CA is returned": {
+			caPEM:    rootPEM,
+			expected: rootSKI,
+		},
+		"certificates without a subject key identifier are rejected": {
+			caPEM:       leafPEM,
+			expectedErr: `certificate "CN=leaf" does not have a subject key identifier`,
+		},
+		"invalid PEM is rejected": {
+			caPEM:       []byte("not a certificate"),
+			expectedErr: "error decoding certificate PEM block",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			aki, err := AuthorityKeyIdentifier(test.caPEM)
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, aki)
+		})
+	}
+
+	t.Run("self-signed certificates use the authority key identifier of the external CA", func(t *testing.T) {
+		aki, err := AuthorityKeyIdentifier(rootPEM)
+		require.NoError(t, err)
+
+		_, cert := selfSign(&x509.Certificate{
+			Subject:               pkix.Name{CommonName: "intermediate"},
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			AuthorityKeyId:        aki,
+		})
+		assert.Equal(t, rootSKI, cert.AuthorityKeyId)
+
+		// The extension is a SEQUENCE holding the key identifier as the
+		// context-specific, implicitly tagged [0] OCTET STRING.
+		var akiExtension []byte
+		for _, ext := range cert.Extensions {
+			if ext.Id.Equal([]int{2, 5, 29, 35}) {
+				akiExtension = ext.Value
+			}
+		}
+		assert.Equal(t, append([]byte{0x30, 0x0c, 0x80, 0x0a}, rootSKI...), akiExtension)
+	})
+}
@@ -16,7 +16,11 @@ limitations under the License.
 
 package pki
 
-import cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+import (
+	"crypto/x509"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
 
 // staticTemporarySerialNumber is a fixed serial number we use for temporary certificates
 const staticTemporarySerialNumber = "1234567890"
@@ -53,6 +57,9 @@ func GenerateLocallySignedTemporaryCertificate(crt *cmapi.Certificate, pkData []
 		return nil, err
 	}
 	template.Subject.SerialNumber = staticTemporarySerialNumber
+	// The throwaway CA has an ECDSA key, so it cannot honour a request for an
+	// RSASSA-PSS signature.
+	template.SignatureAlgorithm = x509.UnknownSignatureAlgorithm
 
 	signeeKey, err := DecodePrivateKeyBytes(pkData)
 	if err != nil {
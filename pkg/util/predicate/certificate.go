@@ -17,8 +17,11 @@ limitations under the License.
 package predicate
 
 import (
+	"slices"
+
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
@@ -44,3 +47,36 @@ func CertificateNextPrivateKeySecretName(name string) Func {
 		return *crt.Status.NextPrivateKeySecretName == name
 	}
 }
+
+// CertificateIssuerRef returns a predicate that used to filter Certificates
+// to only those whose 'spec.issuerRef' references a cert-manager issuer of the
+// given kind with one of the given names. An empty issuerRef kind is treated
+// as referencing an Issuer.
+func CertificateIssuerRef(kind string, names ...string) Func {
+	return func(obj runtime.Object) bool {
+		crt := obj.(*cmapi.Certificate)
+		ref := crt.Spec.IssuerRef
+		if ref.Group != "" && ref.Group != certmanager.GroupName {
+			return false
+		}
+		refKind := ref.Kind
+		if refKind == "" {
+			refKind = cmapi.IssuerKind
+		}
+		return refKind == kind && slices.Contains(names, ref.Name)
+	}
+}
+
+// ExtractIssuerReference returns an ExtractorFunc which selects the
+// Certificates that reference an issuer of the given kind, either by its name
+// or by its `cert-manager.io/issuer-alias` annotation.
+func ExtractIssuerReference(kind string) ExtractorFunc {
+	return func(obj runtime.Object) Func {
+		iss := obj.(cmapi.GenericIssuer)
+		names := []string{iss.GetName()}
+		if alias, ok := iss.GetAnnotations()[cmapi.IssuerAliasAnnotationKey]; ok {
+			names = append(names, alias)
+		}
+		return CertificateIssuerRef(kind, names...)
+	}
+}
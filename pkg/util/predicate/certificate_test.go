@@ -22,6 +22,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 )
 
 func TestCertificateSecretName(t *testing.T) {
@@ -92,3 +93,62 @@ func TestCertificateNextPrivateKeySecretName(t *testing.T) {
 		})
 	}
 }
+
+func TestCertificateIssuerRef(t *testing.T) {
+	certWithIssuerRef := func(ref cmmeta.ObjectReference) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			Spec: cmapi.CertificateSpec{IssuerRef: ref},
+		}
+	}
+	tests := map[string]struct {
+		kind     string
+		names    []string
+		cert     *cmapi.Certificate
+		expected bool
+	}{
+		"returns true if kind and name match": {
+			kind:     cmapi.ClusterIssuerKind,
+			names:    []string{"abc"},
+			cert:     certWithIssuerRef(cmmeta.ObjectReference{Name: "abc", Kind: cmapi.ClusterIssuerKind, Group: "cert-manager.io"}),
+			expected: true,
+		},
+		"returns true if an empty kind and group reference an Issuer": {
+			kind:     cmapi.IssuerKind,
+			names:    []string{"abc"},
+			cert:     certWithIssuerRef(cmmeta.ObjectReference{Name: "abc"}),
+			expected: true,
+		},
+		"returns true if any of the names match": {
+			kind:     cmapi.IssuerKind,
+			names:    []string{"abc", "alias"},
+			cert:     certWithIssuerRef(cmmeta.ObjectReference{Name: "alias", Kind: cmapi.IssuerKind}),
+			expected: true,
+		},
+		"returns false if kind does not match": {
+			kind:     cmapi.ClusterIssuerKind,
+			names:    []string{"abc"},
+			cert:     certWithIssuerRef(cmmeta.ObjectReference{Name: "abc"}),
+			expected: false,
+		},
+		"returns false if name does not match": {
+			kind:     cmapi.IssuerKind,
+			names:    []string{"abc"},
+			cert:     certWithIssuerRef(cmmeta.ObjectReference{Name: "abcd"}),
+			expected: false,
+		},
+		"returns false for an external issuer": {
+			kind:     cmapi.IssuerKind,
+			names:    []string{"abc"},
+			cert:     certWithIssuerRef(cmmeta.ObjectReference{Name: "abc", Kind: cmapi.IssuerKind, Group: "example.com"}),
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := CertificateIssuerRef(test.kind, test.names...)(test.cert)
+			if got != test.expected {
+				t.Errorf("unexpected response: got=%t, exp=%t", got, test.expected)
+			}
+		})
+	}
+}
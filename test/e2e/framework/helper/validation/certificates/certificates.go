@@ -32,6 +32,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -44,7 +45,7 @@ type ValidationFunc func(certificate *cmapi.Certificate, secret *corev1.Secret)
 
 // ExpectValidKeysInSecret checks that the secret contains valid keys
 func ExpectValidKeysInSecret(_ *cmapi.Certificate, secret *corev1.Secret) error {
-	validKeys := []string{corev1.TLSPrivateKeyKey, corev1.TLSCertKey, cmmeta.TLSCAKey, cmapi.CertificateOutputFormatDERKey, cmapi.CertificateOutputFormatCombinedPEMKey}
+	validKeys := []string{corev1.TLSPrivateKeyKey, corev1.TLSCertKey, cmmeta.TLSCAKey, cmapi.CertificateOutputFormatDERKey, cmapi.CertificateOutputFormatCombinedPEMKey, cmapi.CertificateOutputFormatPKCS7Key}
 	nbValidKeys := 0
 	for k := range secret.Data {
 		for _, k2 := range validKeys {
@@ -414,6 +415,18 @@ func ExpectValidAdditionalOutputFormats(certificate *cmapi.Certificate, secret *
 				} else {
 					return fmt.Errorf("expected additional output format CombinedPEM key %s to be present in secret", cmapi.CertificateOutputFormatCombinedPEMKey)
 				}
+			case cmapi.CertificateOutputFormatPKCS7:
+				if p7b, ok := secret.Data[cmapi.CertificateOutputFormatPKCS7Key]; ok {
+					expectedP7b, err := internalcertificates.OutputFormatPKCS7(secret.Data[corev1.TLSCertKey])
+					if err != nil {
+						return fmt.Errorf("failed to encode expected PKCS#7 certificate chain: %w", err)
+					}
+					if !bytes.Equal(p7b, expectedP7b) {
+						return fmt.Errorf("expected additional output format PKCS7 %s to contain the PKCS#7 encoded certificate chain", cmapi.CertificateOutputFormatPKCS7Key)
+					}
+				} else {
+					return fmt.Errorf("expected additional output format PKCS7 key %s to be present in secret", cmapi.CertificateOutputFormatPKCS7Key)
+				}
 
 			default:
 				return fmt.Errorf("unknown additional output format %s", f.Type)
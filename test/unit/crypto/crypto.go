@@ -19,8 +19,10 @@ package crypto
 import (
 	"crypto"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -290,3 +292,41 @@ func MustCreateCert(t *testing.T, pkData []byte, spec *cmapi.Certificate) []byte
 
 	return certData
 }
+
+// MustCreateCertificateChain returns the PEM encoded leaf, intermediate and
+// self-signed root certificates of a certificate chain.
+func MustCreateCertificateChain(t *testing.T) (leaf, intermediate, root []byte) {
+	newKey := func() crypto.Signer {
+		pk, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pk
+	}
+	newTemplate := func(serial int64, commonName string, isCA bool) *x509.Certificate {
+		return &x509.Certificate{
+			SerialNumber:          big.NewInt(serial),
+			Subject:               pkix.Name{CommonName: commonName},
+			NotBefore:             time.Now(),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  isCA,
+			BasicConstraintsValid: true,
+		}
+	}
+	rootPK, intermediatePK, leafPK := newKey(), newKey(), newKey()
+
+	rootTemplate := newTemplate(1, "root", true)
+	root, rootCert, err := pki.SignCertificate(rootTemplate, rootTemplate, rootPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intermediate, intermediateCert, err := pki.SignCertificate(newTemplate(2, "intermediate", true), rootCert, intermediatePK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, _, err = pki.SignCertificate(newTemplate(3, "leaf", false), intermediateCert, leafPK.Public(), intermediatePK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return leaf, intermediate, root
+}
@@ -54,6 +54,12 @@ func SetCertificateIssuer(o cmmeta.ObjectReference) CertificateModifier {
 	}
 }
 
+func SetCertificateCanaryIssuer(o cmmeta.ObjectReference) CertificateModifier {
+	return func(c *v1.Certificate) {
+		c.Spec.CanaryIssuerRef = &o
+	}
+}
+
 func SetCertificateDNSNames(dnsNames ...string) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.DNSNames = dnsNames
@@ -114,6 +120,12 @@ func SetCertificateKeyEncoding(keyEncoding v1.PrivateKeyEncoding) CertificateMod
 	}
 }
 
+func SetCertificateKeyRotationPolicy(rotationPolicy v1.PrivateKeyRotationPolicy) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.PrivateKey.RotationPolicy = rotationPolicy
+	}
+}
+
 func SetCertificateSecretName(secretName string) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.SecretName = secretName
@@ -142,6 +154,18 @@ func SetCertificateRenewBefore(renewBefore *metav1.Duration) CertificateModifier
 	}
 }
 
+func SetCertificateIssueAfter(issueAfter metav1.Time) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.IssueAfter = &issueAfter
+	}
+}
+
+func SetCertificateIssueAhead(issueAhead *metav1.Duration) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.IssueAhead = issueAhead
+	}
+}
+
 func SetCertificateNextPrivateKeySecretName(name string) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Status.NextPrivateKeySecretName = &name
@@ -292,6 +316,54 @@ func SetCertificateAdditionalOutputFormats(additionalOutputFormats ...v1.Certifi
 	}
 }
 
+func SetCertificateAdditionalSecrets(additionalSecrets ...v1.CertificateAdditionalSecret) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.AdditionalSecrets = additionalSecrets
+	}
+}
+
+func SetCertificateCACertificatePolicy(policy v1.CACertificatePolicy) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.CACertificatePolicy = policy
+	}
+}
+
+func SetCertificateExpiredCertificatePolicy(policy v1.ExpiredCertificatePolicy) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.ExpiredCertificatePolicy = policy
+	}
+}
+
+func SetCertificateSecretDeletionPolicy(policy v1.SecretDeletionPolicy) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.SecretDeletionPolicy = policy
+	}
+}
+
+func SetCertificateMaxChainDepth(depth int32) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.MaxChainDepth = &depth
+	}
+}
+
+func SetCertificateFinalizers(finalizers ...string) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Finalizers = finalizers
+	}
+}
+
+func SetCertificateDeletionTimestamp(deletionTimestamp metav1.Time) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.DeletionTimestamp = &deletionTimestamp
+	}
+}
+
+func SetCertificateChainTruncation(anchor v1.CertificateChainTruncation) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.ChainTruncation = &anchor
+	}
+}
+
 func SetCertificateKeystore(keystores *v1.CertificateKeystores) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.Keystores = keystores
@@ -110,6 +110,18 @@ func SetChallengeURL(s string) ChallengeModifier {
 	}
 }
 
+func SetChallengeSolver(s cmacme.ACMEChallengeSolver) ChallengeModifier {
+	return func(ch *cmacme.Challenge) {
+		ch.Spec.Solver = s
+	}
+}
+
+func SetChallengeSolverStatus(s *cmacme.ChallengeSolverStatus) ChallengeModifier {
+	return func(ch *cmacme.Challenge) {
+		ch.Status.Solver = s
+	}
+}
+
 func SetChallengeProcessing(b bool) ChallengeModifier {
 	return func(ch *cmacme.Challenge) {
 		ch.Status.Processing = b
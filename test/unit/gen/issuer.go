@@ -439,6 +439,24 @@ func SetIssuerVenafi(a v1.VenafiIssuer) IssuerModifier {
 	}
 }
 
+func SetIssuerGoogleCAS(a v1.GoogleCASIssuer) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().GoogleCAS = &a
+	}
+}
+
+func SetIssuerTerminalErrors(matchers ...v1.TerminalErrorMatcher) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().TerminalErrors = matchers
+	}
+}
+
+func SetIssuerUsagePolicy(policy v1.IssuerUsagePolicy) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().UsagePolicy = &policy
+	}
+}
+
 func AddIssuerCondition(c v1.IssuerCondition) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		iss.GetStatus().Conditions = append(iss.GetStatus().Conditions, c)
@@ -450,3 +468,9 @@ func SetIssuerNamespace(namespace string) IssuerModifier {
 		iss.GetObjectMeta().Namespace = namespace
 	}
 }
+
+func SetIssuerAnnotations(annotations map[string]string) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetObjectMeta().Annotations = annotations
+	}
+}